@@ -0,0 +1,141 @@
+package analysis
+
+import (
+	"fmt"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// Eccentricities returns the eccentricity of every node of a connected graph: the largest number of hops from it to
+// another node. It returns an error if g has no nodes or is disconnected.
+func Eccentricities(g *model.UndirectedGraph) (map[model.Node]int, error) {
+	if len(g.Nodes) == 0 {
+		return nil, fmt.Errorf("the graph has no nodes")
+	}
+	eccentricities := make(map[model.Node]int, len(g.Nodes))
+	for node := range g.Nodes {
+		distances := hopDistances(g, node)
+		if len(distances) != len(g.Nodes) {
+			return nil, fmt.Errorf("the graph is not connected")
+		}
+		for _, distance := range distances {
+			eccentricities[node] = max(eccentricities[node], distance)
+		}
+	}
+	return eccentricities, nil
+}
+
+// Diameter returns the largest eccentricity of a connected graph, the number of hops of its longest shortest path. It
+// returns an error if g has no nodes or is disconnected.
+func Diameter(g *model.UndirectedGraph) (int, error) {
+	eccentricities, err := Eccentricities(g)
+	if err != nil {
+		return 0, err
+	}
+	diameter := 0
+	for _, eccentricity := range eccentricities {
+		diameter = max(diameter, eccentricity)
+	}
+	return diameter, nil
+}
+
+// Radius returns the smallest eccentricity of a connected graph, that of its centers. It returns an error if g has no
+// nodes or is disconnected.
+func Radius(g *model.UndirectedGraph) (int, error) {
+	eccentricities, err := Eccentricities(g)
+	if err != nil {
+		return 0, err
+	}
+	radius := len(g.Nodes)
+	for _, eccentricity := range eccentricities {
+		radius = min(radius, eccentricity)
+	}
+	return radius, nil
+}
+
+// AverageShortestPathLength returns the mean number of hops between two distinct nodes of a connected graph, 0 for a
+// single node. It returns an error if g has no nodes or is disconnected.
+func AverageShortestPathLength(g *model.UndirectedGraph) (float64, error) {
+	if len(g.Nodes) == 0 {
+		return 0, fmt.Errorf("the graph has no nodes")
+	}
+	if len(g.Nodes) == 1 {
+		return 0, nil
+	}
+	total := 0
+	for node := range g.Nodes {
+		distances := hopDistances(g, node)
+		if len(distances) != len(g.Nodes) {
+			return 0, fmt.Errorf("the graph is not connected")
+		}
+		for _, distance := range distances {
+			total += distance
+		}
+	}
+	n := float64(len(g.Nodes))
+	return float64(total) / (n * (n - 1)), nil
+}
+
+// Density returns the fraction of the n(n - 1)/2 possible edges of g that exist, self-loops counted as edges, or 0 if
+// g has fewer than two nodes.
+func Density(g *model.UndirectedGraph) float64 {
+	n := float64(len(g.Nodes))
+	if n < 2 {
+		return 0
+	}
+	return 2 * float64(g.NumberOfEdges()) / (n * (n - 1))
+}
+
+// LocalClusteringCoefficient returns the fraction of the pairs of neighbors of node that are adjacent, ignoring
+// self-loops, or 0 if node has fewer than two neighbors or is not in g.
+func LocalClusteringCoefficient(g *model.UndirectedGraph, node model.Node) float64 {
+	neighbors := make([]model.Node, 0, len(g.Edges[node]))
+	for _, neighbor := range g.Edges[node] {
+		if neighbor != node {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+	if len(neighbors) < 2 {
+		return 0
+	}
+	links := 0
+	for i := 0; i < len(neighbors); i++ {
+		for j := i + 1; j < len(neighbors); j++ {
+			if g.HasEdge(neighbors[i], neighbors[j]) {
+				links++
+			}
+		}
+	}
+	return 2 * float64(links) / float64(len(neighbors)*(len(neighbors)-1))
+}
+
+// AverageClustering returns the mean of the local clustering coefficients of the nodes of g, those with fewer than two
+// neighbors counting as 0, or 0 if g has no nodes. A Watts-Strogatz small world keeps the high average clustering of
+// its lattice while its average shortest path length drops.
+func AverageClustering(g *model.UndirectedGraph) float64 {
+	if len(g.Nodes) == 0 {
+		return 0
+	}
+	total := 0.0
+	for node := range g.Nodes {
+		total += LocalClusteringCoefficient(g, node)
+	}
+	return total / float64(len(g.Nodes))
+}
+
+// hopDistances returns the number of hops from source to every node it reaches.
+func hopDistances(g *model.UndirectedGraph, source model.Node) map[model.Node]int {
+	distances := map[model.Node]int{source: 0}
+	queue := []model.Node{source}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range g.Edges[node] {
+			if _, seen := distances[neighbor]; !seen {
+				distances[neighbor] = distances[node] + 1
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	return distances
+}
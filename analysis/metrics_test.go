@@ -0,0 +1,96 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestDistances(t *testing.T) {
+	path := model.PathGraph(5)
+	diameter, err := Diameter(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	radius, _ := Radius(path)
+	average, _ := AverageShortestPathLength(path)
+	// The 20 ordered pairs of P5 are 8 at distance 1, 6 at 2, 4 at 3 and 2 at 4
+	if diameter != 4 || radius != 2 || math.Abs(average-2) > 1e-12 {
+		t.Errorf("Expected a diameter of 4, a radius of 2 and an average of 2, but got %d, %d and %v", diameter, radius, average)
+	}
+	eccentricities, _ := Eccentricities(path)
+	if eccentricities[0] != 4 || eccentricities[2] != 2 {
+		t.Errorf("Expected the eccentricities 4 and 2, but got %v", eccentricities)
+	}
+
+	complete := model.CompleteGraph(6)
+	if d, _ := Diameter(complete); d != 1 {
+		t.Errorf("Expected a diameter of 1, but got %d", d)
+	}
+	if average, _ := AverageShortestPathLength(model.TrivialGraph()); average != 0 {
+		t.Errorf("Expected 0 for a single node, but got %v", average)
+	}
+
+	disconnected := model.PathGraph(3)
+	disconnected.AddNode(9)
+	for name, err := range map[string]error{
+		"Diameter": func() error { _, err := Diameter(disconnected); return err }(),
+		"Radius":   func() error { _, err := Radius(model.NullGraph()); return err }(),
+		"Average":  func() error { _, err := AverageShortestPathLength(disconnected); return err }(),
+	} {
+		if err == nil {
+			t.Errorf("Expected an error from %s", name)
+		}
+	}
+}
+
+func TestDensityAndClustering(t *testing.T) {
+	if d := Density(model.CompleteGraph(5)); d != 1 {
+		t.Errorf("Expected a density of 1, but got %v", d)
+	}
+	if d := Density(model.CycleGraph(5)); d != 0.5 {
+		t.Errorf("Expected a density of 0.5, but got %v", d)
+	}
+	if d := Density(model.TrivialGraph()); d != 0 {
+		t.Errorf("Expected a density of 0, but got %v", d)
+	}
+
+	// A triangle with a pendant node on 0 and a self-loop on 1
+	g := &model.UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 2}, {0, 3}, {1, 1}})
+	for node, expected := range map[model.Node]float64{0: 1.0 / 3, 1: 1, 2: 1, 3: 0, 7: 0} {
+		if c := LocalClusteringCoefficient(g, node); math.Abs(c-expected) > 1e-12 {
+			t.Errorf("Expected a clustering of %v for node %d, but got %v", expected, node, c)
+		}
+	}
+	if c := AverageClustering(g); math.Abs(c-7.0/12) > 1e-12 {
+		t.Errorf("Expected an average clustering of 7/12, but got %v", c)
+	}
+	if c := AverageClustering(model.NullGraph()); c != 0 {
+		t.Errorf("Expected 0 for an empty graph, but got %v", c)
+	}
+}
+
+func TestSmallWorld(t *testing.T) {
+	lattice, err := model.WattsStrogatzGraph(500, 10, 0, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	smallWorld, err := model.WattsStrogatzGraph(500, 10, 0.05, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	latticePath, _ := AverageShortestPathLength(lattice)
+	smallWorldPath, err := AverageShortestPathLength(smallWorld)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The ring lattice with k = 10 has a clustering of 3(k - 2) / 4(k - 1) = 2/3
+	if c := AverageClustering(lattice); math.Abs(c-2.0/3) > 1e-12 {
+		t.Errorf("Expected a clustering of 2/3, but got %v", c)
+	}
+	if AverageClustering(smallWorld) < 0.5 || smallWorldPath > latticePath/2 {
+		t.Errorf("Expected a high clustering and short paths, but got %v and %v against %v", AverageClustering(smallWorld), smallWorldPath, latticePath)
+	}
+}
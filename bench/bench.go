@@ -0,0 +1,211 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jmCodeCraft/go-network/centrality"
+	"github.com/jmCodeCraft/go-network/experiments"
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// Library is the name under which the workloads of this module are reported.
+const Library = "go-network"
+
+/*
+Workload is an operation whose running time is measured on a graph, such as a breadth-first search.
+
+Setup prepares a run on a graph and returns the function that is timed, so that the work that must not be measured,
+such as converting the graph to the representation of another library, is done once. Workloads of other libraries,
+for example gonum, are compared with those of this module by giving them the same Name and their own Library.
+*/
+type Workload struct {
+	Name    string
+	Library string
+	Setup   func(g *model.UndirectedGraph) (func() error, error)
+}
+
+// Config controls how long every workload is measured: it runs at least MinRuns times and for at least MinDuration.
+type Config struct {
+	MinRuns     int
+	MinDuration time.Duration
+	// Seed is passed to the Generate function of every graph.
+	Seed int64
+}
+
+// DefaultConfig runs every workload for at least a second and 5 runs.
+var DefaultConfig = Config{MinRuns: 5, MinDuration: time.Second, Seed: 1}
+
+// Result is the running time of a workload on a graph.
+type Result struct {
+	Graph    string        `json:"graph"`
+	Nodes    int           `json:"nodes"`
+	Edges    int           `json:"edges"`
+	Workload string        `json:"workload"`
+	Library  string        `json:"library"`
+	Runs     int           `json:"runs"`
+	Mean     time.Duration `json:"mean_ns"`
+	Median   time.Duration `json:"median_ns"`
+	Min      time.Duration `json:"min_ns"`
+}
+
+// StandardGraphs returns, for every size, a Barabási-Albert graph with 3 edges per node, a Watts-Strogatz graph with 6
+// neighbors and a rewiring probability of 0.1, and a G(n, p) graph of average degree 6.
+func StandardGraphs(sizes ...int) []experiments.GeneratorSpec {
+	var specs []experiments.GeneratorSpec
+	for _, n := range sizes {
+		n := n
+		specs = append(specs,
+			experiments.GeneratorSpec{
+				Name:       fmt.Sprintf("ba-%d", n),
+				Parameters: map[string]float64{"n": float64(n), "m": 3},
+				Generate:   func(seed int64) (*model.UndirectedGraph, error) { return model.BarabasiAlbertGraph(n, 3, seed) },
+			},
+			experiments.GeneratorSpec{
+				Name:       fmt.Sprintf("ws-%d", n),
+				Parameters: map[string]float64{"n": float64(n), "k": 6, "p": 0.1},
+				Generate:   func(seed int64) (*model.UndirectedGraph, error) { return model.WattsStrogatzGraph(n, 6, 0.1, seed) },
+			},
+			experiments.GeneratorSpec{
+				Name:       fmt.Sprintf("gnp-%d", n),
+				Parameters: map[string]float64{"n": float64(n), "p": 6 / float64(n-1)},
+				Generate:   func(seed int64) (*model.UndirectedGraph, error) { return model.GnpRandomGraph(n, 6/float64(n-1), seed) },
+			},
+		)
+	}
+	return specs
+}
+
+// StandardWorkloads returns the workloads of this module: a breadth-first search from the smallest node, PageRank
+// with a damping of 0.85 to a tolerance of 1e-6, and the connected components.
+func StandardWorkloads() []Workload {
+	return []Workload{
+		{Name: "bfs", Library: Library, Setup: func(g *model.UndirectedGraph) (func() error, error) {
+			start, found := model.Node(0), false
+			for node := range g.Nodes {
+				if !found || node < start {
+					start, found = node, true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("the graph has no nodes")
+			}
+			return func() error {
+				model.BFS(g, start, nil)
+				return nil
+			}, nil
+		}},
+		{Name: "pagerank", Library: Library, Setup: func(g *model.UndirectedGraph) (func() error, error) {
+			return func() error {
+				_, err := centrality.PageRank(g, 0.85, 100, 1e-6)
+				return err
+			}, nil
+		}},
+		{Name: "components", Library: Library, Setup: func(g *model.UndirectedGraph) (func() error, error) {
+			return func() error {
+				model.ConnectedComponents(g)
+				return nil
+			}, nil
+		}},
+	}
+}
+
+/*
+Run measures every workload on every graph.
+
+Parameters:
+- graphs: The graphs, each generated once with config.Seed.
+- workloads: The workloads, such as StandardWorkloads, possibly with those of other libraries.
+- config: How long to measure, such as DefaultConfig.
+
+Returns:
+- results: One result per graph and workload, in the order given.
+- err: An error if config asks for no run, a graph cannot be generated, or a workload fails.
+*/
+func Run(graphs []experiments.GeneratorSpec, workloads []Workload, config Config) ([]Result, error) {
+	if config.MinRuns < 1 {
+		return nil, fmt.Errorf("the minimum number of runs must be positive, got %d", config.MinRuns)
+	}
+	var results []Result
+	for _, spec := range graphs {
+		if spec.Generate == nil {
+			return nil, fmt.Errorf("graph %q has no Generate function", spec.Name)
+		}
+		g, err := spec.Generate(config.Seed)
+		if err != nil {
+			return nil, fmt.Errorf("generating graph %q failed: %w", spec.Name, err)
+		}
+		for _, workload := range workloads {
+			result, err := measure(g, workload, config)
+			if err != nil {
+				return nil, fmt.Errorf("workload %s of %s on graph %q failed: %w", workload.Name, workload.Library, spec.Name, err)
+			}
+			result.Graph = spec.Name
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// measure times the runs of a workload on g.
+func measure(g *model.UndirectedGraph, workload Workload, config Config) (Result, error) {
+	result := Result{Nodes: len(g.Nodes), Edges: g.NumberOfEdges(), Workload: workload.Name, Library: workload.Library}
+	run, err := workload.Setup(g)
+	if err != nil {
+		return result, err
+	}
+	var durations []time.Duration
+	var total time.Duration
+	for len(durations) < config.MinRuns || total < config.MinDuration {
+		start := time.Now()
+		if err := run(); err != nil {
+			return result, err
+		}
+		elapsed := time.Since(start)
+		durations = append(durations, elapsed)
+		total += elapsed
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	result.Runs = len(durations)
+	result.Mean = total / time.Duration(len(durations))
+	result.Median = durations[len(durations)/2]
+	result.Min = durations[0]
+	return result, nil
+}
+
+// WriteJSON writes the results as indented JSON, durations in nanoseconds.
+func WriteJSON(w io.Writer, results []Result) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(results); err != nil {
+		return fmt.Errorf("error encoding results: %w", err)
+	}
+	return nil
+}
+
+// WriteTable writes one aligned row per result, with its mean relative to the fastest library on the same graph and
+// workload.
+func WriteTable(w io.Writer, results []Result) error {
+	fastest := make(map[[2]string]time.Duration)
+	for _, result := range results {
+		key := [2]string{result.Graph, result.Workload}
+		if best, ok := fastest[key]; !ok || result.Mean < best {
+			fastest[key] = result.Mean
+		}
+	}
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "graph\tnodes\tedges\tworkload\tlibrary\truns\tmean\tmedian\tmin\trelative")
+	for _, result := range results {
+		relative := 1.0
+		if best := fastest[[2]string{result.Graph, result.Workload}]; best > 0 {
+			relative = float64(result.Mean) / float64(best)
+		}
+		fmt.Fprintf(table, "%s\t%d\t%d\t%s\t%s\t%d\t%v\t%v\t%v\t%.2fx\n", result.Graph, result.Nodes, result.Edges, result.Workload,
+			result.Library, result.Runs, result.Mean, result.Median, result.Min, relative)
+	}
+	return table.Flush()
+}
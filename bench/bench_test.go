@@ -0,0 +1,88 @@
+package bench
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestRun(t *testing.T) {
+	// A second library whose BFS fails on graphs of more than 150 nodes
+	other := Workload{Name: "bfs", Library: "other", Setup: func(g *model.UndirectedGraph) (func() error, error) {
+		return func() error {
+			if len(g.Nodes) > 150 {
+				return fmt.Errorf("too large")
+			}
+			return nil
+		}, nil
+	}}
+	config := Config{MinRuns: 3, Seed: 1}
+	results, err := Run(StandardGraphs(100), append(StandardWorkloads(), other), config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(results) != 12 {
+		t.Fatalf("Expected 3 graphs times 4 workloads, but got %d results", len(results))
+	}
+	var names []string
+	for _, result := range results[:4] {
+		names = append(names, result.Library+"/"+result.Workload)
+		if result.Graph != "ba-100" || result.Nodes != 100 || result.Runs < 3 || result.Min > result.Median || result.Min > result.Mean {
+			t.Errorf("Unexpected result %+v", result)
+		}
+	}
+	if expected := []string{"go-network/bfs", "go-network/pagerank", "go-network/components", "other/bfs"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v, but got %v", expected, names)
+	}
+
+	var table bytes.Buffer
+	if err := WriteTable(&table, results); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(table.String()), "\n"); len(lines) != 13 || !strings.Contains(lines[0], "relative") {
+		t.Errorf("Expected a header and 12 rows, but got %s", table.String())
+	}
+	var buffer bytes.Buffer
+	if err := WriteJSON(&buffer, results); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var decoded []Result
+	if err := json.Unmarshal(buffer.Bytes(), &decoded); err != nil || !reflect.DeepEqual(decoded, results) {
+		t.Errorf("Expected the results to survive a JSON round trip, but got %v", err)
+	}
+
+	if _, err := Run(StandardGraphs(200), []Workload{other}, config); err == nil {
+		t.Errorf("Expected an error for a failing workload")
+	}
+	if _, err := Run(StandardGraphs(100), StandardWorkloads(), Config{}); err == nil {
+		t.Errorf("Expected an error for no runs")
+	}
+}
+
+// BenchmarkStandard runs the standard workloads under go test -bench.
+func BenchmarkStandard(b *testing.B) {
+	for _, spec := range StandardGraphs(1000, 10000) {
+		g, err := spec.Generate(DefaultConfig.Seed)
+		if err != nil {
+			b.Fatalf("Unexpected error: %v", err)
+		}
+		for _, workload := range StandardWorkloads() {
+			run, err := workload.Setup(g)
+			if err != nil {
+				b.Fatalf("Unexpected error: %v", err)
+			}
+			b.Run(spec.Name+"/"+workload.Name, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					if err := run(); err != nil {
+						b.Fatalf("Unexpected error: %v", err)
+					}
+				}
+			})
+		}
+	}
+}
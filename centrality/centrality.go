@@ -0,0 +1,209 @@
+package centrality
+
+import (
+	"sort"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// adjacency holds the nodes of a graph in ascending order, with their neighbors along and against the edges. Both
+// are the same map for an undirected graph.
+type adjacency struct {
+	nodes    []model.Node
+	out, in  map[model.Node][]model.Node
+	directed bool
+}
+
+func undirectedAdjacency(g *model.UndirectedGraph) adjacency {
+	return adjacency{nodes: sortedNodes(g.Nodes), out: g.Edges, in: g.Edges}
+}
+
+func directedAdjacency(g *model.DirectedGraph) adjacency {
+	return adjacency{nodes: sortedNodes(g.Nodes), out: g.Successors, in: g.Predecessors, directed: true}
+}
+
+func sortedNodes(set map[model.Node]bool) []model.Node {
+	nodes := make([]model.Node, 0, len(set))
+	for node := range set {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return nodes
+}
+
+// Degree returns the degree of every node of an UndirectedGraph, divided by n - 1 if normalized.
+func Degree(g *model.UndirectedGraph, normalized bool) map[model.Node]float64 {
+	degrees := make(map[model.Node]float64, len(g.Nodes))
+	for node := range g.Nodes {
+		degrees[node] = float64(g.NodeDegree(node))
+	}
+	return scaleDegrees(degrees, normalized)
+}
+
+// InDegree returns the number of edges entering every node of a DirectedGraph, divided by n - 1 if normalized.
+func InDegree(g *model.DirectedGraph, normalized bool) map[model.Node]float64 {
+	degrees := make(map[model.Node]float64, len(g.Nodes))
+	for node := range g.Nodes {
+		degrees[node] = float64(g.InDegree(node))
+	}
+	return scaleDegrees(degrees, normalized)
+}
+
+// OutDegree returns the number of edges leaving every node of a DirectedGraph, divided by n - 1 if normalized.
+func OutDegree(g *model.DirectedGraph, normalized bool) map[model.Node]float64 {
+	degrees := make(map[model.Node]float64, len(g.Nodes))
+	for node := range g.Nodes {
+		degrees[node] = float64(g.OutDegree(node))
+	}
+	return scaleDegrees(degrees, normalized)
+}
+
+func scaleDegrees(degrees map[model.Node]float64, normalized bool) map[model.Node]float64 {
+	if normalized && len(degrees) > 1 {
+		for node := range degrees {
+			degrees[node] /= float64(len(degrees) - 1)
+		}
+	}
+	return degrees
+}
+
+/*
+Closeness computes the closeness centrality of every node of an UndirectedGraph: how short its paths to the other
+nodes are.
+
+Parameters:
+- g: The graph.
+- normalized: Whether to scale the closeness of a node by the fraction of the other nodes it reaches, so that nodes
+of small components do not look central (the Wasserman and Faust correction).
+
+Returns:
+- closeness: For every node reaching r - 1 other nodes at a total distance d, (r - 1) / d, times (r - 1) / (n - 1) if
+normalized. Nodes reaching no other node have 0.
+
+Description:
+The distances are counted in edges, with a breadth-first search from every node, in O(n (n + m)) time.
+*/
+func Closeness(g *model.UndirectedGraph, normalized bool) map[model.Node]float64 {
+	return closeness(undirectedAdjacency(g), normalized)
+}
+
+// DirectedCloseness computes the closeness centrality of every node of a DirectedGraph from the distances of the paths
+// reaching it, as Closeness does for undirected graphs. Use it on the Reverse of the graph for outgoing paths.
+func DirectedCloseness(g *model.DirectedGraph, normalized bool) map[model.Node]float64 {
+	return closeness(directedAdjacency(g), normalized)
+}
+
+func closeness(a adjacency, normalized bool) map[model.Node]float64 {
+	result := make(map[model.Node]float64, len(a.nodes))
+	for _, node := range a.nodes {
+		distances := breadthFirst(a.in, node)
+		total := 0
+		for _, distance := range distances {
+			total += distance
+		}
+		if total == 0 {
+			result[node] = 0
+			continue
+		}
+		reached := float64(len(distances) - 1)
+		result[node] = reached / float64(total)
+		if normalized {
+			result[node] *= reached / float64(len(a.nodes)-1)
+		}
+	}
+	return result
+}
+
+func breadthFirst(neighbors map[model.Node][]model.Node, source model.Node) map[model.Node]int {
+	distances := map[model.Node]int{source: 0}
+	queue := []model.Node{source}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range neighbors[node] {
+			if _, seen := distances[neighbor]; !seen {
+				distances[neighbor] = distances[node] + 1
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	return distances
+}
+
+/*
+Betweenness computes the betweenness centrality of every node of an UndirectedGraph: the number of shortest paths
+between other nodes that go through it.
+
+Parameters:
+- g: The graph.
+- normalized: Whether to divide by the number (n - 1)(n - 2) / 2 of pairs of other nodes, giving values in [0, 1].
+
+Returns:
+- betweenness: For every node v, the sum over the pairs of other nodes s, t of the fraction of the shortest s-t paths
+going through v.
+
+Description:
+Brandes' algorithm accumulates the dependencies of every source on the other nodes in reverse breadth-first order,
+in O(nm) time and O(n + m) space.
+
+Reference: U. Brandes, "A faster algorithm for betweenness centrality", Journal of Mathematical Sociology 25(2), 2001.
+*/
+func Betweenness(g *model.UndirectedGraph, normalized bool) map[model.Node]float64 {
+	return betweenness(undirectedAdjacency(g), normalized)
+}
+
+// DirectedBetweenness computes the betweenness centrality of every node of a DirectedGraph, counting the shortest
+// paths along the edges, as Betweenness does for undirected graphs. If normalized, it is divided by (n - 1)(n - 2).
+func DirectedBetweenness(g *model.DirectedGraph, normalized bool) map[model.Node]float64 {
+	return betweenness(directedAdjacency(g), normalized)
+}
+
+func betweenness(a adjacency, normalized bool) map[model.Node]float64 {
+	result := make(map[model.Node]float64, len(a.nodes))
+	for _, node := range a.nodes {
+		result[node] = 0
+	}
+	for _, source := range a.nodes {
+		// Count the shortest paths from source, remembering the order the nodes were reached in
+		paths := map[model.Node]float64{source: 1}
+		distances := map[model.Node]int{source: 0}
+		parents := make(map[model.Node][]model.Node)
+		order := []model.Node{source}
+		for i := 0; i < len(order); i++ {
+			node := order[i]
+			for _, neighbor := range a.out[node] {
+				if _, seen := distances[neighbor]; !seen {
+					distances[neighbor] = distances[node] + 1
+					order = append(order, neighbor)
+				}
+				if distances[neighbor] == distances[node]+1 {
+					paths[neighbor] += paths[node]
+					parents[neighbor] = append(parents[neighbor], node)
+				}
+			}
+		}
+
+		dependencies := make(map[model.Node]float64, len(order))
+		for i := len(order) - 1; i > 0; i-- {
+			node := order[i]
+			for _, parent := range parents[node] {
+				dependencies[parent] += paths[parent] / paths[node] * (1 + dependencies[node])
+			}
+			result[node] += dependencies[node]
+		}
+	}
+
+	n := float64(len(a.nodes))
+	scale := 1.0
+	if !a.directed {
+		// Every path is counted from both of its ends
+		scale = 0.5
+	}
+	if normalized && n > 2 {
+		scale = 1 / ((n - 1) * (n - 2))
+	}
+	for node := range result {
+		result[node] *= scale
+	}
+	return result
+}
@@ -0,0 +1,86 @@
+package centrality
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// closeTo checks if every expected score is within 1e-9 of the actual one.
+func closeTo(actual, expected map[model.Node]float64) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+	for node, value := range expected {
+		if math.Abs(actual[node]-value) > 1e-9 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDegree(t *testing.T) {
+	star := model.StarGraph(5)
+	expected := map[model.Node]float64{0: 1, 1: 0.25, 2: 0.25, 3: 0.25, 4: 0.25}
+	if degrees := Degree(star, true); !closeTo(degrees, expected) {
+		t.Errorf("Expected %v, but got %v", expected, degrees)
+	}
+
+	g := &model.DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 2}})
+	in, out := InDegree(g, false), OutDegree(g, true)
+	if !closeTo(in, map[model.Node]float64{0: 0, 1: 1, 2: 2}) || !closeTo(out, map[model.Node]float64{0: 1, 1: 0.5, 2: 0}) {
+		t.Errorf("Expected the in and out degrees, but got %v and %v", in, out)
+	}
+}
+
+func TestCloseness(t *testing.T) {
+	star := model.StarGraph(5)
+	expected := map[model.Node]float64{0: 1, 1: 4.0 / 7, 2: 4.0 / 7, 3: 4.0 / 7, 4: 4.0 / 7}
+	if closeness := Closeness(star, false); !closeTo(closeness, expected) {
+		t.Errorf("Expected %v, but got %v", expected, closeness)
+	}
+
+	// An edge apart from a triangle: the correction penalizes the smaller component
+	g := model.CycleGraph(3)
+	g.AddEdge(model.Edge{Node1: 3, Node2: 4})
+	g.AddNode(5)
+	expected = map[model.Node]float64{0: 0.4, 1: 0.4, 2: 0.4, 3: 0.2, 4: 0.2, 5: 0}
+	if closeness := Closeness(g, true); !closeTo(closeness, expected) {
+		t.Errorf("Expected %v, but got %v", expected, closeness)
+	}
+
+	path := model.DirectedPathGraph(3)
+	expected = map[model.Node]float64{0: 0, 1: 1, 2: 2.0 / 3}
+	if closeness := DirectedCloseness(path, false); !closeTo(closeness, expected) {
+		t.Errorf("Expected %v, but got %v", expected, closeness)
+	}
+}
+
+func TestBetweenness(t *testing.T) {
+	testCases := []struct {
+		name       string
+		graph      *model.UndirectedGraph
+		normalized bool
+		expected   map[model.Node]float64
+	}{
+		{"star", model.StarGraph(5), false, map[model.Node]float64{0: 6, 1: 0, 2: 0, 3: 0, 4: 0}},
+		{"normalized star", model.StarGraph(5), true, map[model.Node]float64{0: 1, 1: 0, 2: 0, 3: 0, 4: 0}},
+		{"path", model.PathGraph(5), false, map[model.Node]float64{0: 0, 1: 3, 2: 4, 3: 3, 4: 0}},
+		// Two shortest paths between opposite nodes share the load
+		{"square", model.CycleGraph(4), false, map[model.Node]float64{0: 0.5, 1: 0.5, 2: 0.5, 3: 0.5}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if betweenness := Betweenness(tc.graph, tc.normalized); !closeTo(betweenness, tc.expected) {
+				t.Errorf("Expected %v, but got %v", tc.expected, betweenness)
+			}
+		})
+	}
+
+	expected := map[model.Node]float64{0: 0, 1: 0.5, 2: 0}
+	if betweenness := DirectedBetweenness(model.DirectedPathGraph(3), true); !closeTo(betweenness, expected) {
+		t.Errorf("Expected %v, but got %v", expected, betweenness)
+	}
+}
@@ -0,0 +1,126 @@
+package centrality
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+/*
+Eigenvector computes the eigenvector centrality of every node of an UndirectedGraph: a node is central if its
+neighbors are.
+
+Parameters:
+- g: The graph.
+- maxIterations: The largest number of power iterations.
+- tolerance: The iteration stops once the scores change by less than tolerance per node, in total.
+
+Returns:
+- centrality: The entries of the leading eigenvector of the adjacency matrix, non-negative and of Euclidean norm 1.
+- err: An error if the iteration does not converge within maxIterations.
+
+Description:
+The power iteration runs on the adjacency matrix plus the identity, which has the same eigenvectors but converges on
+bipartite graphs too. On a disconnected graph the scores concentrate on the component of largest eigenvalue.
+*/
+func Eigenvector(g *model.UndirectedGraph, maxIterations int, tolerance float64) (map[model.Node]float64, error) {
+	a := undirectedAdjacency(g)
+	scores := make(map[model.Node]float64, len(a.nodes))
+	for _, node := range a.nodes {
+		scores[node] = 1 / float64(len(a.nodes))
+	}
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		next := make(map[model.Node]float64, len(a.nodes))
+		norm := 0.0
+		for _, node := range a.nodes {
+			next[node] = scores[node]
+			for _, neighbor := range a.in[node] {
+				next[node] += scores[neighbor]
+			}
+			norm += next[node] * next[node]
+		}
+		norm = math.Sqrt(norm)
+		if norm == 0 {
+			return next, nil
+		}
+		change := 0.0
+		for _, node := range a.nodes {
+			next[node] /= norm
+			change += math.Abs(next[node] - scores[node])
+		}
+		scores = next
+		if change < float64(len(a.nodes))*tolerance {
+			return scores, nil
+		}
+	}
+	return nil, fmt.Errorf("eigenvector centrality did not converge in %d iterations", maxIterations)
+}
+
+/*
+PageRank computes the PageRank of every node of an UndirectedGraph: the probability of finding a random surfer there,
+who follows a random edge with probability damping and jumps to a random node otherwise.
+
+Parameters:
+- g: The graph.
+- damping: The probability of following an edge, in [0, 1]; 0.85 is customary.
+- maxIterations: The largest number of iterations.
+- tolerance: The iteration stops once the ranks change by less than tolerance per node, in total.
+
+Returns:
+- ranks: The PageRank of every node, summing to 1.
+- err: An error if damping is outside [0, 1] or the iteration does not converge within maxIterations.
+
+Description:
+The surfer jumps to a random node from nodes without edges. Unlike BSPPageRank, the ranks are iterated to convergence
+and sum to 1 on every graph.
+
+Reference: S. Brin and L. Page, "The anatomy of a large-scale hypertextual Web search engine", Computer Networks and
+ISDN Systems 30, 1998.
+*/
+func PageRank(g *model.UndirectedGraph, damping float64, maxIterations int, tolerance float64) (map[model.Node]float64, error) {
+	return pageRank(undirectedAdjacency(g), damping, maxIterations, tolerance)
+}
+
+// DirectedPageRank computes the PageRank of every node of a DirectedGraph, the surfer following the edges forward.
+// See PageRank.
+func DirectedPageRank(g *model.DirectedGraph, damping float64, maxIterations int, tolerance float64) (map[model.Node]float64, error) {
+	return pageRank(directedAdjacency(g), damping, maxIterations, tolerance)
+}
+
+func pageRank(a adjacency, damping float64, maxIterations int, tolerance float64) (map[model.Node]float64, error) {
+	if damping < 0 || damping > 1 {
+		return nil, fmt.Errorf("damping factor must be in [0, 1], got %v", damping)
+	}
+	n := float64(len(a.nodes))
+	ranks := make(map[model.Node]float64, len(a.nodes))
+	for _, node := range a.nodes {
+		ranks[node] = 1 / n
+	}
+	if len(a.nodes) == 0 {
+		return ranks, nil
+	}
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		dangling := 0.0
+		for _, node := range a.nodes {
+			if len(a.out[node]) == 0 {
+				dangling += ranks[node]
+			}
+		}
+		next := make(map[model.Node]float64, len(a.nodes))
+		change := 0.0
+		for _, node := range a.nodes {
+			incoming := 0.0
+			for _, neighbor := range a.in[node] {
+				incoming += ranks[neighbor] / float64(len(a.out[neighbor]))
+			}
+			next[node] = (1-damping)/n + damping*(incoming+dangling/n)
+			change += math.Abs(next[node] - ranks[node])
+		}
+		ranks = next
+		if change < n*tolerance {
+			return ranks, nil
+		}
+	}
+	return nil, fmt.Errorf("PageRank did not converge in %d iterations", maxIterations)
+}
@@ -0,0 +1,51 @@
+package centrality
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestEigenvector(t *testing.T) {
+	// The leading eigenvector of a star puts twice the weight of a leaf on the center
+	centrality, err := Eigenvector(model.StarGraph(5), 1000, 1e-12)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	leaf := 1 / math.Sqrt(8)
+	expected := map[model.Node]float64{0: 2 * leaf, 1: leaf, 2: leaf, 3: leaf, 4: leaf}
+	if !closeTo(centrality, expected) {
+		t.Errorf("Expected %v, but got %v", expected, centrality)
+	}
+	if _, err := Eigenvector(model.PathGraph(50), 2, 1e-12); err == nil {
+		t.Errorf("Expected an error when the iteration does not converge")
+	}
+}
+
+func TestPageRank(t *testing.T) {
+	ranks, err := PageRank(model.CycleGraph(5), 0.85, 100, 1e-10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := map[model.Node]float64{0: 0.2, 1: 0.2, 2: 0.2, 3: 0.2, 4: 0.2}
+	if !closeTo(ranks, expected) {
+		t.Errorf("Expected %v, but got %v", expected, ranks)
+	}
+
+	// Node 1 has no successor, so its rank is spread over both nodes
+	g := &model.DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}})
+	ranks, err = DirectedPageRank(g, 0.85, 100, 1e-12)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected = map[model.Node]float64{0: 0.5 / 1.425, 1: 1 - 0.5/1.425}
+	if !closeTo(ranks, expected) {
+		t.Errorf("Expected %v, but got %v", expected, ranks)
+	}
+
+	if _, err := PageRank(model.CycleGraph(5), 1.5, 100, 1e-10); err == nil {
+		t.Errorf("Expected an error for a damping factor above 1")
+	}
+}
@@ -0,0 +1,13 @@
+package centrality
+
+import "github.com/jmCodeCraft/go-network/model"
+
+// Measure is a centrality measure of the nodes of an UndirectedGraph, such as Degree, Closeness or Betweenness.
+type Measure func(g *model.UndirectedGraph, normalized bool) map[model.Node]float64
+
+// TopKCentralNodes returns the k most central nodes of an UndirectedGraph for a measure, with their normalized
+// scores, by decreasing centrality, ties broken by ascending node. For the other measures, such as PageRank, pass
+// their scores to model.TopKNodes.
+func TopKCentralNodes(g *model.UndirectedGraph, k int, measure Measure) []model.ScoredNode {
+	return model.TopKNodes(measure(g, true), k)
+}
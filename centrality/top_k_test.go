@@ -0,0 +1,20 @@
+package centrality
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestTopKCentralNodes(t *testing.T) {
+	// A path 0-1-2-3-4 whose middle node 2 has the highest betweenness, then 1 and 3
+	path := model.PathGraph(5)
+	expected := []model.ScoredNode{{Node: 2, Score: 4.0 / 6}, {Node: 1, Score: 3.0 / 6}, {Node: 3, Score: 3.0 / 6}}
+	if top := TopKCentralNodes(path, 3, Betweenness); !reflect.DeepEqual(top, expected) {
+		t.Errorf("Expected %v, but got %v", expected, top)
+	}
+	if top := TopKCentralNodes(model.StarGraph(6), 1, Degree); len(top) != 1 || top[0].Node != 0 || top[0].Score != 1 {
+		t.Errorf("Expected the center of the star, but got %v", top)
+	}
+}
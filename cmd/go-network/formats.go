@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	graphio "github.com/jmCodeCraft/go-network/io"
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// format is a file format the CLI reads and writes graphs in.
+type format struct {
+	description string
+	extensions  []string
+	read        func(r io.Reader) (*graphio.GraphDocument, error)
+	write       func(w io.Writer, d *graphio.GraphDocument) error
+}
+
+// formats holds every format by name.
+var formats = map[string]format{
+	"dot": {
+		description: "Graphviz DOT language",
+		extensions:  []string{".dot", ".gv"},
+		read:        graphio.ReadDOT,
+		write:       graphio.WriteDOTDocument,
+	},
+	"graphml": {
+		description: "GraphML, read by most graph tools",
+		extensions:  []string{".graphml"},
+		read:        graphio.ReadGraphML,
+		write:       graphio.WriteGraphML,
+	},
+	"gexf": {
+		description: "GEXF, the format of Gephi",
+		extensions:  []string{".gexf"},
+		read:        graphio.ReadGEXF,
+		write:       graphio.WriteGEXF,
+	},
+	"json": {
+		description: "node-link JSON, as read by NetworkX and D3",
+		extensions:  []string{".json"},
+		read:        graphio.ReadNodeLinkJSON,
+		write:       graphio.WriteNodeLinkJSON,
+	},
+	"edgelist": {
+		description: "one edge u,v per line, undirected and without attributes or isolated nodes",
+		extensions:  []string{".csv", ".edges", ".edgelist", ".txt"},
+		read:        readEdgeList,
+		write:       writeEdgeList,
+	},
+}
+
+// defaultFormat is the format used when it is neither given nor implied by the file name.
+const defaultFormat = "edgelist"
+
+// formatNames returns the names of the formats in alphabetical order.
+func formatNames() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resolveFormat returns the format named by name or, if name is empty, the format implied by the extension of path,
+// falling back to defaultFormat for standard input and output and for unknown extensions.
+func resolveFormat(name, path string) (format, error) {
+	if name == "" {
+		extension := strings.ToLower(filepath.Ext(path))
+		for _, candidate := range formatNames() {
+			for _, e := range formats[candidate].extensions {
+				if e == extension {
+					return formats[candidate], nil
+				}
+			}
+		}
+		name = defaultFormat
+	}
+	f, ok := formats[strings.ToLower(name)]
+	if !ok {
+		return format{}, fmt.Errorf("unknown format %q, expected one of %s", name, strings.Join(formatNames(), ", "))
+	}
+	return f, nil
+}
+
+func readEdgeList(r io.Reader) (*graphio.GraphDocument, error) {
+	g := &model.UndirectedGraph{Nodes: make(map[model.Node]bool), Edges: make(map[model.Node][]model.Node)}
+	if _, err := graphio.ReadEdgeStream(r, func(edge model.Edge) error {
+		g.AddEdge(edge)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return graphio.NewUndirectedDocument(g), nil
+}
+
+// writeEdgeList writes every edge once, in ascending order, with the smaller node first unless the graph is directed.
+func writeEdgeList(w io.Writer, d *graphio.GraphDocument) error {
+	out := bufio.NewWriter(w)
+	var adjacency map[model.Node][]model.Node
+	var nodes map[model.Node]bool
+	if d.IsDirected() {
+		adjacency, nodes = d.Directed.Successors, d.Directed.Nodes
+	} else {
+		adjacency, nodes = d.Undirected.Edges, d.Undirected.Nodes
+	}
+	sorted := make([]model.Node, 0, len(nodes))
+	for node := range nodes {
+		sorted = append(sorted, node)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	for _, node := range sorted {
+		neighbors := append([]model.Node(nil), adjacency[node]...)
+		sort.Slice(neighbors, func(i, j int) bool { return neighbors[i] < neighbors[j] })
+		for i, neighbor := range neighbors {
+			// A self-loop is listed twice in the adjacency of its node
+			if (d.IsDirected() || neighbor >= node) && (i == 0 || neighbors[i-1] != neighbor) {
+				fmt.Fprintf(out, "%d,%d\n", node, neighbor)
+			}
+		}
+	}
+	return out.Flush()
+}
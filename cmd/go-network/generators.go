@@ -0,0 +1,792 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	graphio "github.com/jmCodeCraft/go-network/io"
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// parameterKind is the type of the value of a generator parameter, which decides how its flag is parsed.
+type parameterKind int
+
+const (
+	// countParameter is a non-negative integer, such as a number of nodes.
+	countParameter parameterKind = iota
+	// intParameter is any integer, such as a seed.
+	intParameter
+	// floatParameter is a real number, such as a probability.
+	floatParameter
+	// boolParameter is true or false.
+	boolParameter
+	// countsParameter is a comma-separated list of non-negative integers, such as 10,20,30.
+	countsParameter
+	// floatsParameter is a comma-separated list of real numbers, such as 3,1.
+	floatsParameter
+	// matrixParameter is a square matrix of real numbers, its rows separated by semicolons and its entries by
+	// commas, such as 0.5,0.1;0.1,0.5.
+	matrixParameter
+	// sequenceParameter is a string of 0s and 1s, such as 0110.
+	sequenceParameter
+)
+
+// parameter is a flag of a generator, whose default value is given as it would be typed.
+type parameter struct {
+	name, value, usage string
+	kind               parameterKind
+}
+
+// arguments holds the parsed value of every parameter of a generator: an int, a float64, a bool, an []int, a
+// []float64, a [][]float64 or a []bool, according to its kind.
+type arguments map[string]any
+
+func (a arguments) count(name string) int            { return a[name].(int) }
+func (a arguments) seed() int64                      { return int64(a["seed"].(int)) }
+func (a arguments) float(name string) float64        { return a[name].(float64) }
+func (a arguments) bool(name string) bool            { return a[name].(bool) }
+func (a arguments) counts(name string) []int         { return a[name].([]int) }
+func (a arguments) floats(name string) []float64     { return a[name].([]float64) }
+func (a arguments) matrix(name string) [][]float64   { return a[name].([][]float64) }
+func (a arguments) sequence(name string) []bool      { return a[name].([]bool) }
+func (a arguments) countPair(u, v string) (int, int) { return a.count(u), a.count(v) }
+
+// generator is a graph generator of the model package exposed by the gen command.
+type generator struct {
+	description string
+	parameters  []parameter
+	build       func(a arguments) (*graphio.GraphDocument, error)
+}
+
+// parse converts the value of a parameter, as typed on the command line.
+func (p parameter) parse(value string) (any, error) {
+	switch p.kind {
+	case countParameter, intParameter:
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("--%s must be an integer, got %q", p.name, value)
+		}
+		if p.kind == countParameter && n < 0 {
+			return nil, fmt.Errorf("--%s can't be < 0, got %d", p.name, n)
+		}
+		return n, nil
+	case floatParameter:
+		x, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || math.IsNaN(x) {
+			return nil, fmt.Errorf("--%s must be a number, got %q", p.name, value)
+		}
+		return x, nil
+	case boolParameter:
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("--%s must be true or false, got %q", p.name, value)
+		}
+		return b, nil
+	case countsParameter:
+		var counts []int
+		for _, field := range splitList(value, ",") {
+			n, err := strconv.Atoi(field)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("--%s must be a comma-separated list of non-negative integers, got %q", p.name, value)
+			}
+			counts = append(counts, n)
+		}
+		return counts, nil
+	case floatsParameter:
+		numbers, err := parseFloats(value)
+		if err != nil {
+			return nil, fmt.Errorf("--%s must be a comma-separated list of numbers, got %q", p.name, value)
+		}
+		return numbers, nil
+	case matrixParameter:
+		var matrix [][]float64
+		for _, row := range splitList(value, ";") {
+			numbers, err := parseFloats(row)
+			if err != nil {
+				return nil, fmt.Errorf("--%s must be rows of comma-separated numbers separated by semicolons, got %q", p.name, value)
+			}
+			matrix = append(matrix, numbers)
+		}
+		for _, row := range matrix {
+			if len(row) != len(matrix) {
+				return nil, fmt.Errorf("--%s must be a square matrix, got %d rows and a row of %d entries", p.name, len(matrix), len(row))
+			}
+		}
+		return matrix, nil
+	case sequenceParameter:
+		sequence := make([]bool, 0, len(value))
+		for _, c := range strings.TrimSpace(value) {
+			if c != '0' && c != '1' {
+				return nil, fmt.Errorf("--%s must be a string of 0s and 1s, got %q", p.name, value)
+			}
+			sequence = append(sequence, c == '1')
+		}
+		return sequence, nil
+	}
+	return nil, fmt.Errorf("unknown kind of parameter %d", p.kind)
+}
+
+// splitList splits a list at the separator, ignoring white space and a trailing separator.
+func splitList(value, separator string) []string {
+	var fields []string
+	for _, field := range strings.Split(value, separator) {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func parseFloats(value string) ([]float64, error) {
+	var numbers []float64
+	for _, field := range splitList(value, ",") {
+		x, err := strconv.ParseFloat(field, 64)
+		if err != nil || math.IsNaN(x) {
+			return nil, fmt.Errorf("invalid number %q", field)
+		}
+		numbers = append(numbers, x)
+	}
+	return numbers, nil
+}
+
+// The parameters shared by several generators.
+var (
+	nodesParameter       = parameter{name: "nodes", value: "10", usage: "number of nodes", kind: countParameter}
+	rowsParameter        = parameter{name: "rows", value: "4", usage: "number of rows", kind: countParameter}
+	colsParameter        = parameter{name: "cols", value: "4", usage: "number of columns", kind: countParameter}
+	probabilityParameter = parameter{name: "probability", value: "0.1", usage: "probability of every edge, in [0, 1]", kind: floatParameter}
+	seedParameter        = parameter{name: "seed", value: "1", usage: "seed of the random number generator; the same seed gives the same graph", kind: intParameter}
+	inwardParameter      = parameter{name: "inward", value: "false", usage: "direct the edges towards the center", kind: boolParameter}
+	hubsParameter        = parameter{name: "hubs", value: "2", usage: "number of hubs, at most the number of nodes", kind: countParameter}
+)
+
+func undirected(g *model.UndirectedGraph) *graphio.GraphDocument {
+	return graphio.NewUndirectedDocument(g)
+}
+
+func directed(g *model.DirectedGraph) *graphio.GraphDocument {
+	return graphio.NewDirectedDocument(g)
+}
+
+func undirectedOrError(g *model.UndirectedGraph, err error) (*graphio.GraphDocument, error) {
+	if err != nil {
+		return nil, err
+	}
+	return undirected(g), nil
+}
+
+// spatial returns the document of a SpatialGraph, with the coordinates of every node in its x and y attributes.
+func spatial(s *model.SpatialGraph) *graphio.GraphDocument {
+	d := undirected(s.Graph)
+	for node, position := range s.Positions {
+		d.SetNodeAttribute(node, "x", formatFloat(position.X))
+		d.SetNodeAttribute(node, "y", formatFloat(position.Y))
+	}
+	return d
+}
+
+// transport returns the document of a TransportNetwork, with the coordinates of every node in its x and y attributes,
+// whether it is a hub in its hub attribute and the capacity and travel time of every edge in its capacity and
+// travel_time attributes.
+func transport(t *model.TransportNetwork, err error) (*graphio.GraphDocument, error) {
+	if err != nil {
+		return nil, err
+	}
+	d := spatial(t.SpatialGraph)
+	for node := range t.Graph.Nodes {
+		d.SetNodeAttribute(node, "hub", "false")
+	}
+	for _, hub := range t.Hubs {
+		d.SetNodeAttribute(hub, "hub", "true")
+	}
+	for edge, capacity := range t.Capacities {
+		d.SetEdgeAttribute(edge.Node1, edge.Node2, "capacity", formatFloat(capacity))
+		d.SetEdgeAttribute(edge.Node1, edge.Node2, "travel_time", formatFloat(t.TravelTimes[edge]))
+	}
+	return d, nil
+}
+
+// bipartite returns the document of a BipartiteGraph, with the side of every node in its bipartite attribute: 0 for the
+// top nodes and 1 for the bottom ones, as in NetworkX.
+func bipartite(b *model.BipartiteGraph, err error) (*graphio.GraphDocument, error) {
+	if err != nil {
+		return nil, err
+	}
+	d := undirected(b.Graph)
+	for node := range b.Graph.Nodes {
+		side := "0"
+		if b.Bottom[node] {
+			side = "1"
+		}
+		d.SetNodeAttribute(node, "bipartite", side)
+	}
+	return d, nil
+}
+
+func formatFloat(x float64) string {
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+// generators holds every generator of the gen command by name.
+var generators = map[string]generator{
+	// Classic graphs
+	"complete": {
+		description: "every two nodes adjacent",
+		parameters:  []parameter{nodesParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.CompleteGraph(a.count("nodes"))), nil
+		},
+	},
+	"path": {
+		description: "the path 0 - 1 - ... - n-1",
+		parameters:  []parameter{nodesParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.PathGraph(a.count("nodes"))), nil
+		},
+	},
+	"cycle": {
+		description: "the cycle 0 - 1 - ... - n-1 - 0",
+		parameters:  []parameter{nodesParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.CycleGraph(a.count("nodes"))), nil
+		},
+	},
+	"star": {
+		description: "the center 0 adjacent to every other node",
+		parameters:  []parameter{nodesParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.StarGraph(a.count("nodes"))), nil
+		},
+	},
+	"wheel": {
+		description: "the hub 0 adjacent to every node of the cycle 1 - ... - n-1",
+		parameters:  []parameter{nodesParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.WheelGraph(a.count("nodes"))), nil
+		},
+	},
+	"ladder": {
+		description: "two paths of the same length joined by rungs",
+		parameters:  []parameter{{name: "length", value: "5", usage: "number of nodes of each path", kind: countParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.LadderGraph(a.count("length"))), nil
+		},
+	},
+	"circular-ladder": {
+		description: "two cycles of the same length joined by rungs, the prism graph",
+		parameters:  []parameter{{name: "length", value: "5", usage: "number of nodes of each cycle", kind: countParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.CircularLadderGraph(a.count("length")))
+		},
+	},
+	"circulant": {
+		description: "the cycle with every node also linked to the node offset steps ahead",
+		parameters:  []parameter{nodesParameter, {name: "offset", value: "2", usage: "offset of the chords", kind: countParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.CirculantGraph(a.countPair("nodes", "offset"))), nil
+		},
+	},
+	"turan": {
+		description: "the complete multipartite graph with blocks of almost equal sizes",
+		parameters:  []parameter{nodesParameter, {name: "partitions", value: "3", usage: "number of blocks", kind: countParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.TuranGraph(a.countPair("nodes", "partitions"))), nil
+		},
+	},
+	"complete-multipartite": {
+		description: "every two nodes of different blocks adjacent",
+		parameters:  []parameter{{name: "sizes", value: "2,3", usage: "sizes of the blocks, comma-separated", kind: countsParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.CompleteMultipartiteGraph(a.counts("sizes")...))
+		},
+	},
+	"trivial": {
+		description: "a single node",
+		build:       func(a arguments) (*graphio.GraphDocument, error) { return undirected(model.TrivialGraph()), nil },
+	},
+	"null": {
+		description: "no nodes",
+		build:       func(a arguments) (*graphio.GraphDocument, error) { return undirected(model.NullGraph()), nil },
+	},
+	"tadpole": {
+		description: "a cycle and a path joined by an edge",
+		parameters: []parameter{
+			{name: "cycle", value: "4", usage: "number of nodes of the cycle", kind: countParameter},
+			{name: "path", value: "3", usage: "number of nodes of the path", kind: countParameter},
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.TadpoleGraph(a.countPair("cycle", "path")))
+		},
+	},
+	"lollipop": {
+		description: "a complete graph and a path joined by an edge",
+		parameters: []parameter{
+			{name: "clique", value: "4", usage: "number of nodes of the complete graph", kind: countParameter},
+			{name: "path", value: "3", usage: "number of nodes of the path", kind: countParameter},
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.LollipopGraph(a.countPair("clique", "path"))), nil
+		},
+	},
+	"barbell": {
+		description: "two complete graphs joined by a path",
+		parameters: []parameter{
+			{name: "clique", value: "4", usage: "number of nodes of each complete graph", kind: countParameter},
+			{name: "path", value: "2", usage: "number of nodes of the path", kind: countParameter},
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.BarbellGraph(a.countPair("clique", "path")))
+		},
+	},
+	"balanced-tree": {
+		description: "the tree in which every internal node has the same number of children and every leaf the same depth",
+		parameters: []parameter{
+			{name: "branching", value: "2", usage: "number of children of every internal node", kind: countParameter},
+			{name: "height", value: "3", usage: "depth of the leaves", kind: countParameter},
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.BalancedTree(a.countPair("branching", "height")))
+		},
+	},
+	"full-rary-tree": {
+		description: "the tree filled in breadth-first order with the same number of children per node",
+		parameters:  []parameter{{name: "branching", value: "2", usage: "number of children of every internal node", kind: countParameter}, nodesParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.FullRaryTree(a.countPair("branching", "nodes")))
+		},
+	},
+	"binomial-tree": {
+		description: "the binomial tree of 2^order nodes",
+		parameters:  []parameter{{name: "order", value: "3", usage: "order of the tree, at most 30", kind: countParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.BinomialTree(a.count("order")))
+		},
+	},
+	"dorogovtsev-goltsev-mendes": {
+		description: "the pseudofractal scale-free graph",
+		parameters:  []parameter{{name: "generation", value: "3", usage: "number of generations", kind: countParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.DorogovtsevGoltsevMendesGraph(a.count("generation")))
+		},
+	},
+	"hypercube": {
+		description: "the binary words of a length, adjacent when they differ in one bit",
+		parameters:  []parameter{{name: "dimension", value: "3", usage: "length of the words, at most 30", kind: countParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.HypercubeGraph(a.count("dimension")))
+		},
+	},
+	"threshold": {
+		description: "the threshold graph of a creation sequence",
+		parameters:  []parameter{{name: "sequence", value: "0101", usage: "for every node in order, 1 to link it to all the previous nodes, 0 to leave it isolated", kind: sequenceParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.ThresholdGraph(a.sequence("sequence"))), nil
+		},
+	},
+
+	// Lattices
+	"grid": {
+		description: "the rows x cols grid, cell r*cols + c at row r and column c",
+		parameters:  []parameter{rowsParameter, colsParameter, {name: "periodic", value: "false", usage: "wrap the rows and columns around into a torus", kind: boolParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.Grid2DGraph(a.count("rows"), a.count("cols"), a.bool("periodic"))), nil
+		},
+	},
+	"king": {
+		description: "the moves of a chess king on a rows x cols board",
+		parameters:  []parameter{rowsParameter, colsParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.KingGraph(a.countPair("rows", "cols"))), nil
+		},
+	},
+	"rook": {
+		description: "the moves of a chess rook on a rows x cols board",
+		parameters:  []parameter{rowsParameter, colsParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.RookGraph(a.countPair("rows", "cols"))), nil
+		},
+	},
+	"knight": {
+		description: "the moves of a chess knight on a rows x cols board",
+		parameters:  []parameter{rowsParameter, colsParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.KnightGraph(a.countPair("rows", "cols"))), nil
+		},
+	},
+	"hexagonal-lattice": {
+		description: "the honeycomb lattice of rows x cols hexagons",
+		parameters:  []parameter{rowsParameter, colsParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.HexagonalLatticeGraph(a.countPair("rows", "cols"))), nil
+		},
+	},
+	"maze": {
+		description: "a random maze on a rows x cols grid, an edge being an open passage",
+		parameters:  []parameter{rowsParameter, colsParameter, {name: "loops", value: "0", usage: "probability of opening every wall off the spanning tree, in [0, 1]", kind: floatParameter}, seedParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.MazeGraph(a.count("rows"), a.count("cols"), a.float("loops"), a.seed()))
+		},
+	},
+
+	// Expanders
+	"margulis-gabber-galil": {
+		description: "the Margulis-Gabber-Galil expander on the side x side torus",
+		parameters:  []parameter{{name: "side", value: "5", usage: "side of the torus", kind: countParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirected(model.MargulisGabberGalilGraph(a.count("side"))), nil
+		},
+	},
+	"lps": {
+		description: "the Lubotzky-Phillips-Sarnak Ramanujan graph",
+		parameters: []parameter{
+			{name: "p", value: "5", usage: "prime congruent to 1 modulo 4, the degree minus 1", kind: countParameter},
+			{name: "q", value: "13", usage: "prime congruent to 1 modulo 4, above 2√p", kind: countParameter},
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.LPSGraph(a.countPair("p", "q")))
+		},
+	},
+
+	// Directed graphs
+	"directed-path": {
+		description: "the directed path 0 -> 1 -> ... -> n-1",
+		parameters:  []parameter{nodesParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return directed(model.DirectedPathGraph(a.count("nodes"))), nil
+		},
+	},
+	"directed-cycle": {
+		description: "the directed cycle 0 -> 1 -> ... -> n-1 -> 0",
+		parameters:  []parameter{nodesParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return directed(model.DirectedCycleGraph(a.count("nodes"))), nil
+		},
+	},
+	"directed-star": {
+		description: "the star with the edges from the center 0, or towards it",
+		parameters:  []parameter{nodesParameter, inwardParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return directed(model.DirectedStarGraph(a.count("nodes"), a.bool("inward"))), nil
+		},
+	},
+	"directed-wheel": {
+		description: "the wheel with a directed rim and the spokes from the hub 0, or towards it",
+		parameters:  []parameter{nodesParameter, inwardParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return directed(model.DirectedWheelGraph(a.count("nodes"), a.bool("inward"))), nil
+		},
+	},
+	"directed-grid": {
+		description: "the rows x cols grid with the edges going right and down",
+		parameters:  []parameter{rowsParameter, colsParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return directed(model.DirectedGridGraph(a.countPair("rows", "cols"))), nil
+		},
+	},
+	"directed-complete": {
+		description: "an edge in both directions between every two nodes",
+		parameters:  []parameter{nodesParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return directed(model.DirectedCompleteGraph(a.count("nodes"))), nil
+		},
+	},
+	"transitive-tournament": {
+		description: "an edge from i to j for every i < j",
+		parameters:  []parameter{nodesParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return directed(model.TransitiveTournament(a.count("nodes"))), nil
+		},
+	},
+
+	// Random graphs
+	"gnp": {
+		description: "the Erdős-Rényi random graph G(n, p)",
+		parameters:  []parameter{nodesParameter, probabilityParameter, seedParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.GnpRandomGraph(a.count("nodes"), a.float("probability"), a.seed()))
+		},
+	},
+	"parallel-gnp": {
+		description: "the Erdős-Rényi random graph G(n, p), generated by several goroutines",
+		parameters:  []parameter{nodesParameter, probabilityParameter, seedParameter, {name: "workers", value: "4", usage: "number of goroutines", kind: countParameter}},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.ParallelGnpRandomGraph(a.count("nodes"), a.float("probability"), a.seed(), a.count("workers")))
+		},
+	},
+	"gnm": {
+		description: "the random graph G(n, m) with a fixed number of edges",
+		parameters:  []parameter{nodesParameter, {name: "edges", value: "20", usage: "number of edges", kind: countParameter}, seedParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.GnmRandomGraph(a.count("nodes"), a.count("edges"), a.seed()))
+		},
+	},
+	"barabasi-albert": {
+		description: "the scale-free graph grown by preferential attachment",
+		parameters:  []parameter{nodesParameter, {name: "edges-per-node", value: "2", usage: "number of edges of every new node", kind: countParameter}, seedParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.BarabasiAlbertGraph(a.count("nodes"), a.count("edges-per-node"), a.seed()))
+		},
+	},
+	"watts-strogatz": {
+		description: "the small-world graph rewired from a ring lattice",
+		parameters: []parameter{
+			nodesParameter,
+			{name: "neighbors", value: "4", usage: "number of nearest neighbors of every node in the ring", kind: countParameter},
+			{name: "probability", value: "0.1", usage: "probability of rewiring every edge, in [0, 1]", kind: floatParameter},
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.WattsStrogatzGraph(a.count("nodes"), a.count("neighbors"), a.float("probability"), a.seed()))
+		},
+	},
+	"sbm": {
+		description: "the stochastic block model, edge probabilities depending on the blocks of the nodes",
+		parameters: []parameter{
+			{name: "sizes", value: "5,5", usage: "sizes of the blocks, comma-separated", kind: countsParameter},
+			{name: "probabilities", value: "0.8,0.1;0.1,0.8", usage: "symmetric matrix of the edge probabilities between blocks, rows separated by semicolons", kind: matrixParameter},
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.StochasticBlockModelGraph(a.counts("sizes"), a.matrix("probabilities"), a.seed()))
+		},
+	},
+	"degree-corrected-sbm": {
+		description: "the degree-corrected stochastic block model",
+		parameters: []parameter{
+			{name: "sizes", value: "5,5", usage: "sizes of the blocks, comma-separated", kind: countsParameter},
+			{name: "weights", value: "", usage: "degree propensity of every node, comma-separated; empty for all 1", kind: floatsParameter},
+			{name: "edges", value: "10,2;2,10", usage: "symmetric matrix of the expected numbers of edges between blocks, rows separated by semicolons", kind: matrixParameter},
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			weights := a.floats("weights")
+			if weights == nil {
+				for _, size := range a.counts("sizes") {
+					for i := 0; i < size; i++ {
+						weights = append(weights, 1)
+					}
+				}
+			}
+			return undirectedOrError(model.DegreeCorrectedSBMGraph(a.counts("sizes"), weights, a.matrix("edges"), a.seed()))
+		},
+	},
+	"random-chordal": {
+		description: "a chordal graph, the intersection graph of random subtrees of a random tree",
+		parameters: []parameter{
+			nodesParameter,
+			{name: "tree-size", value: "10", usage: "number of nodes of the host tree", kind: countParameter},
+			{name: "max-subtree-size", value: "3", usage: "largest number of host nodes of a subtree", kind: countParameter},
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.RandomChordalGraph(a.count("nodes"), a.count("tree-size"), a.count("max-subtree-size"), a.seed()))
+		},
+	},
+	"random-split": {
+		description: "a clique and an independent set joined by random edges",
+		parameters: []parameter{
+			{name: "clique", value: "4", usage: "number of nodes of the clique", kind: countParameter},
+			{name: "independent", value: "6", usage: "number of nodes of the independent set", kind: countParameter},
+			{name: "probability", value: "0.3", usage: "probability of every edge between the two sets, in [0, 1]", kind: floatParameter},
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return undirectedOrError(model.RandomSplitGraph(a.count("clique"), a.count("independent"), a.float("probability"), a.seed()))
+		},
+	},
+	"social": {
+		description: "a social graph whose nodes belong to groups, with homophily; the group is the label attribute",
+		parameters: []parameter{
+			nodesParameter,
+			{name: "average-degree", value: "4", usage: "expected average degree", kind: floatParameter},
+			{name: "groups", value: "1,1", usage: "relative sizes of the groups, comma-separated", kind: floatsParameter},
+			{name: "homophily", value: "0.8", usage: "expected fraction of the edges inside a group, in [0, 1]", kind: floatParameter},
+			{name: "features", value: "0", usage: "number of numeric features of every node, written as the feature0, feature1, ... attributes", kind: countParameter},
+			{name: "noise", value: "1", usage: "standard deviation of the features around the center of the group", kind: floatParameter},
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			l, err := model.AttributedSocialGraph(model.SocialNetworkConfig{
+				NumberOfNodes:    a.count("nodes"),
+				AverageDegree:    a.float("average-degree"),
+				GroupWeights:     a.floats("groups"),
+				Homophily:        a.float("homophily"),
+				FeatureDimension: a.count("features"),
+				FeatureNoise:     a.float("noise"),
+			}, a.seed())
+			if err != nil {
+				return nil, err
+			}
+			d := undirected(l.Graph)
+			for node, label := range l.Labels {
+				d.SetNodeAttribute(node, "label", strconv.Itoa(label))
+			}
+			for node, features := range l.Features {
+				for i, feature := range features {
+					d.SetNodeAttribute(node, "feature"+strconv.Itoa(i), formatFloat(feature))
+				}
+			}
+			return d, nil
+		},
+	},
+
+	// Spatial graphs, with the coordinates of the nodes in their x and y attributes
+	"random-geometric": {
+		description: "nodes in the unit square, adjacent within a radius; coordinates in x and y",
+		parameters:  []parameter{nodesParameter, {name: "radius", value: "0.3", usage: "largest distance between adjacent nodes", kind: floatParameter}, seedParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			s, err := model.RandomGeometricGraph(a.count("nodes"), a.float("radius"), a.seed())
+			if err != nil {
+				return nil, err
+			}
+			return spatial(s), nil
+		},
+	},
+	"random-maximal-planar": {
+		description: "a random triangulation; coordinates in x and y",
+		parameters:  []parameter{nodesParameter, seedParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			s, err := model.RandomMaximalPlanarGraph(a.count("nodes"), a.seed())
+			if err != nil {
+				return nil, err
+			}
+			return spatial(s), nil
+		},
+	},
+	"random-planar": {
+		description: "random edges of a random triangulation; coordinates in x and y",
+		parameters:  []parameter{nodesParameter, {name: "edges", value: "15", usage: "number of edges, at most 3n - 6", kind: countParameter}, seedParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			s, err := model.RandomPlanarGraph(a.count("nodes"), a.count("edges"), a.seed())
+			if err != nil {
+				return nil, err
+			}
+			return spatial(s), nil
+		},
+	},
+	"road-network": {
+		description: "a connected planar graph resembling a road network; coordinates in x and y, road lengths in length",
+		parameters: []parameter{
+			nodesParameter,
+			{name: "average-degree", value: "2.5", usage: "target average degree", kind: floatParameter},
+			{name: "max-degree", value: "4", usage: "largest number of roads meeting at an intersection", kind: countParameter},
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			r, err := model.RandomRoadNetwork(a.count("nodes"), a.float("average-degree"), a.count("max-degree"), a.seed())
+			if err != nil {
+				return nil, err
+			}
+			d := spatial(r.SpatialGraph)
+			for edge, length := range r.Lengths {
+				d.SetEdgeAttribute(edge.Node1, edge.Node2, "length", formatFloat(length))
+			}
+			return d, nil
+		},
+	},
+	"hyperbolic": {
+		description: "the hyperbolic random graph; polar coordinates in radius and angle, coordinates in x and y",
+		parameters: []parameter{
+			nodesParameter,
+			{name: "average-degree", value: "4", usage: "target average degree", kind: floatParameter},
+			{name: "exponent", value: "2.5", usage: "exponent of the power law of the degrees, above 2", kind: floatParameter},
+			{name: "temperature", value: "0", usage: "temperature in [0, 1), higher for noisier links", kind: floatParameter},
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			h, err := model.HyperbolicRandomGraph(a.count("nodes"), a.float("average-degree"), a.float("exponent"), a.float("temperature"), a.seed())
+			if err != nil {
+				return nil, err
+			}
+			d := spatial(h.SpatialGraph)
+			for node := range h.Graph.Nodes {
+				d.SetNodeAttribute(node, "radius", formatFloat(h.Radii[node]))
+				d.SetNodeAttribute(node, "angle", formatFloat(h.Angles[node]))
+			}
+			return d, nil
+		},
+	},
+	"as-topology": {
+		description: "an AS-level Internet topology; coordinates in x and y, tiers in tier, business relationships in relationship",
+		parameters: []parameter{
+			nodesParameter,
+			{name: "core", value: "3", usage: "number of tier-1 ASes", kind: countParameter},
+			{name: "providers", value: "2", usage: "number of providers of every other AS", kind: countParameter},
+			{name: "locality", value: "0.3", usage: "distance scale of the geographic constraint, positive", kind: floatParameter},
+			{name: "peering", value: "0.2", usage: "probability that two close transit ASes peer, in [0, 1]", kind: floatParameter},
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			t, err := model.ASTopologyGraph(a.count("nodes"), a.count("core"), a.count("providers"), a.float("locality"), a.float("peering"), a.seed())
+			if err != nil {
+				return nil, err
+			}
+			d := spatial(t.SpatialGraph)
+			for node, tier := range t.Tiers {
+				d.SetNodeAttribute(node, "tier", strconv.Itoa(tier))
+			}
+			for edge, relationship := range t.Relationships {
+				d.SetEdgeAttribute(edge.Node1, edge.Node2, "relationship", relationship.String())
+			}
+			return d, nil
+		},
+	},
+	"grid-transport": {
+		description: "a transport network on a grid of streets; coordinates in x and y, hubs in hub, capacity and travel_time on the edges",
+		parameters:  []parameter{rowsParameter, colsParameter, hubsParameter, seedParameter},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return transport(model.GridTransportNetwork(a.count("rows"), a.count("cols"), a.count("hubs"), a.seed()))
+		},
+	},
+	"planar-transport": {
+		description: "a transport network on a random planar graph; coordinates in x and y, hubs in hub, capacity and travel_time on the edges",
+		parameters: []parameter{
+			nodesParameter,
+			{name: "edges", value: "15", usage: "number of roads, between n - 1 and 3n - 6 for a connected network", kind: countParameter},
+			hubsParameter,
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return transport(model.PlanarTransportNetwork(a.count("nodes"), a.count("edges"), a.count("hubs"), a.seed()))
+		},
+	},
+
+	// Bipartite graphs, with the side of every node in its bipartite attribute
+	"complete-bipartite": {
+		description: "every top node adjacent to every bottom node; sides in bipartite",
+		parameters: []parameter{
+			{name: "top", value: "3", usage: "number of top nodes", kind: countParameter},
+			{name: "bottom", value: "4", usage: "number of bottom nodes", kind: countParameter},
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return bipartite(model.CompleteBipartiteGraph(a.countPair("top", "bottom")), nil)
+		},
+	},
+	"random-bipartite": {
+		description: "every top node adjacent to every bottom node with the same probability; sides in bipartite",
+		parameters: []parameter{
+			{name: "top", value: "5", usage: "number of top nodes", kind: countParameter},
+			{name: "bottom", value: "5", usage: "number of bottom nodes", kind: countParameter},
+			{name: "probability", value: "0.3", usage: "probability of every edge, in [0, 1]", kind: floatParameter},
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return bipartite(model.RandomBipartiteGraph(a.count("top"), a.count("bottom"), a.float("probability"), a.seed()))
+		},
+	},
+	"bipartite-preferential-attachment": {
+		description: "bottom nodes linking to top nodes by preferential attachment; sides in bipartite",
+		parameters: []parameter{
+			{name: "initial-top", value: "3", usage: "number of top nodes at the start", kind: countParameter},
+			{name: "bottom", value: "10", usage: "number of bottom nodes added", kind: countParameter},
+			{name: "edges-per-bottom", value: "2", usage: "number of top nodes every bottom node links to", kind: countParameter},
+			{name: "new-top-probability", value: "0.2", usage: "probability that an edge goes to a new top node, in [0, 1]", kind: floatParameter},
+			seedParameter,
+		},
+		build: func(a arguments) (*graphio.GraphDocument, error) {
+			return bipartite(model.BipartitePreferentialAttachmentGraph(a.count("initial-top"), a.count("bottom"), a.count("edges-per-bottom"), a.float("new-top-probability"), a.seed()))
+		},
+	},
+}
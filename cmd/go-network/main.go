@@ -0,0 +1,291 @@
+/*
+Command go-network generates graphs and converts them between file formats, so that test topologies can be produced
+without writing Go.
+
+Usage:
+
+	go-network gen <generator> [--parameter value ...] [--format format] [--output file]
+	go-network convert [--from format] [--to format] <input> <output>
+	go-network list
+	go-network help [generator]
+
+For example, go-network gen wheel --nodes 10 --format dot writes the wheel graph on 10 nodes in the DOT language, and
+go-network convert in.graphml out.json converts a GraphML file to node-link JSON. Formats are implied by the file
+extensions when they are not given, and - stands for the standard input or output. Random generators take a --seed,
+the same seed giving the same graph.
+*/
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	graphio "github.com/jmCodeCraft/go-network/io"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// usageError is an error in the command line, as opposed to a failure to generate, read or write a graph. Its hint
+// tells where to find help, the general usage if empty.
+type usageError struct {
+	error
+	hint string
+}
+
+func usageErrorf(format string, a ...any) error {
+	return usageError{error: fmt.Errorf(format, a...)}
+}
+
+// run executes the command line args and returns the exit status: 0 on success, 1 if the command failed and 2 if the
+// command line is invalid.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	err := execute(args, stdin, stdout, stderr)
+	var usage usageError
+	switch {
+	case err == nil || errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.As(err, &usage):
+		if usage.hint == "" {
+			usage.hint = "Run 'go-network help' for usage."
+		}
+		fmt.Fprintf(stderr, "go-network: %v\n%s\n", err, usage.hint)
+		return 2
+	default:
+		fmt.Fprintf(stderr, "go-network: %v\n", err)
+		return 1
+	}
+}
+
+func execute(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		printUsage(stderr)
+		return usageErrorf("no command given")
+	}
+	switch command, rest := args[0], args[1:]; command {
+	case "gen", "generate":
+		return generate(rest, stdout)
+	case "convert":
+		return convert(rest, stdin, stdout)
+	case "list":
+		printList(stdout)
+		return nil
+	case "help", "-h", "-help", "--help":
+		if len(rest) == 0 {
+			printUsage(stdout)
+			return nil
+		}
+		g, err := lookupGenerator(rest[0])
+		if err != nil {
+			return err
+		}
+		printGenerator(stdout, rest[0], g)
+		return nil
+	default:
+		return usageErrorf("unknown command %q", command)
+	}
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprint(w, `Usage:
+  go-network gen <generator> [--parameter value ...] [--format format] [--output file]
+  go-network convert [--from format] [--to format] <input> <output>
+  go-network list                 list the generators and the formats
+  go-network help <generator>     show the parameters of a generator
+
+Formats are implied by the file extensions when they are not given, and - stands for the
+standard input or output. gen writes to the standard output by default.
+`)
+}
+
+func printList(w io.Writer) {
+	out := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(out, "Generators:")
+	for _, name := range generatorNames() {
+		fmt.Fprintf(out, "  %s\t%s\n", name, generators[name].description)
+	}
+	fmt.Fprintln(out, "\nFormats:")
+	for _, name := range formatNames() {
+		f := formats[name]
+		fmt.Fprintf(out, "  %s\t%s\t%s\n", name, strings.Join(f.extensions, " "), f.description)
+	}
+	out.Flush()
+}
+
+func printGenerator(w io.Writer, name string, g generator) {
+	fmt.Fprintf(w, "%s: %s\n\nUsage:\n  go-network gen %s", name, g.description, name)
+	for _, p := range g.parameters {
+		fmt.Fprintf(w, " [--%s value]", p.name)
+	}
+	fmt.Fprint(w, " [--format format] [--output file]\n\nParameters:\n")
+	out := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, p := range g.parameters {
+		fmt.Fprintf(out, "  --%s\t%s (default %q)\n", p.name, p.usage, p.value)
+	}
+	fmt.Fprintf(out, "  --format\tone of %s (default implied by --output, or %s)\n", strings.Join(formatNames(), ", "), defaultFormat)
+	fmt.Fprintf(out, "  --output, -o\tfile to write, - for the standard output (default \"-\")\n")
+	out.Flush()
+}
+
+func generatorNames() []string {
+	names := make([]string, 0, len(generators))
+	for name := range generators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// lookupGenerator returns the generator of a name, or an error suggesting the generators whose names contain it.
+func lookupGenerator(name string) (generator, error) {
+	if g, ok := generators[name]; ok {
+		return g, nil
+	}
+	var suggestions []string
+	for _, candidate := range generatorNames() {
+		if name != "" && (strings.Contains(candidate, name) || strings.Contains(name, candidate)) {
+			suggestions = append(suggestions, candidate)
+		}
+	}
+	if len(suggestions) > 0 {
+		return generator{}, usageErrorf("unknown generator %q, did you mean %s?", name, strings.Join(suggestions, " or "))
+	}
+	return generator{}, usageErrorf("unknown generator %q; run 'go-network list' for the generators", name)
+}
+
+// parseFlags parses the flags of args, which may come before, between or after the positional arguments, and returns
+// the positional arguments.
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, usageError{error: err}
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+func generate(args []string, stdout io.Writer) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return usageErrorf("gen needs a generator; run 'go-network list' for the generators")
+	}
+	name := args[0]
+	g, err := lookupGenerator(name)
+	if err != nil {
+		return err
+	}
+
+	fs := flag.NewFlagSet("gen "+name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	values := make(map[string]*string, len(g.parameters))
+	for _, p := range g.parameters {
+		values[p.name] = fs.String(p.name, p.value, p.usage)
+	}
+	formatName := fs.String("format", "", "output format")
+	output := fs.String("output", "-", "output file")
+	fs.StringVar(output, "o", "-", "output file")
+	// parameterError points to the parameters of the generator
+	parameterError := func(err error) error {
+		return usageError{error: err, hint: fmt.Sprintf("Run 'go-network help %s' for its parameters.", name)}
+	}
+	positional, err := parseFlags(fs, args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		printGenerator(stdout, name, g)
+		return err
+	}
+	if err != nil {
+		return parameterError(err)
+	}
+	if len(positional) > 0 {
+		return parameterError(fmt.Errorf("unexpected argument %q", positional[0]))
+	}
+	f, err := resolveFormat(*formatName, *output)
+	if err != nil {
+		return parameterError(err)
+	}
+
+	a := make(arguments, len(g.parameters))
+	for _, p := range g.parameters {
+		if a[p.name], err = p.parse(*values[p.name]); err != nil {
+			return parameterError(err)
+		}
+	}
+	d, err := g.build(a)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return writeDocument(*output, f, d, stdout)
+}
+
+func convert(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	from := fs.String("from", "", "input format")
+	to := fs.String("to", "", "output format")
+	positional, err := parseFlags(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		printUsage(stdout)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return usageErrorf("convert needs an input and an output file, got %d arguments", len(positional))
+	}
+	input, output := positional[0], positional[1]
+	inputFormat, err := resolveFormat(*from, input)
+	if err != nil {
+		return usageError{error: err}
+	}
+	outputFormat, err := resolveFormat(*to, output)
+	if err != nil {
+		return usageError{error: err}
+	}
+
+	var d *graphio.GraphDocument
+	if input == "-" {
+		d, err = inputFormat.read(stdin)
+	} else {
+		file, openErr := os.Open(input)
+		if openErr != nil {
+			return openErr
+		}
+		d, err = inputFormat.read(file)
+		file.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", input, err)
+	}
+	return writeDocument(output, outputFormat, d, stdout)
+}
+
+// writeDocument writes a graph to a file, or to stdout if path is -.
+func writeDocument(path string, f format, d *graphio.GraphDocument, stdout io.Writer) error {
+	if path == "-" {
+		return f.write(stdout, d)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := f.write(file, d); err != nil {
+		file.Close()
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return file.Close()
+}
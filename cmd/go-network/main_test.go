@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	graphio "github.com/jmCodeCraft/go-network/io"
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// runCommand runs a command line and returns its exit status and outputs.
+func runCommand(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	status := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return status, stdout.String(), stderr.String()
+}
+
+func TestGenerators(t *testing.T) {
+	for _, name := range generatorNames() {
+		t.Run(name, func(t *testing.T) {
+			g := generators[name]
+			a := make(arguments, len(g.parameters))
+			for _, p := range g.parameters {
+				value, err := p.parse(p.value)
+				if err != nil {
+					t.Fatalf("Unexpected error in the default of --%s: %v", p.name, err)
+				}
+				a[p.name] = value
+			}
+			d, err := g.build(a)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if d.Undirected == nil == (d.Directed == nil) {
+				t.Fatalf("Expected a directed or an undirected graph")
+			}
+			for _, formatName := range formatNames() {
+				var out bytes.Buffer
+				if err := formats[formatName].write(&out, d); err != nil {
+					t.Errorf("Unexpected error writing %s: %v", formatName, err)
+				}
+			}
+		})
+	}
+}
+
+func TestGen(t *testing.T) {
+	status, stdout, stderr := runCommand("", "gen", "wheel", "--nodes", "5", "--format", "dot")
+	if status != 0 {
+		t.Fatalf("Expected success, but got %d: %s", status, stderr)
+	}
+	d, err := graphio.ReadDOT(strings.NewReader(stdout))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !d.Undirected.Equals(model.WheelGraph(5)) {
+		t.Errorf("Expected the wheel on 5 nodes, but got %v", d.Undirected)
+	}
+
+	// Flags may come in any order, and the format is implied by the output file
+	output := filepath.Join(t.TempDir(), "gnp.json")
+	if status, _, stderr := runCommand("", "gen", "gnp", "-o", output, "--probability", "0.5", "--seed=3", "--nodes", "20"); status != 0 {
+		t.Fatalf("Expected success, but got %d: %s", status, stderr)
+	}
+	file, err := os.Open(output)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer file.Close()
+	d, err = graphio.ReadNodeLinkJSON(file)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected, _ := model.GnpRandomGraph(20, 0.5, 3)
+	if !d.Undirected.Equals(expected) {
+		t.Errorf("Expected G(20, 0.5) with seed 3, but got %v", d.Undirected)
+	}
+
+	// Spatial generators write the coordinates of the nodes
+	_, stdout, _ = runCommand("", "gen", "random-geometric", "--nodes", "5", "--format", "graphml")
+	if d, err := graphio.ReadGraphML(strings.NewReader(stdout)); err != nil || d.NodeAttributes[0]["x"] == "" {
+		t.Errorf("Expected the coordinates of the nodes, but got %v", stdout)
+	}
+
+	// Transport networks write their hubs, capacities and travel times
+	_, stdout, _ = runCommand("", "gen", "grid-transport", "--rows", "3", "--cols", "3", "--hubs", "1", "--format", "json")
+	d, err = graphio.ReadNodeLinkJSON(strings.NewReader(stdout))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	hubs := 0
+	for _, attributes := range d.NodeAttributes {
+		if attributes["hub"] == "true" {
+			hubs++
+		}
+	}
+	if hubs != 1 || len(d.EdgeAttributes) != 12 {
+		t.Errorf("Expected a hub and the attributes of the 12 streets, but got %v and %v", d.NodeAttributes, d.EdgeAttributes)
+	}
+	for edge, attributes := range d.EdgeAttributes {
+		if attributes["capacity"] == "" || attributes["travel_time"] == "" {
+			t.Errorf("Expected the capacity and travel time of %v, but got %v", edge, attributes)
+		}
+	}
+}
+
+func TestGenErrors(t *testing.T) {
+	testCases := []struct {
+		args    []string
+		status  int
+		message string
+	}{
+		{[]string{"gen", "whee"}, 2, "did you mean directed-wheel or wheel?"},
+		{[]string{"gen"}, 2, "gen needs a generator"},
+		{[]string{"gen", "wheel", "--nodes", "ten"}, 2, "--nodes must be an integer"},
+		{[]string{"gen", "wheel", "--nodes", "-1"}, 2, "--nodes can't be < 0"},
+		{[]string{"gen", "wheel", "--edges", "3"}, 2, "help wheel"},
+		{[]string{"gen", "wheel", "--format", "png"}, 2, "unknown format \"png\""},
+		{[]string{"gen", "sbm", "--probabilities", "0.5,0.1;0.1"}, 2, "square matrix"},
+		{[]string{"gen", "gnp", "--probability", "2"}, 1, "gnp: "},
+		{[]string{"frobnicate"}, 2, "unknown command"},
+	}
+	for _, testCase := range testCases {
+		status, _, stderr := runCommand("", testCase.args...)
+		if status != testCase.status || !strings.Contains(stderr, testCase.message) {
+			t.Errorf("Expected status %d and %q for %v, but got %d and %q", testCase.status, testCase.message, testCase.args, status, stderr)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	directory := t.TempDir()
+	input := filepath.Join(directory, "in.graphml")
+	if status, _, stderr := runCommand("", "gen", "directed-wheel", "--nodes", "6", "--output", input); status != 0 {
+		t.Fatalf("Expected success, but got %d: %s", status, stderr)
+	}
+	// Through every format that keeps the direction, and back
+	previous := input
+	for _, name := range []string{"dot", "gexf", "json", "graphml"} {
+		next := filepath.Join(directory, "out."+name)
+		if status, _, stderr := runCommand("", "convert", previous, next); status != 0 {
+			t.Fatalf("Expected success converting to %s, but got %d: %s", name, status, stderr)
+		}
+		previous = next
+	}
+	status, stdout, stderr := runCommand("", "convert", "--to", "edgelist", previous, "-")
+	if status != 0 {
+		t.Fatalf("Expected success, but got %d: %s", status, stderr)
+	}
+	expected := "0,1\n0,2\n0,3\n0,4\n0,5\n1,2\n2,3\n3,4\n4,5\n5,1\n"
+	if stdout != expected {
+		t.Errorf("Expected %q, but got %q", expected, stdout)
+	}
+
+	// The standard input, read as an edge list by default
+	status, stdout, _ = runCommand("0,1\n1,2\n", "convert", "-", "-", "--to", "dot")
+	if status != 0 || !strings.Contains(stdout, "1 -- 2") {
+		t.Errorf("Expected the path in DOT, but got %d and %q", status, stdout)
+	}
+
+	if status, _, stderr := runCommand("", "convert", input); status != 2 || !strings.Contains(stderr, "an input and an output") {
+		t.Errorf("Expected a usage error, but got %d and %q", status, stderr)
+	}
+	if status, _, _ := runCommand("", "convert", filepath.Join(directory, "missing.json"), "-"); status != 1 {
+		t.Errorf("Expected a failure for a missing file, but got %d", status)
+	}
+	if status, _, stderr := runCommand("0,x\n", "convert", "-", "-"); status != 1 || !strings.Contains(stderr, "line 1") {
+		t.Errorf("Expected an error on line 1, but got %d and %q", status, stderr)
+	}
+}
+
+func TestListAndHelp(t *testing.T) {
+	_, stdout, _ := runCommand("", "list")
+	for _, name := range append(generatorNames(), formatNames()...) {
+		if !strings.Contains(stdout, name) {
+			t.Errorf("Expected %s to be listed", name)
+		}
+	}
+	status, stdout, _ := runCommand("", "help", "watts-strogatz")
+	if status != 0 || !strings.Contains(stdout, "--neighbors") || !strings.Contains(stdout, "--seed") {
+		t.Errorf("Expected the parameters of watts-strogatz, but got %q", stdout)
+	}
+	if status, stdout, _ := runCommand("", "gen", "star", "--help"); status != 0 || !strings.Contains(stdout, "--nodes") {
+		t.Errorf("Expected the parameters of star, but got %d and %q", status, stdout)
+	}
+}
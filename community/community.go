@@ -0,0 +1,232 @@
+package community
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// The partitions returned by this package hold the nodes of every community in ascending order, the communities being
+// ordered by their smallest node.
+
+// LabelPropagation partitions the nodes of g by asynchronous label propagation, see
+// model.LabelPropagationCommunities. The same seed gives the same partition.
+func LabelPropagation(g *model.UndirectedGraph, seed int64) [][]model.Node {
+	labels := model.LabelPropagationCommunities(g, model.UnitWeight, rand.New(rand.NewSource(seed)))
+	return partitionOf(sortedNodes(g.Nodes), labels)
+}
+
+/*
+Louvain partitions the nodes of g into communities of high modularity with the Louvain method.
+
+Parameters:
+- g: The graph.
+- seed: The seed of the random number generator, which decides the order in which nodes are visited; the same seed
+gives the same partition.
+
+Returns:
+- partition: The communities. Isolated nodes are alone in theirs.
+
+Description:
+Every node starts in its own community. In passes over the nodes in random order, a node moves to the neighboring
+community that increases the modularity the most, if any, until a pass moves no node. The communities then become
+the nodes of a weighted graph, whose edge weights are the number of edges between them and whose self-loops hold
+the edges inside them, and the passes start again on it. The method stops when a level moves no node, and returns
+the communities of the last level expanded to the nodes of g. Each pass takes O(n + m) time and the number of nodes
+usually drops quickly from one level to the next.
+
+Reference: V. D. Blondel, J.-L. Guillaume, R. Lambiotte and E. Lefebvre, "Fast unfolding of communities in large
+networks", J. Stat. Mech. P10008, 2008.
+*/
+func Louvain(g *model.UndirectedGraph, seed int64) [][]model.Node {
+	rng := rand.New(rand.NewSource(seed))
+	nodes := sortedNodes(g.Nodes)
+	index := make(map[model.Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	// weights[i][j] is the entry (i, j) of the adjacency matrix, a self-loop counting twice as in the degrees
+	weights := make([]map[int]float64, len(nodes))
+	for i, node := range nodes {
+		weights[i] = make(map[int]float64)
+		for _, neighbor := range g.Edges[node] {
+			weights[i][index[neighbor]]++
+		}
+	}
+	// membership maps every node of g to its node of the current level
+	membership := make([]int, len(nodes))
+	for i := range membership {
+		membership[i] = i
+	}
+
+	for {
+		communities, moved := louvainLevel(weights, rng)
+		if !moved {
+			break
+		}
+		count := 0
+		renumbered := make(map[int]int)
+		for _, c := range communities {
+			if _, ok := renumbered[c]; !ok {
+				renumbered[c] = count
+				count++
+			}
+		}
+		aggregated := make([]map[int]float64, count)
+		for c := range aggregated {
+			aggregated[c] = make(map[int]float64)
+		}
+		for i, row := range weights {
+			for j, weight := range row {
+				aggregated[renumbered[communities[i]]][renumbered[communities[j]]] += weight
+			}
+		}
+		for i, node := range membership {
+			membership[i] = renumbered[communities[node]]
+		}
+		weights = aggregated
+	}
+
+	labels := make(map[model.Node]int, len(nodes))
+	for i, node := range nodes {
+		labels[node] = membership[i]
+	}
+	return partitionOf(nodes, labels)
+}
+
+// louvainLevel moves the nodes of a weighted graph between communities until no move increases the modularity, and
+// returns the community of every node and whether any node moved.
+func louvainLevel(weights []map[int]float64, rng *rand.Rand) ([]int, bool) {
+	communities := make([]int, len(weights))
+	degrees := make([]float64, len(weights))
+	totals := make([]float64, len(weights))
+	twiceEdges := 0.0
+	for i, row := range weights {
+		communities[i] = i
+		for _, weight := range row {
+			degrees[i] += weight
+		}
+		totals[i] = degrees[i]
+		twiceEdges += degrees[i]
+	}
+	if twiceEdges == 0 {
+		return communities, false
+	}
+
+	moved := false
+	for {
+		changed := false
+		for _, i := range rng.Perm(len(weights)) {
+			current := communities[i]
+			totals[current] -= degrees[i]
+			links := make(map[int]float64)
+			for j, weight := range weights[i] {
+				if j != i {
+					links[communities[j]] += weight
+				}
+			}
+			// The gain of joining c, up to a factor 1/m common to all the communities
+			gain := func(c int) float64 { return links[c] - totals[c]*degrees[i]/twiceEdges }
+			candidates := make([]int, 0, len(links))
+			for c := range links {
+				candidates = append(candidates, c)
+			}
+			sort.Ints(candidates)
+			best, bestGain := current, gain(current)
+			for _, c := range candidates {
+				if g := gain(c); g > bestGain+1e-12 {
+					best, bestGain = c, g
+				}
+			}
+			totals[best] += degrees[i]
+			if best != current {
+				communities[i] = best
+				changed, moved = true, true
+			}
+		}
+		if !changed {
+			return communities, moved
+		}
+	}
+}
+
+/*
+Modularity scores a partition of the nodes of g: the fraction of the edges inside communities, minus the fraction
+expected if the edges were rewired at random keeping the degrees.
+
+Parameters:
+- g: The graph.
+- partition: The communities, which must cover every node of g exactly once.
+
+Returns:
+- q: The modularity, sum over the communities c of L_c / m - (d_c / 2m)^2, where L_c is the number of edges inside
+c, d_c the sum of the degrees of its nodes and m the number of edges of g. It is at most 1, and 0 if g has no edges.
+- err: An error if partition lists a node twice, a node not in g, or misses a node of g.
+
+Reference: M. E. J. Newman, "Networks: An Introduction", Oxford University Press, 2010, section 7.7.
+*/
+func Modularity(g *model.UndirectedGraph, partition [][]model.Node) (float64, error) {
+	labels := make(map[model.Node]int, len(g.Nodes))
+	for c, community := range partition {
+		for _, node := range community {
+			if !g.Nodes[node] {
+				return 0, fmt.Errorf("node %d of community %d is not in the graph", node, c)
+			}
+			if _, seen := labels[node]; seen {
+				return 0, fmt.Errorf("node %d is in more than one community", node)
+			}
+			labels[node] = c
+		}
+	}
+	if len(labels) != len(g.Nodes) {
+		return 0, fmt.Errorf("the partition covers %d of the %d nodes", len(labels), len(g.Nodes))
+	}
+
+	twiceEdges := 0.0
+	inside := make([]float64, len(partition))
+	degrees := make([]float64, len(partition))
+	for node, c := range labels {
+		for _, neighbor := range g.Edges[node] {
+			if labels[neighbor] == c {
+				inside[c]++
+			}
+		}
+		degrees[c] += float64(len(g.Edges[node]))
+		twiceEdges += float64(len(g.Edges[node]))
+	}
+	if twiceEdges == 0 {
+		return 0, nil
+	}
+	q := 0.0
+	for c := range partition {
+		q += inside[c]/twiceEdges - (degrees[c]/twiceEdges)*(degrees[c]/twiceEdges)
+	}
+	return q, nil
+}
+
+// partitionOf groups sorted nodes by their label, the communities being ordered by their smallest node.
+func partitionOf(nodes []model.Node, labels map[model.Node]int) [][]model.Node {
+	var partition [][]model.Node
+	positions := make(map[int]int)
+	for _, node := range nodes {
+		position, ok := positions[labels[node]]
+		if !ok {
+			position = len(partition)
+			positions[labels[node]] = position
+			partition = append(partition, nil)
+		}
+		partition[position] = append(partition[position], node)
+	}
+	return partition
+}
+
+func sortedNodes(set map[model.Node]bool) []model.Node {
+	nodes := make([]model.Node, 0, len(set))
+	for node := range set {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return nodes
+}
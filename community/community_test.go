@@ -0,0 +1,92 @@
+package community
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// twoTriangles returns two triangles, 0-1-2 and 3-4-5, joined by the edge 2-3.
+func twoTriangles() *model.UndirectedGraph {
+	g := &model.UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {0, 2}, {3, 4}, {4, 5}, {3, 5}, {2, 3}})
+	return g
+}
+
+func TestModularity(t *testing.T) {
+	g := twoTriangles()
+	q, err := Modularity(g, [][]model.Node{{0, 1, 2}, {3, 4, 5}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Each triangle has 3 of the 7 edges and half of the degrees: 2 (3/7 - 1/4)
+	if expected := 6.0/7 - 0.5; math.Abs(q-expected) > 1e-12 {
+		t.Errorf("Expected %v, but got %v", expected, q)
+	}
+	if q, _ := Modularity(g, [][]model.Node{{0, 1, 2, 3, 4, 5}}); math.Abs(q) > 1e-12 {
+		t.Errorf("Expected 0 for a single community, but got %v", q)
+	}
+
+	if _, err := Modularity(g, [][]model.Node{{0, 1, 2}, {2, 3, 4, 5}}); err == nil {
+		t.Errorf("Expected an error for a node in two communities")
+	}
+	if _, err := Modularity(g, [][]model.Node{{0, 1, 2}}); err == nil {
+		t.Errorf("Expected an error for a partition missing nodes")
+	}
+	if _, err := Modularity(g, [][]model.Node{{0, 1, 2}, {3, 4, 5, 6}}); err == nil {
+		t.Errorf("Expected an error for a node not in the graph")
+	}
+}
+
+func TestLouvain(t *testing.T) {
+	g := twoTriangles()
+	g.AddNode(6)
+	expected := [][]model.Node{{0, 1, 2}, {3, 4, 5}, {6}}
+	for seed := int64(0); seed < 5; seed++ {
+		if partition := Louvain(g, seed); !reflect.DeepEqual(partition, expected) {
+			t.Errorf("Expected %v, but got %v", expected, partition)
+		}
+	}
+
+	sizes := []int{20, 20, 20}
+	probabilities := [][]float64{{0.6, 0.01, 0.01}, {0.01, 0.6, 0.01}, {0.01, 0.01, 0.6}}
+	sbm, err := model.StochasticBlockModelGraph(sizes, probabilities, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	partition := Louvain(sbm, 2)
+	if len(partition) != 3 || len(partition[0]) != 20 || partition[1][0] != 20 || partition[2][0] != 40 {
+		t.Errorf("Expected the three blocks, but got %v", partition)
+	}
+	if !reflect.DeepEqual(Louvain(sbm, 2), partition) {
+		t.Errorf("Expected the same partition for the same seed")
+	}
+	q, _ := Modularity(sbm, partition)
+	if single, _ := Modularity(sbm, LabelPropagation(sbm, 2)); q < single-1e-9 || q < 0.5 {
+		t.Errorf("Expected a modularity above 0.5 and that of label propagation, but got %v and %v", q, single)
+	}
+
+	if partition := Louvain(&model.UndirectedGraph{}, 1); len(partition) != 0 {
+		t.Errorf("Expected no community for an empty graph, but got %v", partition)
+	}
+}
+
+func TestLabelPropagation(t *testing.T) {
+	g := twoTriangles()
+	partition := LabelPropagation(g, 1)
+	covered := 0
+	for _, community := range partition {
+		covered += len(community)
+	}
+	if covered != 6 {
+		t.Errorf("Expected a partition of the 6 nodes, but got %v", partition)
+	}
+	if _, err := Modularity(g, partition); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(LabelPropagation(g, 1), partition) {
+		t.Errorf("Expected the same partition for the same seed")
+	}
+}
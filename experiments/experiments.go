@@ -0,0 +1,116 @@
+package experiments
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"text/tabwriter"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// GeneratorSpec describes the random graph model an experiment samples from. Generate must build the same graph for
+// the same seed, for example by drawing from rand.New(rand.NewSource(seed)).
+type GeneratorSpec struct {
+	Name       string
+	Parameters map[string]float64
+	Generate   func(seed int64) (*model.UndirectedGraph, error)
+}
+
+// MetricSummary aggregates the values of a metric over the trials of an experiment.
+type MetricSummary struct {
+	Name   string    `json:"name"`
+	Mean   float64   `json:"mean"`
+	StdDev float64   `json:"stddev"`
+	Min    float64   `json:"min"`
+	Max    float64   `json:"max"`
+	Values []float64 `json:"values"`
+}
+
+// Report is the outcome of an experiment, in the order the metrics were requested.
+type Report struct {
+	Generator  string             `json:"generator"`
+	Parameters map[string]float64 `json:"parameters,omitempty"`
+	Seeds      []int64            `json:"seeds"`
+	Metrics    []MetricSummary    `json:"metrics"`
+}
+
+/*
+Run samples graphs from a generator and measures them.
+
+Parameters:
+- spec: The generator to sample from.
+- metrics: The metrics computed on every sampled graph.
+- trials: The number of graphs to sample.
+- baseSeed: The seed of the first trial; trial i uses baseSeed + i.
+
+Returns:
+- report: The seeds used and, for every metric, its values and their mean, standard deviation and range.
+- err: An error if trials is not positive, if spec has no Generate function or if a trial fails.
+*/
+func Run(spec GeneratorSpec, metrics []Metric, trials int, baseSeed int64) (*Report, error) {
+	if trials <= 0 {
+		return nil, fmt.Errorf("the number of trials must be positive")
+	}
+	if spec.Generate == nil {
+		return nil, fmt.Errorf("generator %q has no Generate function", spec.Name)
+	}
+
+	report := &Report{Generator: spec.Name, Parameters: spec.Parameters, Metrics: make([]MetricSummary, len(metrics))}
+	for i, metric := range metrics {
+		report.Metrics[i] = MetricSummary{Name: metric.Name, Values: make([]float64, 0, trials)}
+	}
+
+	for trial := 0; trial < trials; trial++ {
+		seed := baseSeed + int64(trial)
+		g, err := spec.Generate(seed)
+		if err != nil {
+			return nil, fmt.Errorf("trial %d (seed %d) failed: %w", trial, seed, err)
+		}
+		report.Seeds = append(report.Seeds, seed)
+		for i, metric := range metrics {
+			report.Metrics[i].Values = append(report.Metrics[i].Values, metric.Compute(g))
+		}
+	}
+
+	for i := range report.Metrics {
+		summarize(&report.Metrics[i])
+	}
+	return report, nil
+}
+
+func summarize(summary *MetricSummary) {
+	summary.Min, summary.Max = math.Inf(1), math.Inf(-1)
+	for _, value := range summary.Values {
+		summary.Mean += value
+		summary.Min = math.Min(summary.Min, value)
+		summary.Max = math.Max(summary.Max, value)
+	}
+	summary.Mean /= float64(len(summary.Values))
+	for _, value := range summary.Values {
+		summary.StdDev += (value - summary.Mean) * (value - summary.Mean)
+	}
+	summary.StdDev = math.Sqrt(summary.StdDev / float64(len(summary.Values)))
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(r); err != nil {
+		return fmt.Errorf("error encoding report: %w", err)
+	}
+	return nil
+}
+
+// WriteTable writes one aligned row per metric with its mean, standard deviation and range.
+func (r *Report) WriteTable(w io.Writer) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(table, "generator: %s, trials: %d\n", r.Generator, len(r.Seeds))
+	fmt.Fprintln(table, "metric\tmean\tstddev\tmin\tmax")
+	for _, metric := range r.Metrics {
+		fmt.Fprintf(table, "%s\t%.4f\t%.4f\t%.4f\t%.4f\n", metric.Name, metric.Mean, metric.StdDev, metric.Min, metric.Max)
+	}
+	return table.Flush()
+}
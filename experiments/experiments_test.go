@@ -0,0 +1,86 @@
+package experiments
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// cycleSpec builds a cycle with as many nodes as the seed.
+var cycleSpec = GeneratorSpec{
+	Name: "cycle",
+	Generate: func(seed int64) (*model.UndirectedGraph, error) {
+		return model.CycleGraph(int(seed)), nil
+	},
+}
+
+func TestRun(t *testing.T) {
+	report, err := Run(cycleSpec, []Metric{NumberOfNodesMetric, DiameterMetric}, 3, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(report.Seeds, []int64{4, 5, 6}) {
+		t.Errorf("Expected seeds [4 5 6], but got %v", report.Seeds)
+	}
+
+	nodes := report.Metrics[0]
+	if nodes.Name != "nodes" || nodes.Mean != 5 || nodes.Min != 4 || nodes.Max != 6 || math.Abs(nodes.StdDev-math.Sqrt(2.0/3)) > 1e-12 {
+		t.Errorf("Unexpected node count summary %+v", nodes)
+	}
+	if diameters := report.Metrics[1].Values; !reflect.DeepEqual(diameters, []float64{2, 2, 3}) {
+		t.Errorf("Expected diameters [2 2 3], but got %v", diameters)
+	}
+
+	var decoded Report
+	var buffer bytes.Buffer
+	if err := report.WriteJSON(&buffer); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := json.Unmarshal(buffer.Bytes(), &decoded); err != nil || !reflect.DeepEqual(&decoded, report) {
+		t.Errorf("Expected the JSON report to round-trip, but got %+v (error %v)", decoded, err)
+	}
+
+	buffer.Reset()
+	if err := report.WriteTable(&buffer); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buffer.String(), "nodes     5.0000  0.8165  4.0000  6.0000") {
+		t.Errorf("Unexpected table:\n%s", buffer.String())
+	}
+
+	failing := GeneratorSpec{Name: "failing", Generate: func(seed int64) (*model.UndirectedGraph, error) {
+		return nil, fmt.Errorf("invalid parameters")
+	}}
+	if _, err := Run(failing, []Metric{NumberOfNodesMetric}, 2, 0); err == nil {
+		t.Errorf("Expected an error for a failing generator, but got nil")
+	}
+	if _, err := Run(cycleSpec, nil, 0, 0); err == nil {
+		t.Errorf("Expected an error for zero trials, but got nil")
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	g := model.CompleteGraph(4)
+	g.AddEdge(model.Edge{Node1: 3, Node2: 4})
+	g.AddNode(9)
+
+	// Nodes 0, 1 and 2 are fully clustered, node 3 has 3 links out of 6 possible ones
+	if clustering := AverageClusteringMetric.Compute(g); math.Abs(clustering-3.5/6) > 1e-12 {
+		t.Errorf("Expected clustering %v, but got %v", 3.5/6, clustering)
+	}
+	if diameter := DiameterMetric.Compute(g); diameter != 2 {
+		t.Errorf("Expected diameter 2, but got %v", diameter)
+	}
+
+	star := model.StarGraph(5)
+	expected := 1 + 5/(4*math.Log(2)+math.Log(8))
+	if exponent := DegreeExponentMetric.Compute(star); math.Abs(exponent-expected) > 1e-12 {
+		t.Errorf("Expected degree exponent %v, but got %v", expected, exponent)
+	}
+}
@@ -0,0 +1,66 @@
+package experiments
+
+import (
+	"math"
+
+	"github.com/jmCodeCraft/go-network/analysis"
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// Metric is a named scalar measurement of a graph.
+type Metric struct {
+	Name    string
+	Compute func(g *model.UndirectedGraph) float64
+}
+
+var (
+	// NumberOfNodesMetric counts the nodes of the graph.
+	NumberOfNodesMetric = Metric{Name: "nodes", Compute: func(g *model.UndirectedGraph) float64 {
+		return float64(len(g.Nodes))
+	}}
+
+	// NumberOfEdgesMetric counts the edges of the graph.
+	NumberOfEdgesMetric = Metric{Name: "edges", Compute: func(g *model.UndirectedGraph) float64 {
+		return float64(g.NumberOfEdges())
+	}}
+
+	// AverageClusteringMetric is the mean local clustering coefficient, nodes with fewer than two neighbors counting as 0.
+	AverageClusteringMetric = Metric{Name: "clustering", Compute: analysis.AverageClustering}
+
+	// DiameterMetric is the diameter of the largest connected component.
+	DiameterMetric = Metric{Name: "diameter", Compute: largestComponentDiameter}
+
+	// DegreeExponentMetric is the maximum likelihood estimate of the exponent of a power-law degree distribution.
+	DegreeExponentMetric = Metric{Name: "degree_exponent", Compute: degreeExponent}
+)
+
+func largestComponentDiameter(g *model.UndirectedGraph) float64 {
+	components := model.ConnectedComponents(g)
+	component := components.GetBiggestComponent()
+	if component == nil {
+		return 0
+	}
+	// Components hold spanning trees, so the distances are measured in the subgraph of g they induce
+	nodes := make([]model.Node, 0, len(component.Nodes))
+	for node := range component.Nodes {
+		nodes = append(nodes, node)
+	}
+	diameter, _ := analysis.Diameter(model.Subgraph(g, nodes))
+	return float64(diameter)
+}
+
+// degreeExponent uses the discrete approximation alpha = 1 + n / sum(ln(k / (kmin - 1/2))) with kmin = 1, ignoring
+// isolated nodes. It returns 0 if every node is isolated.
+func degreeExponent(g *model.UndirectedGraph) float64 {
+	count, sum := 0, 0.0
+	for node := range g.Nodes {
+		if degree := len(g.Edges[node]); degree > 0 {
+			count++
+			sum += math.Log(float64(degree) / 0.5)
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return 1 + float64(count)/sum
+}
@@ -0,0 +1,97 @@
+package experiments
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// SignificanceResult compares the value of a metric on an observed graph with its distribution under a null model.
+type SignificanceResult struct {
+	Metric   string  `json:"metric"`
+	Observed float64 `json:"observed"`
+	// Null summarizes the values of the metric on the graphs drawn from the null model.
+	Null MetricSummary `json:"null"`
+	// ZScore is (Observed - Null.Mean) / Null.StdDev, or 0 if the result is Degenerate.
+	ZScore float64 `json:"z_score"`
+	// Degenerate reports that the null values are constant, so that the z-score is undefined; the p-values still tell
+	// whether Observed differs from them.
+	Degenerate bool `json:"degenerate"`
+	// PValueGreater and PValueLess are the empirical one-sided p-values (1 + k) / (1 + samples), k being the number of
+	// null values at least, respectively at most, as large as Observed.
+	PValueGreater float64 `json:"p_value_greater"`
+	PValueLess    float64 `json:"p_value_less"`
+	// PValue is the two-sided p-value, twice the smaller one-sided p-value, capped at 1.
+	PValue float64 `json:"p_value"`
+}
+
+/*
+NullModelTest estimates by Monte Carlo sampling how surprising the value of a metric on a graph is under a null model.
+
+Parameters:
+- g: The observed graph.
+- metric: The metric to test. Its Compute function is called concurrently and must not modify the graphs.
+- null: The null model, e.g. model.GNMNullModel, model.ConfigurationNullModel or model.RewiringNullModel(10).
+- samples: The number of graphs drawn from the null model.
+- seed: The seed of the first sample; sample i draws from rand.New(rand.NewSource(seed + i)), so that the result does not depend on workers.
+- workers: The number of goroutines drawing and measuring samples.
+
+Returns:
+- result: The observed value, the null distribution, the z-score and the empirical p-values.
+- err: An error if samples or workers is not positive.
+
+Example:
+
+	g := model.WattsStrogatzRandomGraph(100, 6, 0.1)
+	result, _ := NullModelTest(g, AverageClusteringMetric, model.RewiringNullModel(10), 1000, 1, 8)
+	fmt.Println(result.ZScore > 3) // Output: true
+*/
+func NullModelTest(g *model.UndirectedGraph, metric Metric, null model.NullModel, samples int, seed int64, workers int) (*SignificanceResult, error) {
+	if samples <= 0 || workers <= 0 {
+		return nil, fmt.Errorf("the number of samples and workers must be positive")
+	}
+
+	values := make([]float64, samples)
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				values[i] = metric.Compute(null(g, rand.New(rand.NewSource(seed+int64(i)))))
+			}
+		}()
+	}
+	for i := 0; i < samples; i++ {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	result := &SignificanceResult{Metric: metric.Name, Observed: metric.Compute(g), Null: MetricSummary{Name: metric.Name, Values: values}}
+	summarize(&result.Null)
+
+	greater, less := 0, 0
+	for _, value := range values {
+		if value >= result.Observed {
+			greater++
+		}
+		if value <= result.Observed {
+			less++
+		}
+	}
+	result.PValueGreater = float64(1+greater) / float64(1+samples)
+	result.PValueLess = float64(1+less) / float64(1+samples)
+	result.PValue = math.Min(1, 2*math.Min(result.PValueGreater, result.PValueLess))
+
+	if result.Null.StdDev > 0 {
+		result.ZScore = (result.Observed - result.Null.Mean) / result.Null.StdDev
+	} else {
+		result.Degenerate = true
+	}
+	return result, nil
+}
@@ -0,0 +1,65 @@
+package experiments
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestNullModelTest(t *testing.T) {
+	// A ring lattice is far more clustered than graphs with the same degrees
+	lattice := model.WattsStrogatzRandomGraph(40, 4, 0)
+	result, err := NullModelTest(lattice, AverageClusteringMetric, model.RewiringNullModel(10), 100, 1, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Observed != 0.5 || result.ZScore < 3 || result.PValueGreater != 1.0/101 || result.PValueLess != 1 || result.PValue != 2.0/101 {
+		t.Errorf("Expected a significant clustering of 0.5, but got %+v", result)
+	}
+
+	// The result does not depend on the number of workers
+	again, err := NullModelTest(lattice, AverageClusteringMetric, model.RewiringNullModel(10), 100, 1, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The metric sums over a map, so values may differ in the last bits
+	for i := range result.Null.Values {
+		if math.Abs(result.Null.Values[i]-again.Null.Values[i]) > 1e-9 {
+			t.Errorf("Expected the same samples with one worker, but got %v and %v", result.Null.Values, again.Null.Values)
+			break
+		}
+	}
+
+	// The number of edges is fixed by G(n, m)
+	result, err = NullModelTest(lattice, NumberOfEdgesMetric, model.GNMNullModel, 10, 1, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.ZScore != 0 || !result.Degenerate || result.PValue != 1 {
+		t.Errorf("Expected no significance, but got %+v", result)
+	}
+
+	// Constant null values that differ from the observed one still give a result that can be encoded in JSON
+	observedOnly := Metric{Name: "observed", Compute: func(g *model.UndirectedGraph) float64 {
+		if g == lattice {
+			return 1
+		}
+		return 0
+	}}
+	result, err = NullModelTest(lattice, observedOnly, model.GNMNullModel, 10, 1, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.ZScore != 0 || !result.Degenerate || result.PValueGreater != 1.0/11 {
+		t.Errorf("Expected a degenerate but significant result, but got %+v", result)
+	}
+	if _, err := json.Marshal(result); err != nil {
+		t.Errorf("Unexpected error encoding the result: %v", err)
+	}
+
+	if _, err := NullModelTest(lattice, NumberOfEdgesMetric, model.GNMNullModel, 0, 1, 2); err == nil {
+		t.Errorf("Expected an error for zero samples")
+	}
+}
@@ -5,4 +5,7 @@ go 1.21.1
 require (
 	github.com/jinzhu/copier v0.4.0
 	github.com/mroth/weightedrand v1.0.0
+	go.etcd.io/bbolt v1.3.8
 )
+
+require golang.org/x/sys v0.4.0 // indirect
@@ -0,0 +1,743 @@
+package io
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// StyleRule maps the attributes of a node or an edge to the value of a Graphviz property.
+type StyleRule interface {
+	// Style returns the Graphviz property set by the rule and its value, or ok = false if the rule does not apply.
+	Style(attributes map[string]string) (property string, value string, ok bool)
+}
+
+// StyleRange maps the values in [Min, Max) to a property value.
+type StyleRange struct {
+	Min   float64
+	Max   float64
+	Value string
+}
+
+type categoricalRule struct {
+	attribute, property string
+	values              map[string]string
+}
+
+type paletteRule struct {
+	attribute, property string
+	palette             []string
+}
+
+type rangeRule struct {
+	attribute, property string
+	ranges              []StyleRange
+}
+
+type linearScaleRule struct {
+	attribute, property  string
+	inputMin, inputMax   float64
+	outputMin, outputMax float64
+}
+
+// CategoricalRule sets property to values[v] for elements whose attribute has value v.
+func CategoricalRule(attribute, property string, values map[string]string) StyleRule {
+	return categoricalRule{attribute: attribute, property: property, values: values}
+}
+
+// PaletteRule sets property to palette[i % len(palette)] for elements whose attribute is the integer i, e.g. a community index.
+func PaletteRule(attribute, property string, palette []string) StyleRule {
+	return paletteRule{attribute: attribute, property: property, palette: palette}
+}
+
+// RangeRule sets property to the value of the first range containing the numeric attribute.
+func RangeRule(attribute, property string, ranges []StyleRange) StyleRule {
+	return rangeRule{attribute: attribute, property: property, ranges: ranges}
+}
+
+// LinearScaleRule maps the numeric attribute from [inputMin, inputMax] to [outputMin, outputMax], clamping values outside the input range.
+func LinearScaleRule(attribute, property string, inputMin, inputMax, outputMin, outputMax float64) StyleRule {
+	return linearScaleRule{attribute: attribute, property: property, inputMin: inputMin, inputMax: inputMax, outputMin: outputMin, outputMax: outputMax}
+}
+
+func (r categoricalRule) Style(attributes map[string]string) (string, string, bool) {
+	attribute, set := attributes[r.attribute]
+	if !set {
+		return "", "", false
+	}
+	value, ok := r.values[attribute]
+	return r.property, value, ok
+}
+
+func (r paletteRule) Style(attributes map[string]string) (string, string, bool) {
+	index, err := strconv.Atoi(attributes[r.attribute])
+	if err != nil || len(r.palette) == 0 {
+		return "", "", false
+	}
+	index = ((index % len(r.palette)) + len(r.palette)) % len(r.palette)
+	return r.property, r.palette[index], true
+}
+
+func (r rangeRule) Style(attributes map[string]string) (string, string, bool) {
+	number, err := strconv.ParseFloat(attributes[r.attribute], 64)
+	if err != nil {
+		return "", "", false
+	}
+	for _, styleRange := range r.ranges {
+		if number >= styleRange.Min && number < styleRange.Max {
+			return r.property, styleRange.Value, true
+		}
+	}
+	return "", "", false
+}
+
+func (r linearScaleRule) Style(attributes map[string]string) (string, string, bool) {
+	number, err := strconv.ParseFloat(attributes[r.attribute], 64)
+	if err != nil {
+		return "", "", false
+	}
+	position := 0.0
+	if r.inputMax != r.inputMin {
+		position = min(max((number-r.inputMin)/(r.inputMax-r.inputMin), 0), 1)
+	}
+	return r.property, strconv.FormatFloat(r.outputMin+position*(r.outputMax-r.outputMin), 'g', 4, 64), true
+}
+
+/*
+DOTStyle describes how WriteDOT renders a graph.
+
+NodeAttributes and EdgeAttributes hold the attributes the rules are evaluated on; edge attributes may be keyed by
+either orientation of the edge. Rules are applied in order, later rules overriding the properties set by earlier
+ones. If ClusterAttribute is set, nodes sharing a value of that attribute are drawn in the same cluster.
+
+EdgePaths holds the polylines of bent edges, endpoints included, such as the bundles of
+model.ForceDirectedEdgeBundling; it may be keyed by either orientation of the edge. The SVG and JSON writers draw
+them, while WriteDOT leaves the routing of edges to Graphviz.
+*/
+type DOTStyle struct {
+	GraphName        string
+	NodeAttributes   map[model.Node]map[string]string
+	EdgeAttributes   map[model.Edge]map[string]string
+	NodeRules        []StyleRule
+	EdgeRules        []StyleRule
+	ClusterAttribute string
+	EdgePaths        map[model.Edge][]model.Point
+}
+
+// SetNodeValues stores a numeric attribute of the nodes, e.g. a centrality.
+func (s *DOTStyle) SetNodeValues(attribute string, values map[model.Node]float64) {
+	for node, value := range values {
+		s.setNodeAttribute(node, attribute, strconv.FormatFloat(value, 'g', -1, 64))
+	}
+}
+
+// SetNodeCommunities stores the community index of the nodes.
+func (s *DOTStyle) SetNodeCommunities(attribute string, communities map[model.Node]int) {
+	for node, community := range communities {
+		s.setNodeAttribute(node, attribute, strconv.Itoa(community))
+	}
+}
+
+// SetEdgeWeights stores the weight of every edge of g.
+func (s *DOTStyle) SetEdgeWeights(attribute string, g *model.UndirectedGraph, weight model.WeightFunc) {
+	for _, edge := range dotEdges(g) {
+		s.setEdgeAttribute(edge, attribute, strconv.FormatFloat(weight(edge.Node1, edge.Node2), 'g', -1, 64))
+	}
+}
+
+func (s *DOTStyle) setNodeAttribute(node model.Node, attribute, value string) {
+	if s.NodeAttributes == nil {
+		s.NodeAttributes = make(map[model.Node]map[string]string)
+	}
+	if s.NodeAttributes[node] == nil {
+		s.NodeAttributes[node] = make(map[string]string)
+	}
+	s.NodeAttributes[node][attribute] = value
+}
+
+// setEdgeAttribute sets an attribute of an edge, under the orientation its attributes are already stored with if any.
+func (s *DOTStyle) setEdgeAttribute(edge model.Edge, attribute, value string) {
+	if s.EdgeAttributes == nil {
+		s.EdgeAttributes = make(map[model.Edge]map[string]string)
+	}
+	if reversed := (model.Edge{Node1: edge.Node2, Node2: edge.Node1}); s.EdgeAttributes[edge] == nil && s.EdgeAttributes[reversed] != nil {
+		edge = reversed
+	}
+	if s.EdgeAttributes[edge] == nil {
+		s.EdgeAttributes[edge] = make(map[string]string)
+	}
+	s.EdgeAttributes[edge][attribute] = value
+}
+
+func (s *DOTStyle) edgeAttributes(edge model.Edge) map[string]string {
+	if attributes, ok := s.EdgeAttributes[edge]; ok {
+		return attributes
+	}
+	return s.EdgeAttributes[model.Edge{Node1: edge.Node2, Node2: edge.Node1}]
+}
+
+/*
+WriteDOT writes an UndirectedGraph in the Graphviz DOT language.
+
+Parameters:
+- w: The writer the graph is written to.
+- g: The graph.
+- style: The styling rules, or nil for an unstyled graph.
+
+Returns:
+- err: An error if writing fails.
+
+Description:
+Nodes are written in ascending order, grouped by cluster, and every edge is written once, its smaller node first.
+*/
+func WriteDOT(w io.Writer, g *model.UndirectedGraph, style *DOTStyle) error {
+	if style == nil {
+		style = &DOTStyle{}
+	}
+	name := style.GraphName
+	if name == "" {
+		name = "G"
+	}
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "graph %s {\n", strconv.Quote(name))
+
+	nodes := sortedGraphNodes(g)
+	clusters := make(map[string][]model.Node)
+	var clusterNames []string
+	for _, node := range nodes {
+		cluster, ok := style.NodeAttributes[node][style.ClusterAttribute]
+		if style.ClusterAttribute == "" || !ok {
+			writeDOTStatement(out, "  ", strconv.Itoa(int(node)), applyRules(style.NodeRules, style.NodeAttributes[node]))
+			continue
+		}
+		if _, seen := clusters[cluster]; !seen {
+			clusterNames = append(clusterNames, cluster)
+		}
+		clusters[cluster] = append(clusters[cluster], node)
+	}
+	sort.Strings(clusterNames)
+	for _, cluster := range clusterNames {
+		fmt.Fprintf(out, "  subgraph %s {\n", strconv.Quote("cluster_"+cluster))
+		fmt.Fprintf(out, "    label=%s;\n", strconv.Quote(style.ClusterAttribute+" "+cluster))
+		for _, node := range clusters[cluster] {
+			writeDOTStatement(out, "    ", strconv.Itoa(int(node)), applyRules(style.NodeRules, style.NodeAttributes[node]))
+		}
+		fmt.Fprintln(out, "  }")
+	}
+
+	for _, edge := range dotEdges(g) {
+		statement := fmt.Sprintf("%d -- %d", edge.Node1, edge.Node2)
+		writeDOTStatement(out, "  ", statement, applyRules(style.EdgeRules, style.edgeAttributes(edge)))
+	}
+
+	fmt.Fprintln(out, "}")
+	return out.Flush()
+}
+
+// applyRules evaluates the rules on the attributes of an element, later rules overriding earlier ones.
+func applyRules(rules []StyleRule, attributes map[string]string) map[string]string {
+	properties := make(map[string]string)
+	for _, rule := range rules {
+		if property, value, ok := rule.Style(attributes); ok {
+			properties[property] = value
+		}
+	}
+	return properties
+}
+
+func writeDOTStatement(out *bufio.Writer, indent, statement string, properties map[string]string) {
+	fmt.Fprint(out, indent, statement)
+	if len(properties) > 0 {
+		keys := make([]string, 0, len(properties))
+		for key := range properties {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		fmt.Fprint(out, " [")
+		for i, key := range keys {
+			if i > 0 {
+				fmt.Fprint(out, ", ")
+			}
+			fmt.Fprintf(out, "%s=%s", key, quoteDOT(properties[key]))
+		}
+		fmt.Fprint(out, "]")
+	}
+	fmt.Fprintln(out, ";")
+}
+
+// edgePath returns the polyline of an edge from Node1 to Node2, or nil if it is drawn straight.
+func (s *DOTStyle) edgePath(edge model.Edge) []model.Point {
+	if path, ok := s.EdgePaths[edge]; ok {
+		return path
+	}
+	path := s.EdgePaths[model.Edge{Node1: edge.Node2, Node2: edge.Node1}]
+	reversed := make([]model.Point, len(path))
+	for i, point := range path {
+		reversed[len(path)-1-i] = point
+	}
+	return reversed
+}
+
+// quoteDOT quotes a value for DOT, escaping its backslashes and double quotes so that a value ending in a backslash
+// still closes its string. Graphviz reads UTF-8, so the other characters are kept as they are.
+func quoteDOT(value string) string {
+	return `"` + dotEscaper.Replace(value) + `"`
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// dotEdges returns every edge of g once, its smaller node first, in ascending order.
+func dotEdges(g *model.UndirectedGraph) []model.Edge {
+	var edges []model.Edge
+	for _, edge := range g.GetEdgeTuples() {
+		if edge.Node1 <= edge.Node2 {
+			edges = append(edges, edge)
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Node1 != edges[j].Node1 {
+			return edges[i].Node1 < edges[j].Node1
+		}
+		return edges[i].Node2 < edges[j].Node2
+	})
+	return edges
+}
+
+// sortedGraphNodes returns the nodes of g in ascending order.
+func sortedGraphNodes(g *model.UndirectedGraph) []model.Node {
+	nodes := make([]model.Node, 0, len(g.Nodes))
+	for node := range g.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return nodes
+}
+
+/*
+WriteDOTDocument writes a graph and its attributes in the Graphviz DOT language, as a graph or a digraph.
+
+Parameters:
+- w: The writer the graph is written to.
+- d: The graph and its attributes, written as Graphviz attributes of its nodes and edges.
+
+Returns:
+- err: An error if writing fails.
+
+Description:
+Nodes and edges are written in ascending order, nodes keeping their number as id. Unlike WriteDOT, attributes are
+written as they are, without style rules. Backslashes and double quotes in values are escaped and all other characters
+are written as they are, so that ReadDOT reads the values back unchanged.
+*/
+func WriteDOTDocument(w io.Writer, d *GraphDocument) error {
+	kind, operator := "graph", "--"
+	if d.IsDirected() {
+		kind, operator = "digraph", "->"
+	}
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "%s \"G\" {\n", kind)
+	for _, node := range d.nodes() {
+		writeDOTStatement(out, "  ", strconv.Itoa(int(node)), d.NodeAttributes[node])
+	}
+	for _, edge := range d.edges() {
+		writeDOTStatement(out, "  ", fmt.Sprintf("%d %s %d", edge.Node1, operator, edge.Node2), d.EdgeAttributes[edge])
+	}
+	fmt.Fprintln(out, "}")
+	return out.Flush()
+}
+
+/*
+ReadDOT reads a graph and its attributes from the Graphviz DOT language.
+
+Parameters:
+- r: The reader the graph is read from.
+
+Returns:
+- d: The graph, directed for a digraph, with the attributes of its nodes and edges. Default attributes set by node and
+edge statements apply to the nodes and edges that follow them in the same subgraph, as in Graphviz.
+- err: An error if the input is not valid DOT or an edge operator does not match the kind of graph.
+
+Description:
+The parser is tolerant: subgraphs, including as edge endpoints as in a -> {b c}, are flattened into the graph, and
+graph attributes, ports and compass points are ignored. Comments, quoted strings joined with +, and HTML strings, kept
+without their outer brackets, are supported. Node ids are handled as in ReadGraphML. Only the first graph is read.
+*/
+func ReadDOT(r io.Reader) (*GraphDocument, error) {
+	source, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading dot: %w", err)
+	}
+	tokens, err := tokenizeDOT(string(source))
+	if err != nil {
+		return nil, fmt.Errorf("error reading dot: %w", err)
+	}
+	p := &dotParser{tokens: tokens, nodeAttributes: make(map[string]map[string]string)}
+	if err := p.parseGraph(); err != nil {
+		return nil, fmt.Errorf("error reading dot: %w", err)
+	}
+
+	d := newGraphDocument(p.directed)
+	nodes := nodeIDs(d, p.ids)
+	for _, id := range p.ids {
+		d.addNode(nodes[id])
+		for name, value := range p.nodeAttributes[id] {
+			d.SetNodeAttribute(nodes[id], name, value)
+		}
+	}
+	for _, edge := range p.edges {
+		d.addEdge(nodes[edge.source], nodes[edge.target])
+		for name, value := range edge.attributes {
+			d.SetEdgeAttribute(nodes[edge.source], nodes[edge.target], name, value)
+		}
+	}
+	return d, nil
+}
+
+type dotTokenKind int
+
+const (
+	dotIdentifier dotTokenKind = iota
+	// dotString is a quoted or HTML string, which is never a keyword
+	dotString
+	dotSymbol
+	dotEnd
+)
+
+type dotToken struct {
+	kind dotTokenKind
+	text string
+}
+
+// tokenizeDOT splits DOT source into identifiers, numerals, strings, symbols and edge operators, dropping comments.
+func tokenizeDOT(source string) ([]dotToken, error) {
+	var tokens []dotToken
+	isIdentifier := func(c byte) bool {
+		return c == '_' || c == '.' || c >= 0x80 || ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+	}
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '#' || strings.HasPrefix(source[i:], "//"):
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(source[i:], "/*"):
+			end := strings.Index(source[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment")
+			}
+			i += end + 4
+		case c == '"':
+			var text strings.Builder
+			for i++; ; i++ {
+				if i >= len(source) {
+					return nil, fmt.Errorf("unterminated string")
+				}
+				if source[i] == '"' {
+					break
+				}
+				if source[i] == '\\' && i+1 < len(source) && (source[i+1] == '"' || source[i+1] == '\\' || source[i+1] == '\n') {
+					// An escaped quote or backslash stands for itself and an escaped newline continues the line
+					if source[i+1] != '\n' {
+						text.WriteByte(source[i+1])
+					}
+					i++
+					continue
+				}
+				text.WriteByte(source[i])
+			}
+			i++
+			if n := len(tokens); n >= 2 && tokens[n-1] == (dotToken{kind: dotSymbol, text: "+"}) && tokens[n-2].kind == dotString {
+				tokens = tokens[:n-1]
+				tokens[n-2].text += text.String()
+				continue
+			}
+			tokens = append(tokens, dotToken{kind: dotString, text: text.String()})
+		case c == '<':
+			depth, start := 0, i
+			for ; i < len(source); i++ {
+				if source[i] == '<' {
+					depth++
+				} else if source[i] == '>' {
+					depth--
+				}
+				if depth == 0 {
+					break
+				}
+			}
+			if depth > 0 {
+				return nil, fmt.Errorf("unterminated HTML string")
+			}
+			tokens = append(tokens, dotToken{kind: dotString, text: source[start+1 : i]})
+			i++
+		case strings.HasPrefix(source[i:], "--") || strings.HasPrefix(source[i:], "->"):
+			tokens = append(tokens, dotToken{kind: dotSymbol, text: source[i : i+2]})
+			i += 2
+		case strings.ContainsRune("{}[];,=:+", rune(c)):
+			tokens = append(tokens, dotToken{kind: dotSymbol, text: string(c)})
+			i++
+		case c == '-' || isIdentifier(c):
+			start := i
+			for i++; i < len(source) && isIdentifier(source[i]); i++ {
+			}
+			tokens = append(tokens, dotToken{kind: dotIdentifier, text: source[start:i]})
+		default:
+			return nil, fmt.Errorf("unexpected character %q", c)
+		}
+	}
+	return tokens, nil
+}
+
+type dotEdge struct {
+	source, target string
+	attributes     map[string]string
+}
+
+// dotParser builds the nodes and edges of a DOT graph from its tokens. Nodes are identified by their DOT ids, in
+// order of first appearance; mentioned lists every node mentioned so far, so that subgraphs can find their nodes.
+type dotParser struct {
+	tokens         []dotToken
+	position       int
+	directed       bool
+	ids            []string
+	nodeAttributes map[string]map[string]string
+	edges          []dotEdge
+	mentioned      []string
+}
+
+func (p *dotParser) peek() dotToken {
+	if p.position >= len(p.tokens) {
+		return dotToken{kind: dotEnd}
+	}
+	return p.tokens[p.position]
+}
+
+func (p *dotParser) next() dotToken {
+	token := p.peek()
+	p.position++
+	return token
+}
+
+// isSymbol reports whether the next token is the given symbol.
+func (p *dotParser) isSymbol(symbol string) bool {
+	token := p.peek()
+	return token.kind == dotSymbol && token.text == symbol
+}
+
+// isKeyword reports whether the next token is the given keyword, which is case-insensitive.
+func (p *dotParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token.kind == dotIdentifier && strings.EqualFold(token.text, keyword)
+}
+
+func (p *dotParser) expect(symbol string) error {
+	if !p.isSymbol(symbol) {
+		return fmt.Errorf("expected %q, got %q", symbol, p.peek().text)
+	}
+	p.position++
+	return nil
+}
+
+// id consumes an identifier, numeral or string.
+func (p *dotParser) id() (string, error) {
+	token := p.next()
+	if token.kind != dotIdentifier && token.kind != dotString {
+		return "", fmt.Errorf("expected an id, got %q", token.text)
+	}
+	return token.text, nil
+}
+
+func (p *dotParser) parseGraph() error {
+	if p.isKeyword("strict") {
+		p.position++
+	}
+	switch {
+	case p.isKeyword("graph"):
+	case p.isKeyword("digraph"):
+		p.directed = true
+	default:
+		return fmt.Errorf("expected graph or digraph, got %q", p.peek().text)
+	}
+	p.position++
+	if !p.isSymbol("{") {
+		if _, err := p.id(); err != nil {
+			return err
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	return p.parseStatements(map[string]string{}, map[string]string{})
+}
+
+// parseStatements parses the statements of a graph or subgraph up to its closing brace, with the default attributes
+// of the enclosing graph, which it copies.
+func (p *dotParser) parseStatements(nodeDefaults, edgeDefaults map[string]string) error {
+	nodeDefaults, edgeDefaults = copyAttributes(nodeDefaults), copyAttributes(edgeDefaults)
+	for {
+		switch {
+		case p.isSymbol("}"):
+			p.position++
+			return nil
+		case p.isSymbol(";"):
+			p.position++
+		case p.peek().kind == dotEnd:
+			return fmt.Errorf("unexpected end of input")
+		case (p.isKeyword("graph") || p.isKeyword("node") || p.isKeyword("edge")) && p.position+1 < len(p.tokens) &&
+			p.tokens[p.position+1] == dotToken{kind: dotSymbol, text: "["}:
+			kind := strings.ToLower(p.next().text)
+			attributes, err := p.parseAttributes()
+			if err != nil {
+				return err
+			}
+			defaults := map[string]map[string]string{"node": nodeDefaults, "edge": edgeDefaults}[kind]
+			for name, value := range attributes {
+				if defaults != nil {
+					defaults[name] = value
+				}
+			}
+		case p.peek().kind != dotSymbol && p.position+1 < len(p.tokens) && p.tokens[p.position+1] == dotToken{kind: dotSymbol, text: "="}:
+			// A graph attribute
+			p.position += 2
+			if _, err := p.id(); err != nil {
+				return err
+			}
+		default:
+			if err := p.parseNodeOrEdge(nodeDefaults, edgeDefaults); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// parseNodeOrEdge parses a node statement, or an edge statement joining every node of every operand to every node
+// of the next one.
+func (p *dotParser) parseNodeOrEdge(nodeDefaults, edgeDefaults map[string]string) error {
+	isNode := p.peek().kind != dotSymbol && !p.isKeyword("subgraph")
+	operands := make([][]string, 0, 2)
+	for {
+		operand, err := p.parseOperand(nodeDefaults, edgeDefaults)
+		if err != nil {
+			return err
+		}
+		operands = append(operands, operand)
+		if !p.isSymbol("--") && !p.isSymbol("->") {
+			break
+		}
+		if (p.next().text == "->") != p.directed {
+			return fmt.Errorf("edge operators must be -> in a digraph and -- in a graph")
+		}
+	}
+	attributes, err := p.parseAttributes()
+	if err != nil {
+		return err
+	}
+	if len(operands) == 1 {
+		if isNode {
+			for name, value := range attributes {
+				p.nodeAttributes[operands[0][0]][name] = value
+			}
+		}
+		return nil
+	}
+	for i := 1; i < len(operands); i++ {
+		for _, source := range operands[i-1] {
+			for _, target := range operands[i] {
+				edge := dotEdge{source: source, target: target, attributes: copyAttributes(edgeDefaults)}
+				for name, value := range attributes {
+					edge.attributes[name] = value
+				}
+				p.edges = append(p.edges, edge)
+			}
+		}
+	}
+	return nil
+}
+
+// parseOperand parses a node id, with an optional port, or a subgraph, and returns the nodes it stands for.
+func (p *dotParser) parseOperand(nodeDefaults, edgeDefaults map[string]string) ([]string, error) {
+	if p.isKeyword("subgraph") || p.isSymbol("{") {
+		if p.isKeyword("subgraph") {
+			p.position++
+			if !p.isSymbol("{") {
+				if _, err := p.id(); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err := p.expect("{"); err != nil {
+			return nil, err
+		}
+		start := len(p.mentioned)
+		if err := p.parseStatements(nodeDefaults, edgeDefaults); err != nil {
+			return nil, err
+		}
+		var nodes []string
+		seen := make(map[string]bool)
+		for _, id := range p.mentioned[start:] {
+			if !seen[id] {
+				seen[id] = true
+				nodes = append(nodes, id)
+			}
+		}
+		return nodes, nil
+	}
+
+	id, err := p.id()
+	if err != nil {
+		return nil, err
+	}
+	for port := 0; port < 2 && p.isSymbol(":"); port++ {
+		p.position++
+		if _, err := p.id(); err != nil {
+			return nil, err
+		}
+	}
+	if _, seen := p.nodeAttributes[id]; !seen {
+		p.ids = append(p.ids, id)
+		p.nodeAttributes[id] = copyAttributes(nodeDefaults)
+	}
+	p.mentioned = append(p.mentioned, id)
+	return []string{id}, nil
+}
+
+// parseAttributes parses any number of attribute lists; an attribute without a value is set to "true".
+func (p *dotParser) parseAttributes() (map[string]string, error) {
+	attributes := make(map[string]string)
+	for p.isSymbol("[") {
+		p.position++
+		for !p.isSymbol("]") {
+			name, err := p.id()
+			if err != nil {
+				return nil, err
+			}
+			attributes[name] = "true"
+			if p.isSymbol("=") {
+				p.position++
+				if attributes[name], err = p.id(); err != nil {
+					return nil, err
+				}
+			}
+			if p.isSymbol(",") || p.isSymbol(";") {
+				p.position++
+			}
+		}
+		p.position++
+	}
+	return attributes, nil
+}
+
+func copyAttributes(attributes map[string]string) map[string]string {
+	result := make(map[string]string, len(attributes))
+	for name, value := range attributes {
+		result[name] = value
+	}
+	return result
+}
@@ -0,0 +1,207 @@
+package io
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestWriteDOT(t *testing.T) {
+	g := model.PathGraph(3)
+	var out strings.Builder
+	if err := WriteDOT(&out, g, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "graph \"G\" {\n  0;\n  1;\n  2;\n  0 -- 1;\n  1 -- 2;\n}\n"
+	if out.String() != expected {
+		t.Errorf("Expected %q, but got %q", expected, out.String())
+	}
+}
+
+func TestWriteDOTStyleRules(t *testing.T) {
+	g := model.PathGraph(4)
+	style := &DOTStyle{
+		GraphName:        "styled",
+		ClusterAttribute: "community",
+		NodeRules: []StyleRule{
+			PaletteRule("community", "color", []string{"red", "blue"}),
+			LinearScaleRule("centrality", "width", 0, 1, 0.5, 1.5),
+		},
+		EdgeRules: []StyleRule{
+			RangeRule("weight", "penwidth", []StyleRange{{Min: 0, Max: 2, Value: "1"}, {Min: 2, Max: 10, Value: "3"}}),
+			CategoricalRule("kind", "style", map[string]string{"backup": "dashed"}),
+		},
+	}
+	style.SetNodeCommunities("community", map[model.Node]int{0: 0, 1: 0, 2: 1})
+	style.SetNodeValues("centrality", map[model.Node]float64{1: 0.5, 2: 2})
+	style.SetEdgeWeights("weight", g, func(u, v model.Node) float64 { return float64(u + v) })
+	style.EdgeAttributes[model.Edge{Node1: 2, Node2: 3}]["kind"] = "backup"
+
+	var out strings.Builder
+	if err := WriteDOT(&out, g, style); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `graph "styled" {
+  3;
+  subgraph "cluster_0" {
+    label="community 0";
+    0 [color="red"];
+    1 [color="red", width="1"];
+  }
+  subgraph "cluster_1" {
+    label="community 1";
+    2 [color="blue", width="1.5"];
+  }
+  0 -- 1 [penwidth="1"];
+  1 -- 2 [penwidth="3"];
+  2 -- 3 [penwidth="3", style="dashed"];
+}
+`
+	if out.String() != expected {
+		t.Errorf("Expected\n%s\nbut got\n%s", expected, out.String())
+	}
+}
+
+func TestWriteDOTDocument(t *testing.T) {
+	g := &model.DirectedGraph{}
+	g.AddEdge(model.Edge{Node1: 1, Node2: 0})
+	g.AddEdge(model.Edge{Node1: 0, Node2: 2})
+	d := NewDirectedDocument(g)
+	d.SetNodeAttribute(0, "label", `say "hi"`)
+	d.SetEdgeAttribute(1, 0, "color", "red")
+	d.SetEdgeAttribute(1, 0, "weight", "2.5")
+
+	var out strings.Builder
+	if err := WriteDOTDocument(&out, d); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `digraph "G" {
+  0 [label="say \"hi\""];
+  1;
+  2;
+  0 -> 2;
+  1 -> 0 [color="red", weight="2.5"];
+}
+`
+	if out.String() != expected {
+		t.Errorf("Expected\n%s\nbut got\n%s", expected, out.String())
+	}
+
+	read, err := ReadDOT(strings.NewReader(out.String()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !read.IsDirected() || !read.Directed.Equals(g) {
+		t.Errorf("Expected %v, but got %v", g, read.Directed)
+	}
+	if read.NodeAttributes[0]["label"] != `say "hi"` || read.EdgeWeight(1, 0) != 2.5 || read.EdgeAttributes[model.Edge{Node1: 1, Node2: 0}]["color"] != "red" {
+		t.Errorf("Expected the attributes to be read back, but got %v and %v", read.NodeAttributes, read.EdgeAttributes)
+	}
+}
+
+func TestDOTRoundTripEscapes(t *testing.T) {
+	g := &model.UndirectedGraph{}
+	g.AddEdge(model.Edge{Node1: 0, Node2: 1})
+	d := NewUndirectedDocument(g)
+	values := []string{`C:\dir\`, `\`, `a\"b`, `say "hi\"`, `\\server\share`}
+	for i, value := range values {
+		d.SetNodeAttribute(0, fmt.Sprintf("v%d", i), value)
+	}
+
+	var out strings.Builder
+	if err := WriteDOTDocument(&out, d); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), `v0="C:\\dir\\"`) {
+		t.Errorf("Expected the backslashes to be escaped, but got\n%s", out.String())
+	}
+	read, err := ReadDOT(strings.NewReader(out.String()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v\n%s", err, out.String())
+	}
+	for i, value := range values {
+		if got := read.NodeAttributes[0][fmt.Sprintf("v%d", i)]; got != value {
+			t.Errorf("Expected %q to be read back, but got %q", value, got)
+		}
+	}
+}
+
+func TestReadDOT(t *testing.T) {
+	source := `/* A small network */
+strict graph "net" {
+  rankdir=LR; // graph attributes are ignored
+  graph [bgcolor=white]
+  node [shape=box, color="blue"];
+  a [label="first" + " node"];
+  a -- b -- c [weight=3];
+  # a preprocessor-style comment
+  subgraph cluster_0 {
+    node [shape=circle]
+    d; e:port:n
+  }
+  c -- {d e} [style=dashed]
+  f [label=<<b>bold</b>>, fixed]
+}
+graph ignored { x -- y }
+`
+	d, err := ReadDOT(strings.NewReader(source))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if d.IsDirected() || len(d.Undirected.Nodes) != 6 || d.Undirected.NumberOfEdges() != 4 {
+		t.Fatalf("Expected an undirected graph of 6 nodes and 4 edges, but got %v", d.Undirected)
+	}
+	// The ids are not integers, so the nodes are numbered in order of appearance
+	ids := map[string]model.Node{}
+	for node, attributes := range d.NodeAttributes {
+		ids[attributes["id"]] = node
+	}
+	tests := []struct {
+		node, name, value string
+	}{
+		{"a", "label", "first node"},
+		{"a", "shape", "box"},
+		{"a", "color", "blue"},
+		{"d", "shape", "circle"},
+		{"c", "shape", "box"},
+		{"f", "label", "<b>bold</b>"},
+		{"f", "fixed", "true"},
+	}
+	for _, test := range tests {
+		if value := d.NodeAttributes[ids[test.node]][test.name]; value != test.value {
+			t.Errorf("Expected %s of %s to be %q, but got %q", test.name, test.node, test.value, value)
+		}
+	}
+	if ids["a"] != 0 || ids["f"] != 5 {
+		t.Errorf("Expected the nodes to be numbered in order of appearance, but got %v", ids)
+	}
+	if d.EdgeWeight(ids["a"], ids["b"]) != 3 || d.EdgeWeight(ids["c"], ids["b"]) != 3 {
+		t.Errorf("Expected edge weights 3, but got %v", d.EdgeAttributes)
+	}
+	if d.EdgeAttributes[model.Edge{Node1: ids["c"], Node2: ids["e"]}]["style"] != "dashed" {
+		t.Errorf("Expected the edge from c to e to be dashed, but got %v", d.EdgeAttributes)
+	}
+
+	numbered, err := ReadDOT(strings.NewReader("digraph { 3 -> 1 -> 2; 1 -> 3 }"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !numbered.IsDirected() || numbered.Directed.OutDegree(1) != 2 || !numbered.Directed.Nodes[3] || len(numbered.NodeAttributes[3]) != 0 {
+		t.Errorf("Expected integer ids to be kept, but got %v", numbered.Directed)
+	}
+
+	for _, invalid := range []string{
+		"graph { a -> b }",
+		"digraph { a -- b }",
+		"graph { a -- b",
+		`graph { a [label="unterminated] }`,
+		"network { a }",
+		"graph { a -- ; }",
+	} {
+		if _, err := ReadDOT(strings.NewReader(invalid)); err == nil {
+			t.Errorf("Expected an error for %q", invalid)
+		}
+	}
+}
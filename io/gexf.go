@@ -0,0 +1,255 @@
+package io
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+type gexfDocument struct {
+	XMLName xml.Name  `xml:"gexf"`
+	XMLNS   string    `xml:"xmlns,attr,omitempty"`
+	Version string    `xml:"version,attr,omitempty"`
+	Graph   gexfGraph `xml:"graph"`
+}
+
+type gexfGraph struct {
+	Mode            string           `xml:"mode,attr,omitempty"`
+	DefaultEdgeType string           `xml:"defaultedgetype,attr,omitempty"`
+	TimeFormat      string           `xml:"timeformat,attr,omitempty"`
+	Attributes      []gexfAttributes `xml:"attributes"`
+	Nodes           []gexfNode       `xml:"nodes>node"`
+	Edges           []gexfEdge       `xml:"edges>edge"`
+}
+
+type gexfAttributes struct {
+	Class      string          `xml:"class,attr"`
+	Attributes []gexfAttribute `xml:"attribute"`
+}
+
+type gexfAttribute struct {
+	ID      string  `xml:"id,attr"`
+	Title   string  `xml:"title,attr"`
+	Type    string  `xml:"type,attr"`
+	Default *string `xml:"default"`
+}
+
+type gexfNode struct {
+	ID     string      `xml:"id,attr"`
+	Label  string      `xml:"label,attr,omitempty"`
+	Values *gexfValues `xml:"attvalues"`
+}
+
+type gexfEdge struct {
+	ID     string      `xml:"id,attr,omitempty"`
+	Source string      `xml:"source,attr"`
+	Target string      `xml:"target,attr"`
+	Type   string      `xml:"type,attr,omitempty"`
+	Weight string      `xml:"weight,attr,omitempty"`
+	Values *gexfValues `xml:"attvalues"`
+	Spells *gexfSpells `xml:"spells"`
+}
+
+// gexfSpells holds the time intervals during which an element of a dynamic graph exists.
+type gexfSpells struct {
+	Spells []gexfSpell `xml:"spell"`
+}
+
+type gexfSpell struct {
+	Start string `xml:"start,attr"`
+	End   string `xml:"end,attr"`
+}
+
+type gexfValues struct {
+	Values []gexfValue `xml:"attvalue"`
+}
+
+type gexfValue struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+/*
+WriteGEXF writes a graph and its attributes in GEXF 1.2, the native format of Gephi.
+
+Parameters:
+- w: The writer the graph is written to.
+- d: The graph and its attributes.
+
+Returns:
+- err: An error if writing fails.
+
+Description:
+The "label" attribute of the nodes and the "weight" attribute of the edges are written as the GEXF label and weight;
+the other attributes are declared as attributes of type double if all their values are numbers and string otherwise.
+Nodes keep their number as id, and nodes and edges are written in ascending order.
+*/
+func WriteGEXF(w io.Writer, d *GraphDocument) error {
+	document := gexfDocument{XMLNS: "http://www.gexf.net/1.2draft", Version: "1.2", Graph: gexfGraph{Mode: "static", DefaultEdgeType: "undirected"}}
+	if d.IsDirected() {
+		document.Graph.DefaultEdgeType = "directed"
+	}
+
+	ids := map[string]map[string]string{"node": {}, "edge": {}}
+	declare := func(class, native string, names []string, numeric map[string]bool) {
+		declarations := gexfAttributes{Class: class}
+		for _, name := range names {
+			if name == native {
+				continue
+			}
+			attribute := gexfAttribute{ID: strconv.Itoa(len(declarations.Attributes)), Title: name, Type: "string"}
+			if numeric[name] {
+				attribute.Type = "double"
+			}
+			ids[class][name] = attribute.ID
+			declarations.Attributes = append(declarations.Attributes, attribute)
+		}
+		if len(declarations.Attributes) > 0 {
+			document.Graph.Attributes = append(document.Graph.Attributes, declarations)
+		}
+	}
+	nodeNames, nodeNumeric := attributeNames(d.NodeAttributes)
+	declare("node", "label", nodeNames, nodeNumeric)
+	edgeNames, edgeNumeric := attributeNames(d.EdgeAttributes)
+	declare("edge", "weight", edgeNames, edgeNumeric)
+	values := func(class string, attributes map[string]string) *gexfValues {
+		names := make([]string, 0, len(attributes))
+		for name := range attributes {
+			if _, declared := ids[class][name]; declared {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			return nil
+		}
+		result := &gexfValues{}
+		for _, name := range names {
+			result.Values = append(result.Values, gexfValue{For: ids[class][name], Value: attributes[name]})
+		}
+		return result
+	}
+
+	for _, node := range d.nodes() {
+		attributes := d.NodeAttributes[node]
+		document.Graph.Nodes = append(document.Graph.Nodes, gexfNode{ID: strconv.Itoa(int(node)), Label: attributes["label"], Values: values("node", attributes)})
+	}
+	for i, edge := range d.edges() {
+		attributes := d.EdgeAttributes[edge]
+		document.Graph.Edges = append(document.Graph.Edges, gexfEdge{
+			ID:     strconv.Itoa(i),
+			Source: strconv.Itoa(int(edge.Node1)),
+			Target: strconv.Itoa(int(edge.Node2)),
+			Weight: attributes["weight"],
+			Values: values("edge", attributes),
+		})
+	}
+	return encodeGEXF(w, document)
+}
+
+// encodeGEXF writes a GEXF document with its XML header.
+func encodeGEXF(w io.Writer, document gexfDocument) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("error writing gexf: %w", err)
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("error writing gexf: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+/*
+ReadGEXF reads a graph and its attributes from GEXF.
+
+Parameters:
+- r: The reader the graph is read from.
+
+Returns:
+- d: The graph, directed if its defaultedgetype is directed, with the values of its declared attributes as attributes
+named after their titles, and the labels of the nodes and the weights of the edges as the "label" and "weight"
+attributes. Missing values take the default of their attribute, if any.
+- err: An error if the document is not valid GEXF, an edge refers to an unknown node, or edges of both directions are
+mixed.
+
+Description:
+Node ids are handled as in ReadGraphML. Dynamic attributes and visualization data are ignored.
+*/
+func ReadGEXF(r io.Reader) (*GraphDocument, error) {
+	var document gexfDocument
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("error reading gexf: %w", err)
+	}
+	directed := document.Graph.DefaultEdgeType == "directed"
+	for _, edge := range document.Graph.Edges {
+		if edge.Type != "" && (edge.Type == "directed") != directed {
+			return nil, fmt.Errorf("graphs mixing directed and undirected edges are not supported")
+		}
+	}
+	d := newGraphDocument(directed)
+
+	titles := map[string]map[string]string{"node": {}, "edge": {}}
+	defaults := map[string]map[string]string{"node": {}, "edge": {}}
+	for _, declarations := range document.Graph.Attributes {
+		if titles[declarations.Class] == nil {
+			continue
+		}
+		for _, attribute := range declarations.Attributes {
+			titles[declarations.Class][attribute.ID] = attribute.Title
+			if attribute.Default != nil {
+				defaults[declarations.Class][attribute.Title] = *attribute.Default
+			}
+		}
+	}
+	attributes := func(class string, values *gexfValues) map[string]string {
+		result := make(map[string]string, len(defaults[class]))
+		for name, value := range defaults[class] {
+			result[name] = value
+		}
+		if values == nil {
+			return result
+		}
+		for _, value := range values.Values {
+			if title, declared := titles[class][value.For]; declared {
+				result[title] = value.Value
+			}
+		}
+		return result
+	}
+
+	ids := make([]string, len(document.Graph.Nodes))
+	for i, node := range document.Graph.Nodes {
+		ids[i] = node.ID
+	}
+	nodes := nodeIDs(d, ids)
+	for _, node := range document.Graph.Nodes {
+		d.addNode(nodes[node.ID])
+		values := attributes("node", node.Values)
+		if node.Label != "" {
+			values["label"] = node.Label
+		}
+		for name, value := range values {
+			d.SetNodeAttribute(nodes[node.ID], name, value)
+		}
+	}
+	for _, edge := range document.Graph.Edges {
+		source, sourceFound := nodes[edge.Source]
+		target, targetFound := nodes[edge.Target]
+		if !sourceFound || !targetFound {
+			return nil, fmt.Errorf("edge %s-%s refers to an unknown node", edge.Source, edge.Target)
+		}
+		d.addEdge(source, target)
+		values := attributes("edge", edge.Values)
+		if edge.Weight != "" {
+			values["weight"] = edge.Weight
+		}
+		for name, value := range values {
+			d.SetEdgeAttribute(source, target, name, value)
+		}
+	}
+	return d, nil
+}
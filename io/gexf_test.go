@@ -0,0 +1,107 @@
+package io
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestWriteGEXF(t *testing.T) {
+	g := &model.DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}})
+	d := NewDirectedDocument(g)
+	d.SetNodeAttribute(0, "label", "source")
+	d.SetNodeAttribute(1, "score", "0.5")
+	d.SetEdgeWeights(func(u, v model.Node) float64 { return 3 })
+
+	var out strings.Builder
+	if err := WriteGEXF(&out, d); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2">
+  <graph mode="static" defaultedgetype="directed">
+    <attributes class="node">
+      <attribute id="0" title="score" type="double"></attribute>
+    </attributes>
+    <nodes>
+      <node id="0" label="source"></node>
+      <node id="1">
+        <attvalues>
+          <attvalue for="0" value="0.5"></attvalue>
+        </attvalues>
+      </node>
+    </nodes>
+    <edges>
+      <edge id="0" source="0" target="1" weight="3"></edge>
+    </edges>
+  </graph>
+</gexf>
+`
+	if out.String() != expected {
+		t.Errorf("Expected %s, but got %s", expected, out.String())
+	}
+}
+
+func TestGEXFRoundTrip(t *testing.T) {
+	undirected := NewUndirectedDocument(model.StarGraph(4))
+	undirected.SetEdgeWeights(func(u, v model.Node) float64 { return float64(v) })
+	undirected.SetNodeAttribute(0, "label", "center")
+	undirected.SetNodeAttribute(2, "group", "b")
+
+	g := &model.DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 0}, {2, 1}})
+	directed := NewDirectedDocument(g)
+	directed.SetEdgeAttribute(2, 1, "kind", "backup")
+
+	for _, d := range []*GraphDocument{undirected, directed} {
+		var out strings.Builder
+		if err := WriteGEXF(&out, d); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		read, err := ReadGEXF(strings.NewReader(out.String()))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if read.IsDirected() != d.IsDirected() {
+			t.Fatalf("Expected directed %v, but got %v", d.IsDirected(), read.IsDirected())
+		}
+		if d.IsDirected() && !read.Directed.Equals(d.Directed) || !d.IsDirected() && !read.Undirected.Equals(d.Undirected) {
+			t.Errorf("Expected the same graph, but got %s", out.String())
+		}
+		if !reflect.DeepEqual(read.NodeAttributes, d.NodeAttributes) || !reflect.DeepEqual(read.EdgeAttributes, d.EdgeAttributes) {
+			t.Errorf("Expected attributes %v and %v, but got %v and %v", d.NodeAttributes, d.EdgeAttributes, read.NodeAttributes, read.EdgeAttributes)
+		}
+	}
+}
+
+func TestReadGEXF(t *testing.T) {
+	document := `<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2">
+  <graph defaultedgetype="undirected">
+    <attributes class="node"><attribute id="a" title="type" type="string"><default>person</default></attribute></attributes>
+    <nodes><node id="alice" label="Alice"/><node id="bob"><attvalues><attvalue for="a" value="bot"/></attvalues></node></nodes>
+    <edges><edge source="bob" target="alice" weight="1.5"/></edges>
+  </graph>
+</gexf>`
+	d, err := ReadGEXF(strings.NewReader(document))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := map[model.Node]map[string]string{
+		0: {"id": "alice", "label": "Alice", "type": "person"},
+		1: {"id": "bob", "type": "bot"},
+	}
+	if d.IsDirected() || !reflect.DeepEqual(d.NodeAttributes, expected) {
+		t.Errorf("Expected an undirected graph with attributes %v, but got %v", expected, d.NodeAttributes)
+	}
+	if weight := d.EdgeWeight(0, 1); weight != 1.5 {
+		t.Errorf("Expected 1.5, but got %v", weight)
+	}
+
+	mixed := `<gexf><graph defaultedgetype="directed"><nodes><node id="0"/><node id="1"/></nodes><edges><edge source="0" target="1" type="undirected"/></edges></graph></gexf>`
+	if _, err := ReadGEXF(strings.NewReader(mixed)); err == nil {
+		t.Errorf("Expected an error for mixed edge directions")
+	}
+}
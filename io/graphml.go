@@ -0,0 +1,398 @@
+package io
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+/*
+GraphDocument is a graph exchanged with other tools through GraphML, GEXF or node-link JSON, together with the
+attributes of its nodes and edges.
+
+Exactly one of Undirected and Directed is set. Edge attributes are keyed with the smaller node first in an undirected
+graph and from source to target in a directed one. Attribute values are kept as strings; numbers are written with
+strconv.FormatFloat and can be read back with strconv.ParseFloat.
+*/
+type GraphDocument struct {
+	Undirected     *model.UndirectedGraph
+	Directed       *model.DirectedGraph
+	NodeAttributes map[model.Node]map[string]string
+	EdgeAttributes map[model.Edge]map[string]string
+}
+
+// NewUndirectedDocument wraps an UndirectedGraph without attributes.
+func NewUndirectedDocument(g *model.UndirectedGraph) *GraphDocument {
+	return &GraphDocument{Undirected: g, NodeAttributes: make(map[model.Node]map[string]string), EdgeAttributes: make(map[model.Edge]map[string]string)}
+}
+
+// NewDirectedDocument wraps a DirectedGraph without attributes.
+func NewDirectedDocument(g *model.DirectedGraph) *GraphDocument {
+	return &GraphDocument{Directed: g, NodeAttributes: make(map[model.Node]map[string]string), EdgeAttributes: make(map[model.Edge]map[string]string)}
+}
+
+// IsDirected reports whether the document holds a directed graph.
+func (d *GraphDocument) IsDirected() bool {
+	return d.Directed != nil
+}
+
+// SetNodeAttribute sets an attribute of a node.
+func (d *GraphDocument) SetNodeAttribute(node model.Node, name, value string) {
+	if d.NodeAttributes[node] == nil {
+		d.NodeAttributes[node] = make(map[string]string)
+	}
+	d.NodeAttributes[node][name] = value
+}
+
+// SetEdgeAttribute sets an attribute of the edge between u and v, from u to v in a directed graph.
+func (d *GraphDocument) SetEdgeAttribute(u, v model.Node, name, value string) {
+	key := d.edgeKey(u, v)
+	if d.EdgeAttributes[key] == nil {
+		d.EdgeAttributes[key] = make(map[string]string)
+	}
+	d.EdgeAttributes[key][name] = value
+}
+
+// SetEdgeWeights stores the weight of every edge in its "weight" attribute, which GEXF writes as the edge weight.
+func (d *GraphDocument) SetEdgeWeights(weight model.WeightFunc) {
+	for _, edge := range d.edges() {
+		d.SetEdgeAttribute(edge.Node1, edge.Node2, "weight", strconv.FormatFloat(weight(edge.Node1, edge.Node2), 'g', -1, 64))
+	}
+}
+
+// SetNodeLabels stores the label of every labelled node in its "label" attribute, which GEXF writes as the node label.
+func (d *GraphDocument) SetNodeLabels(labels *model.NodeLabels) {
+	for _, node := range d.nodes() {
+		if label, ok := labels.Label(node); ok {
+			d.SetNodeAttribute(node, "label", label)
+		}
+	}
+}
+
+// NodeLabels returns the labels held by an attribute of the nodes, such as "label", or "id" for the ids kept by the
+// readers, or an error if two nodes have the same value. Nodes without the attribute have no label.
+func (d *GraphDocument) NodeLabels(attribute string) (*model.NodeLabels, error) {
+	labels := model.NewNodeLabels()
+	for _, node := range d.nodes() {
+		if label, ok := d.NodeAttributes[node][attribute]; ok {
+			if err := labels.SetLabel(node, label); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return labels, nil
+}
+
+// EdgeWeight returns the "weight" attribute of the edge between u and v, or 1 if it is missing or not a number. It can
+// be used as a model.WeightFunc.
+func (d *GraphDocument) EdgeWeight(u, v model.Node) float64 {
+	weight, err := strconv.ParseFloat(d.EdgeAttributes[d.edgeKey(u, v)]["weight"], 64)
+	if err != nil {
+		return 1
+	}
+	return weight
+}
+
+func (d *GraphDocument) edgeKey(u, v model.Node) model.Edge {
+	if !d.IsDirected() && v < u {
+		return model.Edge{Node1: v, Node2: u}
+	}
+	return model.Edge{Node1: u, Node2: v}
+}
+
+// nodes returns the nodes of the graph in ascending order.
+func (d *GraphDocument) nodes() []model.Node {
+	if d.IsDirected() {
+		nodes := make([]model.Node, 0, len(d.Directed.Nodes))
+		for node := range d.Directed.Nodes {
+			nodes = append(nodes, node)
+		}
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+		return nodes
+	}
+	return sortedGraphNodes(d.Undirected)
+}
+
+// edges returns every edge once, in ascending order, with the smaller node first in an undirected graph.
+func (d *GraphDocument) edges() []model.Edge {
+	var edges []model.Edge
+	if d.IsDirected() {
+		for _, node := range d.nodes() {
+			successors := append([]model.Node(nil), d.Directed.Successors[node]...)
+			sort.Slice(successors, func(i, j int) bool { return successors[i] < successors[j] })
+			for _, successor := range successors {
+				edges = append(edges, model.Edge{Node1: node, Node2: successor})
+			}
+		}
+		return edges
+	}
+	// A self-loop is listed twice in the adjacency of its node
+	for i, edge := range dotEdges(d.Undirected) {
+		if i == 0 || edge != edges[len(edges)-1] {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+// newGraphDocument returns an empty document holding a directed or undirected graph.
+func newGraphDocument(directed bool) *GraphDocument {
+	if directed {
+		return NewDirectedDocument(&model.DirectedGraph{Nodes: make(map[model.Node]bool), Successors: make(map[model.Node][]model.Node), Predecessors: make(map[model.Node][]model.Node)})
+	}
+	return NewUndirectedDocument(&model.UndirectedGraph{Nodes: make(map[model.Node]bool), Edges: make(map[model.Node][]model.Node)})
+}
+
+func (d *GraphDocument) addNode(node model.Node) {
+	if d.IsDirected() {
+		d.Directed.AddNode(node)
+	} else {
+		d.Undirected.AddNode(node)
+	}
+}
+
+func (d *GraphDocument) addEdge(u, v model.Node) {
+	if d.IsDirected() {
+		d.Directed.AddEdge(model.Edge{Node1: u, Node2: v})
+	} else {
+		d.Undirected.AddEdge(model.Edge{Node1: u, Node2: v})
+	}
+}
+
+// attributeNames returns the sorted names of the attributes set on any element, and whether all the values of each
+// are numbers.
+func attributeNames[K comparable](attributes map[K]map[string]string) ([]string, map[string]bool) {
+	numeric := make(map[string]bool)
+	for _, values := range attributes {
+		for name, value := range values {
+			_, err := strconv.ParseFloat(value, 64)
+			if isNumeric, seen := numeric[name]; !seen || isNumeric {
+				numeric[name] = err == nil
+			}
+		}
+	}
+	names := make([]string, 0, len(numeric))
+	for name := range numeric {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, numeric
+}
+
+// nodeIDs maps the ids of the nodes of a document to graph nodes: the integers themselves if they all are, or else
+// the positions of the nodes in the document, the original ids being kept in the "id" attribute.
+func nodeIDs(d *GraphDocument, ids []string) map[string]model.Node {
+	nodes := make(map[string]model.Node, len(ids))
+	for _, id := range ids {
+		number, err := strconv.Atoi(id)
+		if err != nil {
+			nodes = make(map[string]model.Node, len(ids))
+			for i, id := range ids {
+				nodes[id] = model.Node(i)
+				d.SetNodeAttribute(model.Node(i), "id", id)
+			}
+			return nodes
+		}
+		nodes[id] = model.Node(number)
+	}
+	return nodes
+}
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr,omitempty"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID      string  `xml:"id,attr"`
+	For     string  `xml:"for,attr"`
+	Name    string  `xml:"attr.name,attr"`
+	Type    string  `xml:"attr.type,attr"`
+	Default *string `xml:"default"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr,omitempty"`
+	EdgeDefault string        `xml:"edgedefault,attr,omitempty"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source   string        `xml:"source,attr"`
+	Target   string        `xml:"target,attr"`
+	Directed string        `xml:"directed,attr,omitempty"`
+	Data     []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+/*
+WriteGraphML writes a graph and its attributes in GraphML, the XML format of yEd, Gephi, Cytoscape and NetworkX.
+
+Parameters:
+- w: The writer the graph is written to.
+- d: The graph and its attributes.
+
+Returns:
+- err: An error if writing fails.
+
+Description:
+Every attribute name gets a key, of type double if all its values are numbers and string otherwise, nodes keeping
+their number as id. Nodes and edges are written in ascending order.
+*/
+func WriteGraphML(w io.Writer, d *GraphDocument) error {
+	document := graphMLDocument{XMLNS: "http://graphml.graphdrawing.org/xmlns", Graph: graphMLGraph{ID: "G", EdgeDefault: "undirected"}}
+	if d.IsDirected() {
+		document.Graph.EdgeDefault = "directed"
+	}
+
+	keys := map[string]map[string]string{"node": {}, "edge": {}}
+	declare := func(kind string, names []string, numeric map[string]bool) {
+		for _, name := range names {
+			key := graphMLKey{ID: fmt.Sprintf("d%d", len(document.Keys)), For: kind, Name: name, Type: "string"}
+			if numeric[name] {
+				key.Type = "double"
+			}
+			keys[kind][name] = key.ID
+			document.Keys = append(document.Keys, key)
+		}
+	}
+	nodeNames, nodeNumeric := attributeNames(d.NodeAttributes)
+	declare("node", nodeNames, nodeNumeric)
+	edgeNames, edgeNumeric := attributeNames(d.EdgeAttributes)
+	declare("edge", edgeNames, edgeNumeric)
+	data := func(kind string, attributes map[string]string) []graphMLData {
+		names := make([]string, 0, len(attributes))
+		for name := range attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		var values []graphMLData
+		for _, name := range names {
+			values = append(values, graphMLData{Key: keys[kind][name], Value: attributes[name]})
+		}
+		return values
+	}
+
+	for _, node := range d.nodes() {
+		document.Graph.Nodes = append(document.Graph.Nodes, graphMLNode{ID: strconv.Itoa(int(node)), Data: data("node", d.NodeAttributes[node])})
+	}
+	for _, edge := range d.edges() {
+		document.Graph.Edges = append(document.Graph.Edges, graphMLEdge{
+			Source: strconv.Itoa(int(edge.Node1)),
+			Target: strconv.Itoa(int(edge.Node2)),
+			Data:   data("edge", d.EdgeAttributes[edge]),
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("error writing graphml: %w", err)
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return fmt.Errorf("error writing graphml: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+/*
+ReadGraphML reads a graph and its attributes from GraphML.
+
+Parameters:
+- r: The reader the graph is read from.
+
+Returns:
+- d: The graph, directed if the edgedefault of the graph is directed or missing, as in the GraphML specification,
+with the data of its nodes and edges as attributes named after the attr.name of their keys. Missing values take the
+default of their key, if any.
+- err: An error if the document is not valid GraphML, an edge refers to an unknown node, or edges of both directions
+are mixed.
+
+Description:
+Node ids that are all integers are kept as node numbers. Otherwise, as with the n0, n1, ... ids of yEd, the nodes are
+numbered in document order and their ids are kept in the "id" attribute. Only the first graph of the document is read
+and nested graphs are ignored.
+*/
+func ReadGraphML(r io.Reader) (*GraphDocument, error) {
+	var document graphMLDocument
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("error reading graphml: %w", err)
+	}
+	directed := document.Graph.EdgeDefault != "undirected"
+	for _, edge := range document.Graph.Edges {
+		if edge.Directed != "" && (edge.Directed == "true") != directed {
+			return nil, fmt.Errorf("graphs mixing directed and undirected edges are not supported")
+		}
+	}
+	d := newGraphDocument(directed)
+
+	names := make(map[string]string, len(document.Keys))
+	defaults := map[string]map[string]string{"node": {}, "edge": {}}
+	for _, key := range document.Keys {
+		names[key.ID] = key.Name
+		if key.Name == "" {
+			names[key.ID] = key.ID
+		}
+		if key.Default != nil {
+			for _, kind := range []string{"node", "edge"} {
+				if key.For == kind || key.For == "all" {
+					defaults[kind][names[key.ID]] = *key.Default
+				}
+			}
+		}
+	}
+	attributes := func(kind string, values []graphMLData) map[string]string {
+		if len(values) == 0 && len(defaults[kind]) == 0 {
+			return nil
+		}
+		result := make(map[string]string, len(defaults[kind])+len(values))
+		for name, value := range defaults[kind] {
+			result[name] = value
+		}
+		for _, value := range values {
+			result[names[value.Key]] = value.Value
+		}
+		return result
+	}
+
+	ids := make([]string, len(document.Graph.Nodes))
+	for i, node := range document.Graph.Nodes {
+		ids[i] = node.ID
+	}
+	nodes := nodeIDs(d, ids)
+	for _, node := range document.Graph.Nodes {
+		d.addNode(nodes[node.ID])
+		for name, value := range attributes("node", node.Data) {
+			d.SetNodeAttribute(nodes[node.ID], name, value)
+		}
+	}
+	for _, edge := range document.Graph.Edges {
+		source, sourceFound := nodes[edge.Source]
+		target, targetFound := nodes[edge.Target]
+		if !sourceFound || !targetFound {
+			return nil, fmt.Errorf("edge %s-%s refers to an unknown node", edge.Source, edge.Target)
+		}
+		d.addEdge(source, target)
+		for name, value := range attributes("edge", edge.Data) {
+			d.SetEdgeAttribute(source, target, name, value)
+		}
+	}
+	return d, nil
+}
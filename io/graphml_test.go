@@ -0,0 +1,146 @@
+package io
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestWriteGraphML(t *testing.T) {
+	d := NewUndirectedDocument(model.PathGraph(2))
+	d.SetNodeAttribute(0, "name", "a & b")
+	d.SetEdgeWeights(func(u, v model.Node) float64 { return 2.5 })
+
+	var out strings.Builder
+	if err := WriteGraphML(&out, d); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="node" attr.name="name" attr.type="string"></key>
+  <key id="d1" for="edge" attr.name="weight" attr.type="double"></key>
+  <graph id="G" edgedefault="undirected">
+    <node id="0">
+      <data key="d0">a &amp; b</data>
+    </node>
+    <node id="1"></node>
+    <edge source="0" target="1">
+      <data key="d1">2.5</data>
+    </edge>
+  </graph>
+</graphml>
+`
+	if out.String() != expected {
+		t.Errorf("Expected %s, but got %s", expected, out.String())
+	}
+}
+
+func TestGraphMLRoundTrip(t *testing.T) {
+	undirected := NewUndirectedDocument(model.CycleGraph(5))
+	undirected.SetEdgeWeights(func(u, v model.Node) float64 { return float64(u+v) / 2 })
+	undirected.SetNodeAttribute(3, "community", "1")
+	undirected.SetNodeAttribute(4, "label", "hub")
+	undirected.Undirected.AddNode(7)
+
+	g := &model.DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 0}, {1, 2}, {5, 2}})
+	directed := NewDirectedDocument(g)
+	directed.SetEdgeAttribute(1, 2, "kind", "primary")
+
+	for _, d := range []*GraphDocument{undirected, directed} {
+		var out strings.Builder
+		if err := WriteGraphML(&out, d); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		read, err := ReadGraphML(strings.NewReader(out.String()))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if read.IsDirected() != d.IsDirected() {
+			t.Fatalf("Expected directed %v, but got %v", d.IsDirected(), read.IsDirected())
+		}
+		if d.IsDirected() && !read.Directed.Equals(d.Directed) || !d.IsDirected() && !read.Undirected.Equals(d.Undirected) {
+			t.Errorf("Expected the same graph, but got %s", out.String())
+		}
+		if !reflect.DeepEqual(read.NodeAttributes, d.NodeAttributes) || !reflect.DeepEqual(read.EdgeAttributes, d.EdgeAttributes) {
+			t.Errorf("Expected attributes %v and %v, but got %v and %v", d.NodeAttributes, d.EdgeAttributes, read.NodeAttributes, read.EdgeAttributes)
+		}
+	}
+	if weight := undirected.EdgeWeight(4, 0); weight != 2 {
+		t.Errorf("Expected 2, but got %v", weight)
+	}
+}
+
+func TestReadGraphML(t *testing.T) {
+	// yEd numbers its nodes n0, n1, ... and keys may have defaults
+	document := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="color" for="node" attr.name="color" attr.type="string"><default>gray</default></key>
+  <graph id="G">
+    <node id="n0"><data key="color">red</data></node>
+    <node id="n1"/>
+    <edge source="n1" target="n0"/>
+  </graph>
+</graphml>`
+	d, err := ReadGraphML(strings.NewReader(document))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !d.IsDirected() || !d.Directed.HasEdge(1, 0) || d.Directed.HasEdge(0, 1) {
+		t.Errorf("Expected the directed edge 1 -> 0, but got %v", d.Directed)
+	}
+	expected := map[model.Node]map[string]string{0: {"id": "n0", "color": "red"}, 1: {"id": "n1", "color": "gray"}}
+	if !reflect.DeepEqual(d.NodeAttributes, expected) {
+		t.Errorf("Expected %v, but got %v", expected, d.NodeAttributes)
+	}
+
+	for _, invalid := range []string{
+		`<graphml><graph edgedefault="undirected"><node id="0"/><edge source="0" target="1"/></graph></graphml>`,
+		`<graphml><graph edgedefault="undirected"><node id="0"/><node id="1"/><edge source="0" target="1" directed="true"/></graph></graphml>`,
+		`<graphml><graph>`,
+	} {
+		if _, err := ReadGraphML(strings.NewReader(invalid)); err == nil {
+			t.Errorf("Expected an error for %s", invalid)
+		}
+	}
+}
+
+func TestGraphDocumentNodeLabels(t *testing.T) {
+	named := model.NewNamedGraph(nil)
+	named.AddEdgeWithLabels("alice", "bob")
+	named.AddNodeWithLabel("carol")
+	d := NewUndirectedDocument(named.Graph)
+	d.SetNodeLabels(named.Labels)
+
+	var out strings.Builder
+	if err := WriteGEXF(&out, d); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	read, err := ReadGEXF(strings.NewReader(out.String()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	labels, err := read.NodeLabels("label")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if node, ok := labels.NodeByLabel("carol"); !ok || node != 2 || labels.Len() != 3 {
+		t.Errorf("Expected carol to be node 2, but got %d", node)
+	}
+
+	// Readers keep non-integer ids in the "id" attribute
+	read, err = ReadNodeLinkJSON(strings.NewReader(`{"nodes": [{"id": "x"}, {"id": "y"}], "links": [{"source": "x", "target": "y"}]}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if labels, _ := read.NodeLabels("id"); !reflect.DeepEqual(labels.LabelsOf([]model.Node{0, 1}), []string{"x", "y"}) {
+		t.Errorf("Expected the ids x and y as labels")
+	}
+
+	d.SetNodeAttribute(2, "label", "alice")
+	if _, err := d.NodeLabels("label"); err == nil {
+		t.Errorf("Expected an error for two nodes with the same label")
+	}
+}
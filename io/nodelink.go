@@ -0,0 +1,126 @@
+package io
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type nodeLinkDocument struct {
+	Directed   bool             `json:"directed"`
+	Multigraph bool             `json:"multigraph"`
+	Graph      map[string]any   `json:"graph"`
+	Nodes      []map[string]any `json:"nodes"`
+	Links      []map[string]any `json:"links"`
+}
+
+/*
+WriteNodeLinkJSON writes a graph and its attributes in the node-link JSON format of NetworkX, which D3.js force layouts
+read directly.
+
+Parameters:
+- w: The writer the graph is written to.
+- d: The graph and its attributes.
+
+Returns:
+- err: An error if writing fails.
+
+Description:
+Every node is an object with its number as id and its attributes, and every edge a link object with the ids of its
+source and target and its attributes, in ascending order. Numeric values are written as JSON numbers. Node attributes
+named id and edge attributes named source or target are replaced by the node numbers. The document can be read by
+networkx.node_link_graph or bound with d3.forceLink(links).id(d => d.id).
+*/
+func WriteNodeLinkJSON(w io.Writer, d *GraphDocument) error {
+	document := nodeLinkDocument{Directed: d.IsDirected(), Graph: map[string]any{}, Nodes: []map[string]any{}, Links: []map[string]any{}}
+	for _, node := range d.nodes() {
+		object := jsonAttributes(d.NodeAttributes[node])
+		object["id"] = int(node)
+		document.Nodes = append(document.Nodes, object)
+	}
+	for _, edge := range d.edges() {
+		object := jsonAttributes(d.EdgeAttributes[edge])
+		object["source"] = int(edge.Node1)
+		object["target"] = int(edge.Node2)
+		document.Links = append(document.Links, object)
+	}
+	if err := json.NewEncoder(w).Encode(document); err != nil {
+		return fmt.Errorf("error writing node-link json: %w", err)
+	}
+	return nil
+}
+
+/*
+ReadNodeLinkJSON reads a graph and its attributes from the node-link JSON format of NetworkX and D3.js.
+
+Parameters:
+- r: The reader the graph is read from.
+
+Returns:
+- d: The graph, directed if the document says so, with the members of its node and link objects other than id,
+source and target as attributes. Numbers and booleans are kept as their JSON text, and arrays and objects as JSON.
+- err: An error if the document is not valid JSON, a node has no id, or a link refers to an unknown node.
+
+Description:
+Links are read from links, or from edges as written by recent versions of NetworkX. Node ids that are all integers
+are kept as node numbers; otherwise the nodes are numbered in document order and their ids are kept in the "id"
+attribute, as with ReadGraphML. Parallel edges of a multigraph are merged, the attributes of the last one winning.
+*/
+func ReadNodeLinkJSON(r io.Reader) (*GraphDocument, error) {
+	var document struct {
+		Directed bool                         `json:"directed"`
+		Nodes    []map[string]json.RawMessage `json:"nodes"`
+		Links    []map[string]json.RawMessage `json:"links"`
+		Edges    []map[string]json.RawMessage `json:"edges"`
+	}
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("error reading node-link json: %w", err)
+	}
+	links := document.Links
+	if links == nil {
+		links = document.Edges
+	}
+	d := newGraphDocument(document.Directed)
+
+	ids := make([]string, len(document.Nodes))
+	for i, object := range document.Nodes {
+		id, ok := object["id"]
+		if !ok {
+			return nil, fmt.Errorf("node %d has no id", i)
+		}
+		ids[i] = nodeLinkValue(id)
+	}
+	nodes := nodeIDs(d, ids)
+	for i, object := range document.Nodes {
+		d.addNode(nodes[ids[i]])
+		for name, value := range object {
+			if name != "id" {
+				d.SetNodeAttribute(nodes[ids[i]], name, nodeLinkValue(value))
+			}
+		}
+	}
+	for _, object := range links {
+		sourceID, targetID := nodeLinkValue(object["source"]), nodeLinkValue(object["target"])
+		source, sourceFound := nodes[sourceID]
+		target, targetFound := nodes[targetID]
+		if !sourceFound || !targetFound {
+			return nil, fmt.Errorf("link %s-%s refers to an unknown node", sourceID, targetID)
+		}
+		d.addEdge(source, target)
+		for name, value := range object {
+			if name != "source" && name != "target" {
+				d.SetEdgeAttribute(source, target, name, nodeLinkValue(value))
+			}
+		}
+	}
+	return d, nil
+}
+
+// nodeLinkValue returns a JSON value as an attribute: the string itself for a string, and the JSON text otherwise.
+func nodeLinkValue(value json.RawMessage) string {
+	var text string
+	if err := json.Unmarshal(value, &text); err == nil {
+		return text
+	}
+	return string(value)
+}
@@ -0,0 +1,84 @@
+package io
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestWriteNodeLinkJSON(t *testing.T) {
+	d := NewUndirectedDocument(model.PathGraph(2))
+	d.SetNodeAttribute(0, "name", "a")
+	d.SetEdgeWeights(func(u, v model.Node) float64 { return 2.5 })
+
+	var out strings.Builder
+	if err := WriteNodeLinkJSON(&out, d); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `{"directed":false,"multigraph":false,"graph":{},"nodes":[{"id":0,"name":"a"},{"id":1}],"links":[{"source":0,"target":1,"weight":2.5}]}` + "\n"
+	if out.String() != expected {
+		t.Errorf("Expected %s, but got %s", expected, out.String())
+	}
+}
+
+func TestNodeLinkJSONRoundTrip(t *testing.T) {
+	undirected := NewUndirectedDocument(model.CycleGraph(5))
+	undirected.SetEdgeWeights(func(u, v model.Node) float64 { return float64(u+v) / 2 })
+	undirected.SetNodeAttribute(4, "label", "hub")
+	undirected.Undirected.AddNode(7)
+
+	g := &model.DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 0}, {1, 2}, {5, 2}})
+	directed := NewDirectedDocument(g)
+	directed.SetEdgeAttribute(1, 2, "kind", "primary")
+
+	for _, d := range []*GraphDocument{undirected, directed} {
+		var out strings.Builder
+		if err := WriteNodeLinkJSON(&out, d); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		read, err := ReadNodeLinkJSON(strings.NewReader(out.String()))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if read.IsDirected() != d.IsDirected() || !reflect.DeepEqual(read.nodes(), d.nodes()) || !reflect.DeepEqual(read.edges(), d.edges()) {
+			t.Errorf("Expected the graph to survive the round trip, but got %v", out.String())
+		}
+		if !reflect.DeepEqual(read.NodeAttributes, d.NodeAttributes) || !reflect.DeepEqual(read.EdgeAttributes, d.EdgeAttributes) {
+			t.Errorf("Expected the attributes %v and %v, but got %v and %v", d.NodeAttributes, d.EdgeAttributes, read.NodeAttributes, read.EdgeAttributes)
+		}
+	}
+}
+
+func TestReadNodeLinkJSON(t *testing.T) {
+	// As written by networkx.node_link_data with edges="edges", with string ids
+	source := `{"directed": false, "multigraph": false, "graph": {"name": "g"},
+		"nodes": [{"id": "a", "club": "Mr. Hi", "core": true}, {"id": "b", "pos": [1, 2]}],
+		"edges": [{"source": "a", "target": "b", "weight": 3}]}`
+	d, err := ReadNodeLinkJSON(strings.NewReader(source))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectedNodes := map[model.Node]map[string]string{
+		0: {"id": "a", "club": "Mr. Hi", "core": "true"},
+		1: {"id": "b", "pos": "[1, 2]"},
+	}
+	if !reflect.DeepEqual(d.NodeAttributes, expectedNodes) {
+		t.Errorf("Expected %v, but got %v", expectedNodes, d.NodeAttributes)
+	}
+	if !d.Undirected.HasEdge(0, 1) || d.EdgeWeight(1, 0) != 3 {
+		t.Errorf("Expected an edge of weight 3, but got %v", d.EdgeAttributes)
+	}
+
+	for _, invalid := range []string{
+		`{"nodes": [{"id": 0}], "links": [{"source": 0, "target": 1}]}`,
+		`{"nodes": [{"name": "x"}], "links": []}`,
+		`{"nodes": [`,
+	} {
+		if _, err := ReadNodeLinkJSON(strings.NewReader(invalid)); err == nil {
+			t.Errorf("Expected an error for %s", invalid)
+		}
+	}
+}
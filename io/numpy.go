@@ -0,0 +1,191 @@
+package io
+
+import (
+	"archive/zip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+/*
+EdgeIndex returns the edge-index tensor of an UndirectedGraph in coordinate (COO) format, as used by PyTorch Geometric and DGL.
+
+Parameters:
+- g: The graph.
+
+Returns:
+- nodes: The nodes of g in ascending order; node nodes[i] has index i in the tensor.
+- edgeIndex: The source and target indices of every edge, both orientations of every edge included and self-loops
+once, sorted by source then target.
+*/
+func EdgeIndex(g *model.UndirectedGraph) ([]model.Node, [2][]int64) {
+	nodes := sortedGraphNodes(g)
+	index := make(map[model.Node]int64, len(nodes))
+	for i, node := range nodes {
+		index[node] = int64(i)
+	}
+
+	var edgeIndex [2][]int64
+	for _, node := range nodes {
+		targets := make([]int64, 0, len(g.Edges[node]))
+		selfLoop := false
+		for _, neighbor := range g.Edges[node] {
+			// A self-loop is listed twice in the adjacency of its node
+			if neighbor == node {
+				if selfLoop {
+					continue
+				}
+				selfLoop = true
+			}
+			targets = append(targets, index[neighbor])
+		}
+		sort.Slice(targets, func(i, j int) bool { return targets[i] < targets[j] })
+		for _, target := range targets {
+			edgeIndex[0] = append(edgeIndex[0], index[node])
+			edgeIndex[1] = append(edgeIndex[1], target)
+		}
+	}
+	return nodes, edgeIndex
+}
+
+/*
+WriteNPY writes an array in the NumPy .npy format, version 1.0, in C order.
+
+Parameters:
+- w: The writer the array is written to.
+- shape: The dimensions of the array, whose product must be the length of data.
+- data: The elements of the array, a []int64 or a []float64.
+
+Returns:
+- err: An error if data has an unsupported type or does not match shape, or if writing fails.
+*/
+func WriteNPY(w io.Writer, shape []int, data any) error {
+	var descr string
+	var length int
+	switch values := data.(type) {
+	case []int64:
+		descr, length = "<i8", len(values)
+	case []float64:
+		descr, length = "<f8", len(values)
+	default:
+		return fmt.Errorf("unsupported array type %T", data)
+	}
+	size := 1
+	dimensions := make([]string, len(shape))
+	for i, dimension := range shape {
+		size *= dimension
+		dimensions[i] = fmt.Sprint(dimension)
+	}
+	if size != length {
+		return fmt.Errorf("shape %v does not match %d elements", shape, length)
+	}
+
+	tuple := strings.Join(dimensions, ", ")
+	if len(shape) == 1 {
+		tuple += ","
+	}
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }", descr, tuple)
+	// The magic string, version and header length take 10 bytes, and the header is padded to a multiple of 64 bytes
+	padding := 64 - (10+len(header)+1)%64
+	if padding == 64 {
+		padding = 0
+	}
+	header += strings.Repeat(" ", padding) + "\n"
+
+	if _, err := io.WriteString(w, "\x93NUMPY\x01\x00"); err != nil {
+		return fmt.Errorf("error writing npy header: %w", err)
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint16(len(header))); err != nil {
+		return fmt.Errorf("error writing npy header: %w", err)
+	}
+	if _, err := io.WriteString(w, header); err != nil {
+		return fmt.Errorf("error writing npy header: %w", err)
+	}
+	if err := binary.Write(w, binary.LittleEndian, data); err != nil {
+		return fmt.Errorf("error writing npy data: %w", err)
+	}
+	return nil
+}
+
+/*
+WriteGNNNPZ writes a graph as a NumPy .npz archive ready to build a PyTorch Geometric Data object or a DGL graph.
+
+Parameters:
+- w: The writer the archive is written to.
+- a: The graph and its numeric attributes.
+- nodeFeatures: The node attributes forming the columns of the node feature matrix, or nil for none.
+- edgeFeatures: The edge attributes forming the columns of the edge feature matrix, or nil for none.
+
+Returns:
+- err: An error if writing fails.
+
+Description:
+The archive holds the arrays:
+  - edge_index: the int64 array of shape (2, E) returned by EdgeIndex,
+  - node_ids: the int64 array of shape (N,) giving the original id of every node index,
+  - x: the float64 node feature matrix of shape (N, len(nodeFeatures)), if nodeFeatures is not empty,
+  - edge_attr: the float64 edge feature matrix of shape (E, len(edgeFeatures)), in the order of edge_index, if edgeFeatures is not empty.
+
+Missing attributes are written as 0. In Python:
+
+	arrays = numpy.load("graph.npz")
+	data = torch_geometric.data.Data(x=torch.from_numpy(arrays["x"]), edge_index=torch.from_numpy(arrays["edge_index"]))
+*/
+func WriteGNNNPZ(w io.Writer, a *model.AttributedGraph, nodeFeatures, edgeFeatures []string) error {
+	nodes, edgeIndex := EdgeIndex(a.Graph)
+	ids := make([]int64, len(nodes))
+	for i, node := range nodes {
+		ids[i] = int64(node)
+	}
+	edges := len(edgeIndex[0])
+
+	archive := zip.NewWriter(w)
+	write := func(name string, shape []int, data any) error {
+		// np.savez stores the arrays uncompressed
+		entry, err := archive.CreateHeader(&zip.FileHeader{Name: name + ".npy", Method: zip.Store})
+		if err != nil {
+			return fmt.Errorf("error creating %s: %w", name, err)
+		}
+		return WriteNPY(entry, shape, data)
+	}
+
+	if err := write("edge_index", []int{2, edges}, append(append([]int64{}, edgeIndex[0]...), edgeIndex[1]...)); err != nil {
+		return err
+	}
+	if err := write("node_ids", []int{len(nodes)}, ids); err != nil {
+		return err
+	}
+	if len(nodeFeatures) > 0 {
+		x := make([]float64, 0, len(nodes)*len(nodeFeatures))
+		for _, node := range nodes {
+			for _, feature := range nodeFeatures {
+				value, _ := a.NodeAttribute(node, feature)
+				x = append(x, value)
+			}
+		}
+		if err := write("x", []int{len(nodes), len(nodeFeatures)}, x); err != nil {
+			return err
+		}
+	}
+	if len(edgeFeatures) > 0 {
+		attributes := make([]float64, 0, edges*len(edgeFeatures))
+		for i := 0; i < edges; i++ {
+			u, v := nodes[edgeIndex[0][i]], nodes[edgeIndex[1][i]]
+			for _, feature := range edgeFeatures {
+				value, _ := a.EdgeAttribute(u, v, feature)
+				attributes = append(attributes, value)
+			}
+		}
+		if err := write("edge_attr", []int{edges, len(edgeFeatures)}, attributes); err != nil {
+			return err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("error closing npz archive: %w", err)
+	}
+	return nil
+}
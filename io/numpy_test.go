@@ -0,0 +1,114 @@
+package io
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// readNPY parses an array written by WriteNPY, returning its header and its elements.
+func readNPY(t *testing.T, content []byte, data any) string {
+	t.Helper()
+	if !bytes.HasPrefix(content, []byte("\x93NUMPY\x01\x00")) {
+		t.Fatalf("Expected the npy magic string, but got %q", content[:8])
+	}
+	length := int(binary.LittleEndian.Uint16(content[8:10]))
+	if (10+length)%64 != 0 {
+		t.Errorf("Expected the header to be aligned on 64 bytes, but got %d bytes", 10+length)
+	}
+	if err := binary.Read(bytes.NewReader(content[10+length:]), binary.LittleEndian, data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return strings.TrimRight(string(content[10:10+length]), " \n")
+}
+
+func TestEdgeIndex(t *testing.T) {
+	g := &model.UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{10, 20}, {20, 30}})
+	nodes, edgeIndex := EdgeIndex(g)
+	if !reflect.DeepEqual(nodes, []model.Node{10, 20, 30}) {
+		t.Errorf("Expected [10 20 30], but got %v", nodes)
+	}
+	expected := [2][]int64{{0, 1, 1, 2}, {1, 0, 2, 1}}
+	if !reflect.DeepEqual(edgeIndex, expected) {
+		t.Errorf("Expected %v, but got %v", expected, edgeIndex)
+	}
+
+	// A self-loop appears once
+	g.AddEdge(model.Edge{Node1: 20, Node2: 20})
+	_, edgeIndex = EdgeIndex(g)
+	expected = [2][]int64{{0, 1, 1, 1, 2}, {1, 0, 1, 2, 1}}
+	if !reflect.DeepEqual(edgeIndex, expected) {
+		t.Errorf("Expected %v, but got %v", expected, edgeIndex)
+	}
+}
+
+func TestWriteNPY(t *testing.T) {
+	var out bytes.Buffer
+	if err := WriteNPY(&out, []int{3}, []int64{1, -2, 3}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	data := make([]int64, 3)
+	header := readNPY(t, out.Bytes(), data)
+	if header != "{'descr': '<i8', 'fortran_order': False, 'shape': (3,), }" || !reflect.DeepEqual(data, []int64{1, -2, 3}) {
+		t.Errorf("Expected a 1-D int64 array [1 -2 3], but got %q and %v", header, data)
+	}
+
+	if err := WriteNPY(&out, []int{2, 2}, []float64{1, 2, 3}); err == nil {
+		t.Errorf("Expected an error for a mismatched shape")
+	}
+	if err := WriteNPY(&out, []int{1}, []int32{1}); err == nil {
+		t.Errorf("Expected an error for an unsupported type")
+	}
+}
+
+func TestWriteGNNNPZ(t *testing.T) {
+	a := model.NewAttributedGraph(model.PathGraph(3))
+	a.SetNodeAttribute(0, "age", 30)
+	a.SetNodeAttribute(2, "age", 50)
+	a.SetEdgeAttribute(1, 2, "weight", 4)
+
+	var out bytes.Buffer
+	if err := WriteGNNNPZ(&out, a, []string{"age"}, []string{"weight"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	arrays := make(map[string][]byte)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var content bytes.Buffer
+		content.ReadFrom(reader)
+		arrays[file.Name] = content.Bytes()
+	}
+
+	edgeIndex := make([]int64, 8)
+	if header := readNPY(t, arrays["edge_index.npy"], edgeIndex); !strings.Contains(header, "'shape': (2, 4)") {
+		t.Errorf("Expected shape (2, 4), but got %q", header)
+	}
+	if !reflect.DeepEqual(edgeIndex, []int64{0, 1, 1, 2, 1, 0, 2, 1}) {
+		t.Errorf("Expected the edge index of a path, but got %v", edgeIndex)
+	}
+	x := make([]float64, 3)
+	if header := readNPY(t, arrays["x.npy"], x); !strings.Contains(header, "'<f8'") || !reflect.DeepEqual(x, []float64{30, 0, 50}) {
+		t.Errorf("Expected node features [30 0 50], but got %q and %v", header, x)
+	}
+	edgeAttributes := make([]float64, 4)
+	readNPY(t, arrays["edge_attr.npy"], edgeAttributes)
+	if !reflect.DeepEqual(edgeAttributes, []float64{0, 0, 4, 4}) {
+		t.Errorf("Expected edge features [0 0 4 4], but got %v", edgeAttributes)
+	}
+	if _, ok := arrays["node_ids.npy"]; !ok || len(arrays) != 4 {
+		t.Errorf("Expected 4 arrays, but got %d", len(arrays))
+	}
+}
@@ -0,0 +1,55 @@
+package io
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+/*
+ReadEdgeStream reads an edge list one edge at a time, without building the graph, so that graphs larger than memory
+can be summarized, for instance with a model.GraphSketch.
+
+Parameters:
+- r: The edge list, one edge per line as two node ids separated by a comma or by white space, as written for
+EdgeListReader. Empty lines and lines starting with # are skipped, and fields after the second are ignored.
+- visit: Called with every edge in the order of the lines; reading stops at the first error it returns.
+
+Returns:
+- edges: The number of edges read.
+- err: An error if the input cannot be read, a line is not an edge, or visit fails.
+*/
+func ReadEdgeStream(r io.Reader, visit func(edge model.Edge) error) (int64, error) {
+	scanner := bufio.NewScanner(r)
+	edges := int64(0)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.FieldsFunc(text, func(c rune) bool { return c == ',' || c == ' ' || c == '\t' })
+		if len(fields) < 2 {
+			return edges, fmt.Errorf("line %d: expected two nodes, got %q", line, text)
+		}
+		var nodes [2]model.Node
+		for i := range nodes {
+			value, err := strconv.Atoi(fields[i])
+			if err != nil {
+				return edges, fmt.Errorf("line %d: invalid node %q: %w", line, fields[i], err)
+			}
+			nodes[i] = model.Node(value)
+		}
+		if err := visit(model.Edge{Node1: nodes[0], Node2: nodes[1]}); err != nil {
+			return edges, err
+		}
+		edges++
+	}
+	if err := scanner.Err(); err != nil {
+		return edges, fmt.Errorf("error reading edge stream: %w", err)
+	}
+	return edges, nil
+}
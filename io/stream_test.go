@@ -0,0 +1,54 @@
+package io
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestReadEdgeStream(t *testing.T) {
+	input := "# a comment\n0,1\n1 2\n\n2\t3,weight\n"
+	var edges []model.Edge
+	count, err := ReadEdgeStream(strings.NewReader(input), func(edge model.Edge) error {
+		edges = append(edges, edge)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []model.Edge{{Node1: 0, Node2: 1}, {Node1: 1, Node2: 2}, {Node1: 2, Node2: 3}}
+	if count != 3 || !reflect.DeepEqual(edges, expected) {
+		t.Errorf("Expected %v, but got %d edges %v", expected, count, edges)
+	}
+
+	if _, err := ReadEdgeStream(strings.NewReader("0,1\n0,x\n"), func(model.Edge) error { return nil }); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Expected an error on line 2, but got %v", err)
+	}
+	stop := fmt.Errorf("stop")
+	if count, err := ReadEdgeStream(strings.NewReader("0,1\n1,2\n"), func(model.Edge) error { return stop }); err != stop || count != 0 {
+		t.Errorf("Expected the error of visit, but got %v after %d edges", err, count)
+	}
+}
+
+func TestReadEdgeStreamIntoSketch(t *testing.T) {
+	var list strings.Builder
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(&list, "%d,%d\n", i, i+1)
+	}
+	sketch, err := model.NewGraphSketch(100, 0.01, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := ReadEdgeStream(strings.NewReader(list.String()), func(edge model.Edge) error {
+		sketch.AddEdge(edge)
+		return nil
+	}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if sketch.NumberOfEdges() != 1000 || len(sketch.Sample().Nodes) > 200 {
+		t.Errorf("Expected 1000 edges and at most 100 sampled, but got %d and %v", sketch.NumberOfEdges(), sketch.Sample())
+	}
+}
@@ -0,0 +1,125 @@
+package io
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// svgNodeRadius is the radius of the nodes, unless a rule sets their r attribute.
+const svgNodeRadius = 5.0
+
+/*
+WriteSVG draws an UndirectedGraph as an SVG image.
+
+Parameters:
+- w: The writer the image is written to.
+- g: The graph.
+- positions: The position of every node, e.g. from model.SpringLayout, or nil to use model.CircularLayout.
+- style: The node and edge attributes and styling rules, or nil for an unstyled graph. GraphName becomes the title of
+the image and ClusterAttribute is ignored.
+
+Returns:
+- err: An error if writing fails.
+
+Description:
+Positions are used as SVG user units, the y axis pointing down, and the view box fits the drawing with a margin.
+Edges are drawn first, as polylines along style.EdgePaths for bent edges and as lines otherwise, then the nodes as
+circles titled with their number. The properties set by the rules are written as SVG attributes, so rules should use
+SVG names such as fill, r, stroke or stroke-width. Elements are drawn in ascending order.
+*/
+func WriteSVG(w io.Writer, g *model.UndirectedGraph, positions map[model.Node]model.Point, style *DOTStyle) error {
+	if style == nil {
+		style = &DOTStyle{}
+	}
+	if positions == nil {
+		positions = model.CircularLayout(g, 100)
+	}
+
+	nodes := sortedGraphNodes(g)
+	edges := dotEdges(g)
+	paths := make([][]model.Point, len(edges))
+	low, high := model.Point{X: math.Inf(1), Y: math.Inf(1)}, model.Point{X: math.Inf(-1), Y: math.Inf(-1)}
+	include := func(p model.Point) {
+		low = model.Point{X: math.Min(low.X, p.X), Y: math.Min(low.Y, p.Y)}
+		high = model.Point{X: math.Max(high.X, p.X), Y: math.Max(high.Y, p.Y)}
+	}
+	for _, node := range nodes {
+		include(positions[node])
+	}
+	for i, edge := range edges {
+		paths[i] = style.edgePath(edge)
+		if len(paths[i]) < 2 {
+			paths[i] = []model.Point{positions[edge.Node1], positions[edge.Node2]}
+		}
+		for _, point := range paths[i] {
+			include(point)
+		}
+	}
+	if len(nodes) == 0 {
+		low, high = model.Point{}, model.Point{}
+	}
+	margin := 4 * svgNodeRadius
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, "<svg xmlns=\"http://www.w3.org/2000/svg\" viewBox=\"%s %s %s %s\">\n",
+		svgNumber(low.X-margin), svgNumber(low.Y-margin), svgNumber(high.X-low.X+2*margin), svgNumber(high.Y-low.Y+2*margin))
+	if style.GraphName != "" {
+		fmt.Fprintf(out, "  <title>%s</title>\n", html.EscapeString(style.GraphName))
+	}
+
+	fmt.Fprintln(out, "  <g stroke=\"#999\" fill=\"none\">")
+	for i, edge := range edges {
+		properties := applyRules(style.EdgeRules, style.edgeAttributes(edge))
+		if path := paths[i]; len(path) == 2 {
+			fmt.Fprintf(out, "    <line x1=\"%s\" y1=\"%s\" x2=\"%s\" y2=\"%s\"%s/>\n",
+				svgNumber(path[0].X), svgNumber(path[0].Y), svgNumber(path[1].X), svgNumber(path[1].Y), svgAttributes(properties))
+		} else {
+			points := make([]string, len(path))
+			for j, point := range path {
+				points[j] = svgNumber(point.X) + "," + svgNumber(point.Y)
+			}
+			fmt.Fprintf(out, "    <polyline points=\"%s\"%s/>\n", strings.Join(points, " "), svgAttributes(properties))
+		}
+	}
+	fmt.Fprintln(out, "  </g>")
+
+	fmt.Fprintln(out, "  <g fill=\"#4682b4\">")
+	for _, node := range nodes {
+		properties := applyRules(style.NodeRules, style.NodeAttributes[node])
+		if _, ok := properties["r"]; !ok {
+			properties["r"] = svgNumber(svgNodeRadius)
+		}
+		position := positions[node]
+		fmt.Fprintf(out, "    <circle cx=\"%s\" cy=\"%s\"%s><title>%d</title></circle>\n",
+			svgNumber(position.X), svgNumber(position.Y), svgAttributes(properties), node)
+	}
+	fmt.Fprintln(out, "  </g>")
+	fmt.Fprintln(out, "</svg>")
+	return out.Flush()
+}
+
+// svgAttributes writes properties as SVG attributes in ascending order, each preceded by a space.
+func svgAttributes(properties map[string]string) string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var attributes strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&attributes, " %s=\"%s\"", name, html.EscapeString(properties[name]))
+	}
+	return attributes.String()
+}
+
+func svgNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
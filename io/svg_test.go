@@ -0,0 +1,42 @@
+package io
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestWriteSVG(t *testing.T) {
+	g := model.PathGraph(3)
+	positions := map[model.Node]model.Point{0: {X: 0, Y: 0}, 1: {X: 10, Y: 0}, 2: {X: 10, Y: 10}}
+	style := &DOTStyle{
+		GraphName: "a & b",
+		NodeRules: []StyleRule{PaletteRule("community", "fill", []string{"red", "blue"})},
+		EdgeRules: []StyleRule{CategoricalRule("kind", "stroke-dasharray", map[string]string{"backup": "4"})},
+		EdgePaths: map[model.Edge][]model.Point{{Node1: 2, Node2: 1}: {{X: 10, Y: 10}, {X: 12, Y: 5}, {X: 10, Y: 0}}},
+	}
+	style.SetNodeCommunities("community", map[model.Node]int{1: 1})
+	style.EdgeAttributes = map[model.Edge]map[string]string{{Node1: 0, Node2: 1}: {"kind": "backup"}}
+
+	var out strings.Builder
+	if err := WriteSVG(&out, g, positions, style); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `<svg xmlns="http://www.w3.org/2000/svg" viewBox="-20 -20 52 50">
+  <title>a &amp; b</title>
+  <g stroke="#999" fill="none">
+    <line x1="0" y1="0" x2="10" y2="0" stroke-dasharray="4"/>
+    <polyline points="10,0 12,5 10,10"/>
+  </g>
+  <g fill="#4682b4">
+    <circle cx="0" cy="0" r="5"><title>0</title></circle>
+    <circle cx="10" cy="0" fill="blue" r="5"><title>1</title></circle>
+    <circle cx="10" cy="10" r="5"><title>2</title></circle>
+  </g>
+</svg>
+`
+	if out.String() != expected {
+		t.Errorf("Expected\n%s\nbut got\n%s", expected, out.String())
+	}
+}
@@ -0,0 +1,193 @@
+package io
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+/*
+WriteTemporalGEXF writes a TemporalGraph in the dynamic mode of GEXF 1.2, which Gephi animates with its timeline.
+
+Parameters:
+- w: The writer the graph is written to.
+- g: The temporal graph.
+- duration: How long an edge stays visible after a contact, positive.
+
+Returns:
+- err: An error if duration is not positive or writing fails.
+
+Description:
+Every pair of nodes in contact is written once, directed if g is, with one spell [t, t + duration] per contact, the
+overlapping spells being merged. Times are written as doubles. Nodes exist at all times and keep their number as id;
+nodes and edges are written in ascending order.
+*/
+func WriteTemporalGEXF(w io.Writer, g *model.TemporalGraph, duration float64) error {
+	if !(duration > 0) || math.IsInf(duration, 1) {
+		return fmt.Errorf("contact duration must be positive, got %v", duration)
+	}
+	document := gexfDocument{
+		XMLNS:   "http://www.gexf.net/1.2draft",
+		Version: "1.2",
+		Graph:   gexfGraph{Mode: "dynamic", DefaultEdgeType: "undirected", TimeFormat: "double"},
+	}
+	if g.Directed {
+		document.Graph.DefaultEdgeType = "directed"
+	}
+	for _, node := range sortedTemporalNodes(g) {
+		document.Graph.Nodes = append(document.Graph.Nodes, gexfNode{ID: strconv.Itoa(int(node))})
+	}
+
+	times := temporalEdgeTimes(g)
+	for i, edge := range sortedTemporalEdges(times) {
+		spells := &gexfSpells{}
+		start, end := math.Inf(-1), math.Inf(-1)
+		for _, time := range times[edge] {
+			if time > end {
+				if !math.IsInf(start, -1) {
+					spells.Spells = append(spells.Spells, gexfSpell{Start: formatTime(start), End: formatTime(end)})
+				}
+				start = time
+			}
+			end = time + duration
+		}
+		spells.Spells = append(spells.Spells, gexfSpell{Start: formatTime(start), End: formatTime(end)})
+		document.Graph.Edges = append(document.Graph.Edges, gexfEdge{
+			ID:     strconv.Itoa(i),
+			Source: strconv.Itoa(int(edge.Node1)),
+			Target: strconv.Itoa(int(edge.Node2)),
+			Spells: spells,
+		})
+	}
+	return encodeGEXF(w, document)
+}
+
+type temporalFramesDocument struct {
+	Directed bool            `json:"directed"`
+	Nodes    []string        `json:"nodes"`
+	Frames   []temporalFrame `json:"frames"`
+}
+
+type temporalFrame struct {
+	Start float64             `json:"start"`
+	End   float64             `json:"end"`
+	Edges []temporalFrameEdge `json:"edges"`
+}
+
+type temporalFrameEdge struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	Contacts int    `json:"contacts"`
+}
+
+/*
+WriteTemporalJSONFrames writes a TemporalGraph as a sequence of JSON frames, for a web front-end to animate.
+
+Parameters:
+- w: The writer the frames are written to.
+- g: The temporal graph.
+- windowSize: The duration covered by every frame, positive.
+
+Returns:
+- err: An error if windowSize is not positive or writing fails.
+
+Description:
+The document holds whether the graph is directed, the ids of all its nodes, and one frame per window
+[t0 + i windowSize, t0 + (i + 1) windowSize), t0 being the time of the first contact, as the windows of
+model.Snapshots. Every frame lists its start, its end, and the pairs of nodes in contact during the window with their
+number of contacts, the smaller node first unless the graph is directed. Frames without contacts are kept so that
+frames follow each other at a steady pace.
+*/
+func WriteTemporalJSONFrames(w io.Writer, g *model.TemporalGraph, windowSize float64) error {
+	if !(windowSize > 0) || math.IsInf(windowSize, 1) {
+		return fmt.Errorf("window size must be positive, got %v", windowSize)
+	}
+	document := temporalFramesDocument{Directed: g.Directed, Nodes: []string{}, Frames: []temporalFrame{}}
+	for _, node := range sortedTemporalNodes(g) {
+		document.Nodes = append(document.Nodes, strconv.Itoa(int(node)))
+	}
+
+	contacts := g.SortedContacts()
+	if len(contacts) > 0 {
+		start := contacts[0].Time
+		count := int((contacts[len(contacts)-1].Time-start)/windowSize) + 1
+		windows := make([]map[model.Edge]int, count)
+		for i := range windows {
+			windows[i] = make(map[model.Edge]int)
+		}
+		for _, contact := range contacts {
+			windows[int((contact.Time-start)/windowSize)][temporalEdge(g, contact)]++
+		}
+		for i, window := range windows {
+			frame := temporalFrame{Start: start + float64(i)*windowSize, End: start + float64(i+1)*windowSize, Edges: []temporalFrameEdge{}}
+			edges := make([]model.Edge, 0, len(window))
+			for edge := range window {
+				edges = append(edges, edge)
+			}
+			sortTemporalEdges(edges)
+			for _, edge := range edges {
+				frame.Edges = append(frame.Edges, temporalFrameEdge{
+					Source:   strconv.Itoa(int(edge.Node1)),
+					Target:   strconv.Itoa(int(edge.Node2)),
+					Contacts: window[edge],
+				})
+			}
+			document.Frames = append(document.Frames, frame)
+		}
+	}
+	return json.NewEncoder(w).Encode(document)
+}
+
+// temporalEdge returns the pair of nodes of a contact, the smaller node first unless the graph is directed.
+func temporalEdge(g *model.TemporalGraph, contact model.Contact) model.Edge {
+	if !g.Directed && contact.Node2 < contact.Node1 {
+		return model.Edge{Node1: contact.Node2, Node2: contact.Node1}
+	}
+	return model.Edge{Node1: contact.Node1, Node2: contact.Node2}
+}
+
+// temporalEdgeTimes returns the times of the contacts of every pair of nodes, in increasing order.
+func temporalEdgeTimes(g *model.TemporalGraph) map[model.Edge][]float64 {
+	times := make(map[model.Edge][]float64)
+	for _, contact := range g.SortedContacts() {
+		edge := temporalEdge(g, contact)
+		times[edge] = append(times[edge], contact.Time)
+	}
+	return times
+}
+
+func sortedTemporalEdges(times map[model.Edge][]float64) []model.Edge {
+	edges := make([]model.Edge, 0, len(times))
+	for edge := range times {
+		edges = append(edges, edge)
+	}
+	sortTemporalEdges(edges)
+	return edges
+}
+
+func sortTemporalEdges(edges []model.Edge) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Node1 != edges[j].Node1 {
+			return edges[i].Node1 < edges[j].Node1
+		}
+		return edges[i].Node2 < edges[j].Node2
+	})
+}
+
+func sortedTemporalNodes(g *model.TemporalGraph) []model.Node {
+	nodes := make([]model.Node, 0, len(g.Nodes))
+	for node := range g.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return nodes
+}
+
+func formatTime(time float64) string {
+	return strconv.FormatFloat(time, 'g', -1, 64)
+}
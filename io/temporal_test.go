@@ -0,0 +1,113 @@
+package io
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func temporalTestGraph(directed bool) *model.TemporalGraph {
+	g := &model.TemporalGraph{Directed: directed}
+	g.AddNode(9)
+	for _, contact := range []model.Contact{{Node1: 1, Node2: 0, Time: 0}, {Node1: 0, Node2: 1, Time: 0.5}, {Node1: 1, Node2: 2, Time: 1}, {Node1: 0, Node2: 1, Time: 3}} {
+		g.AddContact(contact)
+	}
+	return g
+}
+
+func TestWriteTemporalGEXF(t *testing.T) {
+	var out strings.Builder
+	if err := WriteTemporalGEXF(&out, temporalTestGraph(false), 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2">
+  <graph mode="dynamic" defaultedgetype="undirected" timeformat="double">
+    <nodes>
+      <node id="0"></node>
+      <node id="1"></node>
+      <node id="2"></node>
+      <node id="9"></node>
+    </nodes>
+    <edges>
+      <edge id="0" source="0" target="1">
+        <spells>
+          <spell start="0" end="1.5"></spell>
+          <spell start="3" end="4"></spell>
+        </spells>
+      </edge>
+      <edge id="1" source="1" target="2">
+        <spells>
+          <spell start="1" end="2"></spell>
+        </spells>
+      </edge>
+    </edges>
+  </graph>
+</gexf>
+`
+	if out.String() != expected {
+		t.Errorf("Expected\n%s\nbut got\n%s", expected, out.String())
+	}
+
+	// The static structure can be read back
+	d, err := ReadGEXF(strings.NewReader(out.String()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(d.Undirected.Nodes) != 4 || d.Undirected.NumberOfEdges() != 2 {
+		t.Errorf("Expected 4 nodes and 2 edges, but got %v", d.Undirected)
+	}
+
+	out.Reset()
+	if err := WriteTemporalGEXF(&out, temporalTestGraph(true), 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), `defaultedgetype="directed"`) || !strings.Contains(out.String(), `source="1" target="0"`) {
+		t.Errorf("Expected directed edges, but got %s", out.String())
+	}
+	if err := WriteTemporalGEXF(&out, temporalTestGraph(false), 0); err == nil {
+		t.Errorf("Expected an error for a zero duration")
+	}
+}
+
+func TestWriteTemporalJSONFrames(t *testing.T) {
+	var out strings.Builder
+	if err := WriteTemporalJSONFrames(&out, temporalTestGraph(false), 1); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `{"directed":false,"nodes":["0","1","2","9"],"frames":[` +
+		`{"start":0,"end":1,"edges":[{"source":"0","target":"1","contacts":2}]},` +
+		`{"start":1,"end":2,"edges":[{"source":"1","target":"2","contacts":1}]},` +
+		`{"start":2,"end":3,"edges":[]},` +
+		`{"start":3,"end":4,"edges":[{"source":"0","target":"1","contacts":1}]}]}` + "\n"
+	if out.String() != expected {
+		t.Errorf("Expected %s, but got %s", expected, out.String())
+	}
+
+	out.Reset()
+	if err := WriteTemporalJSONFrames(&out, temporalTestGraph(true), 10); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var document struct {
+		Directed bool
+		Frames   []struct {
+			Edges []struct{ Source, Target string }
+		}
+	}
+	if err := json.Unmarshal([]byte(out.String()), &document); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !document.Directed || len(document.Frames) != 1 || len(document.Frames[0].Edges) != 3 {
+		t.Errorf("Expected one frame with the 3 directed pairs, but got %s", out.String())
+	}
+
+	out.Reset()
+	if err := WriteTemporalJSONFrames(&out, &model.TemporalGraph{}, 1); err != nil || out.String() != `{"directed":false,"nodes":[],"frames":[]}`+"\n" {
+		t.Errorf("Expected an empty document, but got %s and %v", out.String(), err)
+	}
+	if err := WriteTemporalJSONFrames(&out, temporalTestGraph(false), -1); err == nil {
+		t.Errorf("Expected an error for a negative window size")
+	}
+}
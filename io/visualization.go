@@ -0,0 +1,169 @@
+package io
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// StyleProperties names the properties the coloring helpers of DOTStyle set, which differ from one writer to another.
+type StyleProperties struct {
+	NodeColor string
+	EdgeColor string
+}
+
+var (
+	// GraphvizProperties are the properties of WriteDOT.
+	GraphvizProperties = StyleProperties{NodeColor: "color", EdgeColor: "color"}
+	// CytoscapeProperties are the properties of WriteCytoscapeJSON.
+	CytoscapeProperties = StyleProperties{NodeColor: "background-color", EdgeColor: "line-color"}
+	// SigmaProperties are the properties of WriteSigmaJSON.
+	SigmaProperties = StyleProperties{NodeColor: "color", EdgeColor: "color"}
+	// SVGProperties are the properties of WriteSVG.
+	SVGProperties = StyleProperties{NodeColor: "fill", EdgeColor: "stroke"}
+)
+
+var (
+	// HeatmapColors runs from dark blue for low values to dark red for high values, the RdYlBu scheme of ColorBrewer
+	// reversed.
+	HeatmapColors = []string{"#313695", "#4575b4", "#74add1", "#abd9e9", "#ffffbf", "#fee090", "#fdae61", "#f46d43", "#d73027", "#a50026"}
+	// CommunityColors holds ten colors easy to tell apart, the Tableau 10 scheme.
+	CommunityColors = []string{"#4e79a7", "#f28e2b", "#e15759", "#76b7b2", "#59a14f", "#edc948", "#b07aa1", "#ff9da7", "#9c755f", "#bab0ac"}
+)
+
+// mutedColor is the color of the elements a helper does not highlight, such as unreachable nodes.
+const mutedColor = "#dddddd"
+
+type colorScaleRule struct {
+	attribute, property string
+	inputMin, inputMax  float64
+	colors              []string
+}
+
+// ColorScaleRule maps the numeric attribute from [inputMin, inputMax] to a color interpolated along colors, given as
+// #rrggbb, clamping values outside the input range. inputMin may exceed inputMax to reverse the scale.
+func ColorScaleRule(attribute, property string, inputMin, inputMax float64, colors []string) StyleRule {
+	return colorScaleRule{attribute: attribute, property: property, inputMin: inputMin, inputMax: inputMax, colors: colors}
+}
+
+func (r colorScaleRule) Style(attributes map[string]string) (string, string, bool) {
+	number, err := strconv.ParseFloat(attributes[r.attribute], 64)
+	if err != nil || len(r.colors) == 0 {
+		return "", "", false
+	}
+	position := 0.0
+	if r.inputMax != r.inputMin {
+		position = min(max((number-r.inputMin)/(r.inputMax-r.inputMin), 0), 1)
+	}
+	scaled := position * float64(len(r.colors)-1)
+	index := min(int(scaled), len(r.colors)-1)
+	low, lowOK := parseHexColor(r.colors[index])
+	high, highOK := parseHexColor(r.colors[min(index+1, len(r.colors)-1)])
+	if !lowOK || !highOK {
+		return "", "", false
+	}
+	t := scaled - float64(index)
+	var mixed [3]float64
+	for i := range mixed {
+		mixed[i] = math.Round(low[i] + t*(high[i]-low[i]))
+	}
+	return r.property, fmt.Sprintf("#%02x%02x%02x", int(mixed[0]), int(mixed[1]), int(mixed[2])), true
+}
+
+// parseHexColor returns the red, green and blue components of a #rrggbb color.
+func parseHexColor(color string) ([3]float64, bool) {
+	var components [3]float64
+	if len(color) != 7 || color[0] != '#' {
+		return components, false
+	}
+	for i := range components {
+		value, err := strconv.ParseUint(color[1+2*i:3+2*i], 16, 8)
+		if err != nil {
+			return components, false
+		}
+		components[i] = float64(value)
+	}
+	return components, true
+}
+
+/*
+ColorByDistance colors the nodes and edges of a graph by their distance from a source, to show what can be reached
+from it and how far.
+
+Parameters:
+- g: The graph.
+- source: The node distances are measured from.
+- weight: The length of every edge, non-negative; model.UnitWeight counts hops.
+- properties: The color properties of the writer the style is meant for, such as GraphvizProperties.
+
+Returns:
+- err: An error if source is not in g or an edge has a negative weight.
+
+Description:
+Every node gets a reachable attribute, true or false, and the reachable ones a distance attribute. The edges of the
+shortest path tree from source get a shortest_path_tree attribute set to true and the distance of their far end,
+the other edges false. Rules then color the reachable nodes and the tree edges along HeatmapColors, from dark red
+near source to dark blue at the largest distance, and mute the other nodes and edges in light gray.
+*/
+func (s *DOTStyle) ColorByDistance(g *model.UndirectedGraph, source model.Node, weight model.WeightFunc, properties StyleProperties) error {
+	paths, err := model.Dijkstra(g, source, weight)
+	if err != nil {
+		return err
+	}
+	farthest := 0.0
+	for _, node := range sortedGraphNodes(g) {
+		distance, reachable := paths.Distances[node]
+		s.setNodeAttribute(node, "reachable", strconv.FormatBool(reachable))
+		if reachable {
+			s.setNodeAttribute(node, "distance", strconv.FormatFloat(distance, 'g', -1, 64))
+			farthest = max(farthest, distance)
+		}
+	}
+	for _, edge := range dotEdges(g) {
+		tree, far := false, edge.Node2
+		if parent, ok := paths.Parents[edge.Node2]; ok && parent == edge.Node1 {
+			tree = true
+		} else if parent, ok := paths.Parents[edge.Node1]; ok && parent == edge.Node2 {
+			tree, far = true, edge.Node1
+		}
+		s.setEdgeAttribute(edge, "shortest_path_tree", strconv.FormatBool(tree))
+		if tree {
+			s.setEdgeAttribute(edge, "distance", strconv.FormatFloat(paths.Distances[far], 'g', -1, 64))
+		}
+	}
+
+	s.NodeRules = append(s.NodeRules,
+		CategoricalRule("reachable", properties.NodeColor, map[string]string{"false": mutedColor}),
+		ColorScaleRule("distance", properties.NodeColor, farthest, 0, HeatmapColors))
+	s.EdgeRules = append(s.EdgeRules,
+		CategoricalRule("shortest_path_tree", properties.EdgeColor, map[string]string{"false": mutedColor}),
+		ColorScaleRule("distance", properties.EdgeColor, farthest, 0, HeatmapColors))
+	return nil
+}
+
+// ColorByValue stores a numeric attribute of the nodes, such as a centrality, and colors them along HeatmapColors from
+// the smallest value in dark blue to the largest in dark red.
+func (s *DOTStyle) ColorByValue(attribute string, values map[model.Node]float64, properties StyleProperties) {
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, value := range values {
+		low, high = min(low, value), max(high, value)
+	}
+	s.SetNodeValues(attribute, values)
+	s.NodeRules = append(s.NodeRules, ColorScaleRule(attribute, properties.NodeColor, low, high, HeatmapColors))
+}
+
+// ColorByCommunity stores the community index of the nodes and colors them with CommunityColors, together with the
+// edges of g joining two nodes of the same community, which get the community as attribute too.
+func (s *DOTStyle) ColorByCommunity(g *model.UndirectedGraph, attribute string, communities map[model.Node]int, properties StyleProperties) {
+	s.SetNodeCommunities(attribute, communities)
+	for _, edge := range dotEdges(g) {
+		community, ok := communities[edge.Node1]
+		if other, otherOK := communities[edge.Node2]; ok && otherOK && community == other {
+			s.setEdgeAttribute(edge, attribute, strconv.Itoa(community))
+		}
+	}
+	s.NodeRules = append(s.NodeRules, PaletteRule(attribute, properties.NodeColor, CommunityColors))
+	s.EdgeRules = append(s.EdgeRules, PaletteRule(attribute, properties.EdgeColor, CommunityColors))
+}
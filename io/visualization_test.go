@@ -0,0 +1,115 @@
+package io
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestColorScaleRule(t *testing.T) {
+	rule := ColorScaleRule("value", "color", 0, 10, []string{"#000000", "#ff8000", "#ffffff"})
+	for _, test := range []struct {
+		value, expected string
+	}{
+		{"0", "#000000"}, {"2.5", "#804000"}, {"5", "#ff8000"}, {"10", "#ffffff"}, {"20", "#ffffff"}, {"-1", "#000000"},
+	} {
+		if _, color, ok := rule.Style(map[string]string{"value": test.value}); !ok || color != test.expected {
+			t.Errorf("Expected %s for %s, but got %s", test.expected, test.value, color)
+		}
+	}
+	if _, _, ok := rule.Style(map[string]string{"value": "high"}); ok {
+		t.Errorf("Expected no color for a non-numeric value")
+	}
+	if _, _, ok := ColorScaleRule("value", "color", 0, 1, []string{"red"}).Style(map[string]string{"value": "1"}); ok {
+		t.Errorf("Expected no color for a named color")
+	}
+}
+
+func TestColorByDistance(t *testing.T) {
+	g := model.PathGraph(3)
+	g.AddNode(3)
+	style := &DOTStyle{}
+	if err := style.ColorByDistance(g, 0, model.UnitWeight, GraphvizProperties); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var out strings.Builder
+	if err := WriteDOT(&out, g, style); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `graph "G" {
+  0 [color="#a50026"];
+  1 [color="#fff0a8"];
+  2 [color="#313695"];
+  3 [color="#dddddd"];
+  0 -- 1 [color="#fff0a8"];
+  1 -- 2 [color="#313695"];
+}
+`
+	if out.String() != expected {
+		t.Errorf("Expected\n%s\nbut got\n%s", expected, out.String())
+	}
+
+	// Edges off the shortest path tree are muted
+	cycle := model.CycleGraph(4)
+	style = &DOTStyle{}
+	if err := style.ColorByDistance(cycle, 0, model.UnitWeight, SVGProperties); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	muted := 0
+	for _, edge := range dotEdges(cycle) {
+		if applyRules(style.EdgeRules, style.edgeAttributes(edge))["stroke"] == mutedColor {
+			muted++
+		}
+	}
+	if muted != 1 {
+		t.Errorf("Expected 1 muted edge, but got %d", muted)
+	}
+
+	if err := (&DOTStyle{}).ColorByDistance(g, 7, model.UnitWeight, GraphvizProperties); err == nil {
+		t.Errorf("Expected an error for a missing source")
+	}
+}
+
+func TestColorByValueAndCommunity(t *testing.T) {
+	g := model.PathGraph(3)
+	style := &DOTStyle{}
+	style.ColorByValue("centrality", map[model.Node]float64{0: 1, 1: 3, 2: 2}, CytoscapeProperties)
+	style.ColorByCommunity(g, "community", map[model.Node]int{0: 0, 1: 0, 2: 1}, CytoscapeProperties)
+
+	var out strings.Builder
+	if err := WriteCytoscapeJSON(&out, g, nil, style); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var document struct {
+		Elements struct {
+			Nodes, Edges []struct {
+				Style map[string]any `json:"style"`
+			}
+		}
+	}
+	if err := json.Unmarshal([]byte(out.String()), &document); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The community rule comes last and overrides the value rule
+	for i, expected := range []string{"#4e79a7", "#4e79a7", "#f28e2b"} {
+		if color := document.Elements.Nodes[i].Style["background-color"]; color != expected {
+			t.Errorf("Expected %s for node %d, but got %v", expected, i, color)
+		}
+	}
+	if color := document.Elements.Edges[0].Style["line-color"]; color != "#4e79a7" {
+		t.Errorf("Expected #4e79a7, but got %v", color)
+	}
+	if document.Elements.Edges[1].Style != nil {
+		t.Errorf("Expected no style across communities, but got %v", document.Elements.Edges[1].Style)
+	}
+
+	style = &DOTStyle{}
+	style.ColorByValue("centrality", map[model.Node]float64{0: 1, 1: 3, 2: 2}, GraphvizProperties)
+	for node, expected := range map[model.Node]string{0: "#313695", 1: "#a50026", 2: "#fff0a8"} {
+		if color := applyRules(style.NodeRules, style.NodeAttributes[node])["color"]; color != expected {
+			t.Errorf("Expected %s for node %d, but got %s", expected, node, color)
+		}
+	}
+}
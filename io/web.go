@@ -0,0 +1,236 @@
+package io
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+type cytoscapeDocument struct {
+	Elements cytoscapeElements `json:"elements"`
+}
+
+type cytoscapeElements struct {
+	Nodes []cytoscapeElement `json:"nodes"`
+	Edges []cytoscapeElement `json:"edges"`
+}
+
+type cytoscapeElement struct {
+	Data     map[string]any     `json:"data"`
+	Position *cytoscapePosition `json:"position,omitempty"`
+	Style    map[string]any     `json:"style,omitempty"`
+}
+
+type cytoscapePosition struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type sigmaDocument struct {
+	Attributes map[string]any `json:"attributes"`
+	Options    sigmaOptions   `json:"options"`
+	Nodes      []sigmaNode    `json:"nodes"`
+	Edges      []sigmaEdge    `json:"edges"`
+}
+
+type sigmaOptions struct {
+	Type       string `json:"type"`
+	Multi      bool   `json:"multi"`
+	AllowLoops bool   `json:"allowSelfLoops"`
+}
+
+type sigmaNode struct {
+	Key        string         `json:"key"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+type sigmaEdge struct {
+	Key        string         `json:"key"`
+	Source     string         `json:"source"`
+	Target     string         `json:"target"`
+	Undirected bool           `json:"undirected"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+/*
+WriteCytoscapeJSON writes an UndirectedGraph in the elements JSON format of Cytoscape.js.
+
+Parameters:
+- w: The writer the graph is written to.
+- g: The graph.
+- positions: The position of every node, e.g. from model.SpringLayout, or nil to use model.CircularLayout.
+- style: The node and edge attributes and styling rules, or nil for an unstyled graph. GraphName and ClusterAttribute are ignored.
+
+Returns:
+- err: An error if writing fails.
+
+Description:
+Every element carries its attributes in data, next to its id (the node number, or "u-v" for an edge) and for edges
+its source and target. The properties set by the rules are written as the element's style bypass, so rules should
+use Cytoscape.js property names such as background-color or width. Numeric values are written as JSON numbers.
+Bent edges of style.EdgePaths get their polyline in the points data and, as a style bypass, the segments curve
+style drawing it. The document can be passed directly as cytoscape({elements: document.elements}).
+*/
+func WriteCytoscapeJSON(w io.Writer, g *model.UndirectedGraph, positions map[model.Node]model.Point, style *DOTStyle) error {
+	if style == nil {
+		style = &DOTStyle{}
+	}
+	if positions == nil {
+		positions = model.CircularLayout(g, 100)
+	}
+
+	document := cytoscapeDocument{Elements: cytoscapeElements{Nodes: []cytoscapeElement{}, Edges: []cytoscapeElement{}}}
+	for _, node := range sortedGraphNodes(g) {
+		data := jsonAttributes(style.NodeAttributes[node])
+		data["id"] = strconv.Itoa(int(node))
+		element := cytoscapeElement{Data: data, Style: jsonStyle(style.NodeRules, style.NodeAttributes[node])}
+		if position, ok := positions[node]; ok {
+			element.Position = &cytoscapePosition{X: position.X, Y: position.Y}
+		}
+		document.Elements.Nodes = append(document.Elements.Nodes, element)
+	}
+	for _, edge := range dotEdges(g) {
+		attributes := style.edgeAttributes(edge)
+		data := jsonAttributes(attributes)
+		data["id"] = fmt.Sprintf("%d-%d", edge.Node1, edge.Node2)
+		data["source"] = strconv.Itoa(int(edge.Node1))
+		data["target"] = strconv.Itoa(int(edge.Node2))
+		element := cytoscapeElement{Data: data, Style: jsonStyle(style.EdgeRules, attributes)}
+		if path := style.edgePath(edge); len(path) > 2 {
+			data["points"] = jsonPoints(path)
+			if element.Style == nil {
+				element.Style = make(map[string]any)
+			}
+			weights, distances := segmentOffsets(path)
+			element.Style["curve-style"] = "segments"
+			element.Style["edge-distances"] = "node-position"
+			element.Style["segment-weights"] = weights
+			element.Style["segment-distances"] = distances
+		}
+		document.Elements.Edges = append(document.Elements.Edges, element)
+	}
+	return json.NewEncoder(w).Encode(document)
+}
+
+/*
+WriteSigmaJSON writes an UndirectedGraph in the serialization format of graphology, the graph model of sigma.js.
+
+Parameters:
+- w: The writer the graph is written to.
+- g: The graph.
+- positions: The position of every node, e.g. from model.SpringLayout, or nil to use model.CircularLayout.
+- style: The node and edge attributes and styling rules, or nil for an unstyled graph. GraphName becomes the name
+attribute of the graph and ClusterAttribute is ignored.
+
+Returns:
+- err: An error if writing fails.
+
+Description:
+The attributes of every element are written together with the properties set by the rules, which take precedence
+and should use sigma.js attribute names such as color, size or label. Nodes also get their x and y coordinates, and
+bent edges of style.EdgePaths their polyline in a points attribute, for a custom edge program to draw.
+Numeric values are written as JSON numbers. The document can be loaded with graph.import(document).
+*/
+func WriteSigmaJSON(w io.Writer, g *model.UndirectedGraph, positions map[model.Node]model.Point, style *DOTStyle) error {
+	if style == nil {
+		style = &DOTStyle{}
+	}
+	if positions == nil {
+		positions = model.CircularLayout(g, 100)
+	}
+
+	document := sigmaDocument{
+		Attributes: map[string]any{},
+		Options:    sigmaOptions{Type: "undirected", AllowLoops: true},
+		Nodes:      []sigmaNode{},
+		Edges:      []sigmaEdge{},
+	}
+	if style.GraphName != "" {
+		document.Attributes["name"] = style.GraphName
+	}
+	for _, node := range sortedGraphNodes(g) {
+		attributes := jsonAttributes(style.NodeAttributes[node])
+		position := positions[node]
+		attributes["x"], attributes["y"] = position.X, position.Y
+		for property, value := range jsonStyle(style.NodeRules, style.NodeAttributes[node]) {
+			attributes[property] = value
+		}
+		document.Nodes = append(document.Nodes, sigmaNode{Key: strconv.Itoa(int(node)), Attributes: attributes})
+	}
+	for _, edge := range dotEdges(g) {
+		attributes := jsonAttributes(style.edgeAttributes(edge))
+		for property, value := range jsonStyle(style.EdgeRules, style.edgeAttributes(edge)) {
+			attributes[property] = value
+		}
+		if path := style.edgePath(edge); len(path) > 2 {
+			attributes["points"] = jsonPoints(path)
+		}
+		document.Edges = append(document.Edges, sigmaEdge{
+			Key:        fmt.Sprintf("%d-%d", edge.Node1, edge.Node2),
+			Source:     strconv.Itoa(int(edge.Node1)),
+			Target:     strconv.Itoa(int(edge.Node2)),
+			Undirected: true,
+			Attributes: attributes,
+		})
+	}
+	return json.NewEncoder(w).Encode(document)
+}
+
+// jsonAttributes copies attributes into a JSON object, writing numeric values as numbers.
+func jsonAttributes(attributes map[string]string) map[string]any {
+	object := make(map[string]any, len(attributes))
+	for name, value := range attributes {
+		object[name] = jsonValue(value)
+	}
+	return object
+}
+
+// jsonStyle evaluates the rules like applyRules, writing numeric values as numbers, and returns nil if no rule applies.
+func jsonStyle(rules []StyleRule, attributes map[string]string) map[string]any {
+	properties := applyRules(rules, attributes)
+	if len(properties) == 0 {
+		return nil
+	}
+	return jsonAttributes(properties)
+}
+
+// jsonValue returns value as a number if it is a finite number, as a string otherwise.
+func jsonValue(value string) any {
+	if number, err := strconv.ParseFloat(value, 64); err == nil && !math.IsInf(number, 0) && !math.IsNaN(number) {
+		return number
+	}
+	return value
+}
+
+// jsonPoints writes a polyline as a list of [x, y] pairs.
+func jsonPoints(path []model.Point) [][2]float64 {
+	points := make([][2]float64, len(path))
+	for i, point := range path {
+		points[i] = [2]float64{point.X, point.Y}
+	}
+	return points
+}
+
+// segmentOffsets returns the inner points of a polyline as Cytoscape.js segment weights and distances: the fraction
+// of the way from the source to the target of their projection on the line joining them, and their signed distance
+// to that line, positive on the side of the normal (-dy, dx).
+func segmentOffsets(path []model.Point) ([]float64, []float64) {
+	source, target := path[0], path[len(path)-1]
+	dx, dy := target.X-source.X, target.Y-source.Y
+	length := math.Hypot(dx, dy)
+	weights, distances := make([]float64, 0, len(path)-2), make([]float64, 0, len(path)-2)
+	for _, point := range path[1 : len(path)-1] {
+		if length == 0 {
+			weights, distances = append(weights, 0.5), append(distances, 0)
+			continue
+		}
+		px, py := point.X-source.X, point.Y-source.Y
+		weights = append(weights, (px*dx+py*dy)/(length*length))
+		distances = append(distances, (px*-dy+py*dx)/length)
+	}
+	return weights, distances
+}
@@ -0,0 +1,89 @@
+package io
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestWriteCytoscapeJSON(t *testing.T) {
+	g := model.PathGraph(2)
+	positions := map[model.Node]model.Point{0: {X: 1, Y: 2}, 1: {X: 3, Y: 4}}
+	style := &DOTStyle{NodeRules: []StyleRule{PaletteRule("community", "background-color", []string{"red", "blue"})}}
+	style.SetNodeCommunities("community", map[model.Node]int{0: 0, 1: 1})
+	style.SetEdgeWeights("weight", g, func(u, v model.Node) float64 { return 2.5 })
+
+	var out strings.Builder
+	if err := WriteCytoscapeJSON(&out, g, positions, style); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `{"elements":{"nodes":[` +
+		`{"data":{"community":0,"id":"0"},"position":{"x":1,"y":2},"style":{"background-color":"red"}},` +
+		`{"data":{"community":1,"id":"1"},"position":{"x":3,"y":4},"style":{"background-color":"blue"}}],` +
+		`"edges":[{"data":{"id":"0-1","source":"0","target":"1","weight":2.5}}]}}` + "\n"
+	if out.String() != expected {
+		t.Errorf("Expected %s, but got %s", expected, out.String())
+	}
+}
+
+func TestWriteSigmaJSON(t *testing.T) {
+	g := model.CycleGraph(3)
+	style := &DOTStyle{GraphName: "triangle", EdgeRules: []StyleRule{LinearScaleRule("weight", "size", 0, 1, 1, 5)}}
+	style.SetEdgeWeights("weight", g, model.UnitWeight)
+
+	var out strings.Builder
+	if err := WriteSigmaJSON(&out, g, nil, style); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var document struct {
+		Attributes map[string]any
+		Options    map[string]any
+		Nodes      []struct {
+			Key        string
+			Attributes map[string]float64
+		}
+		Edges []struct {
+			Key, Source, Target string
+			Undirected          bool
+			Attributes          map[string]float64
+		}
+	}
+	if err := json.Unmarshal([]byte(out.String()), &document); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if document.Attributes["name"] != "triangle" || document.Options["type"] != "undirected" {
+		t.Errorf("Expected an undirected graph named triangle, but got %v and %v", document.Attributes, document.Options)
+	}
+	if len(document.Nodes) != 3 || document.Nodes[0].Key != "0" || document.Nodes[0].Attributes["x"] != 100 || document.Nodes[0].Attributes["y"] != 0 {
+		t.Errorf("Expected 3 nodes on a circle of radius 100, but got %v", document.Nodes)
+	}
+	if len(document.Edges) != 3 || document.Edges[2].Key != "1-2" || !document.Edges[2].Undirected || document.Edges[2].Attributes["size"] != 5 || document.Edges[2].Attributes["weight"] != 1 {
+		t.Errorf("Expected 3 styled edges, but got %v", document.Edges)
+	}
+}
+
+func TestWriteJSONEdgePaths(t *testing.T) {
+	g := model.PathGraph(2)
+	positions := map[model.Node]model.Point{0: {X: 0, Y: 0}, 1: {X: 10, Y: 0}}
+	style := &DOTStyle{EdgePaths: map[model.Edge][]model.Point{{Node1: 0, Node2: 1}: {{X: 0, Y: 0}, {X: 5, Y: 2}, {X: 10, Y: 0}}}}
+
+	var out strings.Builder
+	if err := WriteCytoscapeJSON(&out, g, positions, style); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := `"edges":[{"data":{"id":"0-1","points":[[0,0],[5,2],[10,0]],"source":"0","target":"1"},` +
+		`"style":{"curve-style":"segments","edge-distances":"node-position","segment-distances":[2],"segment-weights":[0.5]}}]`
+	if !strings.Contains(out.String(), expected) {
+		t.Errorf("Expected %s in %s", expected, out.String())
+	}
+
+	out.Reset()
+	if err := WriteSigmaJSON(&out, g, positions, style); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), `"points":[[0,0],[5,2],[10,0]]`) {
+		t.Errorf("Expected the edge path in %s", out.String())
+	}
+}
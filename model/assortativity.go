@@ -0,0 +1,172 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+/*
+DegreeAssortativity returns the degree assortativity coefficient of Newman: the Pearson correlation between the degrees
+at both ends of the edges.
+
+Parameters:
+- g: The graph.
+
+Returns:
+- r: The coefficient, between -1 (high-degree nodes link to low-degree ones) and 1 (nodes link to nodes of similar
+degree), or NaN if the graph has no edges or all edge endpoints have the same degree.
+*/
+func DegreeAssortativity(g *UndirectedGraph) float64 {
+	stats := newAssortativityStats(g)
+	product := 0.0
+	for _, edge := range sortedEdgeList(g) {
+		product += float64(g.NodeDegree(edge.Node1) * g.NodeDegree(edge.Node2))
+	}
+	return stats.coefficient(product)
+}
+
+// assortativityStats holds the terms of the assortativity coefficient that only depend on the degree sequence.
+type assortativityStats struct {
+	edges, mean, variance float64
+}
+
+func newAssortativityStats(g *UndirectedGraph) assortativityStats {
+	var stats assortativityStats
+	sum, squares := 0.0, 0.0
+	for _, edge := range sortedEdgeList(g) {
+		j, k := float64(g.NodeDegree(edge.Node1)), float64(g.NodeDegree(edge.Node2))
+		stats.edges++
+		sum += (j + k) / 2
+		squares += (j*j + k*k) / 2
+	}
+	stats.mean = sum / stats.edges
+	stats.variance = squares/stats.edges - stats.mean*stats.mean
+	return stats
+}
+
+// coefficient returns the assortativity for the given sum over the edges of the product of their end degrees.
+func (s assortativityStats) coefficient(product float64) float64 {
+	if s.edges == 0 || s.variance <= 1e-12 {
+		return math.NaN()
+	}
+	return (product/s.edges - s.mean*s.mean) / s.variance
+}
+
+/*
+RewireToAssortativity rewires a copy of a graph toward a target degree assortativity, preserving every node degree.
+
+Parameters:
+- g: The graph. It is not modified.
+- target: The desired assortativity coefficient, in [-1, 1].
+- maxAttempts: The number of swaps attempted.
+- seed: The seed of the random number generator.
+
+Returns:
+- rewired: The rewired graph.
+- r: The assortativity of the rewired graph.
+- err: An error if target is outside [-1, 1] or the assortativity of g is undefined.
+
+Description:
+Following Xulvi-Brunet and Sokolov, every attempt draws two edges and orders their four endpoints by degree. Pairing
+the two largest and the two smallest endpoints raises the assortativity and pairing the largest with the smallest
+lowers it; the pairing moving toward the target is applied if it creates neither a self-loop nor an existing edge
+and brings the coefficient closer to the target. The degree sequence, and therefore the attainable range of the
+coefficient, is fixed, so the target may not be reached.
+*/
+func RewireToAssortativity(g *UndirectedGraph, target float64, maxAttempts int, seed int64) (*UndirectedGraph, float64, error) {
+	if target < -1 || target > 1 {
+		return nil, 0, fmt.Errorf("target assortativity %v is outside [-1, 1]", target)
+	}
+	stats := newAssortativityStats(g)
+	rewired := copyGraph(g)
+	edges := sortedEdgeList(rewired)
+	degree := func(node Node) float64 { return float64(g.NodeDegree(node)) }
+	product := 0.0
+	for _, edge := range edges {
+		product += degree(edge.Node1) * degree(edge.Node2)
+	}
+	r := stats.coefficient(product)
+	if math.IsNaN(r) {
+		return nil, 0, fmt.Errorf("the assortativity of the graph is undefined")
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	for attempt := 0; attempt < maxAttempts && len(edges) >= 2; attempt++ {
+		i, j := rng.Intn(len(edges)), rng.Intn(len(edges))
+		a, b, c, d := edges[i].Node1, edges[i].Node2, edges[j].Node1, edges[j].Node2
+		if i == j || a == b || c == d || a == c || a == d || b == c || b == d {
+			continue
+		}
+		// Order the endpoints by decreasing degree, ties broken at random
+		ends := []Node{a, b, c, d}
+		rng.Shuffle(len(ends), func(x, y int) { ends[x], ends[y] = ends[y], ends[x] })
+		for x := 1; x < len(ends); x++ {
+			for y := x; y > 0 && degree(ends[y]) > degree(ends[y-1]); y-- {
+				ends[y], ends[y-1] = ends[y-1], ends[y]
+			}
+		}
+		first, second := Edge{Node1: ends[0], Node2: ends[1]}, Edge{Node1: ends[2], Node2: ends[3]}
+		if r > target {
+			first, second = Edge{Node1: ends[0], Node2: ends[3]}, Edge{Node1: ends[1], Node2: ends[2]}
+		}
+		if rewired.HasEdge(first.Node1, first.Node2) || rewired.HasEdge(second.Node1, second.Node2) {
+			continue
+		}
+
+		newProduct := product - degree(a)*degree(b) - degree(c)*degree(d) +
+			degree(first.Node1)*degree(first.Node2) + degree(second.Node1)*degree(second.Node2)
+		newR := stats.coefficient(newProduct)
+		if math.Abs(newR-target) >= math.Abs(r-target) {
+			continue
+		}
+		rewired.RemoveEdge(edges[i])
+		rewired.RemoveEdge(edges[j])
+		rewired.AddEdge(first)
+		rewired.AddEdge(second)
+		edges[i], edges[j] = first, second
+		product, r = newProduct, newR
+	}
+	return rewired, r, nil
+}
+
+/*
+AttributeAssortativity returns the assortativity coefficient of Newman for a categorical node attribute: how much more
+often than by chance the edges join nodes of the same category.
+
+Parameters:
+- g: The graph.
+- labels: The category of every node; nodes without a category are ignored, with their edges.
+
+Returns:
+- r: The coefficient, 1 if every edge joins nodes of the same category, 0 for random mixing and negative if edges
+rather join different categories, or NaN if no edge is counted or all of them join nodes of a single category.
+
+Reference: M. E. J. Newman, "Mixing patterns in networks", Physical Review E 67, 2003.
+*/
+func AttributeAssortativity(g *UndirectedGraph, labels map[Node]int) float64 {
+	// The fraction of edge ends in every category, and of edges inside every category, both orientations counted
+	ends, inside := make(map[int]float64), 0.0
+	total := 0.0
+	for _, edge := range sortedEdgeList(g) {
+		a, aOK := labels[edge.Node1]
+		b, bOK := labels[edge.Node2]
+		if !aOK || !bOK {
+			continue
+		}
+		total += 2
+		ends[a]++
+		ends[b]++
+		if a == b {
+			inside += 2
+		}
+	}
+	expected := 0.0
+	for _, count := range ends {
+		expected += (count / total) * (count / total)
+	}
+	if total == 0 || expected >= 1-1e-12 {
+		return math.NaN()
+	}
+	return (inside/total - expected) / (1 - expected)
+}
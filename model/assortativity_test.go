@@ -0,0 +1,91 @@
+package model
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestDegreeAssortativity(t *testing.T) {
+	// Test case 1: A star is perfectly disassortative
+	if r := DegreeAssortativity(StarGraph(6)); math.Abs(r+1) > 1e-9 {
+		t.Errorf("Test case 1 failed: Expected -1, but got %v", r)
+	}
+
+	// Test case 2: Two disjoint stars of different sizes linked at their centers
+	g := StarGraph(4)
+	g.AddEdgesFromIntTupleList([][2]int{{0, 4}, {4, 5}, {4, 6}, {4, 7}})
+	if r := DegreeAssortativity(g); r >= 0 {
+		t.Errorf("Test case 2 failed: Expected a negative coefficient, but got %v", r)
+	}
+
+	// Test case 3: A regular graph has an undefined coefficient
+	if r := DegreeAssortativity(CycleGraph(5)); !math.IsNaN(r) {
+		t.Errorf("Test case 3 failed: Expected NaN, but got %v", r)
+	}
+}
+
+func TestRewireToAssortativity(t *testing.T) {
+	// Stars of increasing sizes, shuffled into a graph with a heterogeneous degree sequence
+	stars := &UndirectedGraph{}
+	next := 0
+	for size := 2; size <= 20; size++ {
+		center := next
+		for leaf := 1; leaf < size; leaf++ {
+			stars.AddEdge(Edge{Node1: Node(center), Node2: Node(center + leaf)})
+		}
+		if center > 0 {
+			stars.AddEdge(Edge{Node1: Node(center), Node2: Node(center - 1)})
+		}
+		next += size
+	}
+	g := RewiringNullModel(10)(stars, rand.New(rand.NewSource(1)))
+	initial := DegreeAssortativity(g)
+
+	for _, target := range []float64{0.3, -0.3} {
+		rewired, r, err := RewireToAssortativity(g, target, 20000, 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if math.Abs(r-DegreeAssortativity(rewired)) > 1e-9 {
+			t.Errorf("Expected the returned coefficient %v to match the graph's %v", r, DegreeAssortativity(rewired))
+		}
+		if math.Abs(r-target) >= math.Abs(initial-target) || math.Abs(r-target) > 0.05 {
+			t.Errorf("Expected a coefficient close to %v, but got %v (initially %v)", target, r, initial)
+		}
+		for node := range g.Nodes {
+			if rewired.NodeDegree(node) != g.NodeDegree(node) {
+				t.Errorf("Expected node %d to keep degree %d, but got %d", node, g.NodeDegree(node), rewired.NodeDegree(node))
+			}
+		}
+	}
+	if DegreeAssortativity(g) != initial {
+		t.Errorf("Expected the original graph to be unchanged")
+	}
+
+	if _, _, err := RewireToAssortativity(g, 2, 10, 1); err == nil {
+		t.Errorf("Expected an error for a target outside [-1, 1]")
+	}
+	if _, _, err := RewireToAssortativity(CycleGraph(5), 0.5, 10, 1); err == nil {
+		t.Errorf("Expected an error for a regular graph")
+	}
+}
+
+func TestAttributeAssortativity(t *testing.T) {
+	// Two triangles joined by an edge, one per category
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 2}, {3, 4}, {3, 5}, {4, 5}, {2, 3}})
+	labels := map[Node]int{0: 0, 1: 0, 2: 0, 3: 1, 4: 1, 5: 1}
+	// e_ii = 3/7 each and a_i = 1/2, so r = (6/7 - 1/2) / (1/2) = 5/7
+	if r := AttributeAssortativity(g, labels); math.Abs(r-5.0/7) > 1e-12 {
+		t.Errorf("Expected 5/7, but got %v", r)
+	}
+
+	bipartite, _ := CompleteMultipartiteGraph(3, 3)
+	if r := AttributeAssortativity(bipartite, labels); math.Abs(r+1) > 1e-12 {
+		t.Errorf("Expected -1, but got %v", r)
+	}
+	if r := AttributeAssortativity(g, map[Node]int{0: 0, 1: 0, 2: 0}); !math.IsNaN(r) {
+		t.Errorf("Expected NaN for a single category, but got %v", r)
+	}
+}
@@ -0,0 +1,250 @@
+package model
+
+import (
+	"math/big"
+	"slices"
+	"sort"
+)
+
+// AutomorphismGroup describes the symmetries of a graph.
+type AutomorphismGroup struct {
+	// Generators are permutations of the nodes that generate the group, none of them the identity.
+	Generators []map[Node]Node
+	// Orbits partitions the nodes into the sets of nodes that can be mapped onto each other, each sorted, ordered by their smallest node.
+	Orbits [][]Node
+	// Size is the number of automorphisms.
+	Size *big.Int
+}
+
+/*
+Automorphisms computes generators of the automorphism group of a graph, its orbits and its size.
+
+Parameters:
+- g: The graph.
+
+Returns:
+- group: The generators, orbits and size of the automorphism group.
+
+Description:
+The search follows the individualization-refinement scheme of nauty. The nodes are first colored by an equitable
+partition obtained by color refinement. Along a first path of the search tree, the first node of the first
+non-singleton cell is individualized and the partition refined again until it is discrete. For every level of that
+path, from the deepest one up, the nodes of the individualized cell that are not yet known to be in the orbit of the
+individualized node are tried, backtracking in the subtree until a leaf defines an automorphism. The generators found
+form a strong generating set for the chain of pointwise stabilizers of the first path, so the size of the group is
+the product of the orbit sizes at every level. The running time can be exponential and is meant for graphs of modest size.
+*/
+func Automorphisms(g *UndirectedGraph) *AutomorphismGroup {
+	nodes := sortedNodes(g.Nodes)
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	adjacency := make([][]int, len(nodes))
+	for i, node := range nodes {
+		for _, neighbor := range g.Edges[node] {
+			adjacency[i] = append(adjacency[i], index[neighbor])
+		}
+	}
+
+	// The first path of the search tree: partitions[i] is refined after individualizing path[0..i-1]
+	partitions := [][]int{refineColors(adjacency, make([]int, len(nodes)), -1)}
+	var path []int
+	for {
+		current := partitions[len(partitions)-1]
+		cell := targetCell(current)
+		if cell < 0 {
+			break
+		}
+		vertex := slices.Index(current, cell)
+		path = append(path, vertex)
+		partitions = append(partitions, refineColors(adjacency, current, vertex))
+	}
+	leaf := partitions[len(partitions)-1]
+
+	parent := make([]int, len(nodes))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(x int) int
+	find = func(x int) int {
+		if parent[x] != x {
+			parent[x] = find(parent[x])
+		}
+		return parent[x]
+	}
+
+	group := &AutomorphismGroup{Size: big.NewInt(1)}
+	for level := len(path) - 1; level >= 0; level-- {
+		partition, vertex := partitions[level], path[level]
+		for candidate, color := range partition {
+			if color != partition[vertex] || find(candidate) == find(vertex) {
+				continue
+			}
+			permutation := findAutomorphism(adjacency, partitions, leaf, level, candidate)
+			if permutation == nil {
+				continue
+			}
+			generator := make(map[Node]Node, len(nodes))
+			for from, to := range permutation {
+				generator[nodes[from]] = nodes[to]
+				parent[find(from)] = find(to)
+			}
+			group.Generators = append(group.Generators, generator)
+		}
+
+		orbitSize := 0
+		for candidate, color := range partition {
+			if color == partition[vertex] && find(candidate) == find(vertex) {
+				orbitSize++
+			}
+		}
+		group.Size.Mul(group.Size, big.NewInt(int64(orbitSize)))
+	}
+
+	orbits := make(map[int][]Node)
+	for i, node := range nodes {
+		orbits[find(i)] = append(orbits[find(i)], node)
+	}
+	for _, orbit := range orbits {
+		group.Orbits = append(group.Orbits, orbit)
+	}
+	sort.Slice(group.Orbits, func(i, j int) bool { return group.Orbits[i][0] < group.Orbits[j][0] })
+	return group
+}
+
+// findAutomorphism looks for an automorphism that fixes the vertices individualized above level on the first path and
+// maps the vertex individualized at level to candidate. It returns the permutation of the vertices, or nil.
+func findAutomorphism(adjacency [][]int, partitions [][]int, leaf []int, level int, candidate int) []int {
+	var search func(depth int, current []int, vertex int) []int
+	search = func(depth int, current []int, vertex int) []int {
+		refined := refineColors(adjacency, current, vertex)
+		if !sameColorCounts(refined, partitions[depth+1]) {
+			return nil
+		}
+		cell := targetCell(refined)
+		if cell < 0 {
+			return automorphismFromLeaves(adjacency, leaf, refined)
+		}
+		for next, color := range refined {
+			if color != cell {
+				continue
+			}
+			if permutation := search(depth+1, refined, next); permutation != nil {
+				return permutation
+			}
+		}
+		return nil
+	}
+	return search(level, partitions[level], candidate)
+}
+
+// automorphismFromLeaves maps every vertex of the first leaf to the vertex with the same color in another leaf, and
+// returns the mapping if it preserves adjacency.
+func automorphismFromLeaves(adjacency [][]int, leaf, other []int) []int {
+	byColor := make([]int, len(other))
+	for vertex, color := range other {
+		byColor[color] = vertex
+	}
+	permutation := make([]int, len(leaf))
+	for vertex, color := range leaf {
+		permutation[vertex] = byColor[color]
+	}
+
+	neighbors := make([]map[int]bool, len(adjacency))
+	for vertex, list := range adjacency {
+		neighbors[vertex] = make(map[int]bool, len(list))
+		for _, neighbor := range list {
+			neighbors[vertex][neighbor] = true
+		}
+	}
+	for vertex, list := range adjacency {
+		if len(list) != len(adjacency[permutation[vertex]]) {
+			return nil
+		}
+		for _, neighbor := range list {
+			if !neighbors[permutation[vertex]][permutation[neighbor]] {
+				return nil
+			}
+		}
+	}
+	return permutation
+}
+
+/*
+refineColors individualizes a vertex (if not negative) and refines the coloring until it is equitable.
+
+New colors are numbered by sorting the signatures of the vertices (their color followed by the sorted colors of their
+neighbors), so that isomorphic inputs get the same colors in corresponding places.
+*/
+func refineColors(adjacency [][]int, colors []int, individualized int) []int {
+	signatures := make([][]int, len(colors))
+	for vertex, color := range colors {
+		flag := 0
+		if vertex == individualized {
+			flag = 1
+		}
+		signatures[vertex] = []int{color, flag}
+	}
+	refined, count := recolor(signatures)
+
+	for {
+		for vertex := range signatures {
+			signature := []int{refined[vertex]}
+			for _, neighbor := range adjacency[vertex] {
+				signature = append(signature, refined[neighbor])
+			}
+			slices.Sort(signature[1:])
+			signatures[vertex] = signature
+		}
+		next, nextCount := recolor(signatures)
+		if nextCount == count {
+			return refined
+		}
+		refined, count = next, nextCount
+	}
+}
+
+// recolor numbers the distinct signatures in lexicographic order and returns the color of every vertex and the number of colors.
+func recolor(signatures [][]int) ([]int, int) {
+	distinct := make([][]int, 0, len(signatures))
+	for _, signature := range signatures {
+		distinct = append(distinct, signature)
+	}
+	slices.SortFunc(distinct, slices.Compare[[]int])
+	distinct = slices.CompactFunc(distinct, slices.Equal[[]int])
+
+	colors := make([]int, len(signatures))
+	for vertex, signature := range signatures {
+		colors[vertex], _ = slices.BinarySearchFunc(distinct, signature, slices.Compare[[]int])
+	}
+	return colors, len(distinct)
+}
+
+// targetCell returns the smallest color shared by several vertices, or -1 if the coloring is discrete.
+func targetCell(colors []int) int {
+	counts := make([]int, len(colors))
+	for _, color := range colors {
+		counts[color]++
+	}
+	for color, count := range counts {
+		if count > 1 {
+			return color
+		}
+	}
+	return -1
+}
+
+func sameColorCounts(a, b []int) bool {
+	counts := make([]int, len(a))
+	for i := range a {
+		counts[a[i]]++
+		counts[b[i]]--
+	}
+	for _, count := range counts {
+		if count != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func petersenGraph() *UndirectedGraph {
+	g := &UndirectedGraph{}
+	for i := 0; i < 5; i++ {
+		g.AddEdgesFromIntTupleList([][2]int{{i, (i + 1) % 5}, {i, i + 5}, {i + 5, (i+2)%5 + 5}})
+	}
+	return g
+}
+
+func TestAutomorphisms(t *testing.T) {
+	twoTriangles := CycleGraph(3)
+	twoTriangles.AddEdgesFromIntTupleList([][2]int{{3, 4}, {4, 5}, {5, 3}})
+
+	// The smallest asymmetric tree has 7 nodes
+	asymmetric := &UndirectedGraph{}
+	asymmetric.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {2, 3}, {0, 4}, {4, 5}, {5, 6}})
+
+	hypercube := &UndirectedGraph{}
+	for u := 0; u < 16; u++ {
+		for bit := 1; bit < 16; bit <<= 1 {
+			hypercube.AddEdge(Edge{Node1: Node(u), Node2: Node(u ^ bit)})
+		}
+	}
+
+	tests := []struct {
+		name   string
+		g      *UndirectedGraph
+		size   int64
+		orbits [][]Node
+	}{
+		{"Path", PathGraph(4), 2, [][]Node{{0, 3}, {1, 2}}},
+		{"Cycle", CycleGraph(5), 10, [][]Node{{0, 1, 2, 3, 4}}},
+		{"Complete", CompleteGraph(4), 24, [][]Node{{0, 1, 2, 3}}},
+		{"Star", StarGraph(5), 24, [][]Node{{0}, {1, 2, 3, 4}}},
+		{"Two triangles", twoTriangles, 72, [][]Node{{0, 1, 2, 3, 4, 5}}},
+		{"Petersen", petersenGraph(), 120, [][]Node{{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}}},
+		{"Hypercube", hypercube, 384, [][]Node{{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}}},
+		{"Asymmetric", asymmetric, 1, [][]Node{{0}, {1}, {2}, {3}, {4}, {5}, {6}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			group := Automorphisms(test.g)
+			if group.Size.Int64() != test.size {
+				t.Errorf("Expected %d automorphisms, but got %v", test.size, group.Size)
+			}
+			if !reflect.DeepEqual(group.Orbits, test.orbits) {
+				t.Errorf("Expected orbits %v, but got %v", test.orbits, group.Orbits)
+			}
+			for _, generator := range group.Generators {
+				for _, edge := range test.g.GetEdgeTuples() {
+					if !test.g.HasEdge(generator[edge.Node1], generator[edge.Node2]) {
+						t.Errorf("Generator %v does not preserve edge %v", generator, edge)
+					}
+				}
+			}
+		})
+	}
+}
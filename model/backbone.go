@@ -0,0 +1,144 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+EdgeDisparityScores computes the disparity filter p-value of every edge of a weighted UndirectedGraph.
+
+Parameters:
+- g: The graph to score.
+- weight: A WeightFunc giving the (positive) strength of every edge.
+
+Returns:
+- scores: A map from every edge, keyed with Node1 <= Node2, to the smallest of the p-values computed at its two endpoints. Small values mark edges carrying a significantly large share of a node's strength.
+
+Description:
+For an endpoint i of degree k > 1 and strength s, the p-value of an edge of weight w is (1 - w/s)^(k-1), the probability of observing such a share under a uniform random split of the strength. Endpoints of degree 1 cannot discriminate between their edges and contribute a p-value of 1.
+
+Reference: M. A. Serrano, M. Boguñá and A. Vespignani, "Extracting the multiscale backbone of complex weighted networks", PNAS 106, 2009.
+*/
+func EdgeDisparityScores(g *UndirectedGraph, weight WeightFunc) map[Edge]float64 {
+	strengths := make(map[Node]float64, len(g.Nodes))
+	for node, neighbors := range g.Edges {
+		for _, neighbor := range neighbors {
+			strengths[node] += weight(node, neighbor)
+		}
+	}
+
+	pValue := func(node Node, w float64) float64 {
+		degree := len(g.Edges[node])
+		if degree <= 1 || strengths[node] == 0 {
+			return 1
+		}
+		return math.Pow(1-w/strengths[node], float64(degree-1))
+	}
+
+	scores := make(map[Edge]float64)
+	for _, edge := range g.GetEdgeTuples() {
+		if edge.Node1 > edge.Node2 {
+			continue
+		}
+		w := weight(edge.Node1, edge.Node2)
+		scores[edge] = math.Min(pValue(edge.Node1, w), pValue(edge.Node2, w))
+	}
+	return scores
+}
+
+/*
+DisparityFilter extracts the backbone of a weighted UndirectedGraph with the disparity filter.
+
+Parameters:
+- g: The graph to filter.
+- weight: A WeightFunc giving the (positive) strength of every edge.
+- alpha: The significance level; edges with a disparity p-value below alpha are kept.
+
+Returns:
+- backbone: A new UndirectedGraph with all the nodes of g and only its significant edges.
+*/
+func DisparityFilter(g *UndirectedGraph, weight WeightFunc, alpha float64) *UndirectedGraph {
+	backbone := &UndirectedGraph{}
+	backbone.AddNodes(GetDictKeys(g.Nodes))
+	for edge, score := range EdgeDisparityScores(g, weight) {
+		if score < alpha {
+			backbone.AddEdge(edge)
+		}
+	}
+	return backbone
+}
+
+/*
+EdgeSalience computes the salience of every edge of a weighted UndirectedGraph.
+
+Parameters:
+- g: The graph to score.
+- weight: A WeightFunc giving the (positive) strength of every edge. Strong edges are short: the length used for shortest paths is 1/weight.
+
+Returns:
+- salience: A map from every edge, keyed with Node1 <= Node2, to the fraction of shortest-path trees (one rooted at every node) that contain it.
+- err: An error if a shortest-path tree cannot be computed.
+
+Reference: D. Grady, C. Thiemann and D. Brockmann, "Robust classification of salient links in complex networks", Nature Communications 3, 2012.
+*/
+func EdgeSalience(g *UndirectedGraph, weight WeightFunc) (map[Edge]float64, error) {
+	length := func(u, v Node) float64 {
+		return 1 / weight(u, v)
+	}
+
+	salience := make(map[Edge]float64)
+	for _, edge := range g.GetEdgeTuples() {
+		if edge.Node1 < edge.Node2 {
+			salience[edge] = 0
+		}
+	}
+	if len(g.Nodes) == 0 {
+		return salience, nil
+	}
+
+	for root := range g.Nodes {
+		tree, err := DijkstraShortestPathTree(g, root, length)
+		if err != nil {
+			return nil, fmt.Errorf("error computing shortest-path tree from %d: %w", root, err)
+		}
+		for parent, children := range tree.Successors {
+			for _, child := range children {
+				salience[orderedEdge(parent, child)]++
+			}
+		}
+	}
+
+	for edge := range salience {
+		salience[edge] /= float64(len(g.Nodes))
+	}
+	return salience, nil
+}
+
+/*
+HighSalienceSkeleton extracts the high-salience skeleton of a weighted UndirectedGraph.
+
+Parameters:
+- g: The graph to filter.
+- weight: A WeightFunc giving the (positive) strength of every edge.
+- threshold: The minimum salience, in [0, 1], of the edges to keep. Salience is typically bimodal, so any value around 0.5 gives a stable skeleton.
+
+Returns:
+- skeleton: A new UndirectedGraph with all the nodes of g and the edges whose salience is at least threshold.
+- err: An error if the salience cannot be computed.
+*/
+func HighSalienceSkeleton(g *UndirectedGraph, weight WeightFunc, threshold float64) (*UndirectedGraph, error) {
+	salience, err := EdgeSalience(g, weight)
+	if err != nil {
+		return nil, err
+	}
+
+	skeleton := &UndirectedGraph{}
+	skeleton.AddNodes(GetDictKeys(g.Nodes))
+	for edge, value := range salience {
+		if value >= threshold {
+			skeleton.AddEdge(edge)
+		}
+	}
+	return skeleton, nil
+}
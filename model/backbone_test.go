@@ -0,0 +1,74 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+// weightsFromMap builds a WeightFunc over undirected edges keyed with Node1 <= Node2.
+func weightsFromMap(weights map[Edge]float64) WeightFunc {
+	return func(u, v Node) float64 {
+		return weights[orderedEdge(u, v)]
+	}
+}
+
+func TestDisparityFilter(t *testing.T) {
+	weights := map[Edge]float64{
+		{Node1: 0, Node2: 1}: 10,
+		{Node1: 0, Node2: 2}: 1,
+		{Node1: 0, Node2: 3}: 1,
+		{Node1: 0, Node2: 4}: 1,
+		{Node1: 1, Node2: 2}: 1,
+	}
+	g := &UndirectedGraph{}
+	for edge := range weights {
+		g.AddEdge(edge)
+	}
+
+	scores := EdgeDisparityScores(g, weightsFromMap(weights))
+	if len(scores) != len(weights) {
+		t.Errorf("Expected %d scores, but got %d", len(weights), len(scores))
+	}
+	if scores[Edge{Node1: 0, Node2: 1}] >= scores[Edge{Node1: 0, Node2: 2}] {
+		t.Errorf("Expected the heavy edge to be more significant, got scores %v", scores)
+	}
+
+	backbone := DisparityFilter(g, weightsFromMap(weights), 0.05)
+	if len(backbone.Nodes) != 5 {
+		t.Errorf("Expected the backbone to keep all 5 nodes, but got %d", len(backbone.Nodes))
+	}
+	expectedEdges := map[Node][]Node{0: {1}, 1: {0}}
+	if !reflect.DeepEqual(backbone.Edges, expectedEdges) {
+		t.Errorf("Expected %v, but got %v", expectedEdges, backbone.Edges)
+	}
+}
+
+func TestHighSalienceSkeleton(t *testing.T) {
+	weights := map[Edge]float64{
+		{Node1: 0, Node2: 1}: 10,
+		{Node1: 1, Node2: 2}: 10,
+		{Node1: 0, Node2: 2}: 1,
+	}
+	g := CompleteGraph(3)
+
+	salience, err := EdgeSalience(g, weightsFromMap(weights))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectedSalience := map[Edge]float64{
+		{Node1: 0, Node2: 1}: 1,
+		{Node1: 1, Node2: 2}: 1,
+		{Node1: 0, Node2: 2}: 0,
+	}
+	if !reflect.DeepEqual(salience, expectedSalience) {
+		t.Errorf("Expected %v, but got %v", expectedSalience, salience)
+	}
+
+	skeleton, err := HighSalienceSkeleton(g, weightsFromMap(weights), 0.5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if skeleton.NumberOfEdges() != 2 || skeleton.HasEdge(0, 2) {
+		t.Errorf("Expected the skeleton to drop the weak edge, but got %v", skeleton.Edges)
+	}
+}
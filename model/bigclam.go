@@ -0,0 +1,283 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+/*
+BigCLAM finds overlapping communities by fitting the cluster affiliation model of Yang and Leskovec.
+
+Parameters:
+- g: The graph.
+- k: The number of communities to fit, at least 1. Communities that end up empty are dropped.
+- iterations: The largest number of passes over the nodes, at least 1; the fit stops earlier once the likelihood
+improves by less than 0.01%.
+- seed: The seed of the random number generator.
+
+Returns:
+- communities: The communities, each sorted, in lexicographic order.
+- memberships: The indices in communities of the communities of every node, in increasing order. Nodes may belong to
+several communities or to none.
+- err: An error if k or iterations is smaller than 1.
+
+Description:
+Every node u gets a non-negative affiliation F_uc with every community c, and two nodes are linked with probability
+1 - exp(-F_u·F_v). The affiliations start from the neighborhoods of the k nodes whose neighborhoods have the lowest
+conductance among their neighbors', plus small random noise, and are fitted by projected gradient ascent on the
+log-likelihood, one node at a time with a backtracking line search. Seeds missing to reach k are drawn at random, so
+the fit is repeated a few times and the most likely one is kept. Since the sum of all the affiliations is kept, a
+pass takes O(k (n + m)) time. Node u belongs to community c if F_uc >= sqrt(-log(1 - ε)), with ε = 2m / (n(n-1))
+the edge density of the graph.
+
+Reference: J. Yang and J. Leskovec, "Overlapping community detection at scale: a nonnegative matrix factorization
+approach", WSDM 2013.
+*/
+func BigCLAM(g *UndirectedGraph, k, iterations int, seed int64) ([][]Node, map[Node][]int, error) {
+	if k < 1 || iterations < 1 {
+		return nil, nil, fmt.Errorf("the number of communities and of iterations must be positive, got %d and %d", k, iterations)
+	}
+	nodes := sortedNodes(g.Nodes)
+	if len(nodes) == 0 {
+		return [][]Node{}, map[Node][]int{}, nil
+	}
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	neighbors := make([][]int, len(nodes))
+	edges := 0
+	for i, node := range nodes {
+		for _, neighbor := range g.Edges[node] {
+			if neighbor != node {
+				neighbors[i] = append(neighbors[i], index[neighbor])
+			}
+		}
+		edges += len(neighbors[i])
+	}
+	edges /= 2
+
+	rng := rand.New(rand.NewSource(seed))
+	var affiliations [][]float64
+	best := math.Inf(-1)
+	for restart := 0; restart < bigCLAMRestarts; restart++ {
+		fitted, likelihood := fitBigCLAM(neighbors, bigCLAMSeeds(neighbors, k, rng), k, iterations, rng)
+		if likelihood > best {
+			affiliations, best = fitted, likelihood
+		}
+	}
+
+	density := 0.0
+	if len(nodes) > 1 {
+		density = 2 * float64(edges) / float64(len(nodes)*(len(nodes)-1))
+	}
+	threshold := math.Sqrt(-math.Log(1 - math.Min(density, 1-1e-12)))
+	members := make([][]Node, k)
+	for i, node := range nodes {
+		for c, value := range affiliations[i] {
+			if value >= threshold && value > 0 {
+				members[c] = append(members[c], node)
+			}
+		}
+	}
+	communities := make([][]Node, 0, k)
+	seen := make(map[string]bool)
+	for _, community := range members {
+		if key := fmt.Sprint(community); len(community) > 0 && !seen[key] {
+			seen[key] = true
+			communities = append(communities, community)
+		}
+	}
+	sortNodeSets(communities)
+
+	memberships := make(map[Node][]int)
+	for c, community := range communities {
+		for _, node := range community {
+			memberships[node] = append(memberships[node], c)
+		}
+	}
+	return communities, memberships, nil
+}
+
+// bigCLAMRestarts is the number of fits, with different random seeds and noise, among which BigCLAM keeps the most likely.
+const bigCLAMRestarts = 5
+
+// fitBigCLAM fits the affiliations of the nodes from the neighborhoods of the seeds and returns them with their log-likelihood.
+func fitBigCLAM(neighbors [][]int, seeds []int, k, iterations int, rng *rand.Rand) ([][]float64, float64) {
+	affiliations := make([][]float64, len(neighbors))
+	sum := make([]float64, k)
+	for i := range affiliations {
+		affiliations[i] = make([]float64, k)
+		for c := range affiliations[i] {
+			affiliations[i][c] = 0.1 * rng.Float64()
+		}
+	}
+	for c, center := range seeds {
+		affiliations[center][c] = 1
+		for _, neighbor := range neighbors[center] {
+			affiliations[neighbor][c] = 1
+		}
+	}
+	for i := range affiliations {
+		for c, value := range affiliations[i] {
+			sum[c] += value
+		}
+	}
+
+	// nodeLikelihood is the part of the log-likelihood that depends on the affiliations f of node i
+	nodeLikelihood := func(i int, f []float64) float64 {
+		likelihood := 0.0
+		rest := append([]float64(nil), sum...)
+		for c := range rest {
+			rest[c] -= affiliations[i][c]
+		}
+		for _, j := range neighbors[i] {
+			dot := bigCLAMDot(f, affiliations[j])
+			likelihood += math.Log(math.Max(1-math.Exp(-dot), 1e-12))
+			for c := range rest {
+				rest[c] -= affiliations[j][c]
+			}
+		}
+		return likelihood - bigCLAMDot(f, rest)
+	}
+	// Every non-edge term appears in the likelihood of both its nodes, every edge term as well
+	totalLikelihood := func() float64 {
+		total := 0.0
+		for i := range affiliations {
+			total += nodeLikelihood(i, affiliations[i])
+		}
+		return total / 2
+	}
+
+	previous := totalLikelihood()
+	gradient := make([]float64, k)
+	candidate := make([]float64, k)
+	for iteration := 0; iteration < iterations; iteration++ {
+		for _, i := range rng.Perm(len(affiliations)) {
+			for c := range gradient {
+				gradient[c] = affiliations[i][c] - sum[c]
+			}
+			for _, j := range neighbors[i] {
+				dot := math.Max(bigCLAMDot(affiliations[i], affiliations[j]), 1e-12)
+				ratio := math.Exp(-dot) / (1 - math.Exp(-dot))
+				for c := range gradient {
+					gradient[c] += affiliations[j][c] * (ratio + 1)
+				}
+			}
+
+			current := nodeLikelihood(i, affiliations[i])
+			for step := 1.0; step > 1e-6; step /= 2 {
+				for c := range candidate {
+					candidate[c] = math.Max(0, affiliations[i][c]+step*gradient[c])
+				}
+				if nodeLikelihood(i, candidate) > current {
+					for c := range candidate {
+						sum[c] += candidate[c] - affiliations[i][c]
+						affiliations[i][c] = candidate[c]
+					}
+					break
+				}
+			}
+		}
+		likelihood := totalLikelihood()
+		if math.Abs(likelihood-previous) < 1e-4*math.Abs(previous) {
+			return affiliations, likelihood
+		}
+		previous = likelihood
+	}
+	return affiliations, previous
+}
+
+// bigCLAMSeeds picks up to k nodes whose closed neighborhoods have a conductance no larger than any of their
+// neighbors', by increasing conductance, completed with the other nodes of lowest conductance.
+func bigCLAMSeeds(neighbors [][]int, k int, rng *rand.Rand) []int {
+	totalVolume := 0
+	for _, list := range neighbors {
+		totalVolume += len(list)
+	}
+	conductance := make([]float64, len(neighbors))
+	for i, list := range neighbors {
+		inside := map[int]bool{i: true}
+		for _, j := range list {
+			inside[j] = true
+		}
+		volume, cut := 0, 0
+		for member := range inside {
+			volume += len(neighbors[member])
+			for _, j := range neighbors[member] {
+				if !inside[j] {
+					cut++
+				}
+			}
+		}
+		if denominator := min(volume, totalVolume-volume); denominator > 0 {
+			conductance[i] = float64(cut) / float64(denominator)
+		} else {
+			conductance[i] = 1
+		}
+	}
+
+	var candidates []int
+	for i, list := range neighbors {
+		minimal := len(list) > 0
+		for _, j := range list {
+			if conductance[j] < conductance[i] {
+				minimal = false
+				break
+			}
+		}
+		if minimal {
+			candidates = append(candidates, i)
+		}
+	}
+	sort.SliceStable(candidates, func(a, b int) bool { return conductance[candidates[a]] < conductance[candidates[b]] })
+
+	// Skip candidates inside the neighborhood of an earlier seed, which would seed the same community
+	var seeds []int
+	covered := make(map[int]bool)
+	for _, i := range candidates {
+		if len(seeds) == k {
+			break
+		}
+		if covered[i] {
+			continue
+		}
+		seeds = append(seeds, i)
+		covered[i] = true
+		for _, j := range neighbors[i] {
+			covered[j] = true
+		}
+	}
+	// Complete with the uncovered nodes by increasing conductance, then with any other node at random
+	others := make([]int, 0, len(neighbors))
+	for i := range neighbors {
+		if !covered[i] {
+			others = append(others, i)
+		}
+	}
+	sort.SliceStable(others, func(a, b int) bool { return conductance[others[a]] < conductance[others[b]] })
+	isSeed := make(map[int]bool, k)
+	for _, i := range seeds {
+		isSeed[i] = true
+	}
+	for _, i := range append(others, rng.Perm(len(neighbors))...) {
+		if len(seeds) == k {
+			break
+		}
+		if !isSeed[i] {
+			seeds = append(seeds, i)
+			isSeed[i] = true
+		}
+	}
+	return seeds
+}
+
+func bigCLAMDot(a, b []float64) float64 {
+	dot := 0.0
+	for c := range a {
+		dot += a[c] * b[c]
+	}
+	return dot
+}
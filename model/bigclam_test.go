@@ -0,0 +1,46 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBigCLAM(t *testing.T) {
+	// Two 7-cliques overlapping on nodes 5 and 6, and a separate 5-clique linked to the second one by one edge
+	g := CompleteGraph(7)
+	for i := 5; i < 12; i++ {
+		for j := i + 1; j < 12; j++ {
+			g.AddEdge(Edge{Node1: Node(i), Node2: Node(j)})
+		}
+	}
+	for i := 12; i < 17; i++ {
+		for j := i + 1; j < 17; j++ {
+			g.AddEdge(Edge{Node1: Node(i), Node2: Node(j)})
+		}
+	}
+	g.AddEdge(Edge{Node1: 11, Node2: 12})
+
+	communities, memberships, err := BigCLAM(g, 3, 100, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := [][]Node{{0, 1, 2, 3, 4, 5, 6}, {5, 6, 7, 8, 9, 10, 11}, {12, 13, 14, 15, 16}}
+	if !reflect.DeepEqual(communities, expected) {
+		t.Errorf("Expected %v, but got %v", expected, communities)
+	}
+	if !reflect.DeepEqual(memberships[5], []int{0, 1}) || !reflect.DeepEqual(memberships[0], []int{0}) {
+		t.Errorf("Expected the overlapping nodes in two communities, but got %v", memberships)
+	}
+
+	again, _, _ := BigCLAM(g, 3, 100, 1)
+	if !reflect.DeepEqual(again, communities) {
+		t.Errorf("Expected the same communities for the same seed, but got %v", again)
+	}
+
+	if _, _, err := BigCLAM(g, 0, 10, 1); err == nil {
+		t.Errorf("Expected an error for k = 0")
+	}
+	if communities, _, err := BigCLAM(&UndirectedGraph{}, 2, 10, 1); err != nil || len(communities) != 0 {
+		t.Errorf("Expected no communities for an empty graph, but got %v and %v", communities, err)
+	}
+}
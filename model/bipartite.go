@@ -0,0 +1,104 @@
+package model
+
+import "fmt"
+
+// IsBipartite reports whether the nodes of g can be colored 0 and 1 so that every edge joins nodes of different
+// colors, and returns such a coloring, or nil. The smallest node of every connected component gets color 0, so that
+// the coloring is unique. A graph with a self-loop is not bipartite.
+func IsBipartite(g *UndirectedGraph) (map[Node]int, bool) {
+	colors := make(map[Node]int, len(g.Nodes))
+	for _, start := range sortedNodes(g.Nodes) {
+		if _, colored := colors[start]; colored {
+			continue
+		}
+		colors[start] = 0
+		queue := []Node{start}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			for _, neighbor := range g.Edges[node] {
+				if color, colored := colors[neighbor]; !colored {
+					colors[neighbor] = 1 - colors[node]
+					queue = append(queue, neighbor)
+				} else if color == colors[node] {
+					return nil, false
+				}
+			}
+		}
+	}
+	return colors, true
+}
+
+// BipartitePartitions splits the nodes of a bipartite graph into the nodes of color 0 and those of color 1 of
+// IsBipartite, in ascending order, or returns an error if the graph is not bipartite. A disconnected graph has several
+// such splits, from which the one of IsBipartite is taken.
+func BipartitePartitions(g *UndirectedGraph) ([]Node, []Node, error) {
+	colors, ok := IsBipartite(g)
+	if !ok {
+		return nil, nil, fmt.Errorf("the graph is not bipartite")
+	}
+	var top, bottom []Node
+	for _, node := range sortedNodes(g.Nodes) {
+		if colors[node] == 0 {
+			top = append(top, node)
+		} else {
+			bottom = append(bottom, node)
+		}
+	}
+	return top, bottom, nil
+}
+
+// BipartiteProjection returns the projection of a bipartite graph onto some of its nodes, see
+// WeightedBipartiteProjection, without the weights.
+func BipartiteProjection(g *UndirectedGraph, nodes []Node) (*UndirectedGraph, error) {
+	w, err := WeightedBipartiteProjection(g, nodes)
+	if err != nil {
+		return nil, err
+	}
+	return w.Graph, nil
+}
+
+/*
+WeightedBipartiteProjection returns the projection of a bipartite graph onto some of its nodes, such as the
+co-purchase graph of the items of a user-item graph.
+
+Parameters:
+- g: The bipartite graph.
+- nodes: The nodes projected onto, no two of them adjacent, typically one side of BipartitePartitions.
+
+Returns:
+- w: The graph on nodes in which two nodes are adjacent when they share a neighbor in g, weighted by the number of
+neighbors they share.
+- err: An error if a node is missing from g or two of the nodes are adjacent.
+*/
+func WeightedBipartiteProjection(g *UndirectedGraph, nodes []Node) (*WeightedUndirectedGraph, error) {
+	projected := make(map[Node]bool, len(nodes))
+	for _, node := range nodes {
+		if !g.Nodes[node] {
+			return nil, fmt.Errorf("node %d is not part of the graph", node)
+		}
+		projected[node] = true
+	}
+	w := NewWeightedUndirectedGraph()
+	for _, node := range sortedNodes(projected) {
+		w.AddNode(node)
+	}
+	for _, node := range sortedNodes(projected) {
+		shared := make(map[Node]bool)
+		for _, middle := range g.Edges[node] {
+			if projected[middle] {
+				return nil, fmt.Errorf("nodes %d and %d are adjacent", node, middle)
+			}
+			for _, other := range g.Edges[middle] {
+				if other > node && projected[other] {
+					shared[other] = true
+					w.Weights[Edge{Node1: node, Node2: other}]++
+				}
+			}
+		}
+		for _, other := range sortedNodes(shared) {
+			w.Graph.addSimpleEdge(node, other)
+		}
+	}
+	return w, nil
+}
@@ -0,0 +1,63 @@
+package model
+
+import "fmt"
+
+// BipartiteGraph is an UndirectedGraph whose nodes are split into a top and a bottom set, every edge joining a top
+// node to a bottom node, such as users and the items they rate or authors and their papers.
+type BipartiteGraph struct {
+	Graph  *UndirectedGraph
+	Top    map[Node]bool
+	Bottom map[Node]bool
+}
+
+// NewBipartiteGraph returns an empty BipartiteGraph.
+func NewBipartiteGraph() *BipartiteGraph {
+	return &BipartiteGraph{
+		Graph:  &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)},
+		Top:    make(map[Node]bool),
+		Bottom: make(map[Node]bool),
+	}
+}
+
+// AddTopNode adds a node to the top set; it returns an error if the node is in the bottom set.
+func (b *BipartiteGraph) AddTopNode(node Node) error {
+	if b.Bottom[node] {
+		return fmt.Errorf("node %d is already a bottom node", node)
+	}
+	b.Graph.AddNode(node)
+	b.Top[node] = true
+	return nil
+}
+
+// AddBottomNode adds a node to the bottom set; it returns an error if the node is in the top set.
+func (b *BipartiteGraph) AddBottomNode(node Node) error {
+	if b.Top[node] {
+		return fmt.Errorf("node %d is already a top node", node)
+	}
+	b.Graph.AddNode(node)
+	b.Bottom[node] = true
+	return nil
+}
+
+// AddEdge adds an edge between a top and a bottom node, adding them to their sets if needed. It returns an error if
+// either node is already on the other side.
+func (b *BipartiteGraph) AddEdge(top, bottom Node) error {
+	if err := b.AddTopNode(top); err != nil {
+		return err
+	}
+	if err := b.AddBottomNode(bottom); err != nil {
+		return err
+	}
+	b.Graph.AddEdge(Edge{Node1: top, Node2: bottom})
+	return nil
+}
+
+// TopNodes returns the top nodes in ascending order.
+func (b *BipartiteGraph) TopNodes() []Node {
+	return sortedNodes(b.Top)
+}
+
+// BottomNodes returns the bottom nodes in ascending order.
+func (b *BipartiteGraph) BottomNodes() []Node {
+	return sortedNodes(b.Bottom)
+}
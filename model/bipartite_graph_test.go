@@ -0,0 +1,32 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBipartiteGraph(t *testing.T) {
+	b := NewBipartiteGraph()
+	if err := b.AddEdge(0, 10); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := b.AddEdge(1, 10); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := b.AddBottomNode(11); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(b.TopNodes(), []Node{0, 1}) || !reflect.DeepEqual(b.BottomNodes(), []Node{10, 11}) {
+		t.Errorf("Expected the top nodes [0 1] and the bottom nodes [10 11], but got %v and %v", b.TopNodes(), b.BottomNodes())
+	}
+	if b.Graph.NumberOfEdges() != 2 || b.Graph.NodeDegree(10) != 2 {
+		t.Errorf("Expected 2 edges at node 10, but got %d edges", b.Graph.NumberOfEdges())
+	}
+
+	if err := b.AddEdge(10, 1); err == nil {
+		t.Errorf("Expected an error for an edge from a bottom node")
+	}
+	if err := b.AddTopNode(11); err == nil {
+		t.Errorf("Expected an error for a bottom node added to the top set")
+	}
+}
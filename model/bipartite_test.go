@@ -0,0 +1,68 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsBipartite(t *testing.T) {
+	g := CycleGraph(6)
+	g.AddEdge(Edge{Node1: 10, Node2: 7})
+	colors, ok := IsBipartite(g)
+	if !ok {
+		t.Fatalf("Expected a 6-cycle and an edge to be bipartite")
+	}
+	expected := map[Node]int{0: 0, 1: 1, 2: 0, 3: 1, 4: 0, 5: 1, 7: 0, 10: 1}
+	if !reflect.DeepEqual(colors, expected) {
+		t.Errorf("Expected %v, but got %v", expected, colors)
+	}
+	top, bottom, err := BipartitePartitions(g)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(top, []Node{0, 2, 4, 7}) || !reflect.DeepEqual(bottom, []Node{1, 3, 5, 10}) {
+		t.Errorf("Expected [0 2 4 7] and [1 3 5 10], but got %v and %v", top, bottom)
+	}
+
+	withLoop := PathGraph(2)
+	withLoop.AddEdge(Edge{Node1: 1, Node2: 1})
+	for _, g := range []*UndirectedGraph{CycleGraph(5), withLoop} {
+		if _, ok := IsBipartite(g); ok {
+			t.Errorf("Expected %v not to be bipartite", g)
+		}
+		if _, _, err := BipartitePartitions(g); err == nil {
+			t.Errorf("Expected an error for %v", g)
+		}
+	}
+}
+
+func TestBipartiteProjection(t *testing.T) {
+	// Users 0 to 2 and items 10 to 12
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 10}, {0, 11}, {1, 10}, {1, 11}, {1, 12}, {2, 12}})
+	w, err := WeightedBipartiteProjection(g, []Node{10, 11, 12})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := map[Edge]float64{{10, 11}: 2, {10, 12}: 1, {11, 12}: 1}
+	if !reflect.DeepEqual(w.Weights, expected) || w.Graph.NumberOfEdges() != 3 {
+		t.Errorf("Expected %v, but got %v", expected, w.Weights)
+	}
+
+	users, err := BipartiteProjection(g, []Node{0, 1, 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectedUsers := &UndirectedGraph{}
+	expectedUsers.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}})
+	if !users.Equals(expectedUsers) {
+		t.Errorf("Expected %v, but got %v", expectedUsers, users)
+	}
+
+	if _, err := BipartiteProjection(g, []Node{0, 10}); err == nil {
+		t.Errorf("Expected an error for adjacent nodes")
+	}
+	if _, err := BipartiteProjection(g, []Node{5}); err == nil {
+		t.Errorf("Expected an error for a missing node")
+	}
+}
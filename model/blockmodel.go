@@ -0,0 +1,353 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Blockmodel summarizes a graph by a partition of its nodes into roles and the density of the edges between them.
+type Blockmodel struct {
+	// Blocks holds the nodes of every role, sorted, the blocks being ordered by their smallest node.
+	Blocks [][]Node
+	// Role gives the index in Blocks of the role of every node.
+	Role map[Node]int
+	// Density[r][s] is the fraction of the possible edges between roles r and s that exist, or within role r if r = s.
+	// It is 0 when no edge is possible.
+	Density [][]float64
+	// Image[r][s] reports whether Density[r][s] reaches the threshold of the blockmodel: a one-block of the image matrix.
+	Image [][]bool
+}
+
+/*
+NewBlockmodel builds the blockmodel of an UndirectedGraph for a partition of its nodes.
+
+Parameters:
+- g: The graph.
+- blocks: The roles, covering every node of g exactly once.
+- threshold: The density from which a block is a one-block of the image matrix (the α-density criterion). Use the
+density of the whole graph for the usual mean-density image.
+
+Returns:
+- model: The blockmodel, the blocks being sorted.
+- err: An error if the blocks do not partition the nodes of g.
+*/
+func NewBlockmodel(g *UndirectedGraph, blocks [][]Node, threshold float64) (*Blockmodel, error) {
+	sorted := make([][]Node, len(blocks))
+	for i, block := range blocks {
+		if len(block) == 0 {
+			return nil, fmt.Errorf("block %d is empty", i)
+		}
+		sorted[i] = append([]Node(nil), block...)
+		sort.Slice(sorted[i], func(a, b int) bool { return sorted[i][a] < sorted[i][b] })
+	}
+	sortBlocks(sorted)
+
+	model := &Blockmodel{Blocks: sorted, Role: make(map[Node]int, len(g.Nodes))}
+	for r, block := range sorted {
+		for _, node := range block {
+			if _, seen := model.Role[node]; seen || !g.Nodes[node] {
+				return nil, fmt.Errorf("node %d is not in the graph or is in several blocks", node)
+			}
+			model.Role[node] = r
+		}
+	}
+	if len(model.Role) != len(g.Nodes) {
+		return nil, fmt.Errorf("the blocks cover %d of the %d nodes", len(model.Role), len(g.Nodes))
+	}
+
+	edges := make([][]int, len(sorted))
+	for r := range edges {
+		edges[r] = make([]int, len(sorted))
+	}
+	for _, edge := range sortedEdgeList(g) {
+		r, s := model.Role[edge.Node1], model.Role[edge.Node2]
+		edges[r][s]++
+		if r != s {
+			edges[s][r]++
+		}
+	}
+	model.Density = make([][]float64, len(sorted))
+	model.Image = make([][]bool, len(sorted))
+	for r := range sorted {
+		model.Density[r] = make([]float64, len(sorted))
+		model.Image[r] = make([]bool, len(sorted))
+		for s := range sorted {
+			possible := len(sorted[r]) * len(sorted[s])
+			if r == s {
+				possible = len(sorted[r]) * (len(sorted[r]) - 1) / 2
+			}
+			if possible > 0 {
+				model.Density[r][s] = float64(edges[r][s]) / float64(possible)
+			}
+			model.Image[r][s] = model.Density[r][s] >= threshold && edges[r][s] > 0
+		}
+	}
+	return model, nil
+}
+
+/*
+ConcorPartition partitions the nodes of an UndirectedGraph into approximately structurally equivalent blocks with
+CONCOR (convergence of iterated correlations).
+
+Parameters:
+- g: The graph.
+- depth: The number of times every block is split in two, giving at most 2^depth blocks.
+- maxIterations: The largest number of correlation iterations of a split.
+
+Returns:
+- blocks: The blocks, sorted and ordered by their smallest node.
+
+Description:
+Structurally equivalent nodes have the same neighbors. The rows of the adjacency matrix of the nodes of a block are
+correlated, and the correlation matrix is correlated again until its entries converge to ±1, which splits the block
+into two groups of positively correlated nodes. Blocks of one node, and blocks that do not split, are kept as they are.
+
+Reference: R. L. Breiger, S. A. Boorman and P. Arabie, "An algorithm for clustering relational data with applications
+to social network analysis", Journal of Mathematical Psychology 12, 1975.
+*/
+func ConcorPartition(g *UndirectedGraph, depth, maxIterations int) [][]Node {
+	nodes := sortedNodes(g.Nodes)
+	if len(nodes) == 0 {
+		return nil
+	}
+	blocks := [][]Node{nodes}
+	for level := 0; level < depth; level++ {
+		var next [][]Node
+		for _, block := range blocks {
+			next = append(next, concorSplit(g, nodes, block, maxIterations)...)
+		}
+		blocks = next
+	}
+	sortBlocks(blocks)
+	return blocks
+}
+
+// concorSplit splits a block in two by iterated correlation of the adjacency profiles of its nodes over all nodes.
+func concorSplit(g *UndirectedGraph, nodes, block []Node, maxIterations int) [][]Node {
+	if len(block) < 2 {
+		return [][]Node{block}
+	}
+	profiles := make([][]float64, len(block))
+	for i, u := range block {
+		profiles[i] = make([]float64, len(nodes))
+		for j, v := range nodes {
+			if g.HasEdge(u, v) {
+				profiles[i][j] = 1
+			}
+		}
+	}
+	correlations := correlationMatrix(profiles)
+	for iteration := 0; iteration < maxIterations && !converged(correlations); iteration++ {
+		correlations = correlationMatrix(correlations)
+	}
+
+	var first, second []Node
+	for i, node := range block {
+		if i == 0 || correlations[0][i] > 0 {
+			first = append(first, node)
+		} else {
+			second = append(second, node)
+		}
+	}
+	if len(second) == 0 {
+		return [][]Node{first}
+	}
+	return [][]Node{first, second}
+}
+
+// correlationMatrix returns the Pearson correlations between the rows of a matrix, 0 for constant rows, and 1 on the diagonal.
+func correlationMatrix(rows [][]float64) [][]float64 {
+	centered := make([][]float64, len(rows))
+	norms := make([]float64, len(rows))
+	for i, row := range rows {
+		mean := 0.0
+		for _, value := range row {
+			mean += value
+		}
+		mean /= float64(len(row))
+		centered[i] = make([]float64, len(row))
+		for k, value := range row {
+			centered[i][k] = value - mean
+			norms[i] += centered[i][k] * centered[i][k]
+		}
+		norms[i] = math.Sqrt(norms[i])
+	}
+	correlations := make([][]float64, len(rows))
+	for i := range rows {
+		correlations[i] = make([]float64, len(rows))
+		for j := range rows {
+			switch {
+			case i == j:
+				correlations[i][j] = 1
+			case norms[i] < 1e-12 || norms[j] < 1e-12:
+				correlations[i][j] = 0
+			default:
+				dot := 0.0
+				for k := range centered[i] {
+					dot += centered[i][k] * centered[j][k]
+				}
+				correlations[i][j] = dot / (norms[i] * norms[j])
+			}
+		}
+	}
+	return correlations
+}
+
+// converged checks if every entry of a correlation matrix is within 1e-9 of ±1 or of 0.
+func converged(correlations [][]float64) bool {
+	for _, row := range correlations {
+		for _, value := range row {
+			if math.Abs(math.Abs(value)-1) > 1e-9 && math.Abs(value) > 1e-9 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+/*
+RegularEquivalencePartition returns the coarsest regular equivalence of an UndirectedGraph refining an initial partition.
+
+Parameters:
+- g: The graph.
+- initial: The initial class of every node, for instance from node attributes, or nil to start from classes of nodes
+of equal degree. Without an initial partition, putting all the nodes of a graph without isolated nodes in one role is
+already a regular equivalence.
+
+Returns:
+- blocks: The roles, sorted and ordered by their smallest node. Two nodes share a role if they were in the same
+initial class and their neighbors occupy the same set of roles.
+
+Description:
+The classes are refined until stable, as in the CATREGE algorithm of Borgatti and Everett, in O(n (n + m)) time.
+*/
+func RegularEquivalencePartition(g *UndirectedGraph, initial map[Node]int) [][]Node {
+	nodes := sortedNodes(g.Nodes)
+	classes := make(map[Node]int, len(nodes))
+	for _, node := range nodes {
+		if initial != nil {
+			classes[node] = initial[node]
+		} else {
+			classes[node] = g.NodeDegree(node)
+		}
+	}
+
+	for count := -1; ; {
+		signatures := make(map[string]int)
+		next := make(map[Node]int, len(nodes))
+		for _, node := range nodes {
+			seen := make(map[int]bool)
+			neighborClasses := []int{}
+			for _, neighbor := range g.Edges[node] {
+				if !seen[classes[neighbor]] {
+					seen[classes[neighbor]] = true
+					neighborClasses = append(neighborClasses, classes[neighbor])
+				}
+			}
+			sort.Ints(neighborClasses)
+			signature := fmt.Sprint(classes[node], neighborClasses)
+			if _, ok := signatures[signature]; !ok {
+				signatures[signature] = len(signatures)
+			}
+			next[node] = signatures[signature]
+		}
+		classes = next
+		if len(signatures) == count {
+			break
+		}
+		count = len(signatures)
+	}
+
+	byClass := make(map[int][]Node)
+	for _, node := range nodes {
+		byClass[classes[node]] = append(byClass[classes[node]], node)
+	}
+	blocks := make([][]Node, 0, len(byClass))
+	for _, block := range byClass {
+		blocks = append(blocks, block)
+	}
+	sortBlocks(blocks)
+	return blocks
+}
+
+/*
+RegularEquivalenceSimilarity computes approximate regular equivalence scores between the nodes of an UndirectedGraph
+with a REGE-style iteration.
+
+Parameters:
+- g: The graph.
+- iterations: The number of iterations; 3 to 5 are customary.
+
+Returns:
+- nodes: The nodes of g, sorted.
+- similarity: similarity[i][j] in [0, 1] measures how regularly equivalent nodes[i] and nodes[j] are.
+
+Description:
+Since putting all the nodes in one role is always regular, the scores start from the degree ratio min(di, dj) /
+max(di, dj) of the nodes, which tells apart nodes whose neighbors play the same roles a different number of times. At
+every iteration, the score of i and j becomes their degree ratio times the average, over the neighbors k of i and the
+neighbors m of j, of the best score of k with a neighbor of j and of m with a neighbor of i. Two isolated nodes have
+score 1 and an isolated node has score 0 with any other node.
+*/
+func RegularEquivalenceSimilarity(g *UndirectedGraph, iterations int) ([]Node, [][]float64) {
+	nodes := sortedNodes(g.Nodes)
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	neighbors := make([][]int, len(nodes))
+	for i, node := range nodes {
+		for _, neighbor := range g.Edges[node] {
+			neighbors[i] = append(neighbors[i], index[neighbor])
+		}
+	}
+
+	ratios := make([][]float64, len(nodes))
+	for i := range ratios {
+		ratios[i] = make([]float64, len(nodes))
+		for j := range ratios[i] {
+			if smaller, larger := min(len(neighbors[i]), len(neighbors[j])), max(len(neighbors[i]), len(neighbors[j])); larger == 0 {
+				ratios[i][j] = 1
+			} else {
+				ratios[i][j] = float64(smaller) / float64(larger)
+			}
+		}
+	}
+	similarity := ratios
+	bestMatch := func(scores [][]float64, k int, candidates []int) float64 {
+		best := 0.0
+		for _, m := range candidates {
+			best = math.Max(best, scores[k][m])
+		}
+		return best
+	}
+	for iteration := 0; iteration < iterations; iteration++ {
+		next := make([][]float64, len(nodes))
+		for i := range next {
+			next[i] = make([]float64, len(nodes))
+			for j := range next[i] {
+				degrees := len(neighbors[i]) + len(neighbors[j])
+				if ratios[i][j] == 0 || degrees == 0 {
+					next[i][j] = ratios[i][j]
+					continue
+				}
+				total := 0.0
+				for _, k := range neighbors[i] {
+					total += bestMatch(similarity, k, neighbors[j])
+				}
+				for _, m := range neighbors[j] {
+					total += bestMatch(similarity, m, neighbors[i])
+				}
+				next[i][j] = ratios[i][j] * total / float64(degrees)
+			}
+		}
+		similarity = next
+	}
+	return nodes, similarity
+}
+
+// sortBlocks orders blocks of sorted nodes by their smallest node.
+func sortBlocks(blocks [][]Node) {
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i][0] < blocks[j][0] })
+}
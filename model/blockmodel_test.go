@@ -0,0 +1,126 @@
+package model
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestConcorPartition(t *testing.T) {
+	bipartite := &UndirectedGraph{}
+	for _, u := range []int{0, 1} {
+		for _, v := range []int{2, 3, 4} {
+			bipartite.AddEdge(Edge{Node1: Node(u), Node2: Node(v)})
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		g        *UndirectedGraph
+		depth    int
+		expected [][]Node
+	}{
+		{"complete bipartite", bipartite, 1, [][]Node{{0, 1}, {2, 3, 4}}},
+		{"star", StarGraph(5), 1, [][]Node{{0}, {1, 2, 3, 4}}},
+		// The leaves cannot be split further and the center is alone
+		{"star, depth 2", StarGraph(5), 2, [][]Node{{0}, {1, 2, 3, 4}}},
+		{"no split", StarGraph(5), 0, [][]Node{{0, 1, 2, 3, 4}}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if blocks := ConcorPartition(testCase.g, testCase.depth, 50); !reflect.DeepEqual(blocks, testCase.expected) {
+				t.Errorf("Expected %v, but got %v", testCase.expected, blocks)
+			}
+		})
+	}
+}
+
+func TestRegularEquivalencePartition(t *testing.T) {
+	// Two stars whose centers are linked: centers and leaves
+	g := StarGraph(4)
+	for i := 5; i < 9; i++ {
+		g.AddEdge(Edge{Node1: 4, Node2: Node(i)})
+	}
+	g.AddEdge(Edge{Node1: 0, Node2: 4})
+
+	testCases := []struct {
+		name     string
+		g        *UndirectedGraph
+		initial  map[Node]int
+		expected [][]Node
+	}{
+		{"path", PathGraph(5), nil, [][]Node{{0, 4}, {1, 3}, {2}}},
+		// The centers have different degrees but the same kinds of neighbors
+		{"linked stars", g, map[Node]int{}, [][]Node{{0, 1, 2, 3, 4, 5, 6, 7, 8}}},
+		{"linked stars by degree", g, nil, [][]Node{{0}, {1, 2, 3}, {4}, {5, 6, 7, 8}}},
+		{"linked stars with centers", g, map[Node]int{0: 1, 4: 1}, [][]Node{{0, 4}, {1, 2, 3, 5, 6, 7, 8}}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if blocks := RegularEquivalencePartition(testCase.g, testCase.initial); !reflect.DeepEqual(blocks, testCase.expected) {
+				t.Errorf("Expected %v, but got %v", testCase.expected, blocks)
+			}
+		})
+	}
+}
+
+func TestRegularEquivalenceSimilarity(t *testing.T) {
+	g := PathGraph(5)
+	g.AddNode(9)
+	nodes, similarity := RegularEquivalenceSimilarity(g, 3)
+	if !reflect.DeepEqual(nodes, []Node{0, 1, 2, 3, 4, 9}) {
+		t.Fatalf("Expected the sorted nodes, but got %v", nodes)
+	}
+	for i := range similarity {
+		if math.Abs(similarity[i][i]-1) > 1e-9 {
+			t.Errorf("Expected self-similarity 1, but got %v for %d", similarity[i][i], nodes[i])
+		}
+		for j := range similarity {
+			if math.Abs(similarity[i][j]-similarity[j][i]) > 1e-9 || similarity[i][j] < 0 || similarity[i][j] > 1 {
+				t.Errorf("Expected symmetric scores in [0, 1], but got %v and %v", similarity[i][j], similarity[j][i])
+			}
+		}
+	}
+	// The two ends of the path are equivalent, an end and the middle are not
+	if math.Abs(similarity[0][4]-1) > 1e-9 || similarity[0][2] >= similarity[1][3] {
+		t.Errorf("Expected the ends to be equivalent, but got %v", similarity)
+	}
+	if similarity[5][0] != 0 {
+		t.Errorf("Expected 0 between an isolated node and another, but got %v", similarity[5][0])
+	}
+}
+
+func TestNewBlockmodel(t *testing.T) {
+	bipartite := &UndirectedGraph{}
+	for _, u := range []int{0, 1} {
+		for _, v := range []int{2, 3, 4} {
+			bipartite.AddEdge(Edge{Node1: Node(u), Node2: Node(v)})
+		}
+	}
+	bipartite.AddEdge(Edge{Node1: 2, Node2: 3})
+
+	model, err := NewBlockmodel(bipartite, [][]Node{{4, 2, 3}, {1, 0}}, 0.5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(model.Blocks, [][]Node{{0, 1}, {2, 3, 4}}) || model.Role[3] != 1 {
+		t.Errorf("Expected sorted blocks, but got %v", model.Blocks)
+	}
+	expectedDensity := [][]float64{{0, 1}, {1, 1.0 / 3}}
+	for r := range expectedDensity {
+		for s := range expectedDensity[r] {
+			if math.Abs(model.Density[r][s]-expectedDensity[r][s]) > 1e-9 {
+				t.Errorf("Expected density %v, but got %v", expectedDensity, model.Density)
+			}
+		}
+	}
+	if !reflect.DeepEqual(model.Image, [][]bool{{false, true}, {true, false}}) {
+		t.Errorf("Expected the bipartite image, but got %v", model.Image)
+	}
+
+	for _, blocks := range [][][]Node{{{0, 1}, {2, 3}}, {{0, 1}, {1, 2, 3, 4}}, {{0, 1, 2, 3, 4}, {}}, {{0, 1, 2, 3, 4, 5}}} {
+		if _, err := NewBlockmodel(bipartite, blocks, 0.5); err == nil {
+			t.Errorf("Expected an error for %v", blocks)
+		}
+	}
+}
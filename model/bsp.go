@@ -0,0 +1,135 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+RunBSP runs a vertex program on a graph held in memory, parallelized over goroutines.
+
+Parameters:
+- g: The graph.
+- program: The vertex program, see VertexProgram.
+- initial: The initial value of every vertex.
+- maxSupersteps: The maximum number of supersteps to run.
+- workers: The number of goroutines the vertices are spread over.
+
+Returns:
+- values: The value of every vertex when the computation stopped.
+- supersteps: The number of supersteps run.
+- err: An error if workers or maxSupersteps is not positive, or if a message was sent to a node outside the graph.
+
+Description:
+The vertices are assigned to the workers with HashPartition and run with RunPregel, so the result does not depend on
+the number of workers as long as the program does not depend on the order of its messages.
+*/
+func RunBSP[V, M any](g *UndirectedGraph, program VertexProgram[V, M], initial func(Node) V, maxSupersteps int, workers int) (map[Node]V, int, error) {
+	if workers <= 0 {
+		return nil, 0, fmt.Errorf("the number of workers must be positive")
+	}
+	pg, err := PartitionGraph(g, HashPartition(g, workers))
+	if err != nil {
+		return nil, 0, err
+	}
+	return RunPregel[V, M](pg, program, initial, maxSupersteps)
+}
+
+// PageRankVertexProgram computes PageRank by having every vertex split its rank among its neighbors at every
+// superstep. The rank of nodes without neighbors is not redistributed.
+type PageRankVertexProgram struct {
+	Damping       float64
+	Iterations    int
+	NumberOfNodes int
+}
+
+// Compute implements VertexProgram.
+func (p PageRankVertexProgram) Compute(superstep int, vertex *Vertex[float64, float64], messages []float64) {
+	if superstep > 0 {
+		sum := 0.0
+		for _, message := range messages {
+			sum += message
+		}
+		vertex.Value = (1-p.Damping)/float64(p.NumberOfNodes) + p.Damping*sum
+	}
+	if superstep < p.Iterations && len(vertex.Neighbors) > 0 {
+		vertex.SendMessageToNeighbors(vertex.Value / float64(len(vertex.Neighbors)))
+		return
+	}
+	if superstep >= p.Iterations {
+		vertex.VoteToHalt()
+	}
+}
+
+// ConnectedComponentsVertexProgram labels every vertex with the smallest node of its connected component.
+type ConnectedComponentsVertexProgram struct{}
+
+// Compute implements VertexProgram.
+func (ConnectedComponentsVertexProgram) Compute(superstep int, vertex *Vertex[Node, Node], messages []Node) {
+	changed := superstep == 0
+	for _, message := range messages {
+		if message < vertex.Value {
+			vertex.Value = message
+			changed = true
+		}
+	}
+	if changed {
+		vertex.SendMessageToNeighbors(vertex.Value)
+	}
+	vertex.VoteToHalt()
+}
+
+// ShortestPathVertexProgram computes the distance of every vertex from a source, vertices starting at +Inf.
+type ShortestPathVertexProgram struct {
+	Source Node
+	Weight WeightFunc
+}
+
+// Compute implements VertexProgram.
+func (p ShortestPathVertexProgram) Compute(superstep int, vertex *Vertex[float64, float64], messages []float64) {
+	distance := math.Inf(1)
+	if superstep == 0 && vertex.ID == p.Source {
+		distance = 0
+	}
+	for _, message := range messages {
+		distance = math.Min(distance, message)
+	}
+	if distance < vertex.Value {
+		vertex.Value = distance
+		for _, neighbor := range vertex.Neighbors {
+			vertex.SendMessage(neighbor, distance+p.Weight(vertex.ID, neighbor))
+		}
+	}
+	vertex.VoteToHalt()
+}
+
+// BSPPageRank computes the PageRank of every node of g with PageRankVertexProgram, running the given number of iterations on the given number of workers.
+func BSPPageRank(g *UndirectedGraph, damping float64, iterations int, workers int) (map[Node]float64, error) {
+	if damping < 0 || damping > 1 {
+		return nil, fmt.Errorf("damping factor must be in [0, 1], got %v", damping)
+	}
+	program := PageRankVertexProgram{Damping: damping, Iterations: iterations, NumberOfNodes: len(g.Nodes)}
+	initial := func(Node) float64 { return 1 / float64(len(g.Nodes)) }
+	ranks, _, err := RunBSP[float64, float64](g, program, initial, iterations+1, workers)
+	return ranks, err
+}
+
+// BSPConnectedComponents labels every node of g with the smallest node of its connected component.
+func BSPConnectedComponents(g *UndirectedGraph, workers int) (map[Node]Node, error) {
+	labels, _, err := RunBSP[Node, Node](g, ConnectedComponentsVertexProgram{}, func(node Node) Node { return node }, len(g.Nodes)+1, workers)
+	return labels, err
+}
+
+// BSPShortestPaths returns the distance of every node of g from source, +Inf for unreachable nodes. Weights must not be negative.
+func BSPShortestPaths(g *UndirectedGraph, source Node, weight WeightFunc, workers int) (map[Node]float64, error) {
+	for _, edge := range g.GetEdgeTuples() {
+		if weight(edge.Node1, edge.Node2) < 0 {
+			return nil, fmt.Errorf("negative weight on edge (%d, %d)", edge.Node1, edge.Node2)
+		}
+	}
+	program := ShortestPathVertexProgram{Source: source, Weight: weight}
+	initial := func(Node) float64 { return math.Inf(1) }
+	// Every superstep extends the shortest paths by one edge, so distances settle within |V| supersteps
+	distances, _, err := RunBSP[float64, float64](g, program, initial, len(g.Nodes)+1, workers)
+	return distances, err
+}
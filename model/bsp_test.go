@@ -0,0 +1,81 @@
+package model
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestBSPPageRank(t *testing.T) {
+	// Test case 1: Every node of a cycle has the same rank
+	ranks, err := BSPPageRank(CycleGraph(4), 0.85, 20, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for node, rank := range ranks {
+		if math.Abs(rank-0.25) > 1e-12 {
+			t.Errorf("Test case 1 failed: Expected rank 0.25 for node %d, but got %v", node, rank)
+		}
+	}
+
+	// Test case 2: The center of a star ranks highest and the ranks sum to 1
+	ranks, err = BSPPageRank(StarGraph(4), 0.85, 50, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sum := 0.0
+	for node, rank := range ranks {
+		sum += rank
+		if node != 0 && rank >= ranks[0] {
+			t.Errorf("Test case 2 failed: Expected the center to outrank node %d, but got %v", node, ranks)
+		}
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("Test case 2 failed: Expected the ranks to sum to 1, but got %v", sum)
+	}
+
+	if _, err := BSPPageRank(CycleGraph(4), 1.5, 20, 2); err == nil {
+		t.Errorf("Expected an error for an invalid damping factor, but got nil")
+	}
+}
+
+func TestBSPConnectedComponents(t *testing.T) {
+	g := PathGraph(3)
+	g.AddEdge(Edge{Node1: 5, Node2: 4})
+	g.AddNode(9)
+
+	expected := map[Node]Node{0: 0, 1: 0, 2: 0, 4: 4, 5: 4, 9: 9}
+	for _, workers := range []int{1, 4} {
+		labels, err := BSPConnectedComponents(g, workers)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(labels, expected) {
+			t.Errorf("Expected %v with %d workers, but got %v", expected, workers, labels)
+		}
+	}
+
+	if _, err := BSPConnectedComponents(g, 0); err == nil {
+		t.Errorf("Expected an error for zero workers, but got nil")
+	}
+}
+
+func TestBSPShortestPaths(t *testing.T) {
+	g := CycleGraph(4)
+	g.AddNode(7)
+	weights := map[Edge]float64{{Node1: 0, Node2: 1}: 1, {Node1: 1, Node2: 2}: 1, {Node1: 2, Node2: 3}: 1, {Node1: 0, Node2: 3}: 5}
+	weight := weightsFromMap(weights)
+
+	distances, err := BSPShortestPaths(g, 0, weight, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := map[Node]float64{0: 0, 1: 1, 2: 2, 3: 3, 7: math.Inf(1)}
+	if !reflect.DeepEqual(distances, expected) {
+		t.Errorf("Expected %v, but got %v", expected, distances)
+	}
+
+	if _, err := BSPShortestPaths(g, 0, func(u, v Node) float64 { return -1 }, 2); err == nil {
+		t.Errorf("Expected an error for negative weights, but got nil")
+	}
+}
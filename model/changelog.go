@@ -0,0 +1,260 @@
+package model
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// MutationKind identifies the operation recorded by a Mutation.
+type MutationKind int
+
+const (
+	AddNodeMutation MutationKind = iota
+	RemoveNodeMutation
+	AddEdgeMutation
+	RemoveEdgeMutation
+)
+
+var mutationKindNames = map[MutationKind]string{
+	AddNodeMutation:    "add_node",
+	RemoveNodeMutation: "remove_node",
+	AddEdgeMutation:    "add_edge",
+	RemoveEdgeMutation: "remove_edge",
+}
+
+func (kind MutationKind) String() string {
+	if name, ok := mutationKindNames[kind]; ok {
+		return name
+	}
+	return fmt.Sprintf("MutationKind(%d)", int(kind))
+}
+
+// Mutation is a single change applied to a graph. Node is used by node mutations and Edge by edge mutations.
+type Mutation struct {
+	Kind MutationKind
+	Node Node
+	Edge Edge
+}
+
+// String returns the changelog line of the mutation, e.g. "add_edge 1 2".
+func (m Mutation) String() string {
+	if m.Kind == AddEdgeMutation || m.Kind == RemoveEdgeMutation {
+		return fmt.Sprintf("%s %d %d", m.Kind, m.Edge.Node1, m.Edge.Node2)
+	}
+	return fmt.Sprintf("%s %d", m.Kind, m.Node)
+}
+
+func (m Mutation) apply(g *UndirectedGraph) {
+	switch m.Kind {
+	case AddNodeMutation:
+		g.AddNode(m.Node)
+	case RemoveNodeMutation:
+		g.RemoveNode(m.Node)
+	case AddEdgeMutation:
+		g.AddEdge(m.Edge)
+	case RemoveEdgeMutation:
+		g.RemoveEdge(m.Edge)
+	}
+}
+
+// parseMutation parses a changelog line written by Mutation.String.
+func parseMutation(line string) (Mutation, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return Mutation{}, fmt.Errorf("empty changelog line")
+	}
+	for kind, name := range mutationKindNames {
+		if name != fields[0] {
+			continue
+		}
+		m := Mutation{Kind: kind}
+		var err error
+		if kind == AddEdgeMutation || kind == RemoveEdgeMutation {
+			_, err = fmt.Sscan(strings.Join(fields[1:], " "), &m.Edge.Node1, &m.Edge.Node2)
+		} else {
+			_, err = fmt.Sscan(strings.Join(fields[1:], " "), &m.Node)
+		}
+		if err != nil {
+			return Mutation{}, fmt.Errorf("invalid changelog line %q: %w", line, err)
+		}
+		return m, nil
+	}
+	return Mutation{}, fmt.Errorf("unknown mutation %q", fields[0])
+}
+
+/*
+VersionedGraph is an UndirectedGraph whose mutations are recorded in an append-only changelog.
+
+Every mutation is written to the changelog before being applied to Graph, so the version of the graph is the number of
+mutations recorded so far and any earlier version can be rebuilt by replaying a prefix of the changelog on the base graph.
+Graph must only be modified through the methods of the VersionedGraph.
+*/
+type VersionedGraph struct {
+	Graph     *UndirectedGraph
+	Changelog []Mutation
+	base      *UndirectedGraph
+	log       io.Writer
+}
+
+// GraphDiff lists the nodes and edges that differ between two versions of a graph. Edges have their smaller node first.
+type GraphDiff struct {
+	AddedNodes   []Node
+	RemovedNodes []Node
+	AddedEdges   []Edge
+	RemovedEdges []Edge
+}
+
+// NewVersionedGraph starts a changelog on a copy of base, which is version 0. If log is not nil, every mutation is also
+// appended to it as a line of text before being applied.
+func NewVersionedGraph(base *UndirectedGraph, log io.Writer) *VersionedGraph {
+	return &VersionedGraph{Graph: copyGraph(base), base: copyGraph(base), log: log}
+}
+
+/*
+ReplayChangelog rebuilds a VersionedGraph from a changelog written by a previous VersionedGraph.
+
+Parameters:
+- base: The graph the changelog was started on.
+- r: The changelog, one mutation per line. Empty lines are ignored.
+- log: The writer further mutations are appended to, or nil.
+
+Returns:
+- g: The VersionedGraph at the last version of the changelog, with the full history available.
+- err: An error if the changelog cannot be read or contains an invalid line.
+*/
+func ReplayChangelog(base *UndirectedGraph, r io.Reader, log io.Writer) (*VersionedGraph, error) {
+	g := NewVersionedGraph(base, nil)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		m, err := parseMutation(scanner.Text())
+		if err != nil {
+			return nil, err
+		}
+		if err := g.Apply(m); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading changelog: %w", err)
+	}
+	g.log = log
+	return g, nil
+}
+
+// Version returns the number of mutations applied since the base graph.
+func (g *VersionedGraph) Version() int {
+	return len(g.Changelog)
+}
+
+// Apply records a mutation in the changelog and applies it to the graph. Nothing is applied if the mutation cannot be written to the log.
+func (g *VersionedGraph) Apply(m Mutation) error {
+	if _, ok := mutationKindNames[m.Kind]; !ok {
+		return fmt.Errorf("unknown mutation kind %v", m.Kind)
+	}
+	if g.log != nil {
+		if _, err := fmt.Fprintln(g.log, m); err != nil {
+			return fmt.Errorf("error writing changelog: %w", err)
+		}
+	}
+	g.Changelog = append(g.Changelog, m)
+	m.apply(g.Graph)
+	return nil
+}
+
+// AddNode records and applies the addition of a node.
+func (g *VersionedGraph) AddNode(node Node) error {
+	return g.Apply(Mutation{Kind: AddNodeMutation, Node: node})
+}
+
+// RemoveNode records and applies the removal of a node and its edges.
+func (g *VersionedGraph) RemoveNode(node Node) error {
+	return g.Apply(Mutation{Kind: RemoveNodeMutation, Node: node})
+}
+
+// AddEdge records and applies the addition of an edge.
+func (g *VersionedGraph) AddEdge(edge Edge) error {
+	return g.Apply(Mutation{Kind: AddEdgeMutation, Edge: edge})
+}
+
+// RemoveEdge records and applies the removal of an edge.
+func (g *VersionedGraph) RemoveEdge(edge Edge) error {
+	return g.Apply(Mutation{Kind: RemoveEdgeMutation, Edge: edge})
+}
+
+// GraphAt rebuilds the graph as it was at the given version, 0 being the base graph.
+func (g *VersionedGraph) GraphAt(version int) (*UndirectedGraph, error) {
+	if version < 0 || version > len(g.Changelog) {
+		return nil, fmt.Errorf("version %d out of range [0, %d]", version, len(g.Changelog))
+	}
+	graph := copyGraph(g.base)
+	for _, m := range g.Changelog[:version] {
+		m.apply(graph)
+	}
+	return graph, nil
+}
+
+// Diff returns the nodes and edges added and removed between two versions of the graph, in ascending order.
+func (g *VersionedGraph) Diff(from, to int) (*GraphDiff, error) {
+	before, err := g.GraphAt(from)
+	if err != nil {
+		return nil, err
+	}
+	after, err := g.GraphAt(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &GraphDiff{}
+	for _, node := range sortedNodes(after.Nodes) {
+		if !before.HasNode(node) {
+			diff.AddedNodes = append(diff.AddedNodes, node)
+		}
+	}
+	for _, node := range sortedNodes(before.Nodes) {
+		if !after.HasNode(node) {
+			diff.RemovedNodes = append(diff.RemovedNodes, node)
+		}
+	}
+	diff.AddedEdges = missingEdges(after, before)
+	diff.RemovedEdges = missingEdges(before, after)
+	return diff, nil
+}
+
+// missingEdges returns the edges of g that are not in other, sorted, self-loops once.
+func missingEdges(g, other *UndirectedGraph) []Edge {
+	var edges []Edge
+	for _, edge := range g.GetEdgeTuples() {
+		if edge.Node1 <= edge.Node2 && !other.HasEdge(edge.Node1, edge.Node2) {
+			edges = append(edges, edge)
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Node1 != edges[j].Node1 {
+			return edges[i].Node1 < edges[j].Node1
+		}
+		return edges[i].Node2 < edges[j].Node2
+	})
+	return slices.Compact(edges)
+}
+
+// copyGraph returns a deep copy of g, or an empty graph if g is nil.
+func copyGraph(g *UndirectedGraph) *UndirectedGraph {
+	graph := &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	if g == nil {
+		return graph
+	}
+	for node := range g.Nodes {
+		graph.Nodes[node] = true
+	}
+	for node, neighbors := range g.Edges {
+		graph.Edges[node] = append([]Node{}, neighbors...)
+	}
+	return graph
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestVersionedGraph(t *testing.T) {
+	var log strings.Builder
+	g := NewVersionedGraph(PathGraph(3), &log)
+
+	_ = g.AddEdge(Edge{Node1: 2, Node2: 0})
+	_ = g.AddNode(5)
+	_ = g.RemoveNode(1)
+	_ = g.RemoveEdge(Edge{Node1: 0, Node2: 2})
+
+	if g.Version() != 4 {
+		t.Errorf("Expected version 4, but got %d", g.Version())
+	}
+
+	expectedLog := "add_edge 2 0\nadd_node 5\nremove_node 1\nremove_edge 0 2\n"
+	if log.String() != expectedLog {
+		t.Errorf("Expected changelog %q, but got %q", expectedLog, log.String())
+	}
+
+	// Test case 1: The base version is untouched
+	base, err := g.GraphAt(0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !base.Equals(PathGraph(3)) {
+		t.Errorf("Test case 1 failed: Expected the base graph, but got %v", base)
+	}
+
+	// Test case 2: An intermediate version
+	cycle, _ := g.GraphAt(1)
+	if !cycle.Equals(CycleGraph(3)) {
+		t.Errorf("Test case 2 failed: Expected a triangle, but got %v", cycle)
+	}
+
+	// Test case 3: Diff between the triangle and the last version
+	diff, err := g.Diff(1, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := &GraphDiff{
+		AddedNodes:   []Node{5},
+		RemovedNodes: []Node{1},
+		RemovedEdges: []Edge{{Node1: 0, Node2: 1}, {Node1: 0, Node2: 2}, {Node1: 1, Node2: 2}},
+	}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Errorf("Test case 3 failed: Expected %+v, but got %+v", expected, diff)
+	}
+
+	// Test case 4: Out of range versions
+	if _, err := g.GraphAt(5); err == nil {
+		t.Errorf("Test case 4 failed: Expected an error, but got nil")
+	}
+
+	// Test case 5: Replaying the written changelog gives the same graph and history
+	replayed, err := ReplayChangelog(PathGraph(3), strings.NewReader(log.String()), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !replayed.Graph.Equals(g.Graph) || !reflect.DeepEqual(replayed.Changelog, g.Changelog) {
+		t.Errorf("Test case 5 failed: Expected %v, but got %v", g.Graph, replayed.Graph)
+	}
+
+	if _, err := ReplayChangelog(nil, strings.NewReader("merge_node 1"), nil); err == nil {
+		t.Errorf("Expected an error for an unknown mutation, but got nil")
+	}
+}
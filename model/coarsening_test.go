@@ -0,0 +1,75 @@
+package model
+
+import "testing"
+
+func TestHeavyEdgeMatchingCoarsen(t *testing.T) {
+	// Test case 1: The heavy edges of a path are contracted first
+	weights := map[Edge]float64{
+		{Node1: 0, Node2: 1}: 5,
+		{Node1: 1, Node2: 2}: 1,
+		{Node1: 2, Node2: 3}: 5,
+	}
+	for seed := int64(0); seed < 5; seed++ {
+		coarse := HeavyEdgeMatchingCoarsen(PathGraph(4), weightsFromMap(weights), nil, seed)
+		if len(coarse.Graph.Nodes) != 2 {
+			t.Fatalf("Test case 1 failed: Expected 2 coarse nodes, but got %d", len(coarse.Graph.Nodes))
+		}
+		if coarse.Projection[0] != coarse.Projection[1] || coarse.Projection[2] != coarse.Projection[3] {
+			t.Errorf("Test case 1 failed: Expected the heavy edges to be contracted, got %v", coarse.Projection)
+		}
+		if w := coarse.EdgeWeight(coarse.Projection[0], coarse.Projection[3]); w != 1 {
+			t.Errorf("Test case 1 failed: Expected coarse edge weight 1, but got %v", w)
+		}
+		if coarse.NodeWeights[coarse.Projection[0]] != 2 {
+			t.Errorf("Test case 1 failed: Expected coarse node weight 2, but got %v", coarse.NodeWeights)
+		}
+	}
+
+	// Test case 2: Total node and edge weight are preserved
+	g := CompleteGraph(6)
+	coarse := HeavyEdgeMatchingCoarsen(g, UnitWeight, nil, 42)
+	if len(coarse.Graph.Nodes) != 3 {
+		t.Errorf("Test case 2 failed: Expected 3 coarse nodes, but got %d", len(coarse.Graph.Nodes))
+	}
+	totalEdgeWeight := 0.0
+	for _, w := range coarse.EdgeWeights {
+		totalEdgeWeight += w
+	}
+	if totalEdgeWeight != float64(g.NumberOfEdges()-3) {
+		t.Errorf("Test case 2 failed: Expected total edge weight %d, but got %v", g.NumberOfEdges()-3, totalEdgeWeight)
+	}
+}
+
+func TestCoarsenHierarchy(t *testing.T) {
+	g := CycleGraph(32)
+	levels := CoarsenHierarchy(g, UnitWeight, 4, 1)
+	if len(levels) == 0 {
+		t.Fatalf("Expected at least one coarsening level")
+	}
+
+	coarsest := levels[len(levels)-1]
+	if len(coarsest.Graph.Nodes) > 4 {
+		t.Errorf("Expected at most 4 nodes in the coarsest graph, but got %d", len(coarsest.Graph.Nodes))
+	}
+	totalNodeWeight := 0.0
+	for _, w := range coarsest.NodeWeights {
+		totalNodeWeight += w
+	}
+	if totalNodeWeight != 32 {
+		t.Errorf("Expected total node weight 32, but got %v", totalNodeWeight)
+	}
+
+	// Every fine node is projected through all the levels
+	for node := range g.Nodes {
+		projected := node
+		for _, level := range levels {
+			var ok bool
+			if projected, ok = level.Projection[projected]; !ok {
+				t.Fatalf("Node %d is missing from a projection", node)
+			}
+		}
+		if !coarsest.Graph.HasNode(projected) {
+			t.Errorf("Node %d is projected on a missing coarse node %d", node, projected)
+		}
+	}
+}
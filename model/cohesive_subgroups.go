@@ -0,0 +1,339 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+KPlexes enumerates the maximal k-plexes of an UndirectedGraph: the sets of nodes in which every node is adjacent to
+all but at most k of the members, itself included.
+
+Parameters:
+- g: The graph.
+- k: The number of missing ties allowed per member; 1-plexes are cliques.
+- minSize: The smallest size of a reported k-plex. With minSize >= 2k - 1 the k-plexes are connected.
+
+Returns:
+- plexes: The maximal k-plexes with at least minSize nodes, each sorted, in lexicographic order.
+- err: An error if k is smaller than 1.
+
+Description:
+Being a k-plex is hereditary, so the sets are enumerated by extending them with later nodes in increasing order,
+discarding nodes that cannot join, as Bron-Kerbosch does for cliques. Nodes of degree below minSize - k, which belong
+to no large enough k-plex, are discarded first. The running time is exponential in the worst case.
+*/
+func KPlexes(g *UndirectedGraph, k, minSize int) ([][]Node, error) {
+	if k < 1 {
+		return nil, fmt.Errorf("k must be at least 1, got %d", k)
+	}
+	nodes := sortedNodes(g.Nodes)
+	eligible := make(map[Node]bool, len(nodes))
+	for _, node := range nodes {
+		eligible[node] = true
+	}
+	// Peel the nodes that cannot have minSize - k neighbors in a k-plex
+	for changed := true; changed; {
+		changed = false
+		for _, node := range nodes {
+			if !eligible[node] {
+				continue
+			}
+			degree := 0
+			for _, neighbor := range g.Edges[node] {
+				if eligible[neighbor] && neighbor != node {
+					degree++
+				}
+			}
+			if degree < minSize-k {
+				eligible[node] = false
+				changed = true
+			}
+		}
+	}
+	var candidates []Node
+	for _, node := range nodes {
+		if eligible[node] {
+			candidates = append(candidates, node)
+		}
+	}
+
+	// canJoin checks if set plus node is a k-plex, given that set is one
+	canJoin := func(set []Node, node Node) bool {
+		missing := 1
+		for _, member := range set {
+			if !g.HasEdge(member, node) {
+				missing++
+				if missing > k {
+					return false
+				}
+				// The member loses a tie as well
+				memberMissing := 1
+				for _, other := range set {
+					if other != member && !g.HasEdge(member, other) {
+						memberMissing++
+					}
+				}
+				if memberMissing+1 > k {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	var plexes [][]Node
+	var extend func(set, candidates, excluded []Node)
+	extend = func(set, candidates, excluded []Node) {
+		if len(set)+len(candidates) < minSize {
+			return
+		}
+		if len(candidates) == 0 {
+			if len(excluded) == 0 {
+				plexes = append(plexes, append([]Node(nil), set...))
+			}
+			return
+		}
+		for i, candidate := range candidates {
+			next := append(append([]Node(nil), set...), candidate)
+			var nextCandidates, nextExcluded []Node
+			for _, other := range candidates[i+1:] {
+				if canJoin(next, other) {
+					nextCandidates = append(nextCandidates, other)
+				}
+			}
+			for _, other := range append(append([]Node(nil), excluded...), candidates[:i]...) {
+				if canJoin(next, other) {
+					nextExcluded = append(nextExcluded, other)
+				}
+			}
+			extend(next, nextCandidates, nextExcluded)
+		}
+	}
+	extend(nil, candidates, nil)
+	sortNodeSets(plexes)
+	return plexes, nil
+}
+
+/*
+NCliques enumerates the maximal n-cliques of an UndirectedGraph: the sets of nodes at distance at most n from each
+other in the whole graph.
+
+Parameters:
+- g: The graph.
+- n: The largest distance; 1-cliques are cliques.
+- minSize: The smallest size of a reported n-clique.
+
+Returns:
+- cliques: The maximal n-cliques with at least minSize nodes, each sorted, in lexicographic order.
+- err: An error if n is smaller than 1.
+
+Description:
+The n-cliques are the cliques of the n-th power of the graph, see GraphPower, found by the Bron-Kerbosch algorithm
+with pivoting. The paths may leave the n-clique, which can even induce a disconnected subgraph; see KClubs for
+subgroups whose own diameter is bounded.
+*/
+func NCliques(g *UndirectedGraph, n, minSize int) ([][]Node, error) {
+	power, err := GraphPower(g, n)
+	if err != nil {
+		return nil, err
+	}
+	var cliques [][]Node
+	for _, clique := range maximalCliques(power) {
+		if len(clique) >= minSize {
+			cliques = append(cliques, clique)
+		}
+	}
+	sortNodeSets(cliques)
+	return cliques, nil
+}
+
+/*
+KClubs enumerates the maximal k-clubs of an UndirectedGraph: the sets of nodes inducing a subgraph of diameter at most k.
+
+Parameters:
+- g: The graph.
+- k: The largest diameter; 1-clubs are cliques.
+- minSize: The smallest size of a reported k-club.
+
+Returns:
+- clubs: The maximal k-clubs with at least minSize nodes, each sorted, in lexicographic order.
+- err: An error if k is smaller than 1.
+
+Description:
+Every k-club is contained in a maximal k-clique, see NCliques. Within every k-clique, while two nodes are farther than
+k apart in the induced subgraph, the search branches on removing one or the other, since no k-club inside contains
+both. The running time is exponential in the worst case.
+*/
+func KClubs(g *UndirectedGraph, k, minSize int) ([][]Node, error) {
+	cliques, err := NCliques(g, k, minSize)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string][]Node)
+	visited := make(map[string]bool)
+	var search func(set []Node)
+	search = func(set []Node) {
+		key := fmt.Sprint(set)
+		if len(set) < minSize || visited[key] {
+			return
+		}
+		visited[key] = true
+		u, v, ok := farPair(g, set, k)
+		if !ok {
+			found[key] = set
+			return
+		}
+		for _, removed := range []Node{u, v} {
+			rest := make([]Node, 0, len(set)-1)
+			for _, node := range set {
+				if node != removed {
+					rest = append(rest, node)
+				}
+			}
+			search(rest)
+		}
+	}
+	for _, clique := range cliques {
+		search(clique)
+	}
+
+	// Keep the clubs contained in no other one
+	var clubs [][]Node
+	for key, club := range found {
+		maximal := true
+		for otherKey, other := range found {
+			if otherKey != key && len(other) > len(club) && isSubsetOf(club, other) {
+				maximal = false
+				break
+			}
+		}
+		if maximal {
+			clubs = append(clubs, club)
+		}
+	}
+	sortNodeSets(clubs)
+	return clubs, nil
+}
+
+// farPair returns two nodes of a sorted set farther than k apart in the subgraph it induces, if any.
+func farPair(g *UndirectedGraph, set []Node, k int) (Node, Node, bool) {
+	members := make(map[Node]bool, len(set))
+	for _, node := range set {
+		members[node] = true
+	}
+	for _, source := range set {
+		distances := map[Node]int{source: 0}
+		queue := []Node{source}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			if distances[node] == k {
+				continue
+			}
+			for _, neighbor := range g.Edges[node] {
+				if _, seen := distances[neighbor]; !seen && members[neighbor] {
+					distances[neighbor] = distances[node] + 1
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+		for _, target := range set {
+			if _, reached := distances[target]; !reached {
+				return source, target, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// isSubsetOf checks if every node of the sorted set a is in the sorted set b.
+func isSubsetOf(a, b []Node) bool {
+	j := 0
+	for _, node := range a {
+		for j < len(b) && b[j] < node {
+			j++
+		}
+		if j == len(b) || b[j] != node {
+			return false
+		}
+	}
+	return true
+}
+
+// MaximalCliques returns the maximal cliques of an UndirectedGraph, each sorted, in lexicographic order.
+func MaximalCliques(g *UndirectedGraph) [][]Node {
+	cliques := maximalCliques(g)
+	sortNodeSets(cliques)
+	return cliques
+}
+
+// maximalCliques returns the maximal cliques of an UndirectedGraph, each sorted, with the Bron-Kerbosch algorithm
+// pivoting on the node with the most neighbors among the candidates.
+func maximalCliques(g *UndirectedGraph) [][]Node {
+	var cliques [][]Node
+	var extend func(clique []Node, candidates, excluded map[Node]bool)
+	extend = func(clique []Node, candidates, excluded map[Node]bool) {
+		if len(candidates) == 0 {
+			if len(excluded) == 0 {
+				sorted := append([]Node(nil), clique...)
+				sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+				cliques = append(cliques, sorted)
+			}
+			return
+		}
+		pivot, best := Node(0), -1
+		for _, set := range []map[Node]bool{candidates, excluded} {
+			for node := range set {
+				count := 0
+				for _, neighbor := range g.Edges[node] {
+					if candidates[neighbor] {
+						count++
+					}
+				}
+				if count > best || (count == best && node < pivot) {
+					pivot, best = node, count
+				}
+			}
+		}
+		for _, node := range sortedNodes(candidates) {
+			if g.HasEdge(pivot, node) {
+				continue
+			}
+			nextCandidates, nextExcluded := make(map[Node]bool), make(map[Node]bool)
+			for _, neighbor := range g.Edges[node] {
+				if candidates[neighbor] {
+					nextCandidates[neighbor] = true
+				}
+				if excluded[neighbor] {
+					nextExcluded[neighbor] = true
+				}
+			}
+			delete(nextCandidates, node)
+			delete(nextExcluded, node)
+			extend(append(clique, node), nextCandidates, nextExcluded)
+			delete(candidates, node)
+			excluded[node] = true
+		}
+	}
+	candidates := make(map[Node]bool, len(g.Nodes))
+	for node := range g.Nodes {
+		candidates[node] = true
+	}
+	extend(nil, candidates, make(map[Node]bool))
+	return cliques
+}
+
+// sortNodeSets orders sorted sets of nodes lexicographically.
+func sortNodeSets(sets [][]Node) {
+	sort.Slice(sets, func(i, j int) bool {
+		a, b := sets[i], sets[j]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+}
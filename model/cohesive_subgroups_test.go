@@ -0,0 +1,149 @@
+package model
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// bruteForceMaximalSets returns the maximal subsets of nodes with at least minSize nodes that satisfy a property,
+// trying every subset.
+func bruteForceMaximalSets(nodes []Node, minSize int, property func(set []Node) bool) [][]Node {
+	var valid [][]Node
+	for mask := 1; mask < 1<<len(nodes); mask++ {
+		var set []Node
+		for i, node := range nodes {
+			if mask&(1<<i) != 0 {
+				set = append(set, node)
+			}
+		}
+		if property(set) {
+			valid = append(valid, set)
+		}
+	}
+	var maximal [][]Node
+	for _, set := range valid {
+		contained := false
+		for _, other := range valid {
+			if len(other) > len(set) && isSubsetOf(set, other) {
+				contained = true
+				break
+			}
+		}
+		if !contained && len(set) >= minSize {
+			maximal = append(maximal, set)
+		}
+	}
+	sortNodeSets(maximal)
+	return maximal
+}
+
+func TestCohesiveSubgroupsAgainstBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	for trial := 0; trial < 5; trial++ {
+		g := &UndirectedGraph{}
+		for i := 0; i < 9; i++ {
+			g.AddNode(Node(i))
+		}
+		for i := 0; i < 9; i++ {
+			for j := i + 1; j < 9; j++ {
+				if rng.Float64() < 0.35 {
+					g.AddEdge(Edge{Node1: Node(i), Node2: Node(j)})
+				}
+			}
+		}
+		nodes := sortedNodes(g.Nodes)
+		distances := make(map[Node]map[Node]int)
+		for _, node := range nodes {
+			distances[node] = bfsDistances(g, node)
+		}
+
+		for k := 1; k <= 3; k++ {
+			isPlex := func(set []Node) bool {
+				for _, u := range set {
+					missing := 0
+					for _, v := range set {
+						if !g.HasEdge(u, v) {
+							missing++
+						}
+					}
+					if missing > k {
+						return false
+					}
+				}
+				return true
+			}
+			plexes, err := KPlexes(g, k, 3)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if expected := bruteForceMaximalSets(nodes, 3, isPlex); !reflect.DeepEqual(plexes, expected) {
+				t.Errorf("Trial %d, %d-plexes: Expected %v, but got %v", trial, k, expected, plexes)
+			}
+		}
+
+		for n := 1; n <= 2; n++ {
+			isNClique := func(set []Node) bool {
+				for _, u := range set {
+					for _, v := range set {
+						if d, ok := distances[u][v]; !ok || d > n {
+							return false
+						}
+					}
+				}
+				return true
+			}
+			cliques, err := NCliques(g, n, 2)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if expected := bruteForceMaximalSets(nodes, 2, isNClique); !reflect.DeepEqual(cliques, expected) {
+				t.Errorf("Trial %d, %d-cliques: Expected %v, but got %v", trial, n, expected, cliques)
+			}
+
+			isClub := func(set []Node) bool {
+				_, _, far := farPair(g, set, n)
+				return !far
+			}
+			clubs, err := KClubs(g, n, 2)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if expected := bruteForceMaximalSets(nodes, 2, isClub); !reflect.DeepEqual(clubs, expected) {
+				t.Errorf("Trial %d, %d-clubs: Expected %v, but got %v", trial, n, expected, clubs)
+			}
+		}
+	}
+}
+
+func TestNCliquesAndKClubs(t *testing.T) {
+	g := PathGraph(5)
+	cliques, err := NCliques(g, 2, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := [][]Node{{0, 1, 2}, {1, 2, 3}, {2, 3, 4}}; !reflect.DeepEqual(cliques, expected) {
+		t.Errorf("Expected %v, but got %v", expected, cliques)
+	}
+
+	// A 5-cycle with a pendant node 5 on 0: both maximal 2-cliques have diameter 2
+	cycle := CycleGraph(5)
+	cycle.AddEdge(Edge{Node1: 0, Node2: 5})
+	clubs, err := KClubs(cycle, 2, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := [][]Node{{0, 1, 2, 3, 4}, {0, 1, 4, 5}}; !reflect.DeepEqual(clubs, expected) {
+		t.Errorf("Expected %v, but got %v", expected, clubs)
+	}
+
+	for _, err := range []error{
+		func() error { _, err := KPlexes(g, 0, 1); return err }(),
+		func() error { _, err := NCliques(g, 0, 1); return err }(),
+		func() error { _, err := KClubs(g, 0, 1); return err }(),
+	} {
+		if err == nil {
+			t.Errorf("Expected an error for a parameter of 0")
+		}
+	}
+}
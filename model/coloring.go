@@ -0,0 +1,190 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ColoringStrategy selects the order in which GreedyColoring colors the nodes.
+type ColoringStrategy int
+
+const (
+	// AscendingOrder colors the nodes in ascending order.
+	AscendingOrder ColoringStrategy = iota
+	// LargestFirst colors the nodes by decreasing degree, as in the Welsh-Powell algorithm.
+	LargestFirst
+	// SmallestLast colors the nodes in the reverse of the order in which nodes of smallest degree are removed from
+	// the graph, which uses at most d + 1 colors for a graph of degeneracy d.
+	SmallestLast
+	// ConnectedSequential colors the nodes in breadth-first order from the smallest node of every connected component,
+	// so that every node but the first of its component has a colored neighbor.
+	ConnectedSequential
+	// DSatur colors next the node with the most distinct colors among its neighbors, ties broken by the largest degree,
+	// which colors bipartite graphs optimally.
+	DSatur
+)
+
+/*
+GreedyColoring colors the nodes of a graph so that adjacent nodes get different colors, for instance to schedule
+exams sharing students in different slots.
+
+Parameters:
+- g: The graph.
+- strategy: The order in which the nodes are colored.
+
+Returns:
+- colors: The color of every node, from 0. Every node gets the smallest color not used by its colored neighbors.
+- err: An error if g has a self-loop, which no coloring can handle, or the strategy is unknown.
+
+Description:
+The number of colors is at most one more than the largest degree, but can be far above the chromatic number, which is
+hard to compute; the strategies trade a little time for fewer colors. Ties are broken by ascending node, so that the
+coloring is deterministic. All strategies but DSatur take O(n + m) time after sorting, and DSatur O(n² + m).
+
+Reference: D. W. Matula and L. L. Beck, "Smallest-last ordering and clustering and graph coloring algorithms",
+J. ACM 30(3), 1983; D. Brélaz, "New methods to color the vertices of a graph", Commun. ACM 22(4), 1979.
+*/
+func GreedyColoring(g *UndirectedGraph, strategy ColoringStrategy) (map[Node]int, error) {
+	for node := range g.Nodes {
+		if g.HasEdge(node, node) {
+			return nil, fmt.Errorf("node %d has a self-loop and cannot be colored", node)
+		}
+	}
+	var order []Node
+	switch strategy {
+	case AscendingOrder:
+		order = sortedNodes(g.Nodes)
+	case LargestFirst:
+		order = sortedNodes(g.Nodes)
+		sort.SliceStable(order, func(i, j int) bool { return g.NodeDegree(order[i]) > g.NodeDegree(order[j]) })
+	case SmallestLast:
+		order = smallestLastOrdering(g)
+	case ConnectedSequential:
+		visited := make(map[Node]bool, len(g.Nodes))
+		for _, start := range sortedNodes(g.Nodes) {
+			if visited[start] {
+				continue
+			}
+			visited[start] = true
+			for queue := []Node{start}; len(queue) > 0; queue = queue[1:] {
+				order = append(order, queue[0])
+				for _, neighbor := range g.Edges[queue[0]] {
+					if !visited[neighbor] {
+						visited[neighbor] = true
+						queue = append(queue, neighbor)
+					}
+				}
+			}
+		}
+	case DSatur:
+		return dsaturColoring(g), nil
+	default:
+		return nil, fmt.Errorf("unknown coloring strategy %d", strategy)
+	}
+
+	colors := make(map[Node]int, len(g.Nodes))
+	for _, node := range order {
+		colors[node] = smallestFreeColor(g, colors, node)
+	}
+	return colors, nil
+}
+
+// IsProperColoring reports whether every node of g has a color and adjacent nodes have different colors.
+func IsProperColoring(g *UndirectedGraph, colors map[Node]int) bool {
+	for node := range g.Nodes {
+		color, ok := colors[node]
+		if !ok {
+			return false
+		}
+		for _, neighbor := range g.Edges[node] {
+			if other, ok := colors[neighbor]; ok && other == color {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// smallestFreeColor returns the smallest color not used by the colored neighbors of a node.
+func smallestFreeColor(g *UndirectedGraph, colors map[Node]int, node Node) int {
+	used := make(map[int]bool, len(g.Edges[node]))
+	for _, neighbor := range g.Edges[node] {
+		if color, ok := colors[neighbor]; ok {
+			used[color] = true
+		}
+	}
+	color := 0
+	for used[color] {
+		color++
+	}
+	return color
+}
+
+// smallestLastOrdering repeatedly removes a node of smallest remaining degree and returns the nodes in the reverse order
+// of their removal, using bucket queues in O(n + m) time.
+func smallestLastOrdering(g *UndirectedGraph) []Node {
+	nodes := sortedNodes(g.Nodes)
+	degrees := make(map[Node]int, len(nodes))
+	maxDegree := 0
+	for _, node := range nodes {
+		degrees[node] = g.NodeDegree(node)
+		maxDegree = max(maxDegree, degrees[node])
+	}
+	// buckets[d] holds the nodes of remaining degree d, possibly with stale entries skipped when popped
+	buckets := make([][]Node, maxDegree+1)
+	for i := len(nodes) - 1; i >= 0; i-- {
+		buckets[degrees[nodes[i]]] = append(buckets[degrees[nodes[i]]], nodes[i])
+	}
+	removed := make(map[Node]bool, len(nodes))
+	order := make([]Node, len(nodes))
+	for position, low := len(nodes)-1, 0; position >= 0; {
+		if len(buckets[low]) == 0 {
+			low++
+			continue
+		}
+		node := buckets[low][len(buckets[low])-1]
+		buckets[low] = buckets[low][:len(buckets[low])-1]
+		if removed[node] || degrees[node] != low {
+			continue
+		}
+		removed[node] = true
+		order[position] = node
+		position--
+		for _, neighbor := range g.Edges[node] {
+			if !removed[neighbor] {
+				degrees[neighbor]--
+				buckets[degrees[neighbor]] = append(buckets[degrees[neighbor]], neighbor)
+				low = min(low, degrees[neighbor])
+			}
+		}
+	}
+	return order
+}
+
+// dsaturColoring colors the nodes by decreasing saturation, see DSatur.
+func dsaturColoring(g *UndirectedGraph) map[Node]int {
+	nodes := sortedNodes(g.Nodes)
+	colors := make(map[Node]int, len(nodes))
+	neighborColors := make(map[Node]map[int]bool, len(nodes))
+	for _, node := range nodes {
+		neighborColors[node] = make(map[int]bool)
+	}
+	for range nodes {
+		best, found := Node(0), false
+		for _, node := range nodes {
+			if _, colored := colors[node]; colored {
+				continue
+			}
+			if !found || len(neighborColors[node]) > len(neighborColors[best]) ||
+				len(neighborColors[node]) == len(neighborColors[best]) && g.NodeDegree(node) > g.NodeDegree(best) {
+				best, found = node, true
+			}
+		}
+		color := smallestFreeColor(g, colors, best)
+		colors[best] = color
+		for _, neighbor := range g.Edges[best] {
+			neighborColors[neighbor][color] = true
+		}
+	}
+	return colors
+}
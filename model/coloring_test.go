@@ -0,0 +1,60 @@
+package model
+
+import "testing"
+
+func TestGreedyColoring(t *testing.T) {
+	g, err := GnpRandomGraph(200, 0.05, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	degeneracy := 0
+	for _, core := range CoreNumbers(g) {
+		degeneracy = max(degeneracy, core)
+	}
+	for _, strategy := range []ColoringStrategy{AscendingOrder, LargestFirst, SmallestLast, ConnectedSequential, DSatur} {
+		colors, err := GreedyColoring(g, strategy)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !IsProperColoring(g, colors) {
+			t.Errorf("Expected a proper coloring with strategy %d", strategy)
+		}
+		if strategy == SmallestLast && numberOfColors(colors) > degeneracy+1 {
+			t.Errorf("Expected at most %d colors, but got %d", degeneracy+1, numberOfColors(colors))
+		}
+	}
+
+	// Ascending order needs 4 colors on this bipartite crown graph, DSatur only 2
+	crown := &UndirectedGraph{}
+	crown.AddEdgesFromIntTupleList([][2]int{{0, 3}, {0, 5}, {0, 7}, {2, 1}, {2, 5}, {2, 7}, {4, 1}, {4, 3}, {4, 7}, {6, 1}, {6, 3}, {6, 5}})
+	colors, _ := GreedyColoring(crown, AscendingOrder)
+	if numberOfColors(colors) != 4 {
+		t.Errorf("Expected 4 colors in ascending order, but got %v", colors)
+	}
+	colors, _ = GreedyColoring(crown, DSatur)
+	if numberOfColors(colors) != 2 || !IsProperColoring(crown, colors) {
+		t.Errorf("Expected 2 colors with DSatur, but got %v", colors)
+	}
+	colors, _ = GreedyColoring(WheelGraph(7), SmallestLast)
+	if numberOfColors(colors) != 3 || !IsProperColoring(WheelGraph(7), colors) {
+		t.Errorf("Expected 3 colors for a wheel with an even rim, but got %v", colors)
+	}
+
+	loop := PathGraph(2)
+	loop.AddEdge(Edge{Node1: 1, Node2: 1})
+	if _, err := GreedyColoring(loop, DSatur); err == nil {
+		t.Errorf("Expected an error for a self-loop")
+	}
+	if _, err := GreedyColoring(g, ColoringStrategy(42)); err == nil {
+		t.Errorf("Expected an error for an unknown strategy")
+	}
+}
+
+// numberOfColors returns the number of distinct colors of a coloring.
+func numberOfColors(colors map[Node]int) int {
+	distinct := make(map[int]bool)
+	for _, color := range colors {
+		distinct[color] = true
+	}
+	return len(distinct)
+}
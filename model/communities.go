@@ -1,5 +1,309 @@
 package model
 
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
 // some common partitioning algorithms:
 // - https://patterns.eecs.berkeley.edu/?page_id=571#1_Find_a_representation_model
 // - https://networkx.org/documentation/stable/reference/algorithms/community.html
+
+/*
+CliquePercolationCommunities finds overlapping communities with the clique percolation method (CPM).
+
+Parameters:
+- g: The graph.
+- k: The size of the cliques that percolate, at least 2. With k = 2 the communities are the connected components with an edge.
+
+Returns:
+- communities: The communities, each sorted, in lexicographic order. A community is the union of the k-cliques
+reachable from one another through k-cliques sharing k - 1 nodes.
+- memberships: The indices in communities of the communities of every node, in increasing order. Nodes in no
+k-clique have none.
+- err: An error if k is smaller than 2.
+
+Description:
+Two k-cliques are adjacent when they share k - 1 nodes, which happens exactly when the maximal cliques containing
+them share at least k - 1 nodes. The maximal cliques of at least k nodes, see MaximalCliques, are therefore merged
+with a union-find whenever they overlap on k - 1 nodes, in O(c^2 k) time for c maximal cliques.
+
+Reference: G. Palla, I. Derényi, I. Farkas and T. Vicsek, "Uncovering the overlapping community structure of complex
+networks in nature and society", Nature 435, 2005.
+*/
+func CliquePercolationCommunities(g *UndirectedGraph, k int) ([][]Node, map[Node][]int, error) {
+	if k < 2 {
+		return nil, nil, fmt.Errorf("the clique size must be at least 2, got %d", k)
+	}
+	var cliques [][]Node
+	for _, clique := range MaximalCliques(g) {
+		if len(clique) >= k {
+			cliques = append(cliques, clique)
+		}
+	}
+
+	parent := make([]int, len(cliques))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := range cliques {
+		for j := i + 1; j < len(cliques); j++ {
+			if find(i) != find(j) && sharedNodes(cliques[i], cliques[j]) >= k-1 {
+				parent[find(i)] = find(j)
+			}
+		}
+	}
+
+	members := make(map[int]map[Node]bool)
+	for i, clique := range cliques {
+		root := find(i)
+		if members[root] == nil {
+			members[root] = make(map[Node]bool)
+		}
+		for _, node := range clique {
+			members[root][node] = true
+		}
+	}
+	communities := make([][]Node, 0, len(members))
+	for _, nodes := range members {
+		communities = append(communities, sortedNodes(nodes))
+	}
+	sortNodeSets(communities)
+
+	memberships := make(map[Node][]int)
+	for i, community := range communities {
+		for _, node := range community {
+			memberships[node] = append(memberships[node], i)
+		}
+	}
+	return communities, memberships, nil
+}
+
+// CommunityDetector partitions the nodes of a weighted graph into communities and returns the community label of every
+// node. It must draw all its randomness from rng.
+type CommunityDetector func(g *UndirectedGraph, weight WeightFunc, rng *rand.Rand) map[Node]int
+
+/*
+LabelPropagationCommunities partitions the nodes of a weighted UndirectedGraph by asynchronous label propagation. It is
+a CommunityDetector.
+
+Parameters:
+- g: The graph.
+- weight: The strength of every edge; use UnitWeight for an unweighted graph.
+- rng: The source of randomness.
+
+Returns:
+- labels: The community of every node, numbered from 0 in increasing order of the smallest node of the communities.
+
+Description:
+Every node starts in its own community. In passes over the nodes in random order, a node joins the community with the
+largest total weight among its neighbors, ties being broken at random, unless its community is already one of them.
+The propagation stops after a pass without changes, or after 100 passes. Every pass takes O(n + m) time, and a few
+passes usually suffice, but the result varies from run to run; see ConsensusClustering.
+
+Reference: U. N. Raghavan, R. Albert and S. Kumara, "Near linear time algorithm to detect community structures in
+large-scale networks", Phys. Rev. E 76, 036106, 2007.
+*/
+func LabelPropagationCommunities(g *UndirectedGraph, weight WeightFunc, rng *rand.Rand) map[Node]int {
+	nodes := sortedNodes(g.Nodes)
+	labels := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		labels[node] = i
+	}
+	for pass := 0; pass < 100; pass++ {
+		changed := false
+		for _, i := range rng.Perm(len(nodes)) {
+			node := nodes[i]
+			totals := make(map[int]float64)
+			for _, neighbor := range g.Edges[node] {
+				if neighbor != node {
+					totals[labels[neighbor]] += weight(node, neighbor)
+				}
+			}
+			best := math.Inf(-1)
+			for _, total := range totals {
+				best = math.Max(best, total)
+			}
+			var dominant []int
+			keep := false
+			for label, total := range totals {
+				if total >= best-1e-12*math.Abs(best) {
+					dominant = append(dominant, label)
+					keep = keep || label == labels[node]
+				}
+			}
+			if len(dominant) == 0 || keep {
+				continue
+			}
+			sort.Ints(dominant)
+			labels[node] = dominant[rng.Intn(len(dominant))]
+			changed = true
+		}
+		if !changed {
+			break
+		}
+	}
+	return renumberCommunities(nodes, labels)
+}
+
+// ConsensusPartition is a partition of the nodes of a graph agreed on by several runs of a community detection.
+type ConsensusPartition struct {
+	// Communities holds the nodes of every community, sorted, the communities being ordered by their smallest node.
+	Communities [][]Node
+	// Community gives the index in Communities of the community of every node.
+	Community map[Node]int
+	// Confidence gives, for every node, the fraction of the runs on the original graph that put it together with the
+	// other members of its community, on average over them. Nodes alone in their community have 1.
+	Confidence map[Node]float64
+	// Rounds is the number of rounds of runs performed.
+	Rounds int
+}
+
+/*
+ConsensusClustering aggregates several runs of a stochastic community detection into a stable partition.
+
+Parameters:
+- g: The graph.
+- weight: The strength of every edge; use UnitWeight for an unweighted graph.
+- detector: The community detection, such as LabelPropagationCommunities.
+- runs: The number of runs per round, at least 1.
+- threshold: The fraction of the runs, in (0, 1], below which two adjacent nodes are no longer linked; 0.5 is a good default.
+- maxRounds: The largest number of rounds, at least 1.
+- seed: The seed of the random number generator.
+
+Returns:
+- partition: The consensus communities and the confidence of every node in its community.
+- err: An error if a parameter is out of range.
+
+Description:
+Every round runs the detector several times and weighs every edge by the fraction of the runs that put its nodes in
+the same community. Edges below the threshold are dropped, and the next round runs the detector on this consensus
+graph, until all the runs agree on every remaining edge or maxRounds is reached. The communities are then the
+connected components of the edges kept by a majority of the runs of the last round.
+
+Reference: A. Lancichinetti and S. Fortunato, "Consensus clustering in complex networks", Scientific Reports 2, 336, 2012.
+*/
+func ConsensusClustering(g *UndirectedGraph, weight WeightFunc, detector CommunityDetector, runs int, threshold float64, maxRounds int, seed int64) (*ConsensusPartition, error) {
+	if runs < 1 || maxRounds < 1 || threshold <= 0 || threshold > 1 {
+		return nil, fmt.Errorf("the runs and rounds must be positive and the threshold in (0, 1], got %d, %d and %v", runs, maxRounds, threshold)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	nodes := sortedNodes(g.Nodes)
+	current, currentWeight := g, weight
+	var firstRuns []map[Node]int
+	var consensus *WeightedUndirectedGraph
+	rounds := 0
+	for rounds < maxRounds {
+		rounds++
+		labelings := make([]map[Node]int, runs)
+		for r := range labelings {
+			labelings[r] = detector(current, currentWeight, rng)
+		}
+		if firstRuns == nil {
+			firstRuns = labelings
+		}
+
+		consensus = NewWeightedUndirectedGraph()
+		consensus.Graph.AddNodes(nodes)
+		unanimous := true
+		for _, edge := range sortedEdgeList(current) {
+			if edge.Node1 == edge.Node2 {
+				continue
+			}
+			agreement := coAssignment(labelings, edge.Node1, edge.Node2)
+			if agreement > 0 && agreement < 1 {
+				unanimous = false
+			}
+			if agreement >= threshold {
+				consensus.AddWeightedEdge(edge, agreement)
+			}
+		}
+		current, currentWeight = consensus.Graph, consensus.EdgeWeight
+		if unanimous {
+			break
+		}
+	}
+
+	majority := &UndirectedGraph{Nodes: make(map[Node]bool, len(nodes)), Edges: make(map[Node][]Node)}
+	majority.AddNodes(nodes)
+	for edge, agreement := range consensus.Weights {
+		if agreement >= 0.5 {
+			majority.AddEdge(edge)
+		}
+	}
+	partition := &ConsensusPartition{Community: make(map[Node]int, len(nodes)), Confidence: make(map[Node]float64, len(nodes)), Rounds: rounds}
+	for _, node := range nodes {
+		if _, seen := partition.Community[node]; seen {
+			continue
+		}
+		component := make(map[Node]bool)
+		for member := range bfsDistances(majority, node) {
+			component[member] = true
+			partition.Community[member] = len(partition.Communities)
+		}
+		partition.Communities = append(partition.Communities, sortedNodes(component))
+	}
+	for _, community := range partition.Communities {
+		for _, node := range community {
+			if len(community) == 1 {
+				partition.Confidence[node] = 1
+				continue
+			}
+			total := 0.0
+			for _, other := range community {
+				if other != node {
+					total += coAssignment(firstRuns, node, other)
+				}
+			}
+			partition.Confidence[node] = total / float64(len(community)-1)
+		}
+	}
+	return partition, nil
+}
+
+// coAssignment returns the fraction of the labelings that put u and v in the same community.
+func coAssignment(labelings []map[Node]int, u, v Node) float64 {
+	together := 0
+	for _, labels := range labelings {
+		if labels[u] == labels[v] {
+			together++
+		}
+	}
+	return float64(together) / float64(len(labelings))
+}
+
+// renumberCommunities numbers the communities of sorted nodes from 0 in the order of their smallest node.
+func renumberCommunities(nodes []Node, labels map[Node]int) map[Node]int {
+	numbers := make(map[int]int)
+	renumbered := make(map[Node]int, len(nodes))
+	for _, node := range nodes {
+		if _, ok := numbers[labels[node]]; !ok {
+			numbers[labels[node]] = len(numbers)
+		}
+		renumbered[node] = numbers[labels[node]]
+	}
+	return renumbered
+}
+
+// sharedNodes counts the nodes common to two sorted sets.
+func sharedNodes(a, b []Node) int {
+	count, j := 0, 0
+	for _, node := range a {
+		for j < len(b) && b[j] < node {
+			j++
+		}
+		if j < len(b) && b[j] == node {
+			count++
+		}
+	}
+	return count
+}
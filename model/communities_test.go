@@ -0,0 +1,124 @@
+package model
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestCliquePercolationCommunities(t *testing.T) {
+	// Two K4 sharing node 3, a triangle sharing an edge with the second one, and a pendant node
+	g := CompleteGraph(4)
+	for _, edge := range [][2]int{{3, 4}, {3, 5}, {3, 6}, {4, 5}, {4, 6}, {5, 6}, {5, 7}, {6, 7}, {7, 8}} {
+		g.AddEdge(Edge{Node1: Node(edge[0]), Node2: Node(edge[1])})
+	}
+
+	testCases := []struct {
+		name        string
+		k           int
+		communities [][]Node
+		memberships map[Node][]int
+	}{
+		{"k = 2", 2, [][]Node{{0, 1, 2, 3, 4, 5, 6, 7, 8}}, nil},
+		// The triangle 5-6-7 shares the edge 5-6 with the second K4
+		{"k = 3", 3, [][]Node{{0, 1, 2, 3}, {3, 4, 5, 6, 7}}, map[Node][]int{3: {0, 1}, 7: {1}, 8: nil}},
+		{"k = 4", 4, [][]Node{{0, 1, 2, 3}, {3, 4, 5, 6}}, map[Node][]int{3: {0, 1}, 7: nil}},
+		{"k = 5", 5, [][]Node{}, map[Node][]int{0: nil}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			communities, memberships, err := CliquePercolationCommunities(g, testCase.k)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(communities, testCase.communities) {
+				t.Errorf("Expected %v, but got %v", testCase.communities, communities)
+			}
+			for node, expected := range testCase.memberships {
+				if !reflect.DeepEqual(memberships[node], expected) {
+					t.Errorf("Expected memberships %v for %d, but got %v", expected, node, memberships[node])
+				}
+			}
+		})
+	}
+
+	if _, _, err := CliquePercolationCommunities(g, 1); err == nil {
+		t.Errorf("Expected an error for k = 1")
+	}
+}
+
+// ringOfCliques returns count cliques of size nodes, numbered in turn, each linked to the next by one edge.
+func ringOfCliques(count, size int) *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	for c := 0; c < count; c++ {
+		for i := 0; i < size; i++ {
+			for j := i + 1; j < size; j++ {
+				g.AddEdge(Edge{Node1: Node(c*size + i), Node2: Node(c*size + j)})
+			}
+		}
+		g.AddEdge(Edge{Node1: Node(c*size + size - 1), Node2: Node((c + 1) % count * size)})
+	}
+	return g
+}
+
+func TestLabelPropagationCommunities(t *testing.T) {
+	g := ringOfCliques(2, 5)
+	g.AddNode(10)
+	labels := LabelPropagationCommunities(g, UnitWeight, rand.New(rand.NewSource(1)))
+	for node := Node(1); node < 5; node++ {
+		if labels[node] != labels[0] || labels[node+5] != labels[5] {
+			t.Errorf("Expected the cliques in one community each, but got %v", labels)
+		}
+	}
+	if labels[0] != 0 || labels[10] == labels[0] || labels[10] == labels[5] {
+		t.Errorf("Expected the isolated node alone and the communities numbered from 0, but got %v", labels)
+	}
+}
+
+func TestConsensusClustering(t *testing.T) {
+	g := ringOfCliques(6, 5)
+	partition, err := ConsensusClustering(g, UnitWeight, LabelPropagationCommunities, 20, 0.5, 10, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(partition.Communities) != 6 {
+		t.Fatalf("Expected the 6 cliques, but got %v", partition.Communities)
+	}
+	for c, community := range partition.Communities {
+		expected := []Node{Node(5 * c), Node(5*c + 1), Node(5*c + 2), Node(5*c + 3), Node(5*c + 4)}
+		if !reflect.DeepEqual(community, expected) {
+			t.Errorf("Expected %v, but got %v", expected, community)
+		}
+		for _, node := range community {
+			if partition.Community[node] != c || partition.Confidence[node] <= 0 || partition.Confidence[node] > 1 {
+				t.Errorf("Expected node %d in community %d with a confidence in (0, 1], but got %d and %v",
+					node, c, partition.Community[node], partition.Confidence[node])
+			}
+		}
+	}
+
+	again, _ := ConsensusClustering(g, UnitWeight, LabelPropagationCommunities, 20, 0.5, 10, 3)
+	if !reflect.DeepEqual(again, partition) {
+		t.Errorf("Expected the same partition for the same seed")
+	}
+
+	// A detector that always agrees converges in one round with full confidence
+	halves := func(g *UndirectedGraph, weight WeightFunc, rng *rand.Rand) map[Node]int {
+		labels := make(map[Node]int)
+		for node := range g.Nodes {
+			labels[node] = int(node) / 15
+		}
+		return labels
+	}
+	partition, err = ConsensusClustering(g, UnitWeight, halves, 5, 0.5, 10, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if partition.Rounds != 1 || len(partition.Communities) != 2 || partition.Confidence[0] != 1 {
+		t.Errorf("Expected two communities in one round with confidence 1, but got %+v", partition)
+	}
+
+	if _, err := ConsensusClustering(g, UnitWeight, halves, 0, 0.5, 10, 1); err == nil {
+		t.Errorf("Expected an error for zero runs")
+	}
+}
@@ -0,0 +1,150 @@
+package model
+
+import "sort"
+
+// ConnectedComponentSets returns the nodes of every connected component of an UndirectedGraph, each sorted, the
+// components being ordered by their smallest node. See ConnectedComponents for the components as subgraphs.
+func ConnectedComponentSets(g *UndirectedGraph) [][]Node {
+	var components [][]Node
+	seen := make(map[Node]bool, len(g.Nodes))
+	for _, node := range sortedNodes(g.Nodes) {
+		if seen[node] {
+			continue
+		}
+		component := make(map[Node]bool)
+		for member := range bfsDistances(g, node) {
+			component[member] = true
+			seen[member] = true
+		}
+		components = append(components, sortedNodes(component))
+	}
+	return components
+}
+
+// IsConnected checks if every node of an UndirectedGraph can be reached from every other one. The graph without nodes
+// is not connected.
+func IsConnected(g *UndirectedGraph) bool {
+	for node := range g.Nodes {
+		return len(bfsDistances(g, node)) == len(g.Nodes)
+	}
+	return false
+}
+
+/*
+StronglyConnectedComponents returns the strongly connected components of a DirectedGraph: the maximal sets of nodes
+that can all reach one another along the edges.
+
+Parameters:
+- g: The directed graph.
+
+Returns:
+- components: The nodes of every component, sorted, the components being ordered by their smallest node. Nodes on no
+cycle form components of their own.
+
+Description:
+The components are found with an iterative version of Tarjan's algorithm in O(n + m) time, so that long paths do not
+exhaust the stack. Contracting every component into a node gives a DAG, see FlowHierarchy.
+
+Reference: R. Tarjan, "Depth-first search and linear graph algorithms", SIAM Journal on Computing 1(2), 1972.
+*/
+func StronglyConnectedComponents(g *DirectedGraph) [][]Node {
+	return componentSets(g.Nodes, stronglyConnectedComponents(g))
+}
+
+// IsStronglyConnected checks if every node of a DirectedGraph can reach every other one. The graph without nodes is
+// not strongly connected.
+func IsStronglyConnected(g *DirectedGraph) bool {
+	return len(g.Nodes) > 0 && len(StronglyConnectedComponents(g)) == 1
+}
+
+// WeaklyConnectedComponents returns the connected components of a DirectedGraph ignoring the direction of its edges,
+// ordered as by ConnectedComponentSets.
+func WeaklyConnectedComponents(g *DirectedGraph) [][]Node {
+	return ConnectedComponentSets(g.ToUndirected())
+}
+
+// componentSets groups nodes by component label into sorted sets ordered by their smallest node.
+func componentSets(nodes map[Node]bool, labels map[Node]int) [][]Node {
+	byLabel := make(map[int][]Node)
+	for _, node := range sortedNodes(nodes) {
+		byLabel[labels[node]] = append(byLabel[labels[node]], node)
+	}
+	components := make([][]Node, 0, len(byLabel))
+	for _, component := range byLabel {
+		components = append(components, component)
+	}
+	sort.Slice(components, func(i, j int) bool { return components[i][0] < components[j][0] })
+	return components
+}
+
+// stronglyConnectedComponents labels every node of a directed graph with the index of its strongly connected
+// component, using an iterative version of Tarjan's algorithm. Components are numbered in the order of their smallest node.
+func stronglyConnectedComponents(g *DirectedGraph) map[Node]int {
+	type frame struct {
+		node Node
+		next int
+	}
+	index := make(map[Node]int, len(g.Nodes))
+	low := make(map[Node]int, len(g.Nodes))
+	onStack := make(map[Node]bool)
+	components := make(map[Node]int, len(g.Nodes))
+	var stack []Node
+	visit := func(node Node) {
+		index[node], low[node] = len(index), len(index)
+		stack = append(stack, node)
+		onStack[node] = true
+	}
+
+	for _, root := range sortedNodes(g.Nodes) {
+		if _, seen := index[root]; seen {
+			continue
+		}
+		visit(root)
+		calls := []frame{{node: root}}
+		for len(calls) > 0 {
+			top := &calls[len(calls)-1]
+			if successors := g.Successors[top.node]; top.next < len(successors) {
+				successor := successors[top.next]
+				top.next++
+				if _, seen := index[successor]; !seen {
+					visit(successor)
+					calls = append(calls, frame{node: successor})
+				} else if onStack[successor] {
+					low[top.node] = min(low[top.node], index[successor])
+				}
+				continue
+			}
+
+			node := top.node
+			calls = calls[:len(calls)-1]
+			if len(calls) > 0 {
+				parent := calls[len(calls)-1].node
+				low[parent] = min(low[parent], low[node])
+			}
+			if low[node] == index[node] {
+				id := len(components)
+				for {
+					member := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[member] = false
+					components[member] = id
+					if member == node {
+						break
+					}
+				}
+			}
+		}
+	}
+
+	// Renumber the components consecutively
+	ids := make(map[int]int)
+	for _, node := range sortedNodes(g.Nodes) {
+		if _, ok := ids[components[node]]; !ok {
+			ids[components[node]] = len(ids)
+		}
+	}
+	for node, id := range components {
+		components[node] = ids[id]
+	}
+	return components
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConnectedComponentSets(t *testing.T) {
+	g := PathGraph(3)
+	g.AddEdge(Edge{Node1: 7, Node2: 5})
+	g.AddNode(4)
+	expected := [][]Node{{0, 1, 2}, {4}, {5, 7}}
+	if components := ConnectedComponentSets(g); !reflect.DeepEqual(components, expected) {
+		t.Errorf("Expected %v, but got %v", expected, components)
+	}
+
+	testCases := []struct {
+		name      string
+		graph     *UndirectedGraph
+		connected bool
+	}{
+		{"path", PathGraph(5), true},
+		{"single node", TrivialGraph(), true},
+		{"two components", g, false},
+		{"no nodes", NullGraph(), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if connected := IsConnected(tc.graph); connected != tc.connected {
+				t.Errorf("Expected %v, but got %v", tc.connected, connected)
+			}
+		})
+	}
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	// A 3-cycle feeding a 2-cycle, and a node reached from the 2-cycle only
+	g := directedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 3}, {4, 5}})
+	expected := [][]Node{{0, 1, 2}, {3, 4}, {5}}
+	if components := StronglyConnectedComponents(g); !reflect.DeepEqual(components, expected) {
+		t.Errorf("Expected %v, but got %v", expected, components)
+	}
+	if IsStronglyConnected(g) || !IsStronglyConnected(DirectedCycleGraph(6)) {
+		t.Errorf("Expected only the cycle to be strongly connected")
+	}
+	if components := WeaklyConnectedComponents(g); !reflect.DeepEqual(components, [][]Node{{0, 1, 2, 3, 4, 5}}) {
+		t.Errorf("Expected one weak component, but got %v", components)
+	}
+
+	// A long path must not exhaust the stack
+	path := DirectedPathGraph(100000)
+	if components := StronglyConnectedComponents(path); len(components) != 100000 {
+		t.Errorf("Expected 100000 components, but got %d", len(components))
+	}
+}
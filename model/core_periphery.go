@@ -0,0 +1,104 @@
+package model
+
+import (
+	"math"
+	"sort"
+)
+
+// CorePeriphery is a partition of the nodes into a densely connected core and a periphery.
+type CorePeriphery struct {
+	Core        map[Node]bool
+	Correlation float64
+}
+
+/*
+CorePeripheryFit fits the discrete Borgatti-Everett core-periphery model to a graph.
+
+Parameters:
+- g: The graph.
+
+Returns:
+- fit: The core nodes and the Pearson correlation between the adjacency matrix and the ideal pattern of the partition,
+in which every pair of nodes with at least one core node is linked and no pair of periphery nodes is.
+
+Description:
+The search starts from the best prefix of the nodes sorted by decreasing degree, then moves single nodes between the
+core and the periphery while the correlation improves. The result is a local optimum of the correlation.
+*/
+func CorePeripheryFit(g *UndirectedGraph) *CorePeriphery {
+	nodes := sortedNodes(g.Nodes)
+	sort.SliceStable(nodes, func(i, j int) bool { return g.NodeDegree(nodes[i]) > g.NodeDegree(nodes[j]) })
+	edges := g.NumberOfEdges()
+
+	// peripheryEdges is the number of edges between periphery nodes
+	core := make(map[Node]bool, len(nodes))
+	peripheryEdges := edges
+	bestSize, bestCorrelation := 0, math.Inf(-1)
+	for size := 1; size <= len(nodes); size++ {
+		node := nodes[size-1]
+		peripheryEdges -= peripheryNeighbors(g, node, core)
+		core[node] = true
+		if correlation := corePeripheryCorrelation(len(nodes), size, edges, peripheryEdges); correlation > bestCorrelation {
+			bestSize, bestCorrelation = size, correlation
+		}
+	}
+
+	core = make(map[Node]bool, bestSize)
+	peripheryEdges = edges
+	for _, node := range nodes[:bestSize] {
+		peripheryEdges -= peripheryNeighbors(g, node, core)
+		core[node] = true
+	}
+
+	for improved := true; improved; {
+		improved = false
+		for _, node := range nodes {
+			// Moving a node only changes the status of its edges to periphery nodes
+			delta := peripheryNeighbors(g, node, core)
+			size, periphery := len(core)+1, peripheryEdges-delta
+			if core[node] {
+				size, periphery = len(core)-1, peripheryEdges+delta
+			}
+			if correlation := corePeripheryCorrelation(len(nodes), size, edges, periphery); correlation > bestCorrelation+1e-12 {
+				bestCorrelation, peripheryEdges, improved = correlation, periphery, true
+				if core[node] {
+					delete(core, node)
+				} else {
+					core[node] = true
+				}
+			}
+		}
+	}
+
+	if math.IsInf(bestCorrelation, -1) {
+		bestCorrelation = 0
+	}
+	return &CorePeriphery{Core: core, Correlation: bestCorrelation}
+}
+
+// peripheryNeighbors returns the number of neighbors of node outside core.
+func peripheryNeighbors(g *UndirectedGraph, node Node, core map[Node]bool) int {
+	count := 0
+	for _, neighbor := range g.Edges[node] {
+		if !core[neighbor] && neighbor != node {
+			count++
+		}
+	}
+	return count
+}
+
+// corePeripheryCorrelation returns the Pearson correlation between the adjacency of a graph with n nodes and m edges
+// and the ideal pattern of a core of the given size, peripheryEdges being the number of edges between periphery nodes.
+func corePeripheryCorrelation(n, coreSize, m, peripheryEdges int) float64 {
+	pairs := float64(n) * float64(n-1) / 2
+	peripheryPairs := float64(n-coreSize) * float64(n-coreSize-1) / 2
+	ideal := pairs - peripheryPairs
+	overlap := float64(m - peripheryEdges)
+	edges := float64(m)
+
+	denominator := math.Sqrt(edges * (pairs - edges) * ideal * (pairs - ideal))
+	if denominator == 0 {
+		return 0
+	}
+	return (pairs*overlap - edges*ideal) / denominator
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestCorePeripheryFit(t *testing.T) {
+	// Test case 1: The center of a star is a perfect core
+	fit := CorePeripheryFit(StarGraph(5))
+	if !reflect.DeepEqual(fit.Core, map[Node]bool{0: true}) || math.Abs(fit.Correlation-1) > 1e-12 {
+		t.Errorf("Test case 1 failed: Expected core {0} with correlation 1, but got %v with %v", fit.Core, fit.Correlation)
+	}
+
+	// Test case 2: A clique with pendant nodes
+	g := CompleteGraph(4)
+	for i := 0; i < 4; i++ {
+		g.AddEdgesFromIntTupleList([][2]int{{i, 4 + 2*i}, {i, 5 + 2*i}})
+	}
+	fit = CorePeripheryFit(g)
+	expected := map[Node]bool{0: true, 1: true, 2: true, 3: true}
+	if !reflect.DeepEqual(fit.Core, expected) {
+		t.Errorf("Test case 2 failed: Expected core %v, but got %v", expected, fit.Core)
+	}
+
+	// Test case 3: An empty graph has no structure
+	if fit := CorePeripheryFit(&UndirectedGraph{}); len(fit.Core) != 0 || fit.Correlation != 0 {
+		t.Errorf("Test case 3 failed: Expected an empty fit, but got %+v", fit)
+	}
+}
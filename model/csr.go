@@ -0,0 +1,111 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+CSRMatrix is a sparse matrix in compressed sparse row format.
+
+The column indices and values of the stored entries of row i are ColIndices[RowOffsets[i]:RowOffsets[i+1]] and
+Values[RowOffsets[i]:RowOffsets[i+1]], with the column indices of every row in ascending order. Entries that are not
+stored are equal to the zero of the semiring the matrix is used with.
+*/
+type CSRMatrix struct {
+	Rows       int
+	Cols       int
+	RowOffsets []int
+	ColIndices []int
+	Values     []float64
+}
+
+// NumberOfEntries returns the number of stored entries of the matrix.
+func (m *CSRMatrix) NumberOfEntries() int {
+	return len(m.ColIndices)
+}
+
+// At returns the entry (i, j) of the matrix and whether it is stored.
+func (m *CSRMatrix) At(i, j int) (float64, bool) {
+	row := m.ColIndices[m.RowOffsets[i]:m.RowOffsets[i+1]]
+	k := sort.SearchInts(row, j)
+	if k < len(row) && row[k] == j {
+		return m.Values[m.RowOffsets[i]+k], true
+	}
+	return 0, false
+}
+
+/*
+AdjacencyCSR builds the weighted adjacency matrix of an UndirectedGraph.
+
+Parameters:
+- g: The graph.
+- weight: The value stored for every edge, in both directions. It is stored even if it is the zero of a semiring.
+
+Returns:
+- matrix: The symmetric adjacency matrix, with one stored entry per edge direction and a single diagonal entry per
+self-loop.
+- nodes: The node of every row and column, in ascending order.
+*/
+func AdjacencyCSR(g *UndirectedGraph, weight WeightFunc) (*CSRMatrix, []Node) {
+	nodes := sortedNodes(g.Nodes)
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+
+	m := &CSRMatrix{Rows: len(nodes), Cols: len(nodes), RowOffsets: make([]int, len(nodes)+1)}
+	for i, node := range nodes {
+		columns := make([]int, 0, len(g.Edges[node]))
+		for _, neighbor := range g.Edges[node] {
+			columns = append(columns, index[neighbor])
+		}
+		sort.Ints(columns)
+		for k, column := range columns {
+			// A self-loop is listed twice in the adjacency of its node
+			if k > 0 && columns[k-1] == column {
+				continue
+			}
+			m.ColIndices = append(m.ColIndices, column)
+			m.Values = append(m.Values, weight(node, nodes[column]))
+		}
+		m.RowOffsets[i+1] = len(m.ColIndices)
+	}
+	return m, nodes
+}
+
+// Transpose returns the transpose of the matrix.
+func (m *CSRMatrix) Transpose() *CSRMatrix {
+	t := &CSRMatrix{
+		Rows:       m.Cols,
+		Cols:       m.Rows,
+		RowOffsets: make([]int, m.Cols+1),
+		ColIndices: make([]int, len(m.ColIndices)),
+		Values:     make([]float64, len(m.Values)),
+	}
+	for _, column := range m.ColIndices {
+		t.RowOffsets[column+1]++
+	}
+	for i := 0; i < m.Cols; i++ {
+		t.RowOffsets[i+1] += t.RowOffsets[i]
+	}
+
+	next := append([]int{}, t.RowOffsets[:m.Cols]...)
+	for i := 0; i < m.Rows; i++ {
+		for k := m.RowOffsets[i]; k < m.RowOffsets[i+1]; k++ {
+			column := m.ColIndices[k]
+			t.ColIndices[next[column]] = i
+			t.Values[next[column]] = m.Values[k]
+			next[column]++
+		}
+	}
+	return t
+}
+
+// checkDimensions returns an error if a matrix with cols columns cannot be multiplied by something with rows rows.
+func checkDimensions(cols, rows int) error {
+	if cols != rows {
+		return fmt.Errorf("dimension mismatch: %d columns times %d rows", cols, rows)
+	}
+	return nil
+}
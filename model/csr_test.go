@@ -0,0 +1,55 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAdjacencyCSR(t *testing.T) {
+	g := PathGraph(3)
+	g.AddEdge(Edge{Node1: 0, Node2: 5})
+
+	m, nodes := AdjacencyCSR(g, func(u, v Node) float64 { return float64(u + v) })
+	if !reflect.DeepEqual(nodes, []Node{0, 1, 2, 5}) {
+		t.Errorf("Expected nodes [0 1 2 5], but got %v", nodes)
+	}
+	expected := &CSRMatrix{
+		Rows:       4,
+		Cols:       4,
+		RowOffsets: []int{0, 2, 4, 5, 6},
+		ColIndices: []int{1, 3, 0, 2, 1, 0},
+		Values:     []float64{1, 5, 1, 3, 3, 5},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("Expected %+v, but got %+v", expected, m)
+	}
+
+	if value, ok := m.At(1, 2); !ok || value != 3 {
+		t.Errorf("Expected entry (1, 2) to be 3, but got %v (stored: %v)", value, ok)
+	}
+	if _, ok := m.At(2, 3); ok {
+		t.Errorf("Expected entry (2, 3) not to be stored")
+	}
+	// The adjacency matrix is symmetric
+	if transposed := m.Transpose(); !reflect.DeepEqual(transposed, m) {
+		t.Errorf("Expected the transpose to be %+v, but got %+v", m, transposed)
+	}
+
+	// A self-loop is stored once on the diagonal
+	g.AddEdge(Edge{Node1: 1, Node2: 1})
+	m, _ = AdjacencyCSR(g, UnitWeight)
+	if !reflect.DeepEqual(m.ColIndices, []int{1, 3, 0, 1, 2, 1, 0}) {
+		t.Errorf("Expected the column indices [1 3 0 1 2 1 0], but got %v", m.ColIndices)
+	}
+	if y, _ := m.MultiplyVector([]float64{1, 1, 1, 1}, PlusTimesSemiring); y[1] != 3 {
+		t.Errorf("Expected the self-loop to be counted once, but got %v", y)
+	}
+}
+
+func TestCSRTranspose(t *testing.T) {
+	m := &CSRMatrix{Rows: 2, Cols: 3, RowOffsets: []int{0, 2, 3}, ColIndices: []int{0, 2, 1}, Values: []float64{1, 2, 3}}
+	expected := &CSRMatrix{Rows: 3, Cols: 2, RowOffsets: []int{0, 1, 2, 3}, ColIndices: []int{0, 1, 0}, Values: []float64{1, 3, 2}}
+	if transposed := m.Transpose(); !reflect.DeepEqual(transposed, expected) {
+		t.Errorf("Expected %+v, but got %+v", expected, transposed)
+	}
+}
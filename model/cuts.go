@@ -0,0 +1,192 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// CutTree is a Gomory-Hu tree: for every pair of nodes, the minimum weight of an edge on the tree path between them is
+// the value of a minimum cut separating them in the original graph, and removing that edge splits the tree into the
+// two sides of such a cut.
+type CutTree struct {
+	Tree    *UndirectedGraph
+	Weights map[Edge]float64 // keyed with the smaller node first
+}
+
+// Weight returns the weight of a tree edge, or 0 if u and v are not adjacent in the tree.
+func (c *CutTree) Weight(u, v Node) float64 {
+	return c.Weights[orderedEdge(u, v)]
+}
+
+/*
+MinCut returns the value of a minimum cut between two nodes and one of its sides.
+
+Parameters:
+- u, v: Two distinct nodes of the tree.
+
+Returns:
+- value: The capacity of a minimum u-v cut of the original graph.
+- side: The nodes on the side of u.
+- err: An error if a node is missing or u equals v.
+*/
+func (c *CutTree) MinCut(u, v Node) (float64, map[Node]bool, error) {
+	if !c.Tree.HasNode(u) || !c.Tree.HasNode(v) {
+		return 0, nil, fmt.Errorf("both nodes must be part of the tree")
+	}
+	if u == v {
+		return 0, nil, fmt.Errorf("the nodes of a cut must be different")
+	}
+
+	// Walk the tree path from v back to u, keeping its lightest edge
+	parents := map[Node]Node{u: u}
+	queue := []Node{u}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range c.Tree.Edges[node] {
+			if _, seen := parents[neighbor]; !seen {
+				parents[neighbor] = node
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	lightest := Edge{}
+	value := math.Inf(1)
+	for node := v; node != u; node = parents[node] {
+		if w := c.Weight(node, parents[node]); w < value {
+			value, lightest = w, Edge{Node1: parents[node], Node2: node}
+		}
+	}
+
+	// The side of u is what remains reachable once the lightest edge is removed
+	side := map[Node]bool{u: true}
+	queue = []Node{u}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range c.Tree.Edges[node] {
+			if side[neighbor] || (node == lightest.Node1 && neighbor == lightest.Node2) {
+				continue
+			}
+			side[neighbor] = true
+			queue = append(queue, neighbor)
+		}
+	}
+	return value, side, nil
+}
+
+/*
+GomoryHuTree builds the Gomory-Hu tree of an undirected graph with Gusfield's algorithm.
+
+Parameters:
+- g: The graph.
+- capacity: The (non-negative) capacity of every edge. Use UnitWeight for edge connectivity.
+
+Returns:
+- tree: The cut tree, spanning all nodes of g. Nodes in different connected components are joined by edges of weight 0.
+- err: An error if a capacity is negative.
+
+Description:
+The tree is built with n-1 maximum flow computations on g itself, without contracting it, after which every
+minimum cut query is answered on the tree.
+*/
+func GomoryHuTree(g *UndirectedGraph, capacity WeightFunc) (*CutTree, error) {
+	nodes := sortedNodes(g.Nodes)
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+
+	network := newFlowNetwork(len(nodes))
+	for _, edge := range g.GetEdgeTuples() {
+		if edge.Node1 >= edge.Node2 {
+			continue
+		}
+		c := capacity(edge.Node1, edge.Node2)
+		if c < 0 {
+			return nil, fmt.Errorf("negative capacity %v on edge (%d, %d)", c, edge.Node1, edge.Node2)
+		}
+		network.addArc(index[edge.Node1], index[edge.Node2], c, c)
+	}
+
+	parent := make([]int, len(nodes))
+	value := make([]float64, len(nodes))
+	for s := 1; s < len(nodes); s++ {
+		t := parent[s]
+		network.reset()
+		value[s] = network.maxFlow(s, t)
+		side := network.sourceSide(s)
+		for i := range nodes {
+			if i != s && side[i] && parent[i] == t {
+				parent[i] = s
+			}
+		}
+		if side[parent[t]] {
+			parent[s], parent[t] = parent[t], s
+			value[s], value[t] = value[t], value[s]
+		}
+	}
+
+	tree := &CutTree{Tree: &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}, Weights: make(map[Edge]float64)}
+	tree.Tree.AddNodes(nodes)
+	for i := 1; i < len(nodes); i++ {
+		tree.Tree.AddEdge(Edge{Node1: nodes[i], Node2: nodes[parent[i]]})
+		tree.Weights[orderedEdge(nodes[i], nodes[parent[i]])] = value[i]
+	}
+	return tree, nil
+}
+
+/*
+MinimumVertexCut returns a smallest set of nodes whose removal disconnects two nodes.
+
+Parameters:
+- g: The graph.
+- source, target: Two distinct, non-adjacent nodes of g.
+
+Returns:
+- cut: The nodes of the cut in ascending order, empty if source and target are already disconnected.
+- err: An error if a node is missing, source equals target or the nodes are adjacent.
+
+Description:
+Every node is split into an entry and an exit vertex joined by an arc of capacity 1, edges becoming arcs of
+unbounded capacity, so that a minimum cut of the resulting network only cuts node arcs (Menger's theorem).
+*/
+func MinimumVertexCut(g *UndirectedGraph, source, target Node) ([]Node, error) {
+	if !g.HasNode(source) || !g.HasNode(target) {
+		return nil, fmt.Errorf("source and target must be part of the graph")
+	}
+	if source == target {
+		return nil, fmt.Errorf("source and target must be different")
+	}
+	if g.HasEdge(source, target) {
+		return nil, fmt.Errorf("adjacent nodes %d and %d cannot be separated by removing nodes", source, target)
+	}
+
+	nodes := sortedNodes(g.Nodes)
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	entry := func(node Node) int { return 2 * index[node] }
+	exit := func(node Node) int { return 2*index[node] + 1 }
+
+	// No cut uses more than n node arcs, so n+1 is unbounded for the edges
+	unbounded := float64(len(nodes) + 1)
+	network := newFlowNetwork(2 * len(nodes))
+	for _, node := range nodes {
+		network.addArc(entry(node), exit(node), 1, 0)
+		for _, neighbor := range g.Edges[node] {
+			network.addArc(exit(node), entry(neighbor), unbounded, 0)
+		}
+	}
+
+	network.maxFlow(exit(source), entry(target))
+	side := network.sourceSide(exit(source))
+	cut := []Node{}
+	for _, node := range nodes {
+		if node != source && side[entry(node)] && !side[exit(node)] {
+			cut = append(cut, node)
+		}
+	}
+	return cut, nil
+}
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestGomoryHuTree(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	g := &UndirectedGraph{}
+	weights := make(map[Edge]float64)
+	for u := 0; u < 9; u++ {
+		g.AddNode(Node(u))
+		for v := u + 1; v < 9; v++ {
+			if rng.Float64() < 0.4 {
+				g.AddEdge(Edge{Node1: Node(u), Node2: Node(v)})
+				weights[Edge{Node1: Node(u), Node2: Node(v)}] = float64(1 + rng.Intn(5))
+			}
+		}
+	}
+	capacity := weightsFromMap(weights)
+
+	tree, err := GomoryHuTree(g, capacity)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tree.Tree.NumberOfEdges() != 8 {
+		t.Fatalf("Expected a spanning tree with 8 edges, but got %d", tree.Tree.NumberOfEdges())
+	}
+
+	for u := 0; u < 9; u++ {
+		for v := u + 1; v < 9; v++ {
+			network := newFlowNetwork(9)
+			for edge, w := range weights {
+				network.addArc(int(edge.Node1), int(edge.Node2), w, w)
+			}
+			expected := network.maxFlow(u, v)
+
+			value, side, err := tree.MinCut(Node(u), Node(v))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if math.Abs(value-expected) > 1e-9 {
+				t.Errorf("Expected min cut %v between %d and %d, but got %v", expected, u, v, value)
+			}
+			if !side[Node(u)] || side[Node(v)] {
+				t.Errorf("Expected the side of %d to exclude %d, but got %v", u, v, side)
+			}
+			crossing := 0.0
+			for edge, w := range weights {
+				if side[edge.Node1] != side[edge.Node2] {
+					crossing += w
+				}
+			}
+			if math.Abs(crossing-expected) > 1e-9 {
+				t.Errorf("Expected the side of the %d-%d cut to have capacity %v, but got %v", u, v, expected, crossing)
+			}
+		}
+	}
+
+	if _, _, err := tree.MinCut(0, 0); err == nil {
+		t.Errorf("Expected an error for identical nodes, but got nil")
+	}
+	if _, err := GomoryHuTree(g, func(u, v Node) float64 { return -1 }); err == nil {
+		t.Errorf("Expected an error for negative capacities, but got nil")
+	}
+}
+
+func TestMinimumVertexCut(t *testing.T) {
+	// Test case 1: Two nodes separate opposite nodes of a cycle
+	cut, err := MinimumVertexCut(CycleGraph(6), 0, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(cut, []Node{1, 5}) {
+		t.Errorf("Test case 1 failed: Expected [1 5], but got %v", cut)
+	}
+
+	// Test case 2: A bottleneck node
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {3, 4}, {4, 5}, {4, 6}})
+	if cut, _ := MinimumVertexCut(g, 0, 6); !reflect.DeepEqual(cut, []Node{3}) {
+		t.Errorf("Test case 2 failed: Expected [3], but got %v", cut)
+	}
+
+	// Test case 3: Disconnected nodes need no cut
+	g.AddNode(9)
+	if cut, _ := MinimumVertexCut(g, 0, 9); len(cut) != 0 {
+		t.Errorf("Test case 3 failed: Expected an empty cut, but got %v", cut)
+	}
+
+	if _, err := MinimumVertexCut(g, 0, 1); err == nil {
+		t.Errorf("Expected an error for adjacent nodes, but got nil")
+	}
+}
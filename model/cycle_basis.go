@@ -0,0 +1,269 @@
+package model
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+/*
+FundamentalCycleBasis returns the fundamental cycles of a spanning forest of the graph.
+
+Parameters:
+- g: The graph.
+
+Returns:
+- cycles: One cycle per edge outside the breadth-first spanning forest rooted at the smallest node of every component,
+made of that edge and the tree path between its endpoints. Every cycle starts at its smallest node and continues
+towards the smaller of its two neighbors in the cycle. The cycles form a basis of the cycle space, whose dimension is
+m - n + c for a graph with m edges, n nodes and c connected components.
+*/
+func FundamentalCycleBasis(g *UndirectedGraph) [][]Node {
+	parent, depth := spanningForest(g)
+	cycles := [][]Node{}
+	for _, edge := range nonTreeEdges(g, parent) {
+		u, v := edge.Node1, edge.Node2
+		var up, down []Node
+		for u != v {
+			if depth[u] >= depth[v] {
+				up = append(up, u)
+				u = parent[u]
+			} else {
+				down = append(down, v)
+				v = parent[v]
+			}
+		}
+		cycle := append(up, u)
+		for i := len(down) - 1; i >= 0; i-- {
+			cycle = append(cycle, down[i])
+		}
+		cycles = append(cycles, canonicalCycle(cycle))
+	}
+	return cycles
+}
+
+/*
+MinimumCycleBasis returns a cycle basis of minimum total weight with the algorithm of de Pina.
+
+Parameters:
+- g: The graph.
+- weight: The positive weight of every edge. Use UnitWeight to minimize the total number of edges.
+
+Returns:
+- cycles: The cycles of the basis, in the order they were found, which is by non-decreasing weight. Every cycle starts
+at its smallest node and continues towards the smaller of its two neighbors in the cycle.
+- err: An error if the graph has a self-loop or a weight is not positive.
+
+Description:
+Cycles are handled as edge vectors over GF(2). For every edge outside a spanning forest, the algorithm keeps a witness
+vector and finds the shortest cycle having an odd number of edges in common with it, by a shortest path search between
+the two copies of every node in a graph where the witness edges switch copies. The other witnesses are then updated
+so that they stay orthogonal to the cycles found.
+*/
+func MinimumCycleBasis(g *UndirectedGraph, weight WeightFunc) ([][]Node, error) {
+	edges := sortedEdgeList(g)
+	index := make(map[Edge]int, len(edges))
+	for i, edge := range edges {
+		if edge.Node1 == edge.Node2 {
+			return nil, fmt.Errorf("self-loop on node %d", edge.Node1)
+		}
+		if w := weight(edge.Node1, edge.Node2); w <= 0 {
+			return nil, fmt.Errorf("non-positive weight %v on edge (%d, %d)", w, edge.Node1, edge.Node2)
+		}
+		index[edge] = i
+	}
+
+	// Every witness starts as the unit vector of an edge outside the spanning forest
+	parent, _ := spanningForest(g)
+	nonTree := nonTreeEdges(g, parent)
+	witnesses := make([][]uint64, len(nonTree))
+	for i, edge := range nonTree {
+		witnesses[i] = make([]uint64, (len(edges)+63)/64)
+		witnesses[i][index[edge]/64] |= 1 << (index[edge] % 64)
+	}
+
+	cycles := make([][]Node, 0, len(witnesses))
+	for i := range witnesses {
+		cycle := shortestOddCycle(g, index, weight, witnesses[i])
+		for j := i + 1; j < len(witnesses); j++ {
+			if oddIntersection(cycle, witnesses[j]) {
+				for k := range witnesses[j] {
+					witnesses[j][k] ^= witnesses[i][k]
+				}
+			}
+		}
+		cycles = append(cycles, cycleFromVector(edges, cycle))
+	}
+	return cycles, nil
+}
+
+// spanningForest returns the parent and depth of every node in the breadth-first spanning forest rooted at the smallest
+// node of every component. Roots are their own parents.
+func spanningForest(g *UndirectedGraph) (map[Node]Node, map[Node]int) {
+	parent := make(map[Node]Node, len(g.Nodes))
+	depth := make(map[Node]int, len(g.Nodes))
+	for _, root := range sortedNodes(g.Nodes) {
+		if _, seen := depth[root]; seen {
+			continue
+		}
+		depth[root] = 0
+		parent[root] = root
+		queue := []Node{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			for _, neighbor := range g.Edges[node] {
+				if _, seen := depth[neighbor]; !seen {
+					depth[neighbor] = depth[node] + 1
+					parent[neighbor] = node
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+	}
+	return parent, depth
+}
+
+// nonTreeEdges returns the edges of g outside a spanning forest, self-loops excluded, sorted.
+func nonTreeEdges(g *UndirectedGraph, parent map[Node]Node) []Edge {
+	var nonTree []Edge
+	for _, edge := range sortedEdgeList(g) {
+		if edge.Node1 != edge.Node2 && parent[edge.Node1] != edge.Node2 && parent[edge.Node2] != edge.Node1 {
+			nonTree = append(nonTree, edge)
+		}
+	}
+	return nonTree
+}
+
+// shortestOddCycle returns, as an edge vector, the lightest cycle with an odd number of edges in the witness.
+func shortestOddCycle(g *UndirectedGraph, index map[Edge]int, weight WeightFunc, witness []uint64) []uint64 {
+	inWitness := func(u, v Node) bool {
+		i := index[orderedEdge(u, v)]
+		return witness[i/64]&(1<<(i%64)) != 0
+	}
+
+	nodes := sortedNodes(g.Nodes)
+	position := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		position[node] = i
+	}
+	// Lifted vertex 2*i+p is the copy p of nodes[i]
+	lifted := func(node Node, copy int) Node { return Node(2*position[node] + copy) }
+
+	var best []uint64
+	bestWeight := math.Inf(1)
+	for _, source := range nodes {
+		distances := map[Node]float64{lifted(source, 0): 0}
+		parents := make(map[Node]Node)
+		queue := &nodePriorityQueue{{node: lifted(source, 0), priority: 0}}
+		target := lifted(source, 1)
+		for queue.Len() > 0 {
+			item := heap.Pop(queue).(nodePriorityItem)
+			if item.priority > distances[item.node] || item.priority >= bestWeight {
+				continue
+			}
+			if item.node == target {
+				break
+			}
+			node, copy := nodes[int(item.node)/2], int(item.node)%2
+			for _, neighbor := range g.Edges[node] {
+				next := copy
+				if inWitness(node, neighbor) {
+					next = 1 - copy
+				}
+				v := lifted(neighbor, next)
+				d := item.priority + weight(node, neighbor)
+				if old, ok := distances[v]; !ok || d < old {
+					distances[v] = d
+					parents[v] = item.node
+					heap.Push(queue, nodePriorityItem{node: v, priority: d})
+				}
+			}
+		}
+
+		d, ok := distances[target]
+		if !ok || d >= bestWeight {
+			continue
+		}
+		vector := make([]uint64, len(witness))
+		for v := target; v != lifted(source, 0); v = parents[v] {
+			u := parents[v]
+			i := index[orderedEdge(nodes[int(u)/2], nodes[int(v)/2])]
+			vector[i/64] ^= 1 << (i % 64)
+		}
+		best, bestWeight = vector, d
+	}
+	return best
+}
+
+// oddIntersection reports whether two edge vectors have an odd number of edges in common.
+func oddIntersection(a, b []uint64) bool {
+	parity := 0
+	for i := range a {
+		parity += bits.OnesCount64(a[i] & b[i])
+	}
+	return parity%2 == 1
+}
+
+// cycleFromVector orders the nodes of a cycle given by its edge vector.
+func cycleFromVector(edges []Edge, vector []uint64) []Node {
+	adjacency := make(map[Node][]Node)
+	for i, edge := range edges {
+		if vector[i/64]&(1<<(i%64)) != 0 {
+			adjacency[edge.Node1] = append(adjacency[edge.Node1], edge.Node2)
+			adjacency[edge.Node2] = append(adjacency[edge.Node2], edge.Node1)
+		}
+	}
+	var start Node
+	first := true
+	for node := range adjacency {
+		if first || node < start {
+			start, first = node, false
+		}
+	}
+
+	cycle := []Node{start}
+	previous, current := start, adjacency[start][0]
+	for current != start {
+		cycle = append(cycle, current)
+		next := adjacency[current][0]
+		if next == previous {
+			next = adjacency[current][1]
+		}
+		previous, current = current, next
+	}
+	return canonicalCycle(cycle)
+}
+
+// canonicalCycle rotates a cycle to start at its smallest node, followed by the smaller of its two neighbors.
+func canonicalCycle(cycle []Node) []Node {
+	smallest := 0
+	for i, node := range cycle {
+		if node < cycle[smallest] {
+			smallest = i
+		}
+	}
+	n := len(cycle)
+	result := make([]Node, n)
+	step := 1
+	if cycle[(smallest+n-1)%n] < cycle[(smallest+1)%n] {
+		step = n - 1
+	}
+	for i := range result {
+		result[i] = cycle[(smallest+i*step)%n]
+	}
+	return result
+}
+
+// sortedEdgeList returns every edge of g once, its smaller node first, sorted.
+func sortedEdgeList(g *UndirectedGraph) []Edge {
+	var edges []Edge
+	for _, edge := range g.GetEdgeTuples() {
+		if edge.Node1 <= edge.Node2 {
+			edges = append(edges, edge)
+		}
+	}
+	sortEdges(edges)
+	return edges
+}
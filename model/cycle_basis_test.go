@@ -0,0 +1,138 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+// cycleSpaceRank returns the rank over GF(2) of the edge sets of the cycles, failing if one is not a cycle of g.
+func cycleSpaceRank(t *testing.T, g *UndirectedGraph, cycles [][]Node) int {
+	t.Helper()
+	var basis []map[Edge]bool
+	for _, cycle := range cycles {
+		vector := make(map[Edge]bool)
+		for i, node := range cycle {
+			next := cycle[(i+1)%len(cycle)]
+			if !g.HasEdge(node, next) {
+				t.Fatalf("Expected a cycle of the graph, but got %v", cycle)
+			}
+			vector[orderedEdge(node, next)] = true
+		}
+		// Reduce the vector by the basis, keyed by the smallest edge of every basis vector
+		for _, b := range basis {
+			pivot := smallestEdge(b)
+			if vector[pivot] {
+				for edge := range b {
+					if vector[edge] {
+						delete(vector, edge)
+					} else {
+						vector[edge] = true
+					}
+				}
+			}
+		}
+		if len(vector) > 0 {
+			for i, b := range basis {
+				if pivot := smallestEdge(vector); b[pivot] {
+					for edge := range vector {
+						if b[edge] {
+							delete(b, edge)
+						} else {
+							b[edge] = true
+						}
+					}
+					basis[i] = b
+				}
+			}
+			basis = append(basis, vector)
+		}
+	}
+	return len(basis)
+}
+
+func smallestEdge(vector map[Edge]bool) Edge {
+	edges := make([]Edge, 0, len(vector))
+	for edge := range vector {
+		edges = append(edges, edge)
+	}
+	sortEdges(edges)
+	return edges[0]
+}
+
+func TestFundamentalCycleBasis(t *testing.T) {
+	// Test case 1: A tree has no cycle
+	if cycles := FundamentalCycleBasis(StarGraph(5)); len(cycles) != 0 {
+		t.Errorf("Test case 1 failed: Expected no cycle, but got %v", cycles)
+	}
+
+	// Test case 2: The triangles through the root of a complete graph
+	expected := [][]Node{{0, 1, 2}, {0, 1, 3}, {0, 2, 3}}
+	if cycles := FundamentalCycleBasis(CompleteGraph(4)); !reflect.DeepEqual(cycles, expected) {
+		t.Errorf("Test case 2 failed: Expected %v, but got %v", expected, cycles)
+	}
+
+	// Test case 3: The dimension of the cycle space is m - n + c
+	g := LadderGraph(5)
+	g.AddEdgesFromIntTupleList([][2]int{{20, 21}, {21, 22}, {22, 20}})
+	cycles := FundamentalCycleBasis(g)
+	dimension := g.NumberOfEdges() - len(g.Nodes) + len(ConnectedComponents(g).ComponentsArray)
+	if len(cycles) != dimension || cycleSpaceRank(t, g, cycles) != dimension {
+		t.Errorf("Test case 3 failed: Expected %d independent cycles, but got %v", dimension, cycles)
+	}
+}
+
+func TestMinimumCycleBasis(t *testing.T) {
+	// Test case 1: A hexagon with a chord splits into two squares
+	g := CycleGraph(6)
+	g.AddEdge(Edge{Node1: 0, Node2: 3})
+	cycles, err := MinimumCycleBasis(g, UnitWeight)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := [][]Node{{0, 1, 2, 3}, {0, 3, 4, 5}}
+	if !reflect.DeepEqual(cycles, expected) && !reflect.DeepEqual(cycles, [][]Node{expected[1], expected[0]}) {
+		t.Errorf("Test case 1 failed: Expected %v, but got %v", expected, cycles)
+	}
+
+	// Test case 2: The squares of a ladder, even though the fundamental basis has longer cycles
+	g = LadderGraph(6)
+	cycles, err = MinimumCycleBasis(g, UnitWeight)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(cycles) != 5 || cycleSpaceRank(t, g, cycles) != 5 {
+		t.Errorf("Test case 2 failed: Expected 5 independent cycles, but got %v", cycles)
+	}
+	for _, cycle := range cycles {
+		if len(cycle) != 4 {
+			t.Errorf("Test case 2 failed: Expected squares, but got %v", cycles)
+		}
+	}
+
+	// Test case 3: A heavy edge lies on a single cycle of the basis
+	g = CompleteGraph(4)
+	heavy := func(u, v Node) float64 {
+		if orderedEdge(u, v) == (Edge{Node1: 0, Node2: 1}) {
+			return 10
+		}
+		return 1
+	}
+	cycles, err = MinimumCycleBasis(g, heavy)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	total := 0.0
+	for _, cycle := range cycles {
+		for i, node := range cycle {
+			total += heavy(node, cycle[(i+1)%len(cycle)])
+		}
+	}
+	if len(cycles) != 3 || cycleSpaceRank(t, g, cycles) != 3 || total != 18 {
+		t.Errorf("Test case 3 failed: Expected 3 independent cycles weighing 18, but got %v weighing %v", cycles, total)
+	}
+
+	// Test case 4: Non-positive weights are rejected
+	if _, err := MinimumCycleBasis(CycleGraph(3), func(u, v Node) float64 { return 0 }); err == nil {
+		t.Errorf("Test case 4 failed: Expected an error for zero weights")
+	}
+}
@@ -0,0 +1,218 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DirectedGraph is a graph whose edges are oriented from Node1 to Node2.
+// Both the successor and the predecessor adjacency lists are kept so that
+// traversals can follow edges in either direction without a reverse scan.
+type DirectedGraph struct {
+	Nodes        map[Node]bool
+	Successors   map[Node][]Node
+	Predecessors map[Node][]Node
+}
+
+// AddNode adds a node to the DirectedGraph if it does not already exist.
+func (g *DirectedGraph) AddNode(node Node) {
+	// Ensure the existence of the Nodes map
+	if g.Nodes == nil {
+		g.Nodes = make(map[Node]bool)
+	}
+
+	g.Nodes[node] = true
+}
+
+/*
+AddEdge adds a directed edge from edge.Node1 to edge.Node2.
+
+Description:
+Both endpoints are added to the graph if they do not already exist. Adding an edge that is already present has no effect.
+
+Example:
+
+	g := DirectedGraph{}
+	g.AddEdge(Edge{Node1: 1, Node2: 2})
+
+	fmt.Println(g.Successors)   // Output: map[1:[2]]
+	fmt.Println(g.Predecessors) // Output: map[2:[1]]
+*/
+func (g *DirectedGraph) AddEdge(edge Edge) {
+	// Ensure the existence of the adjacency maps
+	if g.Successors == nil {
+		g.Successors = make(map[Node][]Node)
+	}
+	if g.Predecessors == nil {
+		g.Predecessors = make(map[Node][]Node)
+	}
+
+	g.AddNode(edge.Node1)
+	g.AddNode(edge.Node2)
+
+	if !g.HasEdge(edge.Node1, edge.Node2) {
+		g.Successors[edge.Node1] = append(g.Successors[edge.Node1], edge.Node2)
+		g.Predecessors[edge.Node2] = append(g.Predecessors[edge.Node2], edge.Node1)
+	}
+}
+
+// HasNode checks if the DirectedGraph contains a specific node.
+func (g *DirectedGraph) HasNode(node Node) bool {
+	return g.Nodes[node]
+}
+
+// HasEdge checks if the DirectedGraph contains an edge from u to v.
+func (g *DirectedGraph) HasEdge(u, v Node) bool {
+	for _, nb := range g.Successors[u] {
+		if nb == v {
+			return true
+		}
+	}
+	return false
+}
+
+// NumberOfEdges returns the total number of directed edges in the graph.
+func (g *DirectedGraph) NumberOfEdges() int {
+	totalEdges := 0
+	for _, successors := range g.Successors {
+		totalEdges += len(successors)
+	}
+	return totalEdges
+}
+
+// AddNodes adds several nodes to the DirectedGraph.
+func (g *DirectedGraph) AddNodes(nodes []Node) {
+	for _, node := range nodes {
+		g.AddNode(node)
+	}
+}
+
+// AddEdgesFromIntTupleList adds a directed edge from the first to the second node of every pair.
+func (g *DirectedGraph) AddEdgesFromIntTupleList(edges [][2]int) {
+	for _, nodes := range edges {
+		g.AddEdge(Edge{Node(nodes[0]), Node(nodes[1])})
+	}
+}
+
+// RemoveEdge removes the directed edge from edge.Node1 to edge.Node2, keeping both nodes. Removing an edge that does
+// not exist has no effect, and the reverse edge is left untouched.
+func (g *DirectedGraph) RemoveEdge(edge Edge) {
+	if len(g.Successors[edge.Node1]) > 0 {
+		g.Successors[edge.Node1] = DeleteFromSlice(g.Successors[edge.Node1], edge.Node2)
+	}
+	if len(g.Predecessors[edge.Node2]) > 0 {
+		g.Predecessors[edge.Node2] = DeleteFromSlice(g.Predecessors[edge.Node2], edge.Node1)
+	}
+}
+
+// RemoveNode removes a node from the DirectedGraph together with its incoming and outgoing edges.
+func (g *DirectedGraph) RemoveNode(node Node) {
+	delete(g.Nodes, node)
+	for _, successor := range g.Successors[node] {
+		g.Predecessors[successor] = DeleteFromSlice(g.Predecessors[successor], node)
+	}
+	for _, predecessor := range g.Predecessors[node] {
+		g.Successors[predecessor] = DeleteFromSlice(g.Successors[predecessor], node)
+	}
+	delete(g.Successors, node)
+	delete(g.Predecessors, node)
+}
+
+// OutDegree returns the number of edges leaving a node.
+func (g *DirectedGraph) OutDegree(node Node) int {
+	return len(g.Successors[node])
+}
+
+// InDegree returns the number of edges entering a node.
+func (g *DirectedGraph) InDegree(node Node) int {
+	return len(g.Predecessors[node])
+}
+
+// GetEdgeTuples returns every directed edge of the graph once, from Node1 to Node2, in no particular order.
+func (g *DirectedGraph) GetEdgeTuples() []Edge {
+	var edges []Edge
+	for node, successors := range g.Successors {
+		for _, successor := range successors {
+			edges = append(edges, Edge{node, successor})
+		}
+	}
+	return edges
+}
+
+// Equals checks if two DirectedGraphs have the same nodes and the same directed edges.
+func (g *DirectedGraph) Equals(other *DirectedGraph) bool {
+	if len(g.Nodes) != len(other.Nodes) || g.NumberOfEdges() != other.NumberOfEdges() {
+		return false
+	}
+	for node := range g.Nodes {
+		if !other.Nodes[node] {
+			return false
+		}
+	}
+	for node, successors := range g.Successors {
+		for _, successor := range successors {
+			if !other.HasEdge(node, successor) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (g *DirectedGraph) String() string {
+	var str strings.Builder
+
+	str.WriteString("Nodes:\n")
+	for node := range g.Nodes {
+		str.WriteString(fmt.Sprintf("%d: true\t", node))
+	}
+
+	str.WriteString("\nSuccessors:\n")
+	for node, successors := range g.Successors {
+		str.WriteString(fmt.Sprintf("%d: %v\n", node, successors))
+	}
+
+	return str.String()
+}
+
+/*
+Reverse returns a new DirectedGraph with the same nodes and every edge reversed.
+
+Example:
+
+	g := &DirectedGraph{}
+	g.AddEdge(Edge{Node1: 1, Node2: 2})
+
+	fmt.Println(g.Reverse().Successors) // Output: map[2:[1]]
+*/
+func (g *DirectedGraph) Reverse() *DirectedGraph {
+	reversed := &DirectedGraph{
+		Nodes:        make(map[Node]bool, len(g.Nodes)),
+		Successors:   make(map[Node][]Node, len(g.Predecessors)),
+		Predecessors: make(map[Node][]Node, len(g.Successors)),
+	}
+	for node := range g.Nodes {
+		reversed.Nodes[node] = true
+	}
+	for node, predecessors := range g.Predecessors {
+		reversed.Successors[node] = append([]Node(nil), predecessors...)
+	}
+	for node, successors := range g.Successors {
+		reversed.Predecessors[node] = append([]Node(nil), successors...)
+	}
+	return reversed
+}
+
+// ToUndirected returns the UndirectedGraph with the same nodes and an edge between every two nodes joined in either direction.
+func (g *DirectedGraph) ToUndirected() *UndirectedGraph {
+	undirected := &UndirectedGraph{Nodes: make(map[Node]bool, len(g.Nodes)), Edges: make(map[Node][]Node)}
+	for node := range g.Nodes {
+		undirected.AddNode(node)
+	}
+	for node, successors := range g.Successors {
+		for _, successor := range successors {
+			undirected.AddEdge(Edge{Node1: node, Node2: successor})
+		}
+	}
+	return undirected
+}
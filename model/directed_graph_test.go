@@ -0,0 +1,97 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDirectedGraph_RemoveEdge(t *testing.T) {
+	g := directedFromEdges([][2]int{{1, 2}, {2, 1}, {2, 3}})
+	g.RemoveEdge(Edge{Node1: 1, Node2: 2})
+
+	if g.HasEdge(1, 2) || !g.HasEdge(2, 1) {
+		t.Errorf("Expected only the edge 1 -> 2 to be removed, but got %v", g.Successors)
+	}
+	if !reflect.DeepEqual(g.Predecessors[2], []Node{}) {
+		t.Errorf("Expected no predecessors for 2, but got %v", g.Predecessors[2])
+	}
+	if !g.HasNode(1) || g.NumberOfEdges() != 2 {
+		t.Errorf("Expected the nodes to be kept and 2 edges, but got %d", g.NumberOfEdges())
+	}
+
+	// Removing a missing edge has no effect
+	g.RemoveEdge(Edge{Node1: 3, Node2: 2})
+	if g.NumberOfEdges() != 2 {
+		t.Errorf("Expected 2 edges, but got %d", g.NumberOfEdges())
+	}
+}
+
+func TestDirectedGraph_RemoveNode(t *testing.T) {
+	g := directedFromEdges([][2]int{{1, 2}, {2, 3}, {3, 1}, {3, 4}})
+	g.RemoveNode(3)
+
+	expected := directedFromEdges([][2]int{{1, 2}})
+	expected.AddNode(4)
+	if !g.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, g)
+	}
+	if g.InDegree(1) != 0 || g.OutDegree(2) != 0 || g.InDegree(4) != 0 {
+		t.Errorf("Expected the edges of 3 to be removed, but got %v and %v", g.Successors, g.Predecessors)
+	}
+}
+
+func TestDirectedGraph_Degrees(t *testing.T) {
+	g := &DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{1, 2}, {1, 3}, {3, 2}})
+	g.AddNodes([]Node{5})
+
+	testCases := []struct {
+		node    Node
+		in, out int
+	}{
+		{1, 0, 2},
+		{2, 2, 0},
+		{3, 1, 1},
+		{5, 0, 0},
+	}
+	for i, testCase := range testCases {
+		if in, out := g.InDegree(testCase.node), g.OutDegree(testCase.node); in != testCase.in || out != testCase.out {
+			t.Errorf("Test case %d failed: Expected %d and %d, but got %d and %d", i+1, testCase.in, testCase.out, in, out)
+		}
+	}
+	if len(g.GetEdgeTuples()) != 3 {
+		t.Errorf("Expected 3 edges, but got %v", g.GetEdgeTuples())
+	}
+}
+
+func TestDirectedGraph_Reverse(t *testing.T) {
+	g := directedFromEdges([][2]int{{1, 2}, {2, 3}, {1, 3}})
+	g.AddNode(7)
+	reversed := g.Reverse()
+
+	expected := directedFromEdges([][2]int{{2, 1}, {3, 2}, {3, 1}})
+	expected.AddNode(7)
+	if !reversed.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, reversed)
+	}
+	if reversed.InDegree(1) != 2 || reversed.OutDegree(3) != 2 {
+		t.Errorf("Expected the predecessor lists to be reversed as well, but got %v", reversed.Predecessors)
+	}
+	if !reversed.Reverse().Equals(g) {
+		t.Errorf("Expected reversing twice to give the original graph")
+	}
+
+	// The reversed graph does not share adjacency lists with the original
+	reversed.AddEdge(Edge{Node1: 2, Node2: 7})
+	if g.HasEdge(2, 7) || len(g.Predecessors[2]) != 1 {
+		t.Errorf("Expected the original graph to be unchanged, but got %v", g)
+	}
+}
+
+func TestDirectedGraph_ToUndirected(t *testing.T) {
+	g := directedFromEdges([][2]int{{1, 2}, {2, 1}, {2, 3}})
+	undirected := g.ToUndirected()
+	if len(undirected.Nodes) != 3 || undirected.NumberOfEdges() != 2 || !undirected.HasEdge(3, 2) {
+		t.Errorf("Expected the path 1 - 2 - 3, but got %v", undirected)
+	}
+}
@@ -0,0 +1,208 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+EdgeDisjointShortestPaths returns k edge-disjoint paths between source and target with minimum total length.
+
+Parameters:
+- g: The graph to route on.
+- source: The first node of every path.
+- target: The last node of every path.
+- k: The number of paths to find; k = 2 gives the classic Suurballe/Bhandari pair.
+- weight: A WeightFunc giving the (non-negative) length of every edge. Use UnitWeight for hop counts.
+
+Returns:
+- paths: k paths, each a slice of nodes from source to target, sharing no edge, ordered as they were extracted.
+- err: An error if the parameters are invalid or fewer than k edge-disjoint paths exist.
+
+Description:
+The paths are computed with Bhandari's algorithm: k successive shortest paths in the residual graph, where edges already used by a path may be traversed backwards at negative cost to re-route it. The union of the resulting routes has minimum total length among all sets of k disjoint paths.
+
+Example:
+
+	g := CycleGraph(6)
+	paths, _ := EdgeDisjointShortestPaths(g, 0, 3, 2, UnitWeight)
+
+	fmt.Println(paths) // Output: [[0 1 2 3] [0 5 4 3]]
+*/
+func EdgeDisjointShortestPaths(g *UndirectedGraph, source, target Node, k int, weight WeightFunc) ([][]Node, error) {
+	return disjointShortestPaths(g, source, target, k, weight, false)
+}
+
+/*
+NodeDisjointShortestPaths returns k paths between source and target with minimum total length that share no node other than source and target.
+
+Parameters and returned values are the same as for EdgeDisjointShortestPaths. Node-disjointness is obtained by splitting every intermediate node into an entry and an exit copy joined by a single unit capacity arc.
+*/
+func NodeDisjointShortestPaths(g *UndirectedGraph, source, target Node, k int, weight WeightFunc) ([][]Node, error) {
+	return disjointShortestPaths(g, source, target, k, weight, true)
+}
+
+// residualArc is an arc of unit capacity in the flow network built by disjointShortestPaths.
+type residualArc struct {
+	from, to int
+	cost     float64
+	used     bool
+}
+
+func disjointShortestPaths(g *UndirectedGraph, source, target Node, k int, weight WeightFunc, splitNodes bool) ([][]Node, error) {
+	if !g.HasNode(source) || !g.HasNode(target) {
+		return nil, fmt.Errorf("source and target must be part of the graph")
+	}
+	if source == target {
+		return nil, fmt.Errorf("source and target must be different")
+	}
+	if k < 1 {
+		return nil, fmt.Errorf("k must be at least 1")
+	}
+
+	// Every node gets an entry and an exit vertex. Without splitting they coincide.
+	nodes := sortedNodes(g.Nodes)
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	entry := func(node Node) int {
+		if splitNodes {
+			return 2 * index[node]
+		}
+		return index[node]
+	}
+	exit := func(node Node) int {
+		if splitNodes {
+			return 2*index[node] + 1
+		}
+		return index[node]
+	}
+	vertexCount := len(nodes)
+	if splitNodes {
+		vertexCount *= 2
+	}
+
+	var arcs []residualArc
+	for _, node := range nodes {
+		if splitNodes {
+			arcs = append(arcs, residualArc{from: entry(node), to: exit(node)})
+		}
+		for _, neighbor := range g.Edges[node] {
+			w := weight(node, neighbor)
+			if w < 0 {
+				return nil, fmt.Errorf("negative weight %v on edge (%d, %d)", w, node, neighbor)
+			}
+			arcs = append(arcs, residualArc{from: exit(node), to: entry(neighbor), cost: w})
+		}
+	}
+
+	from, to := exit(source), entry(target)
+	for found := 0; found < k; found++ {
+		if !augmentShortestPath(arcs, vertexCount, from, to) {
+			return nil, fmt.Errorf("only %d disjoint paths exist between %d and %d", found, source, target)
+		}
+	}
+
+	// Opposite arcs of the same undirected edge carrying flow cancel out
+	reverse := make(map[[2]int]int)
+	for i, arc := range arcs {
+		if arc.used {
+			if j, ok := reverse[[2]int{arc.to, arc.from}]; ok {
+				arcs[i].used = false
+				arcs[j].used = false
+				delete(reverse, [2]int{arc.to, arc.from})
+				continue
+			}
+			reverse[[2]int{arc.from, arc.to}] = i
+		}
+	}
+
+	vertexNode := make([]Node, vertexCount)
+	for _, node := range nodes {
+		vertexNode[entry(node)] = node
+		vertexNode[exit(node)] = node
+	}
+
+	paths := make([][]Node, 0, k)
+	for len(paths) < k {
+		paths = append(paths, extractFlowPath(arcs, vertexNode, from, to))
+	}
+	return paths, nil
+}
+
+// augmentShortestPath finds a shortest path in the residual network with Bellman-Ford and pushes one unit of flow along it.
+func augmentShortestPath(arcs []residualArc, vertexCount int, from, to int) bool {
+	distance := make([]float64, vertexCount)
+	for i := range distance {
+		distance[i] = math.Inf(1)
+	}
+	distance[from] = 0
+	// viaArc[v] is the arc used to reach v, and viaBackward whether it was traversed against its direction
+	viaArc := make([]int, vertexCount)
+	viaBackward := make([]bool, vertexCount)
+
+	for round := 0; round < vertexCount; round++ {
+		changed := false
+		for i, arc := range arcs {
+			u, v, cost := arc.from, arc.to, arc.cost
+			if arc.used {
+				u, v, cost = arc.to, arc.from, -arc.cost
+			}
+			if !math.IsInf(distance[u], 1) && distance[u]+cost < distance[v] {
+				distance[v] = distance[u] + cost
+				viaArc[v] = i
+				viaBackward[v] = arc.used
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	if math.IsInf(distance[to], 1) {
+		return false
+	}
+
+	for vertex := to; vertex != from; {
+		arc := &arcs[viaArc[vertex]]
+		arc.used = !viaBackward[vertex]
+		if viaBackward[vertex] {
+			vertex = arc.to
+		} else {
+			vertex = arc.from
+		}
+	}
+	return true
+}
+
+// extractFlowPath follows (and consumes) used arcs from the source vertex to the target vertex, returning the visited graph nodes.
+func extractFlowPath(arcs []residualArc, vertexNode []Node, from, to int) []Node {
+	path := []Node{vertexNode[from]}
+	position := map[Node]int{vertexNode[from]: 0}
+	for vertex := from; vertex != to; {
+		for i := range arcs {
+			if arcs[i].used && arcs[i].from == vertex {
+				arcs[i].used = false
+				vertex = arcs[i].to
+				break
+			}
+		}
+		node := vertexNode[vertex]
+		if node == path[len(path)-1] {
+			// internal arc of a split node
+			continue
+		}
+		if p, seen := position[node]; seen {
+			// drop a zero-length cycle left in the flow
+			for _, removed := range path[p+1:] {
+				delete(position, removed)
+			}
+			path = path[:p+1]
+			continue
+		}
+		position[node] = len(path)
+		path = append(path, node)
+	}
+	return path
+}
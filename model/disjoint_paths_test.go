@@ -0,0 +1,79 @@
+package model
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func sortPaths(paths [][]Node) {
+	sort.Slice(paths, func(i, j int) bool {
+		for k := 0; k < len(paths[i]) && k < len(paths[j]); k++ {
+			if paths[i][k] != paths[j][k] {
+				return paths[i][k] < paths[j][k]
+			}
+		}
+		return len(paths[i]) < len(paths[j])
+	})
+}
+
+func TestEdgeDisjointShortestPaths(t *testing.T) {
+	// Test case 1: Both sides of a cycle
+	paths, err := EdgeDisjointShortestPaths(CycleGraph(6), 0, 3, 2, UnitWeight)
+	if err != nil {
+		t.Fatalf("Test case 1 failed: Unexpected error: %v", err)
+	}
+	sortPaths(paths)
+	expected := [][]Node{{0, 1, 2, 3}, {0, 5, 4, 3}}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Test case 1 failed: Expected %v, but got %v", expected, paths)
+	}
+
+	// Test case 2: The single shortest path blocks any disjoint pair and must be re-routed
+	weights := map[Edge]float64{
+		{Node1: 0, Node2: 1}: 1, {Node1: 1, Node2: 2}: 1, {Node1: 2, Node2: 3}: 1,
+		{Node1: 1, Node2: 4}: 2, {Node1: 3, Node2: 4}: 2,
+		{Node1: 0, Node2: 5}: 2, {Node1: 2, Node2: 5}: 2,
+	}
+	g := &UndirectedGraph{}
+	for edge := range weights {
+		g.AddEdge(edge)
+	}
+	paths, err = EdgeDisjointShortestPaths(g, 0, 3, 2, weightsFromMap(weights))
+	if err != nil {
+		t.Fatalf("Test case 2 failed: Unexpected error: %v", err)
+	}
+	sortPaths(paths)
+	expected = [][]Node{{0, 1, 4, 3}, {0, 5, 2, 3}}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Test case 2 failed: Expected %v, but got %v", expected, paths)
+	}
+
+	// Test case 3: Not enough paths
+	if _, err := EdgeDisjointShortestPaths(PathGraph(4), 0, 3, 2, UnitWeight); err == nil {
+		t.Errorf("Test case 3 failed: Expected an error, but got nil")
+	}
+}
+
+func TestNodeDisjointShortestPaths(t *testing.T) {
+	// Two edge-disjoint routes that both cross node 1
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {0, 3}, {3, 1}, {1, 4}, {4, 2}})
+
+	if _, err := EdgeDisjointShortestPaths(g, 0, 2, 2, UnitWeight); err != nil {
+		t.Errorf("Expected two edge-disjoint paths, but got error: %v", err)
+	}
+	if _, err := NodeDisjointShortestPaths(g, 0, 2, 2, UnitWeight); err == nil {
+		t.Errorf("Expected an error for node-disjoint paths through a cut vertex, but got nil")
+	}
+
+	paths, err := NodeDisjointShortestPaths(CompleteGraph(4), 0, 1, 3, UnitWeight)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sortPaths(paths)
+	expected := [][]Node{{0, 1}, {0, 2, 1}, {0, 3, 1}}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected %v, but got %v", expected, paths)
+	}
+}
@@ -0,0 +1,202 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// DK3Structure is a connected subgraph on three nodes labeled with the degrees of its nodes. For a wedge (an induced
+// path) Degrees[1] is the degree of the center and Degrees[0] <= Degrees[2]; for a triangle the degrees are sorted.
+type DK3Structure struct {
+	Triangle bool
+	Degrees  [3]int
+}
+
+// DK3Distribution counts the wedges and triangles of a graph by the degrees of their nodes: its dK-3 statistics.
+func DK3Distribution(g *UndirectedGraph) map[DK3Structure]int {
+	degrees := nodeDegrees(g)
+	distribution := make(map[DK3Structure]int)
+	for center := range g.Nodes {
+		neighbors := g.Edges[center]
+		for i, x := range neighbors {
+			for _, y := range neighbors[i+1:] {
+				if x == center || y == center || x == y {
+					continue
+				}
+				if !g.HasEdge(x, y) {
+					distribution[wedgeStructure(degrees, x, center, y)]++
+				} else if center < x && center < y {
+					distribution[triangleStructure(degrees, center, x, y)]++
+				}
+			}
+		}
+	}
+	return distribution
+}
+
+func wedgeStructure(degrees map[Node]int, end1, center, end2 Node) DK3Structure {
+	k1, k2 := degrees[end1], degrees[end2]
+	return DK3Structure{Degrees: [3]int{min(k1, k2), degrees[center], max(k1, k2)}}
+}
+
+func triangleStructure(degrees map[Node]int, u, v, w Node) DK3Structure {
+	ks := []int{degrees[u], degrees[v], degrees[w]}
+	sort.Ints(ks)
+	return DK3Structure{Triangle: true, Degrees: [3]int{ks[0], ks[1], ks[2]}}
+}
+
+func nodeDegrees(g *UndirectedGraph) map[Node]int {
+	degrees := make(map[Node]int, len(g.Nodes))
+	for node := range g.Nodes {
+		degrees[node] = g.NodeDegree(node)
+	}
+	return degrees
+}
+
+/*
+DKSeriesGraph generates a random graph with the same dK-d statistics as a graph, for d from 0 to 3.
+
+Parameters:
+- g: The graph to replicate.
+- d: The order of the statistics to reproduce:
+  - 0: the number of nodes and edges (G(n, m) model), keeping the node ids of g,
+  - 1: the degree sequence, by degree-preserving rewiring of g, keeping the node ids of g,
+  - 2: the joint degree matrix, see JointDegreeGraph, with nodes numbered by increasing degree,
+  - 3: the joint degree matrix exactly and the degree-labeled wedges and triangles as closely as possible.
+- attempts: The number of rewiring attempts for d = 3; ignored otherwise.
+- seed: The seed of the random number generator.
+
+Returns:
+- replica: The generated graph.
+- err: An error if d is not between 0 and 3.
+
+Description:
+For d = 3, a dK-2 random graph is rewired with swaps a - b, c - d to a - d, c - b between edges whose endpoints b and d
+have the same degree, which preserve the joint degree matrix. A swap is kept if it does not increase the L1 distance
+between the DK3Distribution of the graph and that of g, and the rewiring stops early once the distance is zero.
+*/
+func DKSeriesGraph(g *UndirectedGraph, d, attempts int, seed int64) (*UndirectedGraph, error) {
+	rng := rand.New(rand.NewSource(seed))
+	switch d {
+	case 0:
+		return GNMNullModel(g, rng), nil
+	case 1:
+		return RewiringNullModel(10)(g, rng), nil
+	case 2:
+		return JointDegreeGraph(JointDegrees(g), seed)
+	case 3:
+		replica, err := JointDegreeGraph(JointDegrees(g), seed)
+		if err != nil {
+			return nil, err
+		}
+		rewireToDK3(replica, DK3Distribution(g), attempts, rng)
+		return replica, nil
+	}
+	return nil, fmt.Errorf("unsupported dK-series order %d", d)
+}
+
+// dk3Rewiring tracks the dK-3 distribution of a graph being rewired and its L1 distance to a target.
+type dk3Rewiring struct {
+	g            *UndirectedGraph
+	degrees      map[Node]int
+	target       map[DK3Structure]int
+	distribution map[DK3Structure]int
+	distance     int
+}
+
+// change adds delta to the count of a structure, updating the distance.
+func (r *dk3Rewiring) change(structure DK3Structure, delta int) {
+	before := abs(r.distribution[structure] - r.target[structure])
+	r.distribution[structure] += delta
+	r.distance += abs(r.distribution[structure]-r.target[structure]) - before
+}
+
+// toggle adds or removes the edge u - v, updating the structures of every triple containing u and v.
+func (r *dk3Rewiring) toggle(u, v Node, add bool) {
+	var others []Node
+	seen := map[Node]bool{u: true, v: true}
+	for _, x := range append(append([]Node{}, r.g.Edges[u]...), r.g.Edges[v]...) {
+		if !seen[x] {
+			seen[x] = true
+			others = append(others, x)
+		}
+	}
+	for _, x := range others {
+		if structure, ok := r.triple(u, v, x); ok {
+			r.change(structure, -1)
+		}
+	}
+	if add {
+		r.g.AddEdge(Edge{Node1: u, Node2: v})
+	} else {
+		r.g.RemoveEdge(Edge{Node1: u, Node2: v})
+	}
+	for _, x := range others {
+		if structure, ok := r.triple(u, v, x); ok {
+			r.change(structure, 1)
+		}
+	}
+}
+
+// triple returns the structure induced by three nodes, or ok = false if they are not connected.
+func (r *dk3Rewiring) triple(u, v, x Node) (DK3Structure, bool) {
+	uv, ux, vx := r.g.HasEdge(u, v), r.g.HasEdge(u, x), r.g.HasEdge(v, x)
+	switch {
+	case uv && ux && vx:
+		return triangleStructure(r.degrees, u, v, x), true
+	case uv && ux:
+		return wedgeStructure(r.degrees, v, u, x), true
+	case uv && vx:
+		return wedgeStructure(r.degrees, u, v, x), true
+	case ux && vx:
+		return wedgeStructure(r.degrees, u, x, v), true
+	}
+	return DK3Structure{}, false
+}
+
+func rewireToDK3(g *UndirectedGraph, target map[DK3Structure]int, attempts int, rng *rand.Rand) {
+	r := &dk3Rewiring{g: g, degrees: nodeDegrees(g), target: target, distribution: DK3Distribution(g)}
+	for structure, count := range target {
+		r.distance += abs(r.distribution[structure] - count)
+	}
+	for structure, count := range r.distribution {
+		if _, ok := target[structure]; !ok {
+			r.distance += count
+		}
+	}
+
+	edges := sortedEdgeList(g)
+	for attempt := 0; attempt < attempts && r.distance > 0 && len(edges) >= 2; attempt++ {
+		i, j := rng.Intn(len(edges)), rng.Intn(len(edges))
+		a, b := edges[i].Node1, edges[i].Node2
+		c, d := edges[j].Node1, edges[j].Node2
+		if rng.Intn(2) == 0 {
+			c, d = d, c
+		}
+		if r.degrees[b] != r.degrees[d] || a == b || c == d || a == d || c == b || g.HasEdge(a, d) || g.HasEdge(c, b) {
+			continue
+		}
+
+		before := r.distance
+		r.toggle(a, b, false)
+		r.toggle(c, d, false)
+		r.toggle(a, d, true)
+		r.toggle(c, b, true)
+		if r.distance > before {
+			r.toggle(c, b, false)
+			r.toggle(a, d, false)
+			r.toggle(c, d, true)
+			r.toggle(a, b, true)
+			continue
+		}
+		edges[i], edges[j] = Edge{Node1: a, Node2: d}, Edge{Node1: c, Node2: b}
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
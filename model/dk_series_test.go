@@ -0,0 +1,71 @@
+package model
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// dk3Distance returns the L1 distance between two dK-3 distributions.
+func dk3Distance(a, b map[DK3Structure]int) int {
+	distance := 0
+	for structure, count := range a {
+		distance += abs(count - b[structure])
+	}
+	for structure, count := range b {
+		if _, ok := a[structure]; !ok {
+			distance += count
+		}
+	}
+	return distance
+}
+
+func TestDK3Distribution(t *testing.T) {
+	// A triangle with a pendant node
+	g := CycleGraph(3)
+	g.AddEdge(Edge{Node1: 2, Node2: 3})
+	expected := map[DK3Structure]int{
+		{Triangle: true, Degrees: [3]int{2, 2, 3}}: 1,
+		{Degrees: [3]int{1, 3, 2}}:                 2,
+	}
+	if distribution := DK3Distribution(g); !reflect.DeepEqual(distribution, expected) {
+		t.Errorf("Expected %v, but got %v", expected, distribution)
+	}
+}
+
+func TestDKSeriesGraph(t *testing.T) {
+	// Triangles hanging off a degree-heterogeneous core
+	g := RewiringNullModel(10)(WattsStrogatzRandomGraph(40, 4, 0), rand.New(rand.NewSource(3)))
+	for i := 0; i < 40; i += 4 {
+		g.AddEdgesFromIntTupleList([][2]int{{i, 40 + i}, {40 + i, 41 + i}, {41 + i, i}})
+	}
+
+	replica, err := DKSeriesGraph(g, 0, 0, 1)
+	if err != nil || len(replica.Nodes) != len(g.Nodes) || replica.NumberOfEdges() != g.NumberOfEdges() {
+		t.Errorf("Expected %d nodes and %d edges, but got %v (error %v)", len(g.Nodes), g.NumberOfEdges(), replica, err)
+	}
+	replica, err = DKSeriesGraph(g, 1, 0, 1)
+	if err != nil || !reflect.DeepEqual(nodeDegrees(replica), nodeDegrees(g)) {
+		t.Errorf("Expected the degrees %v, but got %v (error %v)", nodeDegrees(g), nodeDegrees(replica), err)
+	}
+	dk2, err := DKSeriesGraph(g, 2, 0, 1)
+	if err != nil || !reflect.DeepEqual(JointDegrees(dk2), JointDegrees(g)) {
+		t.Errorf("Expected the joint degrees %v, but got %v (error %v)", JointDegrees(g), JointDegrees(dk2), err)
+	}
+
+	dk3, err := DKSeriesGraph(g, 3, 20000, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(JointDegrees(dk3), JointDegrees(g)) {
+		t.Errorf("Expected the joint degrees %v, but got %v", JointDegrees(g), JointDegrees(dk3))
+	}
+	target := DK3Distribution(g)
+	if before, after := dk3Distance(DK3Distribution(dk2), target), dk3Distance(DK3Distribution(dk3), target); after >= before {
+		t.Errorf("Expected the dK-3 distance to decrease from %d, but got %d", before, after)
+	}
+
+	if _, err := DKSeriesGraph(g, 4, 0, 1); err == nil {
+		t.Errorf("Expected an error for d = 4")
+	}
+}
@@ -0,0 +1,192 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// The parameters of force-directed edge bundling recommended by Holten and van Wijk: the spring constant of the edges,
+// the first step as a fraction of the size of the layout, and the number of iterations of the first cycle.
+const (
+	bundlingStiffness  = 0.1
+	bundlingStep       = 0.04
+	bundlingIterations = 50
+)
+
+/*
+ForceDirectedEdgeBundling bends the edges of a layout so that compatible edges are drawn together, which makes dense
+drawings readable.
+
+Parameters:
+- g: The graph.
+- positions: The position of every node, e.g. from SpringLayout.
+- cycles: The number of refinement cycles, 6 being customary; every cycle doubles the number of bends of the edges.
+- threshold: The compatibility in [0, 1] above which two edges attract, 0.6 being customary.
+
+Returns:
+- paths: For every edge, keyed with the smaller node first, the polyline from Node1 to Node2, endpoints included.
+Self-loops and edges of length zero are straight.
+- err: An error if a node has no position, cycles is negative or threshold is outside [0, 1].
+
+Description:
+Every edge is a chain of springs between bends, which are attracted by the bends of the same rank of compatible
+edges. The compatibility of two edges is the product of how parallel they are, how similar their lengths are, how
+close their midpoints are, and how much they overlap once projected on each other. Cycle c runs 50 (2/3)^c iterations
+with a step halving at every cycle. With the m' pairs of compatible edges, a cycle costs O(m' 2^c) per iteration.
+
+Reference: D. Holten and J. J. van Wijk, "Force-directed edge bundling for graph visualization", Computer Graphics
+Forum 28(3), 2009.
+*/
+func ForceDirectedEdgeBundling(g *UndirectedGraph, positions map[Node]Point, cycles int, threshold float64) (map[Edge][]Point, error) {
+	if cycles < 0 || threshold < 0 || threshold > 1 {
+		return nil, fmt.Errorf("the number of cycles must be non-negative and the threshold in [0, 1], got %d and %v", cycles, threshold)
+	}
+	var edges []Edge
+	paths := make(map[Edge][]Point)
+	low, high := Point{X: math.Inf(1), Y: math.Inf(1)}, Point{X: math.Inf(-1), Y: math.Inf(-1)}
+	for _, edge := range sortedEdgeList(g) {
+		source, sourceFound := positions[edge.Node1]
+		target, targetFound := positions[edge.Node2]
+		if !sourceFound || !targetFound {
+			return nil, fmt.Errorf("edge (%d, %d) has an endpoint without position", edge.Node1, edge.Node2)
+		}
+		paths[edge] = []Point{source, target}
+		if source != target {
+			edges = append(edges, edge)
+		}
+		for _, p := range []Point{source, target} {
+			low = Point{X: math.Min(low.X, p.X), Y: math.Min(low.Y, p.Y)}
+			high = Point{X: math.Max(high.X, p.X), Y: math.Max(high.Y, p.Y)}
+		}
+	}
+	if len(edges) < 2 || cycles == 0 {
+		return paths, nil
+	}
+
+	// The compatible edges of every edge, and whether they run in the opposite direction
+	type neighbor struct {
+		edge          int
+		compatibility float64
+		reversed      bool
+	}
+	compatible := make([][]neighbor, len(edges))
+	for i := range edges {
+		for j := i + 1; j < len(edges); j++ {
+			p, q := paths[edges[i]], paths[edges[j]]
+			if c := edgeCompatibility(p[0], p[1], q[0], q[1]); c >= threshold {
+				reversed := (p[1].X-p[0].X)*(q[1].X-q[0].X)+(p[1].Y-p[0].Y)*(q[1].Y-q[0].Y) < 0
+				compatible[i] = append(compatible[i], neighbor{edge: j, compatibility: c, reversed: reversed})
+				compatible[j] = append(compatible[j], neighbor{edge: i, compatibility: c, reversed: reversed})
+			}
+		}
+	}
+
+	// bends[i] holds the inner points of edge i
+	bends := make([][]Point, len(edges))
+	for i, edge := range edges {
+		bends[i] = resamplePolyline(paths[edge], 1)
+	}
+	step := bundlingStep * math.Hypot(high.X-low.X, high.Y-low.Y)
+	iterations := float64(bundlingIterations)
+	for cycle := 0; cycle < cycles; cycle++ {
+		if cycle > 0 {
+			for i, edge := range edges {
+				path := append(append([]Point{paths[edge][0]}, bends[i]...), paths[edge][1])
+				bends[i] = resamplePolyline(path, 2*len(bends[i]))
+			}
+			step /= 2
+			iterations *= 2.0 / 3
+		}
+		count := len(bends[0])
+		for iteration := 0; iteration < int(math.Round(iterations)); iteration++ {
+			next := make([][]Point, len(edges))
+			for i, edge := range edges {
+				source, target := paths[edge][0], paths[edge][1]
+				spring := bundlingStiffness / (source.Distance(target) * float64(count+1))
+				next[i] = make([]Point, count)
+				for k, p := range bends[i] {
+					previous, following := source, target
+					if k > 0 {
+						previous = bends[i][k-1]
+					}
+					if k < count-1 {
+						following = bends[i][k+1]
+					}
+					fx := spring * (previous.X + following.X - 2*p.X)
+					fy := spring * (previous.Y + following.Y - 2*p.Y)
+					for _, other := range compatible[i] {
+						q := bends[other.edge][k]
+						if other.reversed {
+							q = bends[other.edge][count-1-k]
+						}
+						dx, dy := q.X-p.X, q.Y-p.Y
+						if d := math.Hypot(dx, dy); d > 1e-9 {
+							fx += other.compatibility * dx / d
+							fy += other.compatibility * dy / d
+						}
+					}
+					next[i][k] = Point{X: p.X + step*fx, Y: p.Y + step*fy}
+				}
+			}
+			bends = next
+		}
+	}
+
+	for i, edge := range edges {
+		paths[edge] = append(append([]Point{paths[edge][0]}, bends[i]...), paths[edge][1])
+	}
+	return paths, nil
+}
+
+// edgeCompatibility returns the compatibility in [0, 1] of the segments p0p1 and q0q1, neither of length zero.
+func edgeCompatibility(p0, p1, q0, q1 Point) float64 {
+	pLength, qLength := p0.Distance(p1), q0.Distance(q1)
+	angle := math.Abs((p1.X-p0.X)*(q1.X-q0.X)+(p1.Y-p0.Y)*(q1.Y-q0.Y)) / (pLength * qLength)
+	average := (pLength + qLength) / 2
+	scale := 2 / (average/math.Min(pLength, qLength) + math.Max(pLength, qLength)/average)
+	pMiddle, qMiddle := Point{X: (p0.X + p1.X) / 2, Y: (p0.Y + p1.Y) / 2}, Point{X: (q0.X + q1.X) / 2, Y: (q0.Y + q1.Y) / 2}
+	position := average / (average + pMiddle.Distance(qMiddle))
+	visibility := math.Min(edgeVisibility(p0, p1, q0, q1), edgeVisibility(q0, q1, p0, p1))
+	return angle * scale * position * visibility
+}
+
+// edgeVisibility measures how much the projection of q0q1 on the line of p0p1 is centered on p0p1.
+func edgeVisibility(p0, p1, q0, q1 Point) float64 {
+	project := func(q Point) Point {
+		dx, dy := p1.X-p0.X, p1.Y-p0.Y
+		t := ((q.X-p0.X)*dx + (q.Y-p0.Y)*dy) / (dx*dx + dy*dy)
+		return Point{X: p0.X + t*dx, Y: p0.Y + t*dy}
+	}
+	i0, i1 := project(q0), project(q1)
+	length := i0.Distance(i1)
+	if length == 0 {
+		return 0
+	}
+	pMiddle := Point{X: (p0.X + p1.X) / 2, Y: (p0.Y + p1.Y) / 2}
+	iMiddle := Point{X: (i0.X + i1.X) / 2, Y: (i0.Y + i1.Y) / 2}
+	return math.Max(1-2*pMiddle.Distance(iMiddle)/length, 0)
+}
+
+// resamplePolyline returns count points dividing a polyline into count + 1 pieces of equal length, endpoints excluded.
+func resamplePolyline(path []Point, count int) []Point {
+	total := 0.0
+	for i := 1; i < len(path); i++ {
+		total += path[i-1].Distance(path[i])
+	}
+	points := make([]Point, 0, count)
+	segment, covered := 1, 0.0
+	for k := 1; k <= count; k++ {
+		target := total * float64(k) / float64(count+1)
+		for segment < len(path)-1 && covered+path[segment-1].Distance(path[segment]) < target {
+			covered += path[segment-1].Distance(path[segment])
+			segment++
+		}
+		a, b := path[segment-1], path[segment]
+		t := 0.0
+		if length := a.Distance(b); length > 0 {
+			t = (target - covered) / length
+		}
+		points = append(points, Point{X: a.X + t*(b.X-a.X), Y: a.Y + t*(b.Y-a.Y)})
+	}
+	return points
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestForceDirectedEdgeBundling(t *testing.T) {
+	// Two parallel edges, the second drawn from right to left, and a crossing edge
+	g := &UndirectedGraph{Nodes: map[Node]bool{}, Edges: map[Node][]Node{}}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {2, 3}, {4, 5}})
+	positions := map[Node]Point{
+		0: {X: 0, Y: 0}, 1: {X: 10, Y: 0},
+		2: {X: 10, Y: 1}, 3: {X: 0, Y: 1},
+		4: {X: 5, Y: -5}, 5: {X: 5, Y: 6},
+	}
+	paths, err := ForceDirectedEdgeBundling(g, positions, 6, 0.6)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, edge := range sortedEdgeList(g) {
+		path := paths[edge]
+		if len(path) != 34 || path[0] != positions[edge.Node1] || path[len(path)-1] != positions[edge.Node2] {
+			t.Errorf("Expected a path of 34 points between the endpoints of %v, but got %v", edge, path)
+		}
+	}
+	first, second := paths[Edge{Node1: 0, Node2: 1}], paths[Edge{Node1: 2, Node2: 3}]
+	middle := func(path []Point) Point {
+		return Point{X: (path[16].X + path[17].X) / 2, Y: (path[16].Y + path[17].Y) / 2}
+	}
+	if gap := middle(first).Distance(middle(second)); gap > 0.5 {
+		t.Errorf("Expected the parallel edges to be bundled, but their middles are %v apart", gap)
+	}
+	for _, p := range paths[Edge{Node1: 4, Node2: 5}] {
+		if math.Abs(p.X-5) > 1e-9 {
+			t.Errorf("Expected the crossing edge to stay straight, but got %v", paths[Edge{Node1: 4, Node2: 5}])
+			break
+		}
+	}
+
+	straight, _ := ForceDirectedEdgeBundling(g, positions, 0, 0.6)
+	if len(straight[Edge{Node1: 0, Node2: 1}]) != 2 {
+		t.Errorf("Expected straight edges without cycles, but got %v", straight)
+	}
+	if _, err := ForceDirectedEdgeBundling(g, map[Node]Point{0: {}}, 6, 0.6); err == nil {
+		t.Errorf("Expected an error for missing positions")
+	}
+	if _, err := ForceDirectedEdgeBundling(g, positions, 6, 2); err == nil {
+		t.Errorf("Expected an error for a threshold above 1")
+	}
+}
+
+func TestResamplePolyline(t *testing.T) {
+	points := resamplePolyline([]Point{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 2}}, 2)
+	expected := []Point{{X: 1, Y: 0}, {X: 1, Y: 1}}
+	for i := range expected {
+		if points[i].Distance(expected[i]) > 1e-9 {
+			t.Errorf("Expected %v, but got %v", expected, points)
+		}
+	}
+}
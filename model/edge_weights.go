@@ -0,0 +1,114 @@
+package model
+
+import (
+	"math"
+	"math/rand"
+)
+
+// The functions below build WeightFuncs to weight the edges of generated graphs with WeightGraph, either at random or
+// from the structure of the graph, so that weighted algorithms can be benchmarked on realistic weights. They can be
+// combined with ProductWeight, for instance to add noise to a structural weight.
+
+// WeightDistribution draws a random edge weight.
+type WeightDistribution func(rng *rand.Rand) float64
+
+// UniformWeights draws weights uniformly in [low, high).
+func UniformWeights(low, high float64) WeightDistribution {
+	return func(rng *rand.Rand) float64 {
+		return low + (high-low)*rng.Float64()
+	}
+}
+
+// ExponentialWeights draws weights from the exponential distribution with the given positive mean.
+func ExponentialWeights(mean float64) WeightDistribution {
+	return func(rng *rand.Rand) float64 {
+		return mean * rng.ExpFloat64()
+	}
+}
+
+// LogNormalWeights draws weights whose logarithm is normal with mean mu and standard deviation sigma, as the traffic
+// of many transport and communication networks.
+func LogNormalWeights(mu, sigma float64) WeightDistribution {
+	return func(rng *rand.Rand) float64 {
+		return math.Exp(mu + sigma*rng.NormFloat64())
+	}
+}
+
+// ParetoWeights draws weights of at least minimum from a power law of density proportional to w^-exponent, the
+// exponent being above 1.
+func ParetoWeights(minimum, exponent float64) WeightDistribution {
+	return func(rng *rand.Rand) float64 {
+		return minimum * math.Pow(1-rng.Float64(), -1/(exponent-1))
+	}
+}
+
+// RandomWeight returns a WeightFunc drawing the weight of every edge from a distribution the first time it is asked
+// for, and returning the same weight afterwards, whichever order its nodes are given in. The weights depend on the
+// order in which the edges are first asked for, which WeightGraph fixes.
+func RandomWeight(distribution WeightDistribution, seed int64) WeightFunc {
+	rng := rand.New(rand.NewSource(seed))
+	weights := make(map[Edge]float64)
+	return func(u, v Node) float64 {
+		edge := orderedEdge(u, v)
+		weight, ok := weights[edge]
+		if !ok {
+			weight = distribution(rng)
+			weights[edge] = weight
+		}
+		return weight
+	}
+}
+
+// DegreeWeight returns a WeightFunc computing the weight of an edge from the degrees of its nodes in g, the node of
+// smaller id first. The degrees are those of g when DegreeWeight is called.
+func DegreeWeight(g *UndirectedGraph, f func(degreeU, degreeV int) float64) WeightFunc {
+	degrees := make(map[Node]int, len(g.Nodes))
+	for node := range g.Nodes {
+		degrees[node] = g.NodeDegree(node)
+	}
+	return func(u, v Node) float64 {
+		if u > v {
+			u, v = v, u
+		}
+		return f(degrees[u], degrees[v])
+	}
+}
+
+/*
+DegreeProductWeight returns a WeightFunc weighting every edge u - v by (k_u k_v)^exponent, k being the degrees in g.
+
+Parameters:
+- g: The graph whose degrees are used.
+- exponent: The exponent θ; 0 gives unit weights, while positive exponents make the edges between hubs heavier.
+
+Description:
+Empirical weighted networks often follow this relation, with θ about 0.5 in the air transportation network, so that
+the strength of a node grows faster than its degree.
+
+Reference: A. Barrat, M. Barthélemy, R. Pastor-Satorras and A. Vespignani, "The architecture of complex weighted
+networks", PNAS 101(11), 2004.
+*/
+func DegreeProductWeight(g *UndirectedGraph, exponent float64) WeightFunc {
+	return DegreeWeight(g, func(degreeU, degreeV int) float64 {
+		return math.Pow(float64(degreeU)*float64(degreeV), exponent)
+	})
+}
+
+// DistanceWeight returns a WeightFunc applying f to the distance between the positions of the nodes of an edge, for
+// instance an affine cost or a gravity-like decay.
+func DistanceWeight(s *SpatialGraph, f func(distance float64) float64) WeightFunc {
+	return func(u, v Node) float64 {
+		return f(s.EuclideanWeight(u, v))
+	}
+}
+
+// ProductWeight returns a WeightFunc multiplying the weights of several WeightFuncs.
+func ProductWeight(weights ...WeightFunc) WeightFunc {
+	return func(u, v Node) float64 {
+		product := 1.0
+		for _, weight := range weights {
+			product *= weight(u, v)
+		}
+		return product
+	}
+}
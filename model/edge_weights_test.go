@@ -0,0 +1,64 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWeightDistributions(t *testing.T) {
+	g, err := GnpRandomGraph(300, 0.1, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for name, test := range map[string]struct {
+		distribution WeightDistribution
+		minimum      float64
+		mean         float64
+	}{
+		"uniform":     {UniformWeights(2, 4), 2, 3},
+		"exponential": {ExponentialWeights(2), 0, 2},
+		"lognormal":   {LogNormalWeights(0, 0.5), 0, math.Exp(0.125)},
+		"pareto":      {ParetoWeights(1, 4), 1, 1.5},
+	} {
+		w := WeightGraph(g, RandomWeight(test.distribution, 2))
+		for edge, weight := range w.Weights {
+			if weight < test.minimum {
+				t.Fatalf("Expected %s weights of at least %v, but got %v on %v", name, test.minimum, weight, edge)
+			}
+		}
+		if mean := w.TotalWeight() / float64(len(w.Weights)); math.Abs(mean-test.mean) > 0.1*test.mean {
+			t.Errorf("Expected a mean %s weight of about %v, but got %v", name, test.mean, mean)
+		}
+	}
+
+	weight := RandomWeight(UniformWeights(0, 1), 3)
+	if first := weight(5, 2); weight(2, 5) != first || weight(5, 2) != first {
+		t.Errorf("Expected the weight of an edge to be drawn once")
+	}
+	if WeightGraph(g, RandomWeight(ExponentialWeights(1), 4)).TotalWeight() != WeightGraph(g, RandomWeight(ExponentialWeights(1), 4)).TotalWeight() {
+		t.Errorf("Expected the same weights with the same seed")
+	}
+}
+
+func TestStructuralWeights(t *testing.T) {
+	g := StarGraph(5)
+	g.AddEdge(Edge{Node1: 1, Node2: 2})
+
+	weight := DegreeProductWeight(g, 0.5)
+	if w := weight(0, 1); math.Abs(w-math.Sqrt(8)) > 1e-12 {
+		t.Errorf("Expected %v, but got %v", math.Sqrt(8), w)
+	}
+	if w := DegreeProductWeight(g, 0)(3, 0); w != 1 {
+		t.Errorf("Expected 1, but got %v", w)
+	}
+	difference := DegreeWeight(g, func(degreeU, degreeV int) float64 { return float64(degreeU - degreeV) })
+	if difference(0, 3) != 3 || difference(3, 0) != 3 {
+		t.Errorf("Expected the degree of the smaller node first, but got %v and %v", difference(0, 3), difference(3, 0))
+	}
+
+	s := NewSpatialGraph(map[Node]Point{0: {X: 0, Y: 0}, 1: {X: 3, Y: 4}})
+	decay := DistanceWeight(s, func(distance float64) float64 { return 1 / distance })
+	if w := ProductWeight(decay, func(u, v Node) float64 { return 10 })(0, 1); math.Abs(w-2) > 1e-12 {
+		t.Errorf("Expected 2, but got %v", w)
+	}
+}
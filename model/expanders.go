@@ -0,0 +1,347 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+/*
+MargulisGabberGalilGraph returns the Margulis-Gabber-Galil expander on the torus Z_n x Z_n.
+
+Parameters:
+- n: The side of the torus; the node of (x, y) is x*n + y.
+
+Returns:
+- g: The graph linking (x, y) to (x + 2y, y), (x + 2y + 1, y), (x, y + 2x) and (x, y + 2x + 1), modulo n.
+
+Description:
+The original construction is an 8-regular multigraph whose second adjacency eigenvalue is at most 5√2 < 8 for every
+n. Since an UndirectedGraph is simple, self-loops and parallel edges are dropped, so a few nodes have a smaller degree.
+*/
+func MargulisGabberGalilGraph(n int) *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool, max(n*n, 0)), Edges: make(map[Node][]Node)}
+	for x := 0; x < n; x++ {
+		for y := 0; y < n; y++ {
+			node := Node(x*n + y)
+			g.AddNode(node)
+			for _, neighbor := range [][2]int{{x + 2*y, y}, {x + 2*y + 1, y}, {x, y + 2*x}, {x, y + 2*x + 1}} {
+				if other := Node(neighbor[0]%n*n + neighbor[1]%n); other != node {
+					g.AddEdge(Edge{Node1: node, Node2: other})
+				}
+			}
+		}
+	}
+	return g
+}
+
+/*
+LPSGraph returns the Lubotzky-Phillips-Sarnak Ramanujan graph X^{p,q}.
+
+Parameters:
+- p, q: Distinct primes congruent to 1 modulo 4, with q > 2√p.
+
+Returns:
+- g: A (p+1)-regular Ramanujan graph: every adjacency eigenvalue other than ±(p+1) is at most 2√p in absolute value.
+Node 0 is the identity matrix and the other nodes are numbered in breadth-first order.
+- err: An error if the parameters are not valid.
+
+Description:
+The graph is the Cayley graph of PGL(2, q) generated by the p + 1 matrices [[a0 + i a1, a2 + i a3], [-a2 + i a3,
+a0 - i a1]], with i a square root of -1 modulo q and a0² + a1² + a2² + a3² = p, a0 > 0 odd and a1, a2, a3 even.
+If p is a square modulo q, the generators lie in PSL(2, q) and the graph is its q(q² - 1)/2 nodes; otherwise the graph
+is bipartite on all the q(q² - 1) nodes of PGL(2, q). The condition q > 2√p keeps the graph free of self-loops and
+parallel edges.
+*/
+func LPSGraph(p, q int) (*UndirectedGraph, error) {
+	if p == q || !isPrime(p) || !isPrime(q) || p%4 != 1 || q%4 != 1 {
+		return nil, fmt.Errorf("p and q must be distinct primes congruent to 1 modulo 4, got %d and %d", p, q)
+	}
+	if q*q <= 4*p {
+		return nil, fmt.Errorf("q must be larger than 2√p, got p = %d and q = %d", p, q)
+	}
+
+	sqrtMinusOne := 0
+	for x := 1; x < q; x++ {
+		if x*x%q == q-1 {
+			sqrtMinusOne = x
+			break
+		}
+	}
+	mod := func(x int) int { return ((x % q) + q) % q }
+	var generators [][4]int
+	bound := int(math.Sqrt(float64(p)))
+	for a0 := 1; a0 <= bound; a0 += 2 {
+		for a1 := -bound - bound%2; a1 <= bound; a1 += 2 {
+			for a2 := -bound - bound%2; a2 <= bound; a2 += 2 {
+				for a3 := -bound - bound%2; a3 <= bound; a3 += 2 {
+					if a0*a0+a1*a1+a2*a2+a3*a3 == p {
+						generators = append(generators, [4]int{
+							mod(a0 + sqrtMinusOne*a1), mod(a2 + sqrtMinusOne*a3),
+							mod(-a2 + sqrtMinusOne*a3), mod(a0 - sqrtMinusOne*a1),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	inverses := make([]int, q)
+	for x := 1; x < q; x++ {
+		inverses[x] = modularPower(x, q-2, q)
+	}
+	// normalize scales a matrix so that its first nonzero entry is 1, picking one representative per element of PGL(2, q)
+	normalize := func(m [4]int) [4]int {
+		scale := inverses[m[0]]
+		if m[0] == 0 {
+			scale = inverses[m[1]]
+		}
+		for i := range m {
+			m[i] = m[i] * scale % q
+		}
+		return m
+	}
+
+	g := &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	identity := [4]int{1, 0, 0, 1}
+	ids := map[[4]int]Node{identity: 0}
+	g.AddNode(0)
+	queue := [][4]int{identity}
+	for len(queue) > 0 {
+		m := queue[0]
+		queue = queue[1:]
+		for _, s := range generators {
+			next := normalize([4]int{
+				(m[0]*s[0] + m[1]*s[2]) % q, (m[0]*s[1] + m[1]*s[3]) % q,
+				(m[2]*s[0] + m[3]*s[2]) % q, (m[2]*s[1] + m[3]*s[3]) % q,
+			})
+			id, seen := ids[next]
+			if !seen {
+				id = Node(len(ids))
+				ids[next] = id
+				queue = append(queue, next)
+			}
+			g.AddEdge(Edge{Node1: ids[m], Node2: id})
+		}
+	}
+	return g, nil
+}
+
+func isPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+	for d := 2; d*d <= n; d++ {
+		if n%d == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func modularPower(base, exponent, modulus int) int {
+	result := 1
+	base %= modulus
+	for ; exponent > 0; exponent >>= 1 {
+		if exponent&1 == 1 {
+			result = result * base % modulus
+		}
+		base = base * base % modulus
+	}
+	return result
+}
+
+// SpectralGapResult describes the adjacency spectrum of a graph as far as expansion is concerned.
+type SpectralGapResult struct {
+	// Degree is the common degree of the nodes, or -1 if the graph is not regular.
+	Degree int
+	// Largest and Second are the two largest adjacency eigenvalues, and Gap their difference.
+	Largest, Second, Gap float64
+	// Bipartite reports whether the smallest eigenvalue is -Largest, which is then trivial as well.
+	Bipartite bool
+	// Lambda is the largest absolute value of the nontrivial eigenvalues.
+	Lambda float64
+	// Ramanujan reports whether the graph is regular of degree d >= 1 with Lambda <= 2√(d-1).
+	Ramanujan bool
+}
+
+/*
+SpectralGap computes the spectral gap of an UndirectedGraph and checks whether it is a Ramanujan graph.
+
+Parameters:
+- g: The graph.
+
+Returns:
+- result: The extreme adjacency eigenvalues, see SpectralGapResult. Everything is zero for graphs with fewer than 2 nodes.
+
+Description:
+A large gap means a good expander: random walks mix in O(log n / gap) steps and every set of nodes has many edges
+leaving it (Cheeger's inequality). The eigenvalues are computed by AdjacencyEigenvalues.
+*/
+func SpectralGap(g *UndirectedGraph) SpectralGapResult {
+	result := SpectralGapResult{Degree: -1}
+	for node := range g.Nodes {
+		if degree := g.NodeDegree(node); result.Degree == -1 || result.Degree == degree {
+			result.Degree = degree
+		} else {
+			result.Degree = -1
+			break
+		}
+	}
+	eigenvalues := AdjacencyEigenvalues(g)
+	if len(eigenvalues) < 2 {
+		return result
+	}
+
+	const tolerance = 1e-9
+	result.Largest, result.Second = eigenvalues[0], eigenvalues[1]
+	result.Gap = result.Largest - result.Second
+	nontrivial := eigenvalues[1:]
+	if smallest := eigenvalues[len(eigenvalues)-1]; result.Largest > 0 && math.Abs(smallest+result.Largest) < tolerance {
+		result.Bipartite = true
+		nontrivial = nontrivial[:len(nontrivial)-1]
+	}
+	if len(nontrivial) > 0 {
+		result.Lambda = math.Max(math.Abs(nontrivial[0]), math.Abs(nontrivial[len(nontrivial)-1]))
+	}
+	result.Ramanujan = result.Degree >= 1 && result.Lambda <= 2*math.Sqrt(float64(result.Degree-1))+tolerance
+	return result
+}
+
+/*
+AdjacencyEigenvalues returns the eigenvalues of the adjacency matrix of an UndirectedGraph, in decreasing order.
+
+Description:
+The dense matrix is reduced to tridiagonal form by Householder reflections, whose eigenvalues are then found by the QL
+algorithm with implicit shifts, in O(n^3) time and O(n^2) memory. It is meant for graphs of up to a few thousand nodes.
+*/
+func AdjacencyEigenvalues(g *UndirectedGraph) []float64 {
+	nodes := sortedNodes(g.Nodes)
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	matrix := make([][]float64, len(nodes))
+	for i, node := range nodes {
+		matrix[i] = make([]float64, len(nodes))
+		for _, neighbor := range g.Edges[node] {
+			matrix[i][index[neighbor]] = 1
+		}
+	}
+	diagonal, offDiagonal := tridiagonalize(matrix)
+	eigenvalues := tridiagonalEigenvalues(diagonal, offDiagonal)
+	sort.Sort(sort.Reverse(sort.Float64Slice(eigenvalues)))
+	return eigenvalues
+}
+
+// tridiagonalize reduces the symmetric matrix a, which it overwrites, to a tridiagonal matrix with the same
+// eigenvalues, returning its diagonal and its subdiagonal in offDiagonal[1:].
+func tridiagonalize(a [][]float64) ([]float64, []float64) {
+	n := len(a)
+	diagonal, offDiagonal := make([]float64, n), make([]float64, n)
+	for i := n - 1; i > 0; i-- {
+		l := i - 1
+		scale := 0.0
+		for k := 0; k <= l; k++ {
+			scale += math.Abs(a[i][k])
+		}
+		if l == 0 || scale == 0 {
+			offDiagonal[i] = a[i][l]
+			continue
+		}
+
+		h := 0.0
+		for k := 0; k <= l; k++ {
+			a[i][k] /= scale
+			h += a[i][k] * a[i][k]
+		}
+		f := a[i][l]
+		g := math.Sqrt(h)
+		if f >= 0 {
+			g = -g
+		}
+		offDiagonal[i] = scale * g
+		h -= f * g
+		a[i][l] = f - g
+		f = 0
+		for j := 0; j <= l; j++ {
+			g = 0
+			for k := 0; k <= j; k++ {
+				g += a[j][k] * a[i][k]
+			}
+			for k := j + 1; k <= l; k++ {
+				g += a[k][j] * a[i][k]
+			}
+			offDiagonal[j] = g / h
+			f += offDiagonal[j] * a[i][j]
+		}
+		hh := f / (h + h)
+		for j := 0; j <= l; j++ {
+			f = a[i][j]
+			g = offDiagonal[j] - hh*f
+			offDiagonal[j] = g
+			for k := 0; k <= j; k++ {
+				a[j][k] -= f*offDiagonal[k] + g*a[i][k]
+			}
+		}
+	}
+	for i := range diagonal {
+		diagonal[i] = a[i][i]
+	}
+	return diagonal, offDiagonal
+}
+
+// tridiagonalEigenvalues returns the eigenvalues of the symmetric tridiagonal matrix returned by tridiagonalize,
+// overwriting its diagonal with them.
+func tridiagonalEigenvalues(d, e []float64) []float64 {
+	n := len(d)
+	if n == 0 {
+		return d
+	}
+	copy(e, e[1:])
+	e[n-1] = 0
+	for l := 0; l < n; l++ {
+		for iteration := 0; iteration < 100; iteration++ {
+			m := l
+			for ; m < n-1; m++ {
+				if dd := math.Abs(d[m]) + math.Abs(d[m+1]); math.Abs(e[m])+dd == dd {
+					break
+				}
+			}
+			if m == l {
+				break
+			}
+
+			g := (d[l+1] - d[l]) / (2 * e[l])
+			r := math.Hypot(g, 1)
+			g = d[m] - d[l] + e[l]/(g+math.Copysign(r, g))
+			s, c, p := 1.0, 1.0, 0.0
+			underflow := false
+			for i := m - 1; i >= l; i-- {
+				f, b := s*e[i], c*e[i]
+				r = math.Hypot(f, g)
+				e[i+1] = r
+				if r == 0 {
+					// Split the matrix and start over
+					d[i+1] -= p
+					e[m] = 0
+					underflow = true
+					break
+				}
+				s, c = f/r, g/r
+				g = d[i+1] - p
+				r = (d[i]-g)*s + 2*c*b
+				p = s * r
+				d[i+1] = g + p
+				g = c*r - b
+			}
+			if underflow {
+				continue
+			}
+			d[l] -= p
+			e[l] = g
+			e[m] = 0
+		}
+	}
+	return d
+}
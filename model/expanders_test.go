@@ -0,0 +1,112 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAdjacencyEigenvalues(t *testing.T) {
+	testCases := []struct {
+		name     string
+		g        *UndirectedGraph
+		expected []float64
+	}{
+		{"petersen", petersenGraph(), []float64{3, 1, 1, 1, 1, 1, -2, -2, -2, -2}},
+		{"cycle", CycleGraph(6), []float64{2, 1, 1, -1, -1, -2}},
+		{"star", StarGraph(4), []float64{math.Sqrt(3), 0, 0, -math.Sqrt(3)}},
+		{"complete", CompleteGraph(4), []float64{3, -1, -1, -1}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			eigenvalues := AdjacencyEigenvalues(testCase.g)
+			if len(eigenvalues) != len(testCase.expected) {
+				t.Fatalf("Expected %v, but got %v", testCase.expected, eigenvalues)
+			}
+			for i := range eigenvalues {
+				if math.Abs(eigenvalues[i]-testCase.expected[i]) > 1e-9 {
+					t.Errorf("Expected %v, but got %v", testCase.expected, eigenvalues)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestSpectralGap(t *testing.T) {
+	petersen := SpectralGap(petersenGraph())
+	if petersen.Degree != 3 || math.Abs(petersen.Gap-2) > 1e-9 || math.Abs(petersen.Lambda-2) > 1e-9 || petersen.Bipartite || !petersen.Ramanujan {
+		t.Errorf("Expected the Petersen graph to be a Ramanujan graph with gap 2, but got %+v", petersen)
+	}
+
+	cycle := SpectralGap(CycleGraph(6))
+	if !cycle.Bipartite || math.Abs(cycle.Lambda-1) > 1e-9 || !cycle.Ramanujan {
+		t.Errorf("Expected the 6-cycle to be a bipartite Ramanujan graph, but got %+v", cycle)
+	}
+
+	// A long cycle mixes slowly
+	longCycle := SpectralGap(CycleGraph(40))
+	if longCycle.Gap > 0.1 {
+		t.Errorf("Expected a small gap for the 40-cycle, but got %+v", longCycle)
+	}
+
+	star := SpectralGap(StarGraph(4))
+	if star.Degree != -1 || star.Ramanujan {
+		t.Errorf("Expected the star not to be regular, but got %+v", star)
+	}
+
+	if empty := SpectralGap(&UndirectedGraph{}); empty.Gap != 0 || empty.Ramanujan {
+		t.Errorf("Expected an empty result, but got %+v", empty)
+	}
+}
+
+func TestMargulisGabberGalilGraph(t *testing.T) {
+	g := MargulisGabberGalilGraph(8)
+	if len(g.Nodes) != 64 || len(ConnectedComponents(g).ComponentsArray) != 1 {
+		t.Fatalf("Expected a connected graph with 64 nodes, but got %d nodes", len(g.Nodes))
+	}
+	for node := range g.Nodes {
+		if degree := g.NodeDegree(node); degree > 8 {
+			t.Errorf("Expected degrees of at most 8, but got %d for %d", degree, node)
+		}
+	}
+	// The second eigenvalue of the multigraph is at most 5√2, dropping loops and parallel edges keeps a wide gap
+	if result := SpectralGap(g); result.Second > 5*math.Sqrt(2) || result.Gap < 0.5 {
+		t.Errorf("Expected a spectral gap, but got %+v", result)
+	}
+}
+
+func TestLPSGraph(t *testing.T) {
+	testCases := []struct {
+		p, q      int
+		nodes     int
+		bipartite bool
+	}{
+		// 5 is not a square modulo 13: the graph covers PGL(2, 13)
+		{5, 13, 13 * 168, true},
+		// 13 is a square modulo 17: the graph covers PSL(2, 17)
+		{13, 17, 17 * 288 / 2, false},
+	}
+	for _, testCase := range testCases {
+		g, err := LPSGraph(testCase.p, testCase.q)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(g.Nodes) != testCase.nodes {
+			t.Errorf("Expected %d nodes, but got %d", testCase.nodes, len(g.Nodes))
+		}
+		for node := range g.Nodes {
+			if degree := g.NodeDegree(node); degree != testCase.p+1 {
+				t.Fatalf("Expected degree %d, but got %d for %d", testCase.p+1, degree, node)
+			}
+		}
+		if _, ok := IsBipartite(g); ok != testCase.bipartite {
+			t.Errorf("Expected bipartite %v for p = %d and q = %d", testCase.bipartite, testCase.p, testCase.q)
+		}
+	}
+
+	for _, parameters := range [][2]int{{5, 5}, {3, 13}, {5, 15}, {13, 5}} {
+		if _, err := LPSGraph(parameters[0], parameters[1]); err == nil {
+			t.Errorf("Expected an error for %v", parameters)
+		}
+	}
+}
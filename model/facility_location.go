@@ -0,0 +1,197 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// FacilityLocation is a solution of a facility placement problem on a graph.
+type FacilityLocation struct {
+	// Facilities are the selected nodes, in the order they were chosen.
+	Facilities []Node
+	// Assignment maps every node to the closest facility.
+	Assignment map[Node]Node
+	// Cost is the objective value: the largest assignment distance for k-center
+	// and the sum of the assignment distances for k-median.
+	Cost float64
+}
+
+/*
+KCenter places k facilities on the nodes of a graph with Gonzalez's farthest-first heuristic.
+
+Parameters:
+- g: The graph to place facilities on.
+- k: The number of facilities, between 1 and the number of nodes.
+- weight: A WeightFunc giving the (non-negative) length of every edge. Use UnitWeight for hop distances.
+
+Returns:
+- location: The facilities, the assignment of every node to its closest facility and the largest assignment distance.
+- err: An error if k is out of range or a shortest-path computation fails.
+
+Description:
+The first facility is the node with the smallest id; every following facility is the node farthest from the facilities chosen so far, the smallest id breaking ties, so the k facilities are distinct. The result is a 2-approximation of the optimal k-center radius. Nodes unreachable from every facility have an infinite distance and are therefore picked first.
+
+Reference: T. F. Gonzalez, "Clustering to minimize the maximum intercluster distance", Theoretical Computer Science 38, 1985.
+*/
+func KCenter(g *UndirectedGraph, k int, weight WeightFunc) (*FacilityLocation, error) {
+	if k < 1 || k > len(g.Nodes) {
+		return nil, fmt.Errorf("k must be between 1 and the number of nodes (%d)", len(g.Nodes))
+	}
+
+	nodes := sortedNodes(g.Nodes)
+	facilities := []Node{nodes[0]}
+	isFacility := map[Node]bool{nodes[0]: true}
+	// closest holds the distance from every node to its nearest facility
+	closest := make(map[Node]float64, len(nodes))
+	for _, node := range nodes {
+		closest[node] = math.Inf(1)
+	}
+
+	for {
+		distances, _, _, err := dijkstra(g.Edges, facilities[len(facilities)-1], weight)
+		if err != nil {
+			return nil, err
+		}
+		for node, distance := range distances {
+			closest[node] = math.Min(closest[node], distance)
+		}
+		if len(facilities) == k {
+			break
+		}
+
+		// The farthest node is picked among the non-facilities, as zero-length edges can leave every node at distance 0
+		farthest, found := Node(0), false
+		for _, node := range nodes {
+			if !isFacility[node] && (!found || closest[node] > closest[farthest]) {
+				farthest, found = node, true
+			}
+		}
+		facilities = append(facilities, farthest)
+		isFacility[farthest] = true
+	}
+
+	distances, err := facilityDistances(g, facilities, weight)
+	if err != nil {
+		return nil, err
+	}
+	location := assignToFacilities(nodes, facilities, distances)
+	for _, node := range nodes {
+		location.Cost = math.Max(location.Cost, distances[location.Assignment[node]][node])
+	}
+	return location, nil
+}
+
+/*
+KMedian places k facilities on the nodes of a graph minimizing the sum of the distances from every node to its closest facility.
+
+Parameters:
+- g: The graph to place facilities on.
+- k: The number of facilities, between 1 and the number of nodes.
+- weight: A WeightFunc giving the (non-negative) length of every edge. Use UnitWeight for hop distances.
+- maxSwaps: The maximum number of improving swaps performed by the local search; a non-positive value means no limit.
+
+Returns:
+- location: The facilities, the assignment of every node to its closest facility and the total assignment distance.
+- err: An error if k is out of range or a shortest-path computation fails.
+
+Description:
+The search starts from the KCenter solution and repeatedly swaps a facility with a non-facility node whenever this lowers the total distance, until no single swap improves it (a 5-approximation). The distances between all pairs of nodes are computed up front, so the function is intended for graphs with up to a few thousand nodes.
+
+Reference: V. Arya et al., "Local search heuristics for k-median and facility location problems", SIAM Journal on Computing 33, 2004.
+*/
+func KMedian(g *UndirectedGraph, k int, weight WeightFunc, maxSwaps int) (*FacilityLocation, error) {
+	initial, err := KCenter(g, k, weight)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := sortedNodes(g.Nodes)
+	distances, err := facilityDistances(g, nodes, weight)
+	if err != nil {
+		return nil, err
+	}
+
+	facilities := initial.Facilities
+	cost := kMedianCost(nodes, facilities, distances)
+	for swaps := 0; maxSwaps <= 0 || swaps < maxSwaps; swaps++ {
+		improved := false
+		isFacility := make(map[Node]bool, len(facilities))
+		for _, facility := range facilities {
+			isFacility[facility] = true
+		}
+
+	search:
+		for i := range facilities {
+			for _, candidate := range nodes {
+				if isFacility[candidate] {
+					continue
+				}
+				swapped := append([]Node{}, facilities...)
+				swapped[i] = candidate
+				if swappedCost := kMedianCost(nodes, swapped, distances); swappedCost < cost {
+					facilities, cost = swapped, swappedCost
+					improved = true
+					break search
+				}
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+
+	location := assignToFacilities(nodes, facilities, distances)
+	location.Cost = cost
+	return location, nil
+}
+
+// facilityDistances returns the shortest-path distances from every given source to every node, unreachable nodes being at infinite distance.
+func facilityDistances(g *UndirectedGraph, sources []Node, weight WeightFunc) (map[Node]map[Node]float64, error) {
+	distances := make(map[Node]map[Node]float64, len(sources))
+	for _, source := range sources {
+		reached, _, _, err := dijkstra(g.Edges, source, weight)
+		if err != nil {
+			return nil, err
+		}
+		distances[source] = make(map[Node]float64, len(g.Nodes))
+		for node := range g.Nodes {
+			if distance, ok := reached[node]; ok {
+				distances[source][node] = distance
+			} else {
+				distances[source][node] = math.Inf(1)
+			}
+		}
+	}
+	return distances, nil
+}
+
+// assignToFacilities assigns every node to its closest facility, ties going to the facility chosen first.
+func assignToFacilities(nodes []Node, facilities []Node, distances map[Node]map[Node]float64) *FacilityLocation {
+	location := &FacilityLocation{
+		Facilities: facilities,
+		Assignment: make(map[Node]Node, len(nodes)),
+	}
+	for _, node := range nodes {
+		best := facilities[0]
+		for _, facility := range facilities[1:] {
+			if distances[facility][node] < distances[best][node] {
+				best = facility
+			}
+		}
+		location.Assignment[node] = best
+	}
+	return location
+}
+
+// kMedianCost returns the sum of the distances from every node to its closest facility.
+func kMedianCost(nodes []Node, facilities []Node, distances map[Node]map[Node]float64) float64 {
+	cost := 0.0
+	for _, node := range nodes {
+		best := math.Inf(1)
+		for _, facility := range facilities {
+			best = math.Min(best, distances[facility][node])
+		}
+		cost += best
+	}
+	return cost
+}
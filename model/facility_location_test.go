@@ -0,0 +1,66 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKCenter(t *testing.T) {
+	// Test case 1: Two centers on a path
+	location, err := KCenter(PathGraph(7), 2, UnitWeight)
+	if err != nil {
+		t.Fatalf("Test case 1 failed: Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(location.Facilities, []Node{0, 6}) {
+		t.Errorf("Test case 1 failed: Expected facilities [0 6], but got %v", location.Facilities)
+	}
+	if location.Cost != 3 {
+		t.Errorf("Test case 1 failed: Expected cost 3, but got %v", location.Cost)
+	}
+	if location.Assignment[2] != 0 || location.Assignment[4] != 6 {
+		t.Errorf("Test case 1 failed: Unexpected assignment %v", location.Assignment)
+	}
+
+	// Test case 2: One center per connected component
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {10, 11}})
+	location, err = KCenter(g, 2, UnitWeight)
+	if err != nil {
+		t.Fatalf("Test case 2 failed: Unexpected error: %v", err)
+	}
+	if location.Assignment[11] == location.Assignment[0] {
+		t.Errorf("Test case 2 failed: Expected both components to get a facility, got %v", location.Facilities)
+	}
+
+	// Test case 3: Distinct facilities when every edge has length 0
+	location, err = KCenter(PathGraph(3), 2, func(u, v Node) float64 { return 0 })
+	if err != nil {
+		t.Fatalf("Test case 3 failed: Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(location.Facilities, []Node{0, 1}) || location.Cost != 0 {
+		t.Errorf("Test case 3 failed: Expected facilities [0 1] with cost 0, but got %v with cost %v", location.Facilities, location.Cost)
+	}
+
+	// Test case 4: Invalid k
+	if _, err := KCenter(PathGraph(3), 4, UnitWeight); err == nil {
+		t.Errorf("Test case 4 failed: Expected an error, but got nil")
+	}
+}
+
+func TestKMedian(t *testing.T) {
+	location, err := KMedian(PathGraph(7), 1, UnitWeight, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(location.Facilities, []Node{3}) || location.Cost != 12 {
+		t.Errorf("Expected facility 3 with cost 12, but got %v with cost %v", location.Facilities, location.Cost)
+	}
+
+	location, err = KMedian(PathGraph(7), 2, UnitWeight, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if location.Cost != 6 {
+		t.Errorf("Expected cost 6, but got %v with facilities %v", location.Cost, location.Facilities)
+	}
+}
@@ -0,0 +1,312 @@
+package model
+
+import "sort"
+
+/*
+FeedbackArcSet returns a small set of arcs whose removal makes a directed graph acyclic.
+
+Parameters:
+- g: The directed graph.
+
+Returns:
+- arcs: The removed arcs, sorted by Node1 then Node2.
+- dag: A new DirectedGraph with all the nodes of g and its remaining arcs.
+
+Description:
+The nodes are first ordered with the greedy heuristic of Eades, Lin and Smyth: sinks are repeatedly moved to the end
+of the order and sources to its beginning, and when neither exists the node with the largest difference between
+out-degree and in-degree is moved to the beginning. The order is then improved by local search, moving single
+nodes to the position that minimizes the number of arcs pointing backwards, until no move helps. The arcs pointing
+backwards in the final order, self-loops included, form the feedback arc set.
+
+Example:
+
+	g := &DirectedGraph{}
+	g.AddEdge(Edge{Node1: 0, Node2: 1})
+	g.AddEdge(Edge{Node1: 1, Node2: 2})
+	g.AddEdge(Edge{Node1: 2, Node2: 0})
+	arcs, _ := FeedbackArcSet(g)
+
+	fmt.Println(len(arcs)) // Output: 1
+*/
+func FeedbackArcSet(g *DirectedGraph) ([]Edge, *DirectedGraph) {
+	order := greedyFeedbackOrder(g)
+	improveFeedbackOrder(g, order)
+
+	position := make(map[Node]int, len(order))
+	for i, node := range order {
+		position[node] = i
+	}
+	var arcs []Edge
+	dag := &DirectedGraph{Nodes: make(map[Node]bool, len(g.Nodes)), Successors: make(map[Node][]Node), Predecessors: make(map[Node][]Node)}
+	for _, node := range order {
+		dag.AddNode(node)
+		for _, successor := range g.Successors[node] {
+			if position[successor] <= position[node] {
+				arcs = append(arcs, Edge{Node1: node, Node2: successor})
+			} else {
+				dag.AddEdge(Edge{Node1: node, Node2: successor})
+			}
+		}
+	}
+	sortEdges(arcs)
+	return arcs, dag
+}
+
+// greedyFeedbackOrder returns the Eades-Lin-Smyth order of the nodes.
+func greedyFeedbackOrder(g *DirectedGraph) []Node {
+	inDegree := make(map[Node]int, len(g.Nodes))
+	outDegree := make(map[Node]int, len(g.Nodes))
+	for node := range g.Nodes {
+		for _, successor := range g.Successors[node] {
+			if successor != node {
+				outDegree[node]++
+				inDegree[successor]++
+			}
+		}
+	}
+
+	remaining := make(map[Node]bool, len(g.Nodes))
+	for node := range g.Nodes {
+		remaining[node] = true
+	}
+	remove := func(node Node) {
+		delete(remaining, node)
+		for _, successor := range g.Successors[node] {
+			if successor != node {
+				inDegree[successor]--
+			}
+		}
+		for _, predecessor := range g.Predecessors[node] {
+			if predecessor != node {
+				outDegree[predecessor]--
+			}
+		}
+	}
+
+	var head, tail []Node
+	for len(remaining) > 0 {
+		nodes := sortedNodes(remaining)
+		progress := false
+		for _, node := range nodes {
+			if remaining[node] && outDegree[node] == 0 {
+				tail = append(tail, node)
+				remove(node)
+				progress = true
+			}
+		}
+		for _, node := range nodes {
+			if remaining[node] && inDegree[node] == 0 {
+				head = append(head, node)
+				remove(node)
+				progress = true
+			}
+		}
+		if progress {
+			continue
+		}
+
+		best := nodes[0]
+		for _, node := range nodes[1:] {
+			if outDegree[node]-inDegree[node] > outDegree[best]-inDegree[best] {
+				best = node
+			}
+		}
+		head = append(head, best)
+		remove(best)
+	}
+
+	// The sinks were collected from the end of the order
+	for i := len(tail) - 1; i >= 0; i-- {
+		head = append(head, tail[i])
+	}
+	return head
+}
+
+// improveFeedbackOrder moves single nodes of the order to the position minimizing their backward arcs until no move helps.
+func improveFeedbackOrder(g *DirectedGraph, order []Node) {
+	for improved := true; improved; {
+		improved = false
+		for _, node := range sortedNodes(g.Nodes) {
+			position := make(map[Node]int, len(order))
+			for i, other := range order {
+				position[other] = i
+			}
+			current := position[node]
+
+			// rank is the position of a node once node is taken out of the order
+			rank := func(other Node) int {
+				if position[other] > current {
+					return position[other] - 1
+				}
+				return position[other]
+			}
+			// Inserting node at slot i makes arcs to successors ranked before i and from predecessors ranked from i backward
+			delta := make([]int, len(order))
+			cost := 0
+			for _, successor := range g.Successors[node] {
+				if successor != node {
+					delta[rank(successor)]++
+				}
+			}
+			for _, predecessor := range g.Predecessors[node] {
+				if predecessor != node {
+					delta[rank(predecessor)]--
+					cost++
+				}
+			}
+
+			bestSlot, bestCost, currentCost := 0, cost, -1
+			for slot := 0; slot < len(order); slot++ {
+				if slot == current {
+					currentCost = cost
+				}
+				if cost < bestCost {
+					bestSlot, bestCost = slot, cost
+				}
+				cost += delta[slot]
+			}
+			if bestCost >= currentCost {
+				continue
+			}
+
+			copy(order[current:], order[current+1:])
+			copy(order[bestSlot+1:], order[bestSlot:len(order)-1])
+			order[bestSlot] = node
+			improved = true
+		}
+	}
+}
+
+/*
+FeedbackVertexSet returns a small set of nodes whose removal makes a directed graph acyclic.
+
+Parameters:
+- g: The directed graph.
+
+Returns:
+- nodes: The removed nodes, sorted.
+- dag: A new DirectedGraph without the removed nodes and their arcs.
+
+Description:
+Nodes that cannot lie on a cycle (no incoming or no outgoing arc among the remaining nodes) are repeatedly discarded;
+when none is left, a node with a self-loop, or else the node with the largest product of in-degree and out-degree,
+is added to the set. Finally, nodes of the set whose return would not create a cycle are returned to the graph.
+*/
+func FeedbackVertexSet(g *DirectedGraph) ([]Node, *DirectedGraph) {
+	remaining := make(map[Node]bool, len(g.Nodes))
+	for node := range g.Nodes {
+		remaining[node] = true
+	}
+	degrees := func(node Node) (in, out int) {
+		for _, successor := range g.Successors[node] {
+			if remaining[successor] {
+				out++
+			}
+		}
+		for _, predecessor := range g.Predecessors[node] {
+			if remaining[predecessor] {
+				in++
+			}
+		}
+		return in, out
+	}
+
+	removed := make(map[Node]bool)
+	for len(remaining) > 0 {
+		progress := false
+		for _, node := range sortedNodes(remaining) {
+			if in, out := degrees(node); in == 0 || out == 0 {
+				delete(remaining, node)
+				progress = true
+			}
+		}
+		if progress {
+			continue
+		}
+
+		var best Node
+		bestScore := -1
+		for _, node := range sortedNodes(remaining) {
+			in, out := degrees(node)
+			score := in * out
+			if g.HasEdge(node, node) {
+				score = len(g.Nodes) * len(g.Nodes)
+			}
+			if score > bestScore {
+				best, bestScore = node, score
+			}
+		}
+		removed[best] = true
+		delete(remaining, best)
+	}
+
+	// Drop redundant nodes, larger ids first
+	candidates := sortedNodes(removed)
+	for i := len(candidates) - 1; i >= 0; i-- {
+		delete(removed, candidates[i])
+		if !isAcyclicWithout(g, removed) {
+			removed[candidates[i]] = true
+		}
+	}
+
+	dag := &DirectedGraph{Nodes: make(map[Node]bool), Successors: make(map[Node][]Node), Predecessors: make(map[Node][]Node)}
+	for _, node := range sortedNodes(g.Nodes) {
+		if removed[node] {
+			continue
+		}
+		dag.AddNode(node)
+		for _, successor := range g.Successors[node] {
+			if !removed[successor] {
+				dag.AddEdge(Edge{Node1: node, Node2: successor})
+			}
+		}
+	}
+	return sortedNodes(removed), dag
+}
+
+// isAcyclicWithout reports whether g has no cycle once the removed nodes are deleted, using Kahn's algorithm.
+func isAcyclicWithout(g *DirectedGraph, removed map[Node]bool) bool {
+	inDegree := make(map[Node]int, len(g.Nodes))
+	var queue []Node
+	for node := range g.Nodes {
+		if removed[node] {
+			continue
+		}
+		for _, predecessor := range g.Predecessors[node] {
+			if !removed[predecessor] {
+				inDegree[node]++
+			}
+		}
+		if inDegree[node] == 0 {
+			queue = append(queue, node)
+		}
+	}
+
+	visited := 0
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		visited++
+		for _, successor := range g.Successors[node] {
+			if removed[successor] {
+				continue
+			}
+			inDegree[successor]--
+			if inDegree[successor] == 0 {
+				queue = append(queue, successor)
+			}
+		}
+	}
+	return visited == len(g.Nodes)-len(removed)
+}
+
+// sortEdges sorts edges by Node1 then Node2.
+func sortEdges(edges []Edge) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Node1 != edges[j].Node1 {
+			return edges[i].Node1 < edges[j].Node1
+		}
+		return edges[i].Node2 < edges[j].Node2
+	})
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func directedFromEdges(edges [][2]int) *DirectedGraph {
+	g := &DirectedGraph{}
+	for _, edge := range edges {
+		g.AddEdge(Edge{Node1: Node(edge[0]), Node2: Node(edge[1])})
+	}
+	return g
+}
+
+func TestFeedbackArcSet(t *testing.T) {
+	tests := []struct {
+		name string
+		g    *DirectedGraph
+		size int
+	}{
+		{"DAG", directedFromEdges([][2]int{{0, 1}, {1, 2}, {0, 2}}), 0},
+		{"Cycle", directedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}}), 1},
+		{"Self-loop", directedFromEdges([][2]int{{0, 1}, {1, 1}}), 1},
+		{"Two cycles sharing an arc", directedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 0}, {1, 3}, {3, 0}}), 1},
+		{"Opposite arcs", directedFromEdges([][2]int{{0, 1}, {1, 0}, {1, 2}, {2, 1}}), 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			arcs, dag := FeedbackArcSet(test.g)
+			if len(arcs) != test.size {
+				t.Errorf("Expected %d arcs, but got %v", test.size, arcs)
+			}
+			if !isAcyclicWithout(dag, nil) || len(dag.Nodes) != len(test.g.Nodes) || dag.NumberOfEdges()+len(arcs) != test.g.NumberOfEdges() {
+				t.Errorf("Expected an acyclic remainder with all nodes, but got %v", dag.Successors)
+			}
+		})
+	}
+
+	// A random tournament always yields a valid DAG
+	rng := rand.New(rand.NewSource(5))
+	tournament := &DirectedGraph{}
+	for u := 0; u < 12; u++ {
+		for v := u + 1; v < 12; v++ {
+			if rng.Intn(2) == 0 {
+				tournament.AddEdge(Edge{Node1: Node(u), Node2: Node(v)})
+			} else {
+				tournament.AddEdge(Edge{Node1: Node(v), Node2: Node(u)})
+			}
+		}
+	}
+	arcs, dag := FeedbackArcSet(tournament)
+	if !isAcyclicWithout(dag, nil) || dag.NumberOfEdges()+len(arcs) != 66 {
+		t.Errorf("Expected the tournament to be split into a DAG and %d feedback arcs", len(arcs))
+	}
+}
+
+func TestFeedbackVertexSet(t *testing.T) {
+	// Test case 1: Two cycles sharing a node
+	g := directedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 2}, {4, 5}})
+	nodes, dag := FeedbackVertexSet(g)
+	if !reflect.DeepEqual(nodes, []Node{2}) {
+		t.Errorf("Test case 1 failed: Expected [2], but got %v", nodes)
+	}
+	if !isAcyclicWithout(dag, nil) || dag.HasNode(2) || len(dag.Nodes) != 5 {
+		t.Errorf("Test case 1 failed: Unexpected remainder %v", dag.Successors)
+	}
+
+	// Test case 2: Self-loops must be removed
+	nodes, _ = FeedbackVertexSet(directedFromEdges([][2]int{{0, 0}, {0, 1}, {1, 2}}))
+	if !reflect.DeepEqual(nodes, []Node{0}) {
+		t.Errorf("Test case 2 failed: Expected [0], but got %v", nodes)
+	}
+
+	// Test case 3: Nothing to remove from a DAG
+	if nodes, _ := FeedbackVertexSet(directedFromEdges([][2]int{{0, 1}, {1, 2}})); len(nodes) != 0 {
+		t.Errorf("Test case 3 failed: Expected no nodes, but got %v", nodes)
+	}
+}
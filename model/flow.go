@@ -0,0 +1,242 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// flowEpsilon is the residual capacity below which an arc is considered saturated.
+const flowEpsilon = 1e-12
+
+// flowNetwork is a capacitated network on vertices 0..n-1. Arcs are stored in pairs, arc i^1 being the reverse of arc i.
+type flowNetwork struct {
+	adjacency [][]int
+	to        []int
+	capacity  []float64
+	flow      []float64
+}
+
+func newFlowNetwork(vertexCount int) *flowNetwork {
+	return &flowNetwork{adjacency: make([][]int, vertexCount)}
+}
+
+// addArc adds an arc from u to v with the given capacity, and its reverse with reverseCapacity.
+func (f *flowNetwork) addArc(u, v int, capacity, reverseCapacity float64) {
+	f.adjacency[u] = append(f.adjacency[u], len(f.to))
+	f.to = append(f.to, v)
+	f.capacity = append(f.capacity, capacity)
+	f.flow = append(f.flow, 0)
+	f.adjacency[v] = append(f.adjacency[v], len(f.to))
+	f.to = append(f.to, u)
+	f.capacity = append(f.capacity, reverseCapacity)
+	f.flow = append(f.flow, 0)
+}
+
+func (f *flowNetwork) residual(arc int) float64 {
+	return f.capacity[arc] - f.flow[arc]
+}
+
+// reset removes all the flow from the network.
+func (f *flowNetwork) reset() {
+	for i := range f.flow {
+		f.flow[i] = 0
+	}
+}
+
+// maxFlow pushes a maximum flow from s to t with Dinic's algorithm and returns its value.
+func (f *flowNetwork) maxFlow(s, t int) float64 {
+	total := 0.0
+	level := make([]int, len(f.adjacency))
+	next := make([]int, len(f.adjacency))
+
+	var push func(u int, limit float64) float64
+	push = func(u int, limit float64) float64 {
+		if u == t {
+			return limit
+		}
+		for ; next[u] < len(f.adjacency[u]); next[u]++ {
+			arc := f.adjacency[u][next[u]]
+			v := f.to[arc]
+			if level[v] != level[u]+1 || f.residual(arc) <= flowEpsilon {
+				continue
+			}
+			if pushed := push(v, math.Min(limit, f.residual(arc))); pushed > flowEpsilon {
+				f.flow[arc] += pushed
+				f.flow[arc^1] -= pushed
+				return pushed
+			}
+		}
+		return 0
+	}
+
+	for f.buildLevels(s, level); level[t] >= 0; f.buildLevels(s, level) {
+		for i := range next {
+			next[i] = 0
+		}
+		for pushed := push(s, math.Inf(1)); pushed > flowEpsilon; pushed = push(s, math.Inf(1)) {
+			total += pushed
+		}
+	}
+	return total
+}
+
+// edmondsKarp pushes a maximum flow from s to t along shortest augmenting paths and returns its value.
+func (f *flowNetwork) edmondsKarp(s, t int) float64 {
+	total := 0.0
+	parentArc := make([]int, len(f.adjacency))
+	for {
+		for i := range parentArc {
+			parentArc[i] = -1
+		}
+		parentArc[s] = len(f.to)
+		queue := []int{s}
+		for len(queue) > 0 && parentArc[t] < 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, arc := range f.adjacency[u] {
+				if v := f.to[arc]; parentArc[v] < 0 && f.residual(arc) > flowEpsilon {
+					parentArc[v] = arc
+					queue = append(queue, v)
+				}
+			}
+		}
+		if parentArc[t] < 0 {
+			return total
+		}
+		pushed := math.Inf(1)
+		for v := t; v != s; v = f.to[parentArc[v]^1] {
+			pushed = math.Min(pushed, f.residual(parentArc[v]))
+		}
+		for v := t; v != s; v = f.to[parentArc[v]^1] {
+			f.flow[parentArc[v]] += pushed
+			f.flow[parentArc[v]^1] -= pushed
+		}
+		total += pushed
+	}
+}
+
+// buildLevels computes the BFS level of every vertex in the residual network, -1 for unreachable vertices.
+func (f *flowNetwork) buildLevels(s int, level []int) {
+	for i := range level {
+		level[i] = -1
+	}
+	level[s] = 0
+	queue := []int{s}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, arc := range f.adjacency[u] {
+			if v := f.to[arc]; level[v] < 0 && f.residual(arc) > flowEpsilon {
+				level[v] = level[u] + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+}
+
+// sourceSide returns the vertices reachable from s in the residual network, the source side of a minimum cut after maxFlow.
+func (f *flowNetwork) sourceSide(s int) []bool {
+	level := make([]int, len(f.adjacency))
+	f.buildLevels(s, level)
+	side := make([]bool, len(level))
+	for i, l := range level {
+		side[i] = l >= 0
+	}
+	return side
+}
+
+// FlowAlgorithm selects the algorithm computing a maximum flow.
+type FlowAlgorithm int
+
+const (
+	// Dinic augments along blocking flows of the level graph, in O(n²m) time.
+	Dinic FlowAlgorithm = iota
+	// EdmondsKarp augments along shortest paths, in O(nm²) time.
+	EdmondsKarp
+)
+
+// MaxFlowResult is a maximum flow between two nodes of a DirectedGraph and the minimum cut it saturates.
+type MaxFlowResult struct {
+	// Value is the amount of flow leaving the source, which is also the capacity of the minimum cut.
+	Value float64
+	// Flow holds the flow on every edge of the graph, from Node1 to Node2.
+	Flow map[Edge]float64
+	// SourceSide and SinkSide hold the nodes on either side of the minimum cut, in ascending order: those reachable
+	// from the source in the residual network, and the others.
+	SourceSide, SinkSide []Node
+	// CutEdges holds the edges from the source side to the sink side, all saturated, in ascending order.
+	CutEdges []Edge
+}
+
+/*
+MaxFlow computes a maximum flow from source to sink in a capacitated DirectedGraph, and a minimum cut.
+
+Parameters:
+- g: The graph.
+- source, sink: Two distinct nodes of g.
+- capacity: The non-negative capacity of every edge, such as the bandwidth of a link.
+- algorithm: Dinic, the faster in practice, or EdmondsKarp. Both find the same flow value and minimum cut.
+
+Returns:
+- result: The flow value, the flow on every edge and the minimum cut.
+- err: An error if a node is missing, source equals sink, or a capacity is negative.
+
+Description:
+Self-loops carry no flow. An undirected network is handled by adding every edge in both directions. Flow values
+below 1e-12 are treated as zero, so that capacities far larger than that keep the result exact up to rounding.
+*/
+func MaxFlow(g *DirectedGraph, source, sink Node, capacity WeightFunc, algorithm FlowAlgorithm) (*MaxFlowResult, error) {
+	if !g.Nodes[source] || !g.Nodes[sink] {
+		return nil, fmt.Errorf("source and sink must be part of the graph")
+	}
+	if source == sink {
+		return nil, fmt.Errorf("source and sink must be different")
+	}
+	nodes := sortedNodes(g.Nodes)
+	index := nodeIndices(nodes)
+	network := newFlowNetwork(len(nodes))
+	var edges []Edge
+	for _, node := range nodes {
+		for _, successor := range g.Successors[node] {
+			if successor == node {
+				continue
+			}
+			c := capacity(node, successor)
+			if c < 0 {
+				return nil, fmt.Errorf("negative capacity %v on edge (%d, %d)", c, node, successor)
+			}
+			network.addArc(index[node], index[successor], c, 0)
+			edges = append(edges, Edge{Node1: node, Node2: successor})
+		}
+	}
+
+	result := &MaxFlowResult{Flow: make(map[Edge]float64, len(edges))}
+	switch algorithm {
+	case EdmondsKarp:
+		result.Value = network.edmondsKarp(index[source], index[sink])
+	default:
+		result.Value = network.maxFlow(index[source], index[sink])
+	}
+	for i, edge := range edges {
+		result.Flow[edge] = network.flow[2*i]
+	}
+	side := network.sourceSide(index[source])
+	for i, node := range nodes {
+		if side[i] {
+			result.SourceSide = append(result.SourceSide, node)
+		} else {
+			result.SinkSide = append(result.SinkSide, node)
+		}
+	}
+	for _, edge := range edges {
+		if side[index[edge.Node1]] && !side[index[edge.Node2]] {
+			result.CutEdges = append(result.CutEdges, edge)
+		}
+	}
+	sort.Slice(result.CutEdges, func(i, j int) bool {
+		a, b := result.CutEdges[i], result.CutEdges[j]
+		return a.Node1 < b.Node1 || a.Node1 == b.Node1 && a.Node2 < b.Node2
+	})
+	return result, nil
+}
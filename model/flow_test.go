@@ -0,0 +1,65 @@
+package model
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestMaxFlow(t *testing.T) {
+	// The network of Cormen et al., Introduction to Algorithms, figure 26.1, whose maximum flow is 23
+	g := &DirectedGraph{}
+	capacities := map[Edge]float64{
+		{Node1: 0, Node2: 1}: 16, {Node1: 0, Node2: 2}: 13, {Node1: 2, Node2: 1}: 4, {Node1: 1, Node2: 3}: 12,
+		{Node1: 3, Node2: 2}: 9, {Node1: 2, Node2: 4}: 14, {Node1: 4, Node2: 3}: 7, {Node1: 3, Node2: 5}: 20,
+		{Node1: 4, Node2: 5}: 4,
+	}
+	for edge := range capacities {
+		g.AddEdge(edge)
+	}
+	g.AddNode(6)
+	capacity := func(u, v Node) float64 { return capacities[Edge{Node1: u, Node2: v}] }
+
+	for _, algorithm := range []FlowAlgorithm{Dinic, EdmondsKarp} {
+		result, err := MaxFlow(g, 0, 5, capacity, algorithm)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if math.Abs(result.Value-23) > 1e-9 {
+			t.Errorf("Expected a maximum flow of 23, but got %v", result.Value)
+		}
+		balance := make(map[Node]float64)
+		for edge, flow := range result.Flow {
+			if flow < -1e-9 || flow > capacities[edge]+1e-9 {
+				t.Errorf("Expected the flow on %v within its capacity, but got %v", edge, flow)
+			}
+			balance[edge.Node1] -= flow
+			balance[edge.Node2] += flow
+		}
+		for node, b := range balance {
+			if node != 0 && node != 5 && math.Abs(b) > 1e-9 {
+				t.Errorf("Expected the flow to be conserved at %d, but got %v", node, b)
+			}
+		}
+		if !reflect.DeepEqual(result.SourceSide, []Node{0, 1, 2, 4}) || !reflect.DeepEqual(result.SinkSide, []Node{3, 5, 6}) {
+			t.Errorf("Expected the cut {0 1 2 4} | {3 5 6}, but got %v | %v", result.SourceSide, result.SinkSide)
+		}
+		expected := []Edge{{Node1: 1, Node2: 3}, {Node1: 4, Node2: 3}, {Node1: 4, Node2: 5}}
+		if !reflect.DeepEqual(result.CutEdges, expected) {
+			t.Errorf("Expected the cut edges %v, but got %v", expected, result.CutEdges)
+		}
+	}
+
+	if result, _ := MaxFlow(g, 5, 0, capacity, Dinic); result.Value != 0 || !reflect.DeepEqual(result.SourceSide, []Node{5}) {
+		t.Errorf("Expected no flow against the edges, but got %v", result.Value)
+	}
+	if _, err := MaxFlow(g, 0, 0, capacity, Dinic); err == nil {
+		t.Errorf("Expected an error for equal source and sink")
+	}
+	if _, err := MaxFlow(g, 0, 9, capacity, Dinic); err == nil {
+		t.Errorf("Expected an error for a missing sink")
+	}
+	if _, err := MaxFlow(g, 0, 5, func(u, v Node) float64 { return -1 }, Dinic); err == nil {
+		t.Errorf("Expected an error for a negative capacity")
+	}
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+/*
+BipartitePreferentialAttachmentGraph grows a random bipartite graph in which new bottom nodes link preferentially to
+popular top nodes, such as users rating items, for recommender experiments.
+
+Parameters:
+- initialTop: The number of top nodes at the start, at least edgesPerBottom.
+- numberOfBottom: The number of bottom nodes added.
+- edgesPerBottom: The number of distinct top nodes every bottom node links to, at least 1.
+- newTopProbability: The probability, in [0, 1], that an edge goes to a new top node rather than an existing one.
+- seed: The seed of the random number generator; the same seed gives the same graph.
+
+Returns:
+- b: The graph. Nodes are numbered from 0 in the order they are created: the initial top nodes, then every bottom
+node followed by the new top nodes it links to.
+- err: An error if a parameter is out of range.
+
+Description:
+Every bottom node draws edgesPerBottom distinct targets. With probability newTopProbability a target is a new top
+node, and otherwise an existing top node chosen with probability proportional to its degree plus one, so that top
+nodes without edges can still be found. The degrees of the top nodes are heavy-tailed, the more so the rarer new top
+nodes are, while every bottom node has degree edgesPerBottom.
+
+Reference: J.-L. Guillaume and M. Latapy, "Bipartite graphs as models of complex networks", Physica A 371, 2006.
+*/
+func BipartitePreferentialAttachmentGraph(initialTop, numberOfBottom, edgesPerBottom int, newTopProbability float64, seed int64) (*BipartiteGraph, error) {
+	if edgesPerBottom < 1 || initialTop < edgesPerBottom || numberOfBottom < 0 {
+		return nil, fmt.Errorf("expected at least one edge per bottom node, at least as many initial top nodes and a non-negative number of bottom nodes, got %d, %d and %d", edgesPerBottom, initialTop, numberOfBottom)
+	}
+	if newTopProbability < 0 || newTopProbability > 1 {
+		return nil, fmt.Errorf("the probability of a new top node must be in [0, 1], got %v", newTopProbability)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	b := NewBipartiteGraph()
+	// Every top node appears in endpoints once, plus once per edge, so a uniform draw from it is proportional to the
+	// degree plus one
+	endpoints := make([]Node, 0, initialTop+numberOfBottom*edgesPerBottom*2)
+	next := Node(0)
+	for ; next < Node(initialTop); next++ {
+		b.AddTopNode(next)
+		endpoints = append(endpoints, next)
+	}
+	for i := 0; i < numberOfBottom; i++ {
+		bottom := next
+		next++
+		b.AddBottomNode(bottom)
+		targets := make([]Node, 0, edgesPerBottom)
+		chosen := make(map[Node]bool, edgesPerBottom)
+		for len(targets) < edgesPerBottom {
+			if rng.Float64() < newTopProbability {
+				b.AddTopNode(next)
+				endpoints = append(endpoints, next)
+				targets = append(targets, next)
+				next++
+				continue
+			}
+			if target := endpoints[rng.Intn(len(endpoints))]; !chosen[target] {
+				chosen[target] = true
+				targets = append(targets, target)
+			}
+		}
+		for _, target := range targets {
+			b.AddEdge(target, bottom)
+			endpoints = append(endpoints, target)
+		}
+	}
+	return b, nil
+}
+
+// CompleteBipartiteGraph returns the complete bipartite graph K(n, m): the top nodes 0 to n-1 are all adjacent to
+// the bottom nodes n to n+m-1. Negative sizes count as 0.
+func CompleteBipartiteGraph(n, m int) *BipartiteGraph {
+	n, m = max(n, 0), max(m, 0)
+	b := &BipartiteGraph{Graph: completeMultipartiteGraph([]int{n, m}), Top: make(map[Node]bool, n), Bottom: make(map[Node]bool, m)}
+	for i := 0; i < n; i++ {
+		b.Top[Node(i)] = true
+	}
+	for i := n; i < n+m; i++ {
+		b.Bottom[Node(i)] = true
+	}
+	return b
+}
+
+/*
+RandomBipartiteGraph generates a random bipartite graph in which every top node is linked to every bottom node
+independently with probability p, the bipartite analogue of GnpRandomGraph.
+
+Parameters:
+- n: The number of top nodes, numbered from 0.
+- m: The number of bottom nodes, numbered from n.
+- probability: The probability of every edge, in [0, 1].
+- seed: The seed of the random number generator; the same seed gives the same graph.
+
+Returns:
+- b: The random graph, whose nodes are all present even if isolated.
+- err: An error if a size is negative or the probability is outside [0, 1].
+
+Description:
+The n·m pairs are visited in order and the gaps between successive edges are drawn from a geometric distribution, as
+in GnpRandomGraph, in O(n + m + edges) time.
+*/
+func RandomBipartiteGraph(n, m int, probability float64, seed int64) (*BipartiteGraph, error) {
+	if n < 0 || m < 0 || probability < 0 || probability > 1 {
+		return nil, fmt.Errorf("the numbers of nodes must not be negative and the probability must be in [0, 1], got %d, %d and %v", n, m, probability)
+	}
+	if probability == 1 {
+		return CompleteBipartiteGraph(n, m), nil
+	}
+	rng := rand.New(rand.NewSource(seed))
+	b := NewBipartiteGraph()
+	for i := 0; i < n; i++ {
+		b.AddTopNode(Node(i))
+	}
+	for i := n; i < n+m; i++ {
+		b.AddBottomNode(Node(i))
+	}
+	if probability == 0 {
+		return b, nil
+	}
+	logComplement := math.Log(1 - probability)
+	for pair := -1; ; {
+		pair += 1 + int(math.Log(1-rng.Float64())/logComplement)
+		if pair >= n*m || pair < 0 {
+			return b, nil
+		}
+		b.Graph.addSimpleEdge(Node(pair/m), Node(n+pair%m))
+	}
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBipartitePreferentialAttachmentGraph(t *testing.T) {
+	b, err := BipartitePreferentialAttachmentGraph(5, 500, 3, 0.1, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(b.Bottom) != 500 || len(b.Top)+len(b.Bottom) != len(b.Graph.Nodes) || b.Graph.NumberOfEdges() != 1500 {
+		t.Errorf("Expected 500 bottom nodes and 1500 edges, but got %d and %d", len(b.Bottom), b.Graph.NumberOfEdges())
+	}
+	for _, edge := range sortedEdgeList(b.Graph) {
+		if b.Top[edge.Node1] == b.Top[edge.Node2] {
+			t.Fatalf("Expected every edge to join a top and a bottom node, but got %v", edge)
+		}
+	}
+	maxDegree := 0
+	for node := range b.Bottom {
+		if b.Graph.NodeDegree(node) != 3 {
+			t.Errorf("Expected bottom node %d to have degree 3, but got %d", node, b.Graph.NodeDegree(node))
+		}
+	}
+	for node := range b.Top {
+		maxDegree = max(maxDegree, b.Graph.NodeDegree(node))
+	}
+	// The average top degree is about 1500 / 155, preferential attachment making hubs far above it
+	if maxDegree < 50 {
+		t.Errorf("Expected a top node of degree at least 50, but got %d", maxDegree)
+	}
+
+	again, _ := BipartitePreferentialAttachmentGraph(5, 500, 3, 0.1, 1)
+	if len(again.Top) != len(b.Top) || again.Graph.NodeDegree(0) != b.Graph.NodeDegree(0) {
+		t.Errorf("Expected the same graph for the same seed")
+	}
+
+	if _, err := BipartitePreferentialAttachmentGraph(2, 10, 3, 0.1, 1); err == nil {
+		t.Errorf("Expected an error for fewer initial top nodes than edges per bottom node")
+	}
+	if _, err := BipartitePreferentialAttachmentGraph(5, 10, 3, 1.5, 1); err == nil {
+		t.Errorf("Expected an error for a probability above 1")
+	}
+}
+
+func TestCompleteBipartiteGraph(t *testing.T) {
+	b := CompleteBipartiteGraph(3, 4)
+	if !b.Graph.Equals(func() *UndirectedGraph { g, _ := CompleteMultipartiteGraph(3, 4); return g }()) {
+		t.Errorf("Expected K(3, 4), but got %v", b.Graph)
+	}
+	if !reflect.DeepEqual(b.TopNodes(), []Node{0, 1, 2}) || !reflect.DeepEqual(b.BottomNodes(), []Node{3, 4, 5, 6}) {
+		t.Errorf("Expected top nodes [0 1 2] and bottom nodes [3 4 5 6], but got %v and %v", b.TopNodes(), b.BottomNodes())
+	}
+}
+
+func TestRandomBipartiteGraph(t *testing.T) {
+	b, err := RandomBipartiteGraph(200, 300, 0.05, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(b.Top) != 200 || len(b.Bottom) != 300 || len(b.Graph.Nodes) != 500 {
+		t.Errorf("Expected 200 top and 300 bottom nodes, but got %d and %d", len(b.Top), len(b.Bottom))
+	}
+	// 3000 edges are expected, with a standard deviation of about 53
+	if m := b.Graph.NumberOfEdges(); m < 2800 || m > 3200 {
+		t.Errorf("Expected about 3000 edges, but got %d", m)
+	}
+	for _, edge := range sortedEdgeList(b.Graph) {
+		if !b.Top[edge.Node1] || !b.Bottom[edge.Node2] {
+			t.Fatalf("Expected every edge to join a top and a bottom node, but got %v", edge)
+		}
+	}
+	if full, err := RandomBipartiteGraph(3, 2, 1, 1); err != nil || full.Graph.NumberOfEdges() != 6 {
+		t.Errorf("Expected K(3, 2), but got %v", full)
+	}
+	if _, err := RandomBipartiteGraph(3, -1, 0.5, 1); err == nil {
+		t.Errorf("Expected an error for a negative size")
+	}
+}
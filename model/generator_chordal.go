@@ -0,0 +1,117 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+/*
+RandomChordalGraph generates a random chordal graph as the intersection graph of random subtrees of a random tree.
+
+Parameters:
+- numberOfNodes: The number of nodes.
+- treeSize: The number of nodes of the host tree, at least 1.
+- maxSubtreeSize: The largest number of host tree nodes of a subtree, at least 1.
+- seed: The seed of the random number generator.
+
+Returns:
+- g: A chordal graph with nodes 0 to numberOfNodes-1, node i and j being adjacent if their subtrees share a host node.
+- err: An error if a parameter is out of range.
+
+Description:
+The host tree is a random recursive tree: every host node links to a uniformly chosen earlier one. The subtree of a
+node grows from a random host node by repeatedly adding a random host node adjacent to it, up to a size drawn
+uniformly between 1 and maxSubtreeSize. Every chordal graph is such an intersection graph (Gavril), and the host tree
+becomes a clique tree of the graph. Larger subtrees relative to the tree give denser graphs.
+*/
+func RandomChordalGraph(numberOfNodes, treeSize, maxSubtreeSize int, seed int64) (*UndirectedGraph, error) {
+	if numberOfNodes < 0 || treeSize < 1 || maxSubtreeSize < 1 {
+		return nil, fmt.Errorf("the number of nodes must not be negative and the tree and subtree sizes must be positive")
+	}
+	rng := rand.New(rand.NewSource(seed))
+	tree := &UndirectedGraph{Nodes: make(map[Node]bool, treeSize), Edges: make(map[Node][]Node)}
+	tree.AddNode(0)
+	for i := 1; i < treeSize; i++ {
+		tree.AddEdge(Edge{Node1: Node(i), Node2: Node(rng.Intn(i))})
+	}
+
+	subtrees := make([][]Node, numberOfNodes)
+	for i := range subtrees {
+		size := 1 + rng.Intn(maxSubtreeSize)
+		subtree := map[Node]bool{Node(rng.Intn(treeSize)): true}
+		for len(subtree) < size {
+			boundary := subgraphBoundary(tree, subtree)
+			if len(boundary) == 0 {
+				break
+			}
+			subtree[boundary[rng.Intn(len(boundary))]] = true
+		}
+		subtrees[i] = sortedNodes(subtree)
+	}
+	return IntersectionGraph(subtrees), nil
+}
+
+/*
+RandomSplitGraph generates a random split graph: a clique and an independent set with random edges between them.
+
+Parameters:
+- cliqueSize: The number of nodes of the clique, numbered from 0.
+- independentSize: The number of nodes of the independent set, numbered after the clique.
+- probability: The probability of every edge between a clique node and an independent node.
+- seed: The seed of the random number generator.
+
+Returns:
+- g: The split graph.
+- err: An error if a size is negative or the probability is outside [0, 1].
+*/
+func RandomSplitGraph(cliqueSize, independentSize int, probability float64, seed int64) (*UndirectedGraph, error) {
+	if cliqueSize < 0 || independentSize < 0 || probability < 0 || probability > 1 {
+		return nil, fmt.Errorf("the sizes must not be negative and the probability must be in [0, 1]")
+	}
+	rng := rand.New(rand.NewSource(seed))
+	g := CompleteGraph(cliqueSize)
+	for j := 0; j < cliqueSize; j++ {
+		g.AddNode(Node(j))
+	}
+	for i := cliqueSize; i < cliqueSize+independentSize; i++ {
+		g.AddNode(Node(i))
+		for j := 0; j < cliqueSize; j++ {
+			if rng.Float64() < probability {
+				g.AddEdge(Edge{Node1: Node(j), Node2: Node(i)})
+			}
+		}
+	}
+	return g, nil
+}
+
+/*
+IsSplitGraph checks if the nodes of an UndirectedGraph can be partitioned into a clique and an independent set.
+
+Description:
+By the theorem of Hammer and Simeone, with the degrees sorted in decreasing order d1 >= ... >= dn and m the largest
+i such that di >= i - 1, the graph is split if and only if d1 + ... + dm = m(m - 1) + dm+1 + ... + dn.
+*/
+func IsSplitGraph(g *UndirectedGraph) bool {
+	degrees := make([]int, 0, len(g.Nodes))
+	for node := range g.Nodes {
+		degrees = append(degrees, g.NodeDegree(node))
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(degrees)))
+
+	m := 0
+	for i, degree := range degrees {
+		if degree >= i {
+			m = i + 1
+		}
+	}
+	head, tail := 0, 0
+	for i, degree := range degrees {
+		if i < m {
+			head += degree
+		} else {
+			tail += degree
+		}
+	}
+	return head == m*(m-1)+tail
+}
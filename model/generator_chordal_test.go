@@ -0,0 +1,70 @@
+package model
+
+import "testing"
+
+func TestRandomChordalGraph(t *testing.T) {
+	for seed := int64(0); seed < 10; seed++ {
+		g, err := RandomChordalGraph(40, 20, 5, seed)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(g.Nodes) != 40 || !IsChordal(g) {
+			t.Errorf("Expected a chordal graph with 40 nodes, but got %v", g)
+		}
+	}
+	// A single host node makes every subtree intersect
+	g, err := RandomChordalGraph(6, 1, 3, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !g.Equals(CompleteGraph(6)) {
+		t.Errorf("Expected a complete graph, but got %v", g)
+	}
+	if _, err := RandomChordalGraph(5, 0, 3, 1); err == nil {
+		t.Errorf("Expected an error for an empty tree")
+	}
+}
+
+func TestRandomSplitGraph(t *testing.T) {
+	g, err := RandomSplitGraph(5, 10, 0.4, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(g.Nodes) != 15 || !IsSplitGraph(g) || !IsChordal(g) {
+		t.Errorf("Expected a split graph with 15 nodes, but got %v", g)
+	}
+	for i := 5; i < 15; i++ {
+		for j := i + 1; j < 15; j++ {
+			if g.HasEdge(Node(i), Node(j)) {
+				t.Errorf("Expected no edge between independent nodes %d and %d", i, j)
+			}
+		}
+	}
+	if _, err := RandomSplitGraph(3, 3, 1.5, 1); err == nil {
+		t.Errorf("Expected an error for a probability above 1")
+	}
+}
+
+func TestIsSplitGraph(t *testing.T) {
+	twoEdges := PathGraph(2)
+	twoEdges.AddEdge(Edge{Node1: 2, Node2: 3})
+
+	testCases := []struct {
+		name     string
+		g        *UndirectedGraph
+		expected bool
+	}{
+		{"star", StarGraph(5), true},
+		{"complete", CompleteGraph(4), true},
+		{"square", CycleGraph(4), false},
+		{"pentagon", CycleGraph(5), false},
+		{"two disjoint edges", twoEdges, false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := IsSplitGraph(testCase.g); result != testCase.expected {
+				t.Errorf("Expected %v, but got %v", testCase.expected, result)
+			}
+		})
+	}
+}
@@ -19,16 +19,11 @@ import "fmt"
 //	// Generate a complete graph with 4 nodes
 //	graph := CompleteGraph(4)
 func CompleteGraph(numberOfNodes int) *UndirectedGraph {
-	g := &UndirectedGraph{}
-	for i := 0; i < numberOfNodes; i++ {
-		for j := i + 1; j < numberOfNodes; j++ {
-			g.AddEdge(Edge{
-				Node1: Node(i),
-				Node2: Node(j),
-			})
-		}
+	sizes := make([]int, max(numberOfNodes, 0))
+	for i := range sizes {
+		sizes[i] = 1
 	}
-	return g
+	return completeMultipartiteGraph(sizes)
 }
 
 // LadderGraph returns the Ladder graph of length n and 2n nodes
@@ -77,7 +72,7 @@ func CircularLadderGraph(nodesInSinglePath int) (*UndirectedGraph, error) {
 	return g, nil
 }
 
-// WheelGraph returns the wheel graph
+// WheelGraph returns the wheel graph: the hub 0 adjacent to every node of the cycle 1, 2, ..., numberOfNodes-1.
 func WheelGraph(numberOfNodes int) *UndirectedGraph {
 	g := &UndirectedGraph{}
 	g.AddNode(0)
@@ -91,67 +86,29 @@ func WheelGraph(numberOfNodes int) *UndirectedGraph {
 			Node2: Node(i),
 		})
 	}
+	if numberOfNodes > 3 {
+		// Close the rim
+		g.AddEdge(Edge{
+			Node1: Node(numberOfNodes - 1),
+			Node2: Node(1),
+		})
+	}
 	return g
 }
 
-// TuranGraph returns the Turán graph
+// TuranGraph returns the Turán graph T(n, r), the complete multipartite graph on n nodes with r blocks whose sizes
+// differ by at most one, the smaller blocks first. It has the most edges of all the graphs on n nodes without a
+// clique of r + 1 nodes. Fewer than one partition are treated as one.
 func TuranGraph(numberOfNodes int, numberOfPartitions int) *UndirectedGraph {
-	g := &UndirectedGraph{}
-
-	numberOfPartitionsA := numberOfPartitions - (numberOfNodes % numberOfPartitions)
-	sizeOfPartitionsA := numberOfNodes / numberOfPartitions
-	numberOfPartitionsB := numberOfNodes % numberOfPartitions
-	sizeOfPartitionsB := numberOfNodes / (numberOfPartitions + 1)
-
-	partitionsA := make(map[int]map[Node]bool, 0)
-	partitionsB := make(map[int]map[Node]bool, 0)
-
-	nodeId := 0
-	for p := 0; p < numberOfPartitionsA; p++ {
-		for n := 0; n < sizeOfPartitionsA; n++ {
-			g.AddNode(Node(nodeId))
-			partitionsA[p][Node(nodeId)] = true
-			nodeId = nodeId + 1
-		}
-	}
-
-	for p := 0; p < numberOfPartitionsB; p++ {
-		for n := 0; n < sizeOfPartitionsB; n++ {
-			g.AddNode(Node(nodeId))
-			partitionsB[p][Node(nodeId)] = true
-			nodeId = nodeId + 1
-		}
-	}
-
-	// for nodes in partitions
-	// generate connections to nodes outside the partition
-	for p := 0; p < numberOfPartitionsA; p++ {
-		for node := range partitionsA[p] {
-			for i := 0; i < numberOfNodes; i++ {
-				if !partitionsA[p][Node(i)] {
-					g.AddEdge(Edge{
-						Node1: node,
-						Node2: Node(i),
-					})
-				}
-			}
-		}
-	}
-
-	for p := 0; p < numberOfPartitionsB; p++ {
-		for node := range partitionsB[p] {
-			for i := 0; i < numberOfNodes; i++ {
-				if !partitionsB[p][Node(i)] {
-					g.AddEdge(Edge{
-						Node1: node,
-						Node2: Node(i),
-					})
-				}
-			}
+	numberOfPartitions = max(numberOfPartitions, 1)
+	sizes := make([]int, numberOfPartitions)
+	for p := range sizes {
+		sizes[p] = numberOfNodes / numberOfPartitions
+		if p >= numberOfPartitions-numberOfNodes%numberOfPartitions {
+			sizes[p]++
 		}
 	}
-
-	return g
+	return completeMultipartiteGraph(sizes)
 }
 
 // TrivialGraph returns a graph with one node (with label 0) and no edges
@@ -263,4 +220,183 @@ func CirculantGraph(numberOfNodes int, offset int) *UndirectedGraph {
 	return g
 }
 
-// TODO: balanced tree, binomial tree, barbell graph, complete multipartite graph, dorogovtsev goltsev mendes graph, full rary tree
+// BalancedTree returns the perfectly balanced r-ary tree of height h, in which every internal node has r children,
+// numbered in breadth-first order from the root 0.
+func BalancedTree(r int, h int) (*UndirectedGraph, error) {
+	if r < 0 || h < 0 {
+		return nil, fmt.Errorf("branching factor and height must be non-negative, got %d and %d", r, h)
+	}
+	// 1 + r + ... + r^h nodes, at most 2^30 like the largest binomial tree
+	tooLarge := fmt.Errorf("balanced tree of branching factor %d and height %d has more than 2^30 nodes", r, h)
+	if r > 0 && h >= 1<<30 {
+		return nil, tooLarge
+	}
+	numberOfNodes, levelSize := 0, 1
+	for level := 0; level <= h && levelSize > 0; level++ {
+		numberOfNodes += levelSize
+		if level < h && r > 0 && levelSize > (1<<30-numberOfNodes)/r {
+			return nil, tooLarge
+		}
+		levelSize *= r
+	}
+	return FullRaryTree(r, numberOfNodes)
+}
+
+// FullRaryTree returns the tree of numberOfNodes nodes numbered in breadth-first order from the root 0, in which every
+// node has r children until the nodes run out: the children of node i are r*i+1 to r*i+r.
+func FullRaryTree(r int, numberOfNodes int) (*UndirectedGraph, error) {
+	if r < 0 || numberOfNodes < 0 {
+		return nil, fmt.Errorf("branching factor and number of nodes must be non-negative, got %d and %d", r, numberOfNodes)
+	}
+	g := &UndirectedGraph{}
+	for i := 0; i < numberOfNodes; i++ {
+		g.AddNode(Node(i))
+		if i > 0 && r > 0 {
+			g.AddEdge(Edge{
+				Node1: Node((i - 1) / r),
+				Node2: Node(i),
+			})
+		}
+	}
+	return g, nil
+}
+
+// BinomialTree returns the binomial tree of the given order, with 2^order nodes: two copies of the tree of the
+// previous order, the second numbered after the first and its root linked to the root 0 of the first. The parent of
+// node i is i without its lowest set bit.
+func BinomialTree(order int) (*UndirectedGraph, error) {
+	if order < 0 || order > 30 {
+		return nil, fmt.Errorf("order must be in [0, 30], got %d", order)
+	}
+	g := TrivialGraph()
+	for i := 1; i < 1<<order; i++ {
+		g.AddEdge(Edge{
+			Node1: Node(i & (i - 1)),
+			Node2: Node(i),
+		})
+	}
+	return g, nil
+}
+
+// BarbellGraph returns two complete graphs of leftSize nodes, 0 to leftSize-1 and the last leftSize nodes, joined by a
+// path of pathSize nodes.
+func BarbellGraph(leftSize int, pathSize int) (*UndirectedGraph, error) {
+	if leftSize < 2 {
+		return nil, fmt.Errorf("bell size can't be < 2")
+	}
+	if pathSize < 0 {
+		return nil, fmt.Errorf("path size can't be < 0")
+	}
+	g := LollipopGraph(leftSize, pathSize)
+	offset := leftSize + pathSize
+	for i := 0; i < leftSize; i++ {
+		for j := i + 1; j < leftSize; j++ {
+			g.AddEdge(Edge{
+				Node1: Node(offset + i),
+				Node2: Node(offset + j),
+			})
+		}
+	}
+	g.AddEdge(Edge{
+		Node1: Node(offset - 1),
+		Node2: Node(offset),
+	})
+	return g, nil
+}
+
+// CompleteMultipartiteGraph returns the graph with one block of nodes per size, numbered consecutively, in which
+// every two nodes of different blocks are adjacent.
+func CompleteMultipartiteGraph(sizes ...int) (*UndirectedGraph, error) {
+	for _, size := range sizes {
+		if size < 0 {
+			return nil, fmt.Errorf("block sizes can't be < 0, got %d", size)
+		}
+	}
+	return completeMultipartiteGraph(sizes), nil
+}
+
+// completeMultipartiteGraph builds the complete multipartite graph with blocks of the given non-negative sizes in a
+// preallocated graph.
+func completeMultipartiteGraph(sizes []int) *UndirectedGraph {
+	starts := make([]int, len(sizes)+1)
+	for b, size := range sizes {
+		starts[b+1] = starts[b] + size
+	}
+	total := starts[len(sizes)]
+	degrees := make([]int, 0, total)
+	for _, size := range sizes {
+		for i := 0; i < size; i++ {
+			degrees = append(degrees, total-size)
+		}
+	}
+	g := preallocatedGraph(degrees)
+	for b := range sizes {
+		for other := b + 1; other < len(sizes); other++ {
+			for u := starts[b]; u < starts[b+1]; u++ {
+				for v := starts[other]; v < starts[other+1]; v++ {
+					g.addSimpleEdge(Node(u), Node(v))
+				}
+			}
+		}
+	}
+	return g
+}
+
+// DorogovtsevGoltsevMendesGraph returns the pseudofractal scale-free graph of the given generation: generation 0 is
+// the edge (0, 1), and every generation adds a new node adjacent to both ends of every edge of the previous one, in
+// the order of NetworkX.
+func DorogovtsevGoltsevMendesGraph(generation int) (*UndirectedGraph, error) {
+	if generation < 0 {
+		return nil, fmt.Errorf("generation can't be < 0")
+	}
+	g := &UndirectedGraph{}
+	g.AddEdge(Edge{
+		Node1: 0,
+		Node2: 1,
+	})
+	newNode := Node(2)
+	for i := 0; i < generation; i++ {
+		// The edges in insertion order of their smaller node, then of the adjacency lists
+		var lastGeneration []Edge
+		for u := Node(0); u < newNode; u++ {
+			for _, v := range g.Edges[u] {
+				if v >= u {
+					lastGeneration = append(lastGeneration, Edge{Node1: u, Node2: v})
+				}
+			}
+		}
+		for _, edge := range lastGeneration {
+			g.AddEdge(Edge{
+				Node1: newNode,
+				Node2: edge.Node1,
+			})
+			g.AddEdge(Edge{
+				Node1: newNode,
+				Node2: edge.Node2,
+			})
+			newNode++
+		}
+	}
+	return g, nil
+}
+
+// HypercubeGraph returns the hypercube graph of the given dimension, the network of many parallel computers: its
+// 2^dimension nodes are the binary words of that length, adjacent when they differ in a single bit.
+func HypercubeGraph(dimension int) (*UndirectedGraph, error) {
+	if dimension < 0 || dimension > 30 {
+		return nil, fmt.Errorf("dimension must be between 0 and 30, got %d", dimension)
+	}
+	degrees := make([]int, 1<<dimension)
+	for i := range degrees {
+		degrees[i] = dimension
+	}
+	g := preallocatedGraph(degrees)
+	for u := range degrees {
+		for bit := 0; bit < dimension; bit++ {
+			if v := u | 1<<bit; v != u {
+				g.addSimpleEdge(Node(u), Node(v))
+			}
+		}
+	}
+	return g, nil
+}
@@ -215,3 +215,159 @@ func validateGraph(t *testing.T, g *UndirectedGraph, expectedNodes map[Node]bool
 		t.Errorf("Graph mismatch, expected: %v, got: %v", expectedGraph, g)
 	}
 }
+
+func TestTreeGenerators(t *testing.T) {
+	balanced, err := BalancedTree(2, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(balanced.Nodes) != 15 || balanced.NumberOfEdges() != 14 || !balanced.HasEdge(6, 14) || balanced.NodeDegree(0) != 2 {
+		t.Errorf("Expected the binary tree of height 3, but got %v", balanced)
+	}
+	if path, _ := BalancedTree(1, 4); !path.Equals(PathGraph(5)) {
+		t.Errorf("Expected a path of 5 nodes, but got %v", path)
+	}
+	if single, _ := BalancedTree(0, 3); len(single.Nodes) != 1 {
+		t.Errorf("Expected a single node, but got %v", single)
+	}
+
+	rary, err := FullRaryTree(3, 6)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 4}, {1, 5}})
+	if !rary.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, rary)
+	}
+
+	binomial, err := BinomialTree(3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected = &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {2, 3}, {0, 4}, {4, 5}, {4, 6}, {6, 7}})
+	if !binomial.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, binomial)
+	}
+	if trivial, _ := BinomialTree(0); !trivial.Equals(TrivialGraph()) {
+		t.Errorf("Expected the trivial graph, but got %v", trivial)
+	}
+
+	for name, generate := range map[string]func() (*UndirectedGraph, error){
+		"BalancedTree":         func() (*UndirectedGraph, error) { return BalancedTree(-1, 2) },
+		"BalancedTreeOverflow": func() (*UndirectedGraph, error) { return BalancedTree(2, 63) },
+		"BalancedTreeTooLarge": func() (*UndirectedGraph, error) { return BalancedTree(10, 19) },
+		"BalancedPathTooLong":  func() (*UndirectedGraph, error) { return BalancedTree(1, 1<<30) },
+		"FullRaryTree":         func() (*UndirectedGraph, error) { return FullRaryTree(2, -1) },
+		"BinomialTree":         func() (*UndirectedGraph, error) { return BinomialTree(-1) },
+		"BarbellGraph":         func() (*UndirectedGraph, error) { return BarbellGraph(1, 2) },
+		"BarbellPath":          func() (*UndirectedGraph, error) { return BarbellGraph(3, -1) },
+		"Multipartite":         func() (*UndirectedGraph, error) { return CompleteMultipartiteGraph(2, -1) },
+		"Pseudofractal":        func() (*UndirectedGraph, error) { return DorogovtsevGoltsevMendesGraph(-1) },
+	} {
+		if graph, err := generate(); err == nil || graph != nil {
+			t.Errorf("Expected an error from %s, but got %v", name, graph)
+		}
+	}
+}
+
+func TestBarbellGraph(t *testing.T) {
+	g, err := BarbellGraph(3, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 6}, {5, 7}, {6, 7}})
+	if !g.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, g)
+	}
+	g, _ = BarbellGraph(2, 0)
+	if !g.Equals(PathGraph(4)) {
+		t.Errorf("Expected a path of 4 nodes, but got %v", g)
+	}
+}
+
+func TestCompleteMultipartiteGraph(t *testing.T) {
+	g, err := CompleteMultipartiteGraph(1, 2, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(g.Nodes) != 6 || g.NumberOfEdges() != 11 || g.HasEdge(1, 2) || g.HasEdge(3, 5) || !g.HasEdge(2, 5) {
+		t.Errorf("Expected K(1, 2, 3), but got %v", g)
+	}
+	if star, _ := CompleteMultipartiteGraph(1, 4); !star.Equals(StarGraph(5)) {
+		t.Errorf("Expected a star, but got %v", star)
+	}
+	if empty, _ := CompleteMultipartiteGraph(); len(empty.Nodes) != 0 {
+		t.Errorf("Expected an empty graph, but got %v", empty)
+	}
+}
+
+func TestDorogovtsevGoltsevMendesGraph(t *testing.T) {
+	nodes, edges := 2, 1
+	for generation := 0; generation < 5; generation++ {
+		g, err := DorogovtsevGoltsevMendesGraph(generation)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(g.Nodes) != nodes || g.NumberOfEdges() != edges {
+			t.Errorf("Expected %d nodes and %d edges in generation %d, but got %d and %d", nodes, edges, generation, len(g.Nodes), g.NumberOfEdges())
+		}
+		nodes, edges = nodes+edges, 3*edges
+	}
+	g, _ := DorogovtsevGoltsevMendesGraph(2)
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 1}, {2, 0}, {2, 1}, {3, 0}, {3, 1}, {4, 0}, {4, 2}, {5, 1}, {5, 2}})
+	if !g.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, g)
+	}
+}
+
+func TestTuranGraph(t *testing.T) {
+	g := TuranGraph(7, 3)
+	expected, _ := CompleteMultipartiteGraph(2, 2, 3)
+	if !g.Equals(expected) || g.NumberOfEdges() != 16 {
+		t.Errorf("Expected the complete tripartite graph K(2, 2, 3), but got %v", g.Edges)
+	}
+	if g := TuranGraph(4, 6); !g.Equals(CompleteGraph(4)) {
+		t.Errorf("Expected K4 for more partitions than nodes, but got %v", g.Edges)
+	}
+	if g := TuranGraph(5, 0); len(g.Nodes) != 5 || g.NumberOfEdges() != 0 {
+		t.Errorf("Expected 5 isolated nodes, but got %v", g.Edges)
+	}
+}
+
+func TestHypercubeGraph(t *testing.T) {
+	g, err := HypercubeGraph(4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(g.Nodes) != 16 || g.NumberOfEdges() != 32 || !g.HasEdge(5, 13) || g.HasEdge(5, 6) {
+		t.Errorf("Expected the 4-cube, but got %v", g)
+	}
+	for node := range g.Nodes {
+		if g.NodeDegree(node) != 4 {
+			t.Errorf("Expected every node to have degree 4, but %d has %d", node, g.NodeDegree(node))
+		}
+	}
+	if square, _ := HypercubeGraph(2); !IsIsomorphic(square, CycleGraph(4)) {
+		t.Errorf("Expected a square, but got %v", square)
+	}
+	if point, _ := HypercubeGraph(0); len(point.Nodes) != 1 || point.NumberOfEdges() != 0 {
+		t.Errorf("Expected a single node, but got %v", point)
+	}
+	if _, err := HypercubeGraph(-1); err == nil {
+		t.Errorf("Expected an error for a negative dimension")
+	}
+}
+
+func TestWheelGraph(t *testing.T) {
+	g := WheelGraph(6)
+	if len(g.Nodes) != 6 || g.NumberOfEdges() != 10 || !g.HasEdge(5, 1) || g.NodeDegree(0) != 5 {
+		t.Errorf("Expected the hub 0 and the rim 1-2-3-4-5-1, but got %v", g)
+	}
+	if triangle := WheelGraph(3); triangle.NumberOfEdges() != 3 {
+		t.Errorf("Expected a triangle, but got %v", triangle)
+	}
+}
@@ -0,0 +1,101 @@
+package model
+
+// The directed generators add all the nodes 0 to numberOfNodes-1, even those without edges.
+
+// DirectedPathGraph returns the directed path 0 -> 1 -> ... -> numberOfNodes-1.
+func DirectedPathGraph(numberOfNodes int) *DirectedGraph {
+	g := newDirectedGraph(numberOfNodes)
+	for i := 1; i < numberOfNodes; i++ {
+		g.AddEdge(Edge{Node1: Node(i - 1), Node2: Node(i)})
+	}
+	return g
+}
+
+// DirectedCycleGraph returns the directed cycle 0 -> 1 -> ... -> numberOfNodes-1 -> 0. A single node gets a self-loop.
+func DirectedCycleGraph(numberOfNodes int) *DirectedGraph {
+	g := newDirectedGraph(numberOfNodes)
+	for i := 0; i < numberOfNodes; i++ {
+		g.AddEdge(Edge{Node1: Node(i), Node2: Node((i + 1) % numberOfNodes)})
+	}
+	return g
+}
+
+// DirectedStarGraph returns the star with center 0 and an edge from the center to every other node, or from every
+// other node to the center if inward is set.
+func DirectedStarGraph(numberOfNodes int, inward bool) *DirectedGraph {
+	g := newDirectedGraph(numberOfNodes)
+	for i := 1; i < numberOfNodes; i++ {
+		if inward {
+			g.AddEdge(Edge{Node1: Node(i), Node2: 0})
+		} else {
+			g.AddEdge(Edge{Node1: 0, Node2: Node(i)})
+		}
+	}
+	return g
+}
+
+// DirectedWheelGraph returns the wheel with hub 0 and the directed cycle 1 -> 2 -> ... -> numberOfNodes-1 -> 1 as
+// its rim, the spokes going from the hub to the rim, or from the rim to the hub if inward is set. See WheelGraph.
+func DirectedWheelGraph(numberOfNodes int, inward bool) *DirectedGraph {
+	g := DirectedStarGraph(numberOfNodes, inward)
+	for i := 2; i < numberOfNodes; i++ {
+		g.AddEdge(Edge{Node1: Node(i - 1), Node2: Node(i)})
+	}
+	if numberOfNodes > 3 {
+		g.AddEdge(Edge{Node1: Node(numberOfNodes - 1), Node2: 1})
+	}
+	return g
+}
+
+// DirectedGridGraph returns the rows x cols grid with every cell linked to the cells on its right and below it, the
+// acyclic orientation of GridGraph, whose numbering it keeps: cell r*cols + c is at row r and column c.
+func DirectedGridGraph(rows, cols int) *DirectedGraph {
+	g := newDirectedGraph(rows * cols)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if c+1 < cols {
+				g.AddEdge(Edge{Node1: Node(r*cols + c), Node2: Node(r*cols + c + 1)})
+			}
+			if r+1 < rows {
+				g.AddEdge(Edge{Node1: Node(r*cols + c), Node2: Node((r+1)*cols + c)})
+			}
+		}
+	}
+	return g
+}
+
+// DirectedCompleteGraph returns the complete directed graph, with an edge in both directions between every two nodes.
+func DirectedCompleteGraph(numberOfNodes int) *DirectedGraph {
+	g := newDirectedGraph(numberOfNodes)
+	for i := 0; i < numberOfNodes; i++ {
+		for j := 0; j < numberOfNodes; j++ {
+			if i != j {
+				g.AddEdge(Edge{Node1: Node(i), Node2: Node(j)})
+			}
+		}
+	}
+	return g
+}
+
+// TransitiveTournament returns the acyclic tournament with an edge from i to j for every i < j.
+func TransitiveTournament(numberOfNodes int) *DirectedGraph {
+	g := newDirectedGraph(numberOfNodes)
+	for i := 0; i < numberOfNodes; i++ {
+		for j := i + 1; j < numberOfNodes; j++ {
+			g.AddEdge(Edge{Node1: Node(i), Node2: Node(j)})
+		}
+	}
+	return g
+}
+
+func newDirectedGraph(numberOfNodes int) *DirectedGraph {
+	g := &DirectedGraph{
+		Nodes:        make(map[Node]bool, max(numberOfNodes, 0)),
+		Successors:   make(map[Node][]Node),
+		Predecessors: make(map[Node][]Node),
+	}
+	for i := 0; i < numberOfNodes; i++ {
+		g.AddNode(Node(i))
+	}
+	return g
+}
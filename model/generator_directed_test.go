@@ -0,0 +1,52 @@
+package model
+
+import "testing"
+
+func TestDirectedGenerators(t *testing.T) {
+	testCases := []struct {
+		name            string
+		g               *DirectedGraph
+		nodes, edges    int
+		in0, out0       int
+		hasEdge, noEdge Edge
+	}{
+		{"path", DirectedPathGraph(4), 4, 3, 0, 1, Edge{Node1: 2, Node2: 3}, Edge{Node1: 3, Node2: 2}},
+		{"cycle", DirectedCycleGraph(4), 4, 4, 1, 1, Edge{Node1: 3, Node2: 0}, Edge{Node1: 0, Node2: 3}},
+		{"out-star", DirectedStarGraph(4, false), 4, 3, 0, 3, Edge{Node1: 0, Node2: 2}, Edge{Node1: 2, Node2: 0}},
+		{"in-star", DirectedStarGraph(4, true), 4, 3, 3, 0, Edge{Node1: 2, Node2: 0}, Edge{Node1: 0, Node2: 2}},
+		{"out-wheel", DirectedWheelGraph(5, false), 5, 8, 0, 4, Edge{Node1: 4, Node2: 1}, Edge{Node1: 1, Node2: 4}},
+		{"in-wheel", DirectedWheelGraph(5, true), 5, 8, 4, 0, Edge{Node1: 3, Node2: 0}, Edge{Node1: 0, Node2: 3}},
+		{"grid", DirectedGridGraph(2, 3), 6, 7, 0, 2, Edge{Node1: 1, Node2: 4}, Edge{Node1: 4, Node2: 1}},
+		{"complete", DirectedCompleteGraph(4), 4, 12, 3, 3, Edge{Node1: 3, Node2: 1}, Edge{Node1: 1, Node2: 1}},
+		{"tournament", TransitiveTournament(4), 4, 6, 0, 3, Edge{Node1: 1, Node2: 3}, Edge{Node1: 3, Node2: 1}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := testCase.g
+			if len(g.Nodes) != testCase.nodes || g.NumberOfEdges() != testCase.edges {
+				t.Errorf("Expected %d nodes and %d edges, but got %d and %d", testCase.nodes, testCase.edges, len(g.Nodes), g.NumberOfEdges())
+			}
+			if g.InDegree(0) != testCase.in0 || g.OutDegree(0) != testCase.out0 {
+				t.Errorf("Expected degrees %d and %d for node 0, but got %d and %d", testCase.in0, testCase.out0, g.InDegree(0), g.OutDegree(0))
+			}
+			if !g.HasEdge(testCase.hasEdge.Node1, testCase.hasEdge.Node2) || g.HasEdge(testCase.noEdge.Node1, testCase.noEdge.Node2) {
+				t.Errorf("Expected %v and not %v", testCase.hasEdge, testCase.noEdge)
+			}
+		})
+	}
+
+	// The directed grid orients every edge of the grid from the smaller cell to the larger one
+	grid, directed := GridGraph(4, 5), DirectedGridGraph(4, 5)
+	for _, edge := range grid.GetEdgeTuples() {
+		if directed.HasEdge(edge.Node1, edge.Node2) != (edge.Node1 < edge.Node2) {
+			t.Errorf("Expected the edge %v to go from the smaller cell", edge)
+		}
+	}
+	if directed.NumberOfEdges() != grid.NumberOfEdges() {
+		t.Errorf("Expected %d edges, but got %d", grid.NumberOfEdges(), directed.NumberOfEdges())
+	}
+
+	if g := DirectedPathGraph(1); len(g.Nodes) != 1 || g.NumberOfEdges() != 0 {
+		t.Errorf("Expected a single node, but got %v", g)
+	}
+}
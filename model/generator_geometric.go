@@ -0,0 +1,49 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+/*
+RandomGeometricGraph generates a random geometric graph, the usual model of a wireless sensor network in which two
+sensors communicate when they are within range of each other.
+
+Parameters:
+- numberOfNodes: The number of nodes.
+- radius: The communication range: two nodes are adjacent when their distance is at most radius.
+- seed: The seed of the random number generator.
+
+Returns:
+- s: A SpatialGraph whose nodes 0 to numberOfNodes - 1 are placed uniformly at random in the unit square, their
+positions being in s.Positions.
+- err: An error if numberOfNodes or radius is negative.
+
+Description:
+The neighbors of every node are found with the k-d tree of the SpatialGraph, in about O(n log n + m) time rather than
+by comparing all the pairs. The graph becomes connected with high probability around πr²n = ln n.
+
+Reference: M. Penrose, "Random Geometric Graphs", Oxford University Press, 2003.
+*/
+func RandomGeometricGraph(numberOfNodes int, radius float64, seed int64) (*SpatialGraph, error) {
+	if numberOfNodes < 0 {
+		return nil, fmt.Errorf("the number of nodes can't be < 0, got %d", numberOfNodes)
+	}
+	if !(radius >= 0) {
+		return nil, fmt.Errorf("the radius can't be < 0, got %v", radius)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	positions := make(map[Node]Point, numberOfNodes)
+	for i := 0; i < numberOfNodes; i++ {
+		positions[Node(i)] = Point{X: rng.Float64(), Y: rng.Float64()}
+	}
+	s := NewSpatialGraph(positions)
+	for u := 0; u < numberOfNodes; u++ {
+		for _, v := range s.WithinRadius(positions[Node(u)], radius) {
+			if v > Node(u) {
+				s.Graph.addSimpleEdge(Node(u), v)
+			}
+		}
+	}
+	return s, nil
+}
@@ -0,0 +1,35 @@
+package model
+
+import "testing"
+
+func TestRandomGeometricGraph(t *testing.T) {
+	s, err := RandomGeometricGraph(200, 0.15, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(s.Graph.Nodes) != 200 || len(s.Positions) != 200 {
+		t.Fatalf("Expected 200 placed nodes, but got %d and %d", len(s.Graph.Nodes), len(s.Positions))
+	}
+	for u := Node(0); u < 200; u++ {
+		p := s.Positions[u]
+		if p.X < 0 || p.X >= 1 || p.Y < 0 || p.Y >= 1 {
+			t.Fatalf("Expected a position in the unit square, but got %v", p)
+		}
+		for v := u + 1; v < 200; v++ {
+			if within := p.Distance(s.Positions[v]) <= 0.15; within != s.Graph.HasEdge(u, v) || within != s.Graph.HasEdge(v, u) {
+				t.Fatalf("Expected an edge between %d and %d exactly when they are within the radius", u, v)
+			}
+		}
+	}
+
+	again, _ := RandomGeometricGraph(200, 0.15, 1)
+	if !again.Graph.Equals(s.Graph) {
+		t.Errorf("Expected the same graph with the same seed")
+	}
+	if empty, _ := RandomGeometricGraph(50, 0, 1); empty.Graph.NumberOfEdges() != 0 {
+		t.Errorf("Expected no edges with a radius of 0")
+	}
+	if _, err := RandomGeometricGraph(10, -1, 1); err == nil {
+		t.Errorf("Expected an error for a negative radius")
+	}
+}
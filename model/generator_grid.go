@@ -0,0 +1,222 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// The grid generators number the cell at row r and column c of a grid with cols columns r*cols + c, rows and columns
+// counting from 0. GridPositions places every cell at (c, r).
+
+// GridGraph returns the rows x cols grid graph, every cell linked to the cells above, below, left and right of it.
+func GridGraph(rows, cols int) *UndirectedGraph {
+	return movesGraph(rows, cols, [][2]int{{0, 1}, {1, 0}}, false)
+}
+
+// KingGraph returns the graph of the moves of a chess king on a rows x cols board: every cell is linked to its eight
+// neighbors, diagonals included.
+func KingGraph(rows, cols int) *UndirectedGraph {
+	return movesGraph(rows, cols, [][2]int{{0, 1}, {1, -1}, {1, 0}, {1, 1}}, false)
+}
+
+// RookGraph returns the graph of the moves of a chess rook on a rows x cols board: every cell is linked to all the
+// cells of its row and of its column.
+func RookGraph(rows, cols int) *UndirectedGraph {
+	return movesGraph(rows, cols, [][2]int{{0, 1}, {1, 0}}, true)
+}
+
+// KnightGraph returns the graph of the moves of a chess knight on a rows x cols board. Its Hamiltonian cycles are the
+// closed knight's tours.
+func KnightGraph(rows, cols int) *UndirectedGraph {
+	return movesGraph(rows, cols, [][2]int{{1, -2}, {1, 2}, {2, -1}, {2, 1}}, false)
+}
+
+// Grid2DGraph returns the rows x cols grid graph, see GridGraph. If periodic, the first and the last cell of every row
+// and of every column are linked too, turning the grid into a torus on which every cell has four neighbors, as in
+// simulations avoiding border effects; rows or columns of fewer than 3 cells are not wrapped, which would repeat an
+// edge or add a self-loop.
+func Grid2DGraph(rows, cols int, periodic bool) *UndirectedGraph {
+	g := GridGraph(rows, cols)
+	if !periodic {
+		return g
+	}
+	if cols > 2 {
+		for r := 0; r < rows; r++ {
+			g.AddEdge(Edge{Node1: Node(r * cols), Node2: Node(r*cols + cols - 1)})
+		}
+	}
+	if rows > 2 {
+		for c := 0; c < cols; c++ {
+			g.AddEdge(Edge{Node1: Node(c), Node2: Node((rows-1)*cols + c)})
+		}
+	}
+	return g
+}
+
+/*
+HexagonalLatticeGraph returns the honeycomb lattice of rows x cols hexagons, whose nodes have at most three
+neighbors, as in the cellular coverage of a wireless network.
+
+Parameters:
+- rows, cols: The number of rows and columns of hexagons.
+
+Returns:
+- g: The lattice, with (rows + 1)(2 cols + 2) - 2 nodes, or none if rows or cols is not positive. As in NetworkX, the
+nodes form cols + 1 columns of 2 rows + 2 nodes each, from the two ends of which two corners of degree 1 are left out;
+they are numbered column by column, from the bottom of every column.
+*/
+func HexagonalLatticeGraph(rows, cols int) *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	if rows < 1 || cols < 1 {
+		return g
+	}
+	height := 2*rows + 2
+	// ids holds the node of the j-th position of the i-th column, -1 for the two corners left out
+	ids := make([][]Node, cols+1)
+	next := Node(0)
+	for i := range ids {
+		ids[i] = make([]Node, height)
+		for j := range ids[i] {
+			if i == 0 && j == height-1 || i == cols && j == (height-1)*(cols%2) {
+				ids[i][j] = -1
+				continue
+			}
+			ids[i][j] = next
+			g.AddNode(next)
+			next++
+		}
+	}
+	link := func(u, v Node) {
+		if u >= 0 && v >= 0 {
+			g.addSimpleEdge(u, v)
+		}
+	}
+	for i := range ids {
+		for j := range ids[i] {
+			if j+1 < height {
+				link(ids[i][j], ids[i][j+1])
+			}
+			if i < cols && i%2 == j%2 {
+				link(ids[i][j], ids[i+1][j])
+			}
+		}
+	}
+	return g
+}
+
+// GridPositions returns the position (c, r) of every cell of a rows x cols grid, for use with SpatialGraph.
+func GridPositions(rows, cols int) map[Node]Point {
+	positions := make(map[Node]Point, max(rows*cols, 0))
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			positions[Node(r*cols+c)] = Point{X: float64(c), Y: float64(r)}
+		}
+	}
+	return positions
+}
+
+// movesGraph links every cell of a rows x cols grid to the cells reached by the moves, given as (row, column) steps,
+// and by repeating them until the border if sliding is set. The moves must cover one orientation of every direction.
+func movesGraph(rows, cols int, moves [][2]int, sliding bool) *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool, max(rows*cols, 0)), Edges: make(map[Node][]Node)}
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			g.AddNode(Node(r*cols + c))
+			for _, move := range moves {
+				for tr, tc := r+move[0], c+move[1]; tr >= 0 && tr < rows && tc >= 0 && tc < cols; tr, tc = tr+move[0], tc+move[1] {
+					g.AddEdge(Edge{Node1: Node(r*cols + c), Node2: Node(tr*cols + tc)})
+					if !sliding {
+						break
+					}
+				}
+			}
+		}
+	}
+	return g
+}
+
+/*
+MazeGraph generates a random maze on a rows x cols grid, as a subgraph of GridGraph(rows, cols).
+
+Parameters:
+- rows, cols: The size of the grid.
+- loopProbability: The probability of keeping every grid edge that is not in the spanning tree. With 0 the maze is
+perfect: exactly one path joins every two cells.
+- seed: The seed of the random number generator.
+
+Returns:
+- g: The maze, with all the cells of the grid, cell r*cols + c being at row r and column c. An edge is an open passage.
+- err: An error if loopProbability is outside [0, 1].
+
+Description:
+The passages are a uniform spanning tree of the grid, see UniformSpanningTree, which has no bias towards long
+corridors or short dead ends. The other walls are then knocked down with probability loopProbability each.
+*/
+func MazeGraph(rows, cols int, loopProbability float64, seed int64) (*UndirectedGraph, error) {
+	if loopProbability < 0 || loopProbability > 1 {
+		return nil, fmt.Errorf("the loop probability must be in [0, 1], got %v", loopProbability)
+	}
+	grid := GridGraph(rows, cols)
+	maze := UniformSpanningTree(grid, seed)
+	rng := rand.New(rand.NewSource(seed + 1))
+	for _, edge := range sortedEdgeList(grid) {
+		if !maze.HasEdge(edge.Node1, edge.Node2) && rng.Float64() < loopProbability {
+			maze.AddEdge(edge)
+		}
+	}
+	return maze, nil
+}
+
+/*
+UniformSpanningTree returns a spanning tree of an UndirectedGraph drawn uniformly at random among all its spanning trees.
+
+Parameters:
+- g: The graph.
+- seed: The seed of the random number generator.
+
+Returns:
+- tree: A subgraph with all the nodes of g. If g is disconnected, it is a uniform spanning forest made of a uniform
+spanning tree of every connected component.
+
+Description:
+Wilson's algorithm grows the tree from the smallest node of every component. From every node not yet in the tree, a
+random walk runs until it hits the tree, and its loop-erased path is added to it. The expected running time is the
+mean hitting time of the graph, O(n^2) steps for a grid.
+*/
+func UniformSpanningTree(g *UndirectedGraph, seed int64) *UndirectedGraph {
+	rng := rand.New(rand.NewSource(seed))
+	tree := &UndirectedGraph{Nodes: make(map[Node]bool, len(g.Nodes)), Edges: make(map[Node][]Node)}
+	neighbors := make(map[Node][]Node, len(g.Nodes))
+	for node := range g.Nodes {
+		neighbors[node] = append([]Node(nil), g.Edges[node]...)
+		sort.Slice(neighbors[node], func(i, j int) bool { return neighbors[node][i] < neighbors[node][j] })
+	}
+
+	next := make(map[Node]Node, len(g.Nodes))
+	nodes := sortedNodes(g.Nodes)
+	for _, root := range nodes {
+		if tree.Nodes[root] {
+			continue
+		}
+		tree.AddNode(root)
+		component := bfsDistances(g, root)
+		for _, start := range nodes {
+			if _, ok := component[start]; !ok || tree.Nodes[start] {
+				continue
+			}
+			// The walk overwrites next when it revisits a node, which erases the loop it closed
+			for node := start; !tree.Nodes[node]; node = next[node] {
+				next[node] = neighbors[node][rng.Intn(len(neighbors[node]))]
+			}
+			var path []Node
+			for node := start; !tree.Nodes[node]; node = next[node] {
+				path = append(path, node)
+			}
+			for _, node := range path {
+				tree.AddEdge(Edge{Node1: node, Node2: next[node]})
+			}
+		}
+	}
+	return tree
+}
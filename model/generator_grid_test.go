@@ -0,0 +1,153 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGridGenerators(t *testing.T) {
+	testCases := []struct {
+		name            string
+		g               *UndirectedGraph
+		nodes, edges    int
+		corner, central int
+	}{
+		// Degrees of cell 0 and of the central cell 1*4 + 1 = 5 of a 3 x 4 board
+		{"grid", GridGraph(3, 4), 12, 17, 2, 4},
+		{"king", KingGraph(3, 4), 12, 29, 3, 8},
+		{"rook", RookGraph(3, 4), 12, 30, 5, 5},
+		{"knight", KnightGraph(3, 4), 12, 14, 2, 2},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if len(testCase.g.Nodes) != testCase.nodes || testCase.g.NumberOfEdges() != testCase.edges {
+				t.Errorf("Expected %d nodes and %d edges, but got %d and %d", testCase.nodes, testCase.edges, len(testCase.g.Nodes), testCase.g.NumberOfEdges())
+			}
+			if corner, central := testCase.g.NodeDegree(0), testCase.g.NodeDegree(5); corner != testCase.corner || central != testCase.central {
+				t.Errorf("Expected degrees %d and %d, but got %d and %d", testCase.corner, testCase.central, corner, central)
+			}
+		})
+	}
+
+	// Knight moves from a corner of a chessboard
+	knight := KnightGraph(8, 8)
+	if !knight.HasEdge(0, 10) || !knight.HasEdge(0, 17) || knight.NodeDegree(0) != 2 || knight.NodeDegree(27) != 8 {
+		t.Errorf("Expected the knight moves of a chessboard, but got %v from the corner", knight.Edges[0])
+	}
+
+	positions := GridPositions(3, 4)
+	if positions[5] != (Point{X: 1, Y: 1}) || positions[11] != (Point{X: 3, Y: 2}) {
+		t.Errorf("Expected cells 5 and 11 at (1, 1) and (3, 2), but got %v and %v", positions[5], positions[11])
+	}
+	if len(GridGraph(0, 5).Nodes) != 0 {
+		t.Errorf("Expected an empty grid")
+	}
+}
+
+func TestGrid2DGraph(t *testing.T) {
+	if g := Grid2DGraph(3, 4, false); !g.Equals(GridGraph(3, 4)) {
+		t.Errorf("Expected the grid, but got %v", g)
+	}
+	torus := Grid2DGraph(3, 4, true)
+	if torus.NumberOfEdges() != 24 || !torus.HasEdge(0, 3) || !torus.HasEdge(1, 9) {
+		t.Errorf("Expected 24 edges wrapping around, but got %v", torus)
+	}
+	for node := range torus.Nodes {
+		if torus.NodeDegree(node) != 4 {
+			t.Errorf("Expected every cell of the torus to have 4 neighbors, but %d has %d", node, torus.NodeDegree(node))
+		}
+	}
+	// Columns of 2 cells are not wrapped
+	if g := Grid2DGraph(2, 5, true); g.NumberOfEdges() != 15 {
+		t.Errorf("Expected only the rows to wrap, but got %d edges", g.NumberOfEdges())
+	}
+}
+
+func TestHexagonalLatticeGraph(t *testing.T) {
+	hexagon := HexagonalLatticeGraph(1, 1)
+	if !IsIsomorphic(hexagon, CycleGraph(6)) {
+		t.Errorf("Expected a single hexagon, but got %v", hexagon)
+	}
+	for _, size := range [][2]int{{2, 3}, {3, 2}, {4, 4}} {
+		rows, cols := size[0], size[1]
+		g := HexagonalLatticeGraph(rows, cols)
+		// Every hexagon adds 6 edges, less those shared with the hexagons below and on its left
+		nodes, edges := (rows+1)*(2*cols+2)-2, 3*rows*cols+2*rows+2*cols-1
+		if len(g.Nodes) != nodes || g.NumberOfEdges() != edges {
+			t.Errorf("Expected %d nodes and %d edges for %v, but got %d and %d", nodes, edges, size, len(g.Nodes), g.NumberOfEdges())
+		}
+		for node := range g.Nodes {
+			if degree := g.NodeDegree(node); degree < 2 || degree > 3 {
+				t.Errorf("Expected degrees of 2 or 3, but %d has %d", node, degree)
+			}
+		}
+		if _, bipartite := IsBipartite(g); !bipartite || !IsConnected(g) {
+			t.Errorf("Expected a connected bipartite lattice for %v", size)
+		}
+	}
+	if len(HexagonalLatticeGraph(0, 3).Nodes) != 0 {
+		t.Errorf("Expected an empty lattice")
+	}
+}
+
+func TestUniformSpanningTree(t *testing.T) {
+	g := petersenGraph()
+	g.AddEdge(Edge{Node1: 20, Node2: 21})
+	g.AddNode(30)
+	tree := UniformSpanningTree(g, 1)
+	if len(tree.Nodes) != len(g.Nodes) || tree.NumberOfEdges() != len(g.Nodes)-3 {
+		t.Fatalf("Expected a spanning forest of 3 trees, but got %d nodes and %d edges", len(tree.Nodes), tree.NumberOfEdges())
+	}
+	for _, edge := range sortedEdgeList(tree) {
+		if !g.HasEdge(edge.Node1, edge.Node2) {
+			t.Errorf("Expected edges of the graph, but got %v", edge)
+		}
+	}
+	if !tree.Equals(UniformSpanningTree(g, 1)) {
+		t.Errorf("Expected the same tree for the same seed")
+	}
+
+	// All 16 spanning trees of K4 should come up about as often
+	counts := make(map[string]int)
+	for seed := int64(0); seed < 3200; seed++ {
+		counts[fmt.Sprint(sortedEdgeList(UniformSpanningTree(CompleteGraph(4), seed)))]++
+	}
+	if len(counts) != 16 {
+		t.Fatalf("Expected 16 spanning trees, but got %d", len(counts))
+	}
+	for tree, count := range counts {
+		if count < 140 || count > 260 {
+			t.Errorf("Expected about 200 draws of every tree, but got %d for %s", count, tree)
+		}
+	}
+}
+
+func TestMazeGraph(t *testing.T) {
+	maze, err := MazeGraph(10, 12, 0, 7)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(maze.Nodes) != 120 || maze.NumberOfEdges() != 119 || len(bfsDistances(maze, 0)) != 120 {
+		t.Errorf("Expected a perfect maze, but got %d nodes and %d edges", len(maze.Nodes), maze.NumberOfEdges())
+	}
+	grid := GridGraph(10, 12)
+	for _, edge := range sortedEdgeList(maze) {
+		if !grid.HasEdge(edge.Node1, edge.Node2) {
+			t.Errorf("Expected passages between adjacent cells, but got %v", edge)
+		}
+	}
+
+	braided, err := MazeGraph(10, 12, 0.5, 7)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if extra := braided.NumberOfEdges() - 119; extra < 30 || extra > 70 {
+		t.Errorf("Expected about 50 extra passages, but got %d", extra)
+	}
+	if open, _ := MazeGraph(10, 12, 1, 7); !open.Equals(grid) {
+		t.Errorf("Expected the full grid with loop probability 1")
+	}
+	if _, err := MazeGraph(3, 3, 1.5, 7); err == nil {
+		t.Errorf("Expected an error for a probability above 1")
+	}
+}
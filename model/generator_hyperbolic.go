@@ -0,0 +1,129 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// HyperbolicGraph is a graph whose nodes lie in the hyperbolic disk, as generated by HyperbolicRandomGraph. Positions
+// holds the native representation of the disk: a node at radius r and angle θ is drawn at (r cos θ, r sin θ).
+type HyperbolicGraph struct {
+	*SpatialGraph
+	Radii  map[Node]float64
+	Angles map[Node]float64
+	// Radius is the radius R of the disk, the distance below which two nodes are linked in the threshold model.
+	Radius float64
+}
+
+// HyperbolicDistance returns the hyperbolic distance between two nodes. It can be used as a WeightFunc.
+func (h *HyperbolicGraph) HyperbolicDistance(u, v Node) float64 {
+	return hyperbolicDistance(h.Radii[u], h.Angles[u], h.Radii[v], h.Angles[v])
+}
+
+/*
+GreedyRoute routes a message from source to target by greedy geometric routing: every node forwards it to its
+neighbor closest to target in the hyperbolic plane, as long as that neighbor is closer than itself.
+
+Parameters:
+- source, target: The endpoints of the route.
+
+Returns:
+- path: The nodes visited, from source to target or to the node where the message got stuck.
+- ok: Whether the message reached target.
+*/
+func (h *HyperbolicGraph) GreedyRoute(source, target Node) ([]Node, bool) {
+	path := []Node{source}
+	for current := source; current != target; {
+		next, best := current, h.HyperbolicDistance(current, target)
+		for _, neighbor := range h.Graph.Edges[current] {
+			if distance := h.HyperbolicDistance(neighbor, target); distance < best {
+				next, best = neighbor, distance
+			}
+		}
+		if next == current {
+			return path, false
+		}
+		path = append(path, next)
+		current = next
+	}
+	return path, true
+}
+
+/*
+HyperbolicRandomGraph generates a random hyperbolic graph, whose nodes are scattered in a hyperbolic disk and linked
+when they are close, giving both power-law degrees and high clustering. Such graphs are navigable by greedy routing
+on their coordinates, see GreedyRoute.
+
+Parameters:
+- numberOfNodes: The number of nodes, numbered from 0.
+- averageDegree: The target average degree, positive.
+- exponent: The exponent γ of the power law of the degrees, above 2.
+- temperature: The temperature T in [0, 1): 0 links exactly the nodes closer than the radius of the disk, while higher
+temperatures make the links noisier and lower the clustering.
+- seed: The seed of the random number generator; the same seed gives the same graph.
+
+Returns:
+- h: The graph and the coordinates of its nodes.
+- err: An error if a parameter is out of range.
+
+Description:
+Every node gets an angle uniformly at random and a radius in [0, R] with density α sinh(αr) / (cosh(αR) - 1), where
+α = (γ - 1) / 2, so that nodes crowd towards the border of the disk. Two nodes at hyperbolic distance d are linked
+with probability 1 / (1 + exp((d - R) / 2T)), or when d <= R if T = 0. The radius R = 2 ln(n / ν) is set from the
+asymptotic average degree 8α²ν / (π(2α - 1)²), multiplied by πT / sin(πT) when T > 0, so the average degree of
+small graphs deviates somewhat from the target. Like the other pairwise models, it takes O(n²) time.
+
+Reference: D. Krioukov, F. Papadopoulos, M. Kitsak, A. Vahdat and M. Boguñá, "Hyperbolic geometry of complex
+networks", Phys. Rev. E 82, 036106, 2010.
+*/
+func HyperbolicRandomGraph(numberOfNodes int, averageDegree, exponent, temperature float64, seed int64) (*HyperbolicGraph, error) {
+	if numberOfNodes < 1 || !(averageDegree > 0) {
+		return nil, fmt.Errorf("expected at least one node and a positive average degree, got %d and %v", numberOfNodes, averageDegree)
+	}
+	if !(exponent > 2) || temperature < 0 || temperature >= 1 {
+		return nil, fmt.Errorf("expected an exponent above 2 and a temperature in [0, 1), got %v and %v", exponent, temperature)
+	}
+	alpha := (exponent - 1) / 2
+	nu := averageDegree * math.Pi * (2*alpha - 1) * (2*alpha - 1) / (8 * alpha * alpha)
+	if temperature > 0 {
+		nu *= math.Sin(math.Pi*temperature) / (math.Pi * temperature)
+	}
+	radius := 2 * math.Log(float64(numberOfNodes)/nu)
+	if radius <= 0 {
+		return nil, fmt.Errorf("an average degree of %v is too large for %d nodes", averageDegree, numberOfNodes)
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	h := &HyperbolicGraph{
+		SpatialGraph: NewSpatialGraph(nil),
+		Radii:        make(map[Node]float64, numberOfNodes),
+		Angles:       make(map[Node]float64, numberOfNodes),
+		Radius:       radius,
+	}
+	for i := 0; i < numberOfNodes; i++ {
+		node := Node(i)
+		r := math.Acosh(1+(math.Cosh(alpha*radius)-1)*rng.Float64()) / alpha
+		theta := 2 * math.Pi * rng.Float64()
+		h.Radii[node], h.Angles[node] = r, theta
+		h.SetPosition(node, Point{X: r * math.Cos(theta), Y: r * math.Sin(theta)})
+	}
+	for u := 0; u < numberOfNodes; u++ {
+		for v := u + 1; v < numberOfNodes; v++ {
+			d := h.HyperbolicDistance(Node(u), Node(v))
+			if temperature == 0 && d <= radius || temperature > 0 && rng.Float64() < 1/(1+math.Exp((d-radius)/(2*temperature))) {
+				h.Graph.AddEdge(Edge{Node1: Node(u), Node2: Node(v)})
+			}
+		}
+	}
+	return h, nil
+}
+
+// hyperbolicDistance returns the distance between two points of the hyperbolic plane given in polar coordinates.
+func hyperbolicDistance(r1, theta1, r2, theta2 float64) float64 {
+	angle := math.Pi - math.Abs(math.Pi-math.Abs(theta1-theta2))
+	if angle == 0 {
+		return math.Abs(r1 - r2)
+	}
+	return math.Acosh(math.Max(1, math.Cosh(r1)*math.Cosh(r2)-math.Sinh(r1)*math.Sinh(r2)*math.Cos(angle)))
+}
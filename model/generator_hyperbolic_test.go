@@ -0,0 +1,110 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+// meanClustering returns the average over the nodes of degree at least 2 of the fraction of pairs of their neighbors
+// that are adjacent.
+func meanClustering(g *UndirectedGraph) float64 {
+	total, counted := 0.0, 0
+	for node := range g.Nodes {
+		neighbors := g.Edges[node]
+		if len(neighbors) < 2 {
+			continue
+		}
+		closed := 0
+		for i, a := range neighbors {
+			for _, b := range neighbors[i+1:] {
+				if g.HasEdge(a, b) {
+					closed++
+				}
+			}
+		}
+		total += float64(2*closed) / float64(len(neighbors)*(len(neighbors)-1))
+		counted++
+	}
+	return total / float64(counted)
+}
+
+func TestHyperbolicRandomGraph(t *testing.T) {
+	h, err := HyperbolicRandomGraph(1000, 8, 2.5, 0, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if average := 2 * float64(h.Graph.NumberOfEdges()) / 1000; average < 6.4 || average > 9.6 {
+		t.Errorf("Expected an average degree close to 8, but got %v", average)
+	}
+	maxDegree := 0
+	for node := range h.Graph.Nodes {
+		maxDegree = max(maxDegree, h.Graph.NodeDegree(node))
+		if h.Radii[node] < 0 || h.Radii[node] > h.Radius {
+			t.Fatalf("Expected node %d in the disk, but got the radius %v", node, h.Radii[node])
+		}
+	}
+	// The power law of exponent 2.5 puts hubs far above the average
+	if maxDegree < 50 {
+		t.Errorf("Expected a hub of degree at least 50, but got %d", maxDegree)
+	}
+	for _, edge := range sortedEdgeList(h.Graph) {
+		if h.HyperbolicDistance(edge.Node1, edge.Node2) > h.Radius {
+			t.Fatalf("Expected the edge %v to be shorter than the radius", edge)
+		}
+	}
+	if c := meanClustering(h.Graph); c < 0.5 {
+		t.Errorf("Expected a high clustering, but got %v", c)
+	}
+	hot, _ := HyperbolicRandomGraph(1000, 8, 2.5, 0.8, 1)
+	if meanClustering(hot.Graph) >= meanClustering(h.Graph) {
+		t.Errorf("Expected a higher temperature to lower the clustering")
+	}
+
+	if _, err := HyperbolicRandomGraph(100, 8, 2, 0, 1); err == nil {
+		t.Errorf("Expected an error for an exponent of 2")
+	}
+	if _, err := HyperbolicRandomGraph(100, 8, 2.5, 1, 1); err == nil {
+		t.Errorf("Expected an error for a temperature of 1")
+	}
+}
+
+func TestHyperbolicDistance(t *testing.T) {
+	h := &HyperbolicGraph{Radii: map[Node]float64{0: 0, 1: 2, 2: 2}, Angles: map[Node]float64{0: 0, 1: 0, 2: math.Pi}}
+	if d := h.HyperbolicDistance(0, 1); math.Abs(d-2) > 1e-12 {
+		t.Errorf("Expected 2 from the center, but got %v", d)
+	}
+	if d := h.HyperbolicDistance(1, 2); math.Abs(d-4) > 1e-9 {
+		t.Errorf("Expected 4 through the center, but got %v", d)
+	}
+}
+
+func TestGreedyRoute(t *testing.T) {
+	h, err := HyperbolicRandomGraph(500, 10, 2.2, 0, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	successes, attempts := 0, 0
+	for source := Node(0); source < 50; source++ {
+		target := source + 250
+		if h.Graph.NodeDegree(source) == 0 || h.Graph.NodeDegree(target) == 0 {
+			continue
+		}
+		attempts++
+		path, ok := h.GreedyRoute(source, target)
+		for i := 1; i < len(path); i++ {
+			if !h.Graph.HasEdge(path[i-1], path[i]) {
+				t.Fatalf("Expected a path along edges, but got %v", path)
+			}
+		}
+		if ok {
+			successes++
+			if path[len(path)-1] != target {
+				t.Errorf("Expected the path to end at %d, but got %v", target, path)
+			}
+		}
+	}
+	// Greedy routing succeeds for most pairs of the giant component of a hyperbolic graph
+	if successes < attempts*3/4 {
+		t.Errorf("Expected most routes to succeed, but got %d of %d", successes, attempts)
+	}
+}
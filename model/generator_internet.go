@@ -0,0 +1,189 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// ASRelationship is the business relationship of an autonomous system with a neighbor, which decides the routes it
+// exports to it.
+type ASRelationship int
+
+const (
+	// PeerToPeer links two ASes exchanging the traffic of their customers free of charge.
+	PeerToPeer ASRelationship = iota
+	// ProviderToCustomer links an AS to a customer buying transit from it.
+	ProviderToCustomer
+	// CustomerToProvider links an AS to a provider it buys transit from.
+	CustomerToProvider
+)
+
+var asRelationshipNames = map[ASRelationship]string{
+	PeerToPeer:         "p2p",
+	ProviderToCustomer: "p2c",
+	CustomerToProvider: "c2p",
+}
+
+func (r ASRelationship) String() string {
+	if name, ok := asRelationshipNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("ASRelationship(%d)", int(r))
+}
+
+// ASTopology is an AS-level Internet topology: a spatial graph of autonomous systems whose edges are labelled with
+// business relationships.
+type ASTopology struct {
+	*SpatialGraph
+	// Relationships holds the relationship of Node1 with Node2 for every edge, keyed with Node1 <= Node2.
+	Relationships map[Edge]ASRelationship
+	// Tiers gives the tier of every AS: 1 for the core, and one more than its best provider otherwise.
+	Tiers map[Node]int
+}
+
+// Relationship returns the relationship of u with its neighbor v, and false if they are not adjacent.
+func (t *ASTopology) Relationship(u, v Node) (ASRelationship, bool) {
+	relationship, ok := t.Relationships[orderedEdge(u, v)]
+	if ok && u > v && relationship != PeerToPeer {
+		relationship = ProviderToCustomer + CustomerToProvider - relationship
+	}
+	return relationship, ok
+}
+
+// Providers returns the providers of node, in the order of its adjacency list.
+func (t *ASTopology) Providers(node Node) []Node {
+	return t.neighborsWith(node, CustomerToProvider)
+}
+
+// Customers returns the customers of node, in the order of its adjacency list.
+func (t *ASTopology) Customers(node Node) []Node {
+	return t.neighborsWith(node, ProviderToCustomer)
+}
+
+// Peers returns the peers of node, in the order of its adjacency list.
+func (t *ASTopology) Peers(node Node) []Node {
+	return t.neighborsWith(node, PeerToPeer)
+}
+
+func (t *ASTopology) neighborsWith(node Node, relationship ASRelationship) []Node {
+	var neighbors []Node
+	for _, neighbor := range t.Graph.Edges[node] {
+		if r, _ := t.Relationship(node, neighbor); r == relationship {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+	return neighbors
+}
+
+/*
+ASTopologyGraph generates a hierarchical AS-level Internet topology in the manner of the BRITE and Inet generators,
+for simulating inter-domain routing.
+
+Parameters:
+- numberOfNodes: The number of ASes, numbered from 0.
+- coreSize: The number of tier-1 ASes, 0 to coreSize - 1, between 1 and numberOfNodes.
+- providers: The number of providers of every other AS, at least 1; an AS joining before there are enough ASes
+buys transit from all of them.
+- locality: The distance scale of the geographic constraint, positive; +Inf ignores geography.
+- peering: The probability that two transit ASes closer than locality peer, in [0, 1].
+- seed: The seed of the random number generator; the same seed gives the same topology.
+
+Returns:
+- t: The topology, placed in the unit square.
+- err: An error if a parameter is out of range.
+
+Description:
+The tier-1 ASes form a full mesh of peers. Every other AS is placed uniformly at random and chooses its providers
+among the ASes placed before it, with probability proportional to (degree + 1) exp(-distance / locality):
+preferential attachment yields the heavy-tailed degrees of the Internet, while the geographic term favors nearby
+providers. Since providers are older than their customers, the provider-customer edges form a hierarchy without
+cycles. Finally, every two transit ASes outside the core, those with customers, which are closer than locality and
+not yet adjacent peer with probability peering.
+
+Reference: A. Medina, A. Lakhina, I. Matta and J. Byers, "BRITE: an approach to universal topology generation",
+MASCOTS 2001.
+*/
+func ASTopologyGraph(numberOfNodes, coreSize, providers int, locality, peering float64, seed int64) (*ASTopology, error) {
+	if coreSize < 1 || coreSize > numberOfNodes || providers < 1 {
+		return nil, fmt.Errorf("the core size must be in [1, %d] and the number of providers positive, got %d and %d", numberOfNodes, coreSize, providers)
+	}
+	if !(locality > 0) || peering < 0 || peering > 1 {
+		return nil, fmt.Errorf("the locality must be positive and the peering probability in [0, 1], got %v and %v", locality, peering)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	t := &ASTopology{
+		SpatialGraph:  NewSpatialGraph(nil),
+		Relationships: make(map[Edge]ASRelationship),
+		Tiers:         make(map[Node]int, numberOfNodes),
+	}
+	link := func(u, v Node, relationship ASRelationship) {
+		t.Graph.AddEdge(Edge{Node1: u, Node2: v})
+		if u > v {
+			u, v = v, u
+			if relationship != PeerToPeer {
+				relationship = ProviderToCustomer + CustomerToProvider - relationship
+			}
+		}
+		t.Relationships[Edge{Node1: u, Node2: v}] = relationship
+	}
+
+	for i := 0; i < numberOfNodes; i++ {
+		node := Node(i)
+		t.SetPosition(node, Point{X: rng.Float64(), Y: rng.Float64()})
+		if i < coreSize {
+			t.Tiers[node] = 1
+			for peer := Node(0); peer < node; peer++ {
+				link(peer, node, PeerToPeer)
+			}
+			continue
+		}
+		// Distances are measured from the nearest candidate so that the weights cannot all underflow
+		t.Tiers[node] = math.MaxInt
+		chosen := make(map[Node]bool, providers)
+		for len(chosen) < min(providers, i) {
+			nearest := math.Inf(1)
+			for j := 0; j < i; j++ {
+				if !chosen[Node(j)] {
+					nearest = math.Min(nearest, t.EuclideanWeight(node, Node(j)))
+				}
+			}
+			weights, total := make([]float64, i), 0.0
+			for j := range weights {
+				if candidate := Node(j); !chosen[candidate] {
+					distance := t.EuclideanWeight(node, candidate)
+					weights[j] = float64(t.Graph.NodeDegree(candidate)+1) * math.Exp(-(distance-nearest)/locality)
+					total += weights[j]
+				}
+			}
+			// The last candidate is kept if rounding exhausts the target
+			target, provider := rng.Float64()*total, Node(-1)
+			for j, weight := range weights {
+				if weight > 0 {
+					provider = Node(j)
+					if target -= weight; target < 0 {
+						break
+					}
+				}
+			}
+			chosen[provider] = true
+			link(provider, node, ProviderToCustomer)
+			t.Tiers[node] = min(t.Tiers[node], t.Tiers[provider]+1)
+		}
+	}
+
+	var transit []Node
+	for i := coreSize; i < numberOfNodes; i++ {
+		if len(t.Customers(Node(i))) > 0 {
+			transit = append(transit, Node(i))
+		}
+	}
+	for a, u := range transit {
+		for _, v := range transit[a+1:] {
+			if t.Positions[u].Distance(t.Positions[v]) < locality && !t.Graph.HasEdge(u, v) && rng.Float64() < peering {
+				link(u, v, PeerToPeer)
+			}
+		}
+	}
+	return t, nil
+}
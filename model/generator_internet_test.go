@@ -0,0 +1,79 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestASTopologyGraph(t *testing.T) {
+	topology, err := ASTopologyGraph(200, 4, 2, 0.3, 0.5, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(topology.Graph.Nodes) != 200 || len(topology.Relationships) != topology.Graph.NumberOfEdges() {
+		t.Fatalf("Expected 200 ASes with a relationship per edge, but got %d and %d", len(topology.Graph.Nodes), len(topology.Relationships))
+	}
+	for u := Node(0); u < 4; u++ {
+		if len(topology.Peers(u)) != 3 || len(topology.Providers(u)) != 0 || topology.Tiers[u] != 1 {
+			t.Errorf("Expected AS %d to peer with the rest of the core, but got %v", u, topology.Peers(u))
+		}
+	}
+	peers := 0
+	for node := Node(4); node < 200; node++ {
+		providers := topology.Providers(node)
+		if len(providers) != 2 {
+			t.Errorf("Expected 2 providers of AS %d, but got %v", node, providers)
+		}
+		tier := math.MaxInt
+		for _, provider := range providers {
+			if provider >= node {
+				t.Errorf("Expected the providers of AS %d to be older, but got %d", node, provider)
+			}
+			if r, ok := topology.Relationship(provider, node); !ok || r != ProviderToCustomer {
+				t.Errorf("Expected %d to be a provider of %d, but got %v", provider, node, r)
+			}
+			tier = min(tier, topology.Tiers[provider]+1)
+		}
+		if topology.Tiers[node] != tier {
+			t.Errorf("Expected tier %d for AS %d, but got %d", tier, node, topology.Tiers[node])
+		}
+		for _, peer := range topology.Peers(node) {
+			peers++
+			if len(topology.Customers(node)) == 0 || len(topology.Customers(peer)) == 0 {
+				t.Errorf("Expected only transit ASes to peer, but got %d and %d", node, peer)
+			}
+		}
+	}
+	if peers == 0 {
+		t.Errorf("Expected peering between transit ASes")
+	}
+	if r, _ := topology.Relationship(0, 1); r.String() != "p2p" {
+		t.Errorf("Expected p2p, but got %v", r)
+	}
+}
+
+func TestASTopologyLocality(t *testing.T) {
+	averageProviderDistance := func(locality float64) float64 {
+		topology, err := ASTopologyGraph(300, 3, 1, locality, 0, 2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		total := 0.0
+		for node := Node(3); node < 300; node++ {
+			total += topology.EuclideanWeight(node, topology.Providers(node)[0])
+		}
+		return total / 297
+	}
+	if local, global := averageProviderDistance(0.05), averageProviderDistance(math.Inf(1)); local >= global/2 {
+		t.Errorf("Expected closer providers with a small locality, but got %v and %v", local, global)
+	}
+
+	for _, parameters := range [][2]int{{10, 0}, {10, 11}} {
+		if _, err := ASTopologyGraph(parameters[0], parameters[1], 1, 1, 0, 1); err == nil {
+			t.Errorf("Expected an error for a core of %d ASes", parameters[1])
+		}
+	}
+	if _, err := ASTopologyGraph(10, 2, 1, 0, 0, 1); err == nil {
+		t.Errorf("Expected an error for a zero locality")
+	}
+}
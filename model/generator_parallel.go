@@ -0,0 +1,198 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelShards is the number of shards the parallel generators split their nodes into. It does not depend on the
+// number of workers, so that the generated graphs do not either.
+const parallelShards = 256
+
+/*
+ParallelGnpRandomGraph generates an Erdős-Rényi G(n, p) random graph like GnpRandomGraph, spreading the work over
+several goroutines for very large graphs.
+
+Parameters:
+- numberOfNodes: The number of nodes, numbered from 0.
+- probability: The probability of every edge, in [0, 1].
+- seed: The seed of the random number generators; the same seed gives the same graph whatever the number of workers.
+- workers: The number of goroutines generating and merging the shards.
+
+Returns:
+- g: The random graph, whose nodes are all present even if isolated and whose adjacency lists are sorted.
+- err: An error if the number of nodes is negative, the probability is outside [0, 1] or workers is not positive.
+
+Description:
+The nodes are split into shards of consecutive nodes with about as many pairs each. Shard i draws the edges from its
+nodes to the smaller ones with the geometric skipping of GnpRandomGraph and rand.New(rand.NewSource(seed + i)), so
+the graph follows the same distribution as GnpRandomGraph but differs from the one it generates with the same seed.
+The shards are merged into adjacency lists carved out of a single array, see parallelGraph.
+*/
+func ParallelGnpRandomGraph(numberOfNodes int, probability float64, seed int64, workers int) (*UndirectedGraph, error) {
+	if numberOfNodes < 0 || probability < 0 || probability > 1 {
+		return nil, fmt.Errorf("the number of nodes must not be negative and the probability must be in [0, 1]")
+	}
+	if workers <= 0 {
+		return nil, fmt.Errorf("the number of workers must be positive")
+	}
+	if probability == 0 {
+		return parallelGraph(numberOfNodes, 0, workers, nil), nil
+	}
+	if probability == 1 {
+		return ParallelCompleteGraph(numberOfNodes, workers)
+	}
+	bounds := pairShardBounds(numberOfNodes)
+	logComplement := math.Log(1 - probability)
+	return parallelGraph(numberOfNodes, len(bounds)-1, workers, func(shard int) []Edge {
+		rng := rand.New(rand.NewSource(seed + int64(shard)))
+		var edges []Edge
+		for v, w, end := max(bounds[shard], 1), -1, bounds[shard+1]; v < end; {
+			w += 1 + int(math.Log(1-rng.Float64())/logComplement)
+			for w >= v && v < end {
+				w -= v
+				v++
+			}
+			if v < end {
+				edges = append(edges, Edge{Node1: Node(v), Node2: Node(w)})
+			}
+		}
+		return edges
+	}), nil
+}
+
+// ParallelCompleteGraph returns the same graph as CompleteGraph, generated by the given number of goroutines, or an
+// error if workers is not positive.
+func ParallelCompleteGraph(numberOfNodes int, workers int) (*UndirectedGraph, error) {
+	if workers <= 0 {
+		return nil, fmt.Errorf("the number of workers must be positive")
+	}
+	bounds := pairShardBounds(max(numberOfNodes, 0))
+	return parallelGraph(max(numberOfNodes, 0), len(bounds)-1, workers, func(shard int) []Edge {
+		var edges []Edge
+		for v := bounds[shard]; v < bounds[shard+1]; v++ {
+			for w := 0; w < v; w++ {
+				edges = append(edges, Edge{Node1: Node(v), Node2: Node(w)})
+			}
+		}
+		return edges
+	}), nil
+}
+
+// ParallelGridGraph returns the same graph as GridGraph, generated by the given number of goroutines, or an error if
+// workers is not positive.
+func ParallelGridGraph(rows, cols int, workers int) (*UndirectedGraph, error) {
+	if workers <= 0 {
+		return nil, fmt.Errorf("the number of workers must be positive")
+	}
+	rows, cols = max(rows, 0), max(cols, 0)
+	if cols == 0 {
+		rows = 0
+	}
+	shards := min(rows, parallelShards)
+	return parallelGraph(rows*cols, shards, workers, func(shard int) []Edge {
+		var edges []Edge
+		for r := shard * rows / shards; r < (shard+1)*rows/shards; r++ {
+			for c := 0; c < cols; c++ {
+				node := Node(r*cols + c)
+				if c+1 < cols {
+					edges = append(edges, Edge{Node1: node, Node2: node + 1})
+				}
+				if r+1 < rows {
+					edges = append(edges, Edge{Node1: node, Node2: node + Node(cols)})
+				}
+			}
+		}
+		return edges
+	}), nil
+}
+
+// pairShardBounds splits the nodes 0 to n-1 into at most parallelShards ranges of consecutive nodes, shard i holding
+// the nodes bounds[i] to bounds[i+1]-1, so that every range has about as many pairs to smaller nodes.
+func pairShardBounds(n int) []int {
+	shards := min(n, parallelShards)
+	bounds := []int{0}
+	for i := 1; i < shards; i++ {
+		if bound := int(float64(n) * math.Sqrt(float64(i)/float64(shards))); bound > bounds[len(bounds)-1] {
+			bounds = append(bounds, bound)
+		}
+	}
+	return append(bounds, n)
+}
+
+/*
+parallelGraph builds a graph on the nodes 0 to numberOfNodes-1 from the edges of shards generated concurrently.
+
+generate returns the edges of a shard, which must be simple and disjoint from those of the other shards. The workers
+generate the shards, count the degrees with atomic increments, then copy every edge into adjacency lists carved out
+of a single array, as in preallocatedGraph, at positions reserved with atomic increments. Since these positions depend
+on the scheduling, the adjacency lists are finally sorted, so that the graph does not depend on it.
+*/
+func parallelGraph(numberOfNodes, shards, workers int, generate func(shard int) []Edge) *UndirectedGraph {
+	shardEdges := make([][]Edge, shards)
+	parallelFor(shards, workers, func(shard int) {
+		shardEdges[shard] = generate(shard)
+	})
+
+	degrees := make([]int64, numberOfNodes)
+	parallelFor(shards, workers, func(shard int) {
+		for _, edge := range shardEdges[shard] {
+			atomic.AddInt64(&degrees[edge.Node1], 1)
+			atomic.AddInt64(&degrees[edge.Node2], 1)
+		}
+	})
+	offsets := make([]int64, numberOfNodes+1)
+	for i, degree := range degrees {
+		offsets[i+1] = offsets[i] + degree
+	}
+	backing := make([]Node, offsets[numberOfNodes])
+	cursors := make([]int64, numberOfNodes)
+	copy(cursors, offsets)
+	parallelFor(shards, workers, func(shard int) {
+		for _, edge := range shardEdges[shard] {
+			backing[atomic.AddInt64(&cursors[edge.Node1], 1)-1] = edge.Node2
+			backing[atomic.AddInt64(&cursors[edge.Node2], 1)-1] = edge.Node1
+		}
+		shardEdges[shard] = nil
+	})
+	blocks := min(numberOfNodes, parallelShards)
+	parallelFor(blocks, workers, func(block int) {
+		for i := block * numberOfNodes / blocks; i < (block+1)*numberOfNodes/blocks; i++ {
+			neighbors := backing[offsets[i]:offsets[i+1]]
+			sort.Slice(neighbors, func(a, b int) bool { return neighbors[a] < neighbors[b] })
+		}
+	})
+
+	g := &UndirectedGraph{Nodes: make(map[Node]bool, numberOfNodes), Edges: make(map[Node][]Node, numberOfNodes)}
+	for i := 0; i < numberOfNodes; i++ {
+		g.Nodes[Node(i)] = true
+		if offsets[i+1] > offsets[i] {
+			g.Edges[Node(i)] = backing[offsets[i]:offsets[i+1]:offsets[i+1]]
+		}
+	}
+	return g
+}
+
+// parallelFor calls f with every index from 0 to n-1 on the given number of goroutines and waits for them.
+func parallelFor(n, workers int, f func(i int)) {
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < min(workers, n); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				f(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestParallelGnpRandomGraph(t *testing.T) {
+	n, p := 2000, 0.01
+	g, err := ParallelGnpRandomGraph(n, p, 3, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(g.Nodes) != n {
+		t.Errorf("Expected %d nodes, but got %d", n, len(g.Nodes))
+	}
+	expected := p * float64(n*(n-1)/2)
+	if m := float64(g.NumberOfEdges()); math.Abs(m-expected) > 4*math.Sqrt(expected) {
+		t.Errorf("Expected about %v edges, but got %v", expected, m)
+	}
+	for node, neighbors := range g.Edges {
+		if !sort.SliceIsSorted(neighbors, func(i, j int) bool { return neighbors[i] < neighbors[j] }) {
+			t.Fatalf("Expected sorted neighbors of %d, but got %v", node, neighbors)
+		}
+		for i, neighbor := range neighbors {
+			if neighbor == node || i > 0 && neighbor == neighbors[i-1] || !g.HasEdge(neighbor, node) {
+				t.Fatalf("Unexpected neighbors of %d: %v", node, neighbors)
+			}
+		}
+	}
+
+	// The graph depends on the seed only
+	for _, workers := range []int{1, 7} {
+		other, err := ParallelGnpRandomGraph(n, p, 3, workers)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(other.Edges, g.Edges) {
+			t.Errorf("Expected the same graph with %d workers", workers)
+		}
+	}
+
+	if empty, err := ParallelGnpRandomGraph(10, 0, 1, 2); err != nil || len(empty.Nodes) != 10 || empty.NumberOfEdges() != 0 {
+		t.Errorf("Expected 10 isolated nodes, but got %v, %v", empty, err)
+	}
+	if _, err := ParallelGnpRandomGraph(10, 0.5, 1, 0); err == nil {
+		t.Errorf("Expected an error for no workers")
+	}
+	if _, err := ParallelGnpRandomGraph(10, 1.5, 1, 2); err == nil {
+		t.Errorf("Expected an error for a probability above 1")
+	}
+}
+
+func TestParallelCompleteAndGridGraphs(t *testing.T) {
+	for _, n := range []int{0, 1, 5, 300} {
+		g, err := ParallelCompleteGraph(n, 3)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !g.Equals(CompleteGraph(n)) {
+			t.Errorf("Expected the complete graph on %d nodes, but got %v", n, g)
+		}
+	}
+	for _, size := range [][2]int{{0, 4}, {1, 1}, {3, 4}, {500, 3}} {
+		g, err := ParallelGridGraph(size[0], size[1], 4)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !g.Equals(GridGraph(size[0], size[1])) {
+			t.Errorf("Expected the %dx%d grid, but got %v", size[0], size[1], g)
+		}
+	}
+	if _, err := ParallelGridGraph(3, 3, 0); err == nil {
+		t.Errorf("Expected an error for no workers")
+	}
+}
+
+func BenchmarkParallelGnpRandomGraph(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := ParallelGnpRandomGraph(100000, 0.0002, int64(i), 8); err != nil {
+			b.Fatalf("Unexpected error: %v", err)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+/*
+RandomMaximalPlanarGraph generates a random triangulation: a planar graph to which no edge can be added without
+losing planarity.
+
+Parameters:
+- numberOfNodes: The number of nodes, at least 3.
+- seed: The seed of the random number generator.
+
+Returns:
+- s: A SpatialGraph whose straight-line drawing is a planar embedding with 3n - 6 edges. Nodes 0, 1 and 2 are the
+corners (0, 0), (1, 0) and (0.5, 1) of the outer face; the other nodes lie uniformly at random inside it.
+- err: An error if numberOfNodes is smaller than 3.
+
+Description:
+The graph is the Delaunay triangulation of the points, see DelaunayGraph. Since the convex hull of the points is
+the outer triangle, every face of the triangulation, the outer one included, is a triangle.
+*/
+func RandomMaximalPlanarGraph(numberOfNodes int, seed int64) (*SpatialGraph, error) {
+	if numberOfNodes < 3 {
+		return nil, fmt.Errorf("a maximal planar graph needs at least 3 nodes, got %d", numberOfNodes)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	corners := []Point{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0.5, Y: 1}}
+	positions := make(map[Node]Point, numberOfNodes)
+	for i, corner := range corners {
+		positions[Node(i)] = corner
+	}
+	for i := 3; i < numberOfNodes; i++ {
+		// Uniform barycentric coordinates, reflecting the points that fall in the other half of the parallelogram
+		u, v := rng.Float64(), rng.Float64()
+		if u+v > 1 {
+			u, v = 1-u, 1-v
+		}
+		positions[Node(i)] = Point{
+			X: corners[0].X + u*(corners[1].X-corners[0].X) + v*(corners[2].X-corners[0].X),
+			Y: corners[0].Y + u*(corners[1].Y-corners[0].Y) + v*(corners[2].Y-corners[0].Y),
+		}
+	}
+	return DelaunayGraph(positions), nil
+}
+
+/*
+RandomPlanarGraph generates a random planar graph by removing random edges from a random triangulation.
+
+Parameters:
+- numberOfNodes: The number of nodes, at least 3.
+- numberOfEdges: The number of edges, at most 3n - 6.
+- seed: The seed of the random number generator.
+
+Returns:
+- s: A SpatialGraph whose straight-line drawing is a planar embedding, see RandomMaximalPlanarGraph. It is connected
+if numberOfEdges >= numberOfNodes - 1.
+- err: An error if the numbers of nodes or edges are out of range.
+
+Description:
+The edges of the triangulation are visited in random order and removed until numberOfEdges are left. When the graph
+can stay connected, an edge whose removal would disconnect it is kept.
+*/
+func RandomPlanarGraph(numberOfNodes, numberOfEdges int, seed int64) (*SpatialGraph, error) {
+	if numberOfNodes >= 3 && (numberOfEdges < 0 || numberOfEdges > 3*numberOfNodes-6) {
+		return nil, fmt.Errorf("a planar graph with %d nodes has between 0 and %d edges, got %d", numberOfNodes, 3*numberOfNodes-6, numberOfEdges)
+	}
+	s, err := RandomMaximalPlanarGraph(numberOfNodes, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewSource(seed + 1))
+	edges := sortedEdgeList(s.Graph)
+	rng.Shuffle(len(edges), func(i, j int) { edges[i], edges[j] = edges[j], edges[i] })
+	connected := numberOfEdges >= numberOfNodes-1
+	remaining := len(edges)
+	for _, edge := range edges {
+		if remaining == numberOfEdges {
+			break
+		}
+		s.Graph.RemoveEdge(edge)
+		if _, reachable := bfsDistances(s.Graph, edge.Node1)[edge.Node2]; connected && !reachable {
+			s.Graph.AddEdge(edge)
+			continue
+		}
+		remaining--
+	}
+	return s, nil
+}
+
+// The shape of the road networks of RandomRoadNetwork: how far intersections stray from their cell, how much longer
+// than the straight line a road may be, and the smallest angle between two roads meeting at an intersection.
+const (
+	roadJitter   = 0.4
+	roadDetour   = 0.15
+	roadMinAngle = math.Pi / 6
+)
+
+// RoadNetwork is a SpatialGraph whose edges have a length, as generated by RandomRoadNetwork.
+type RoadNetwork struct {
+	*SpatialGraph
+	// Lengths holds the length of every edge, keyed with Node1 <= Node2.
+	Lengths map[Edge]float64
+}
+
+// Length returns the length of the edge between u and v, or 0 if there is none. It can be used as a WeightFunc.
+func (r *RoadNetwork) Length(u, v Node) float64 {
+	return r.Lengths[orderedEdge(u, v)]
+}
+
+/*
+RandomRoadNetwork generates a connected planar graph resembling a road network, for benchmarking shortest path
+algorithms such as A* or contraction hierarchies.
+
+Parameters:
+- numberOfNodes: The number of intersections, at least 1.
+- averageDegree: The target average degree, road networks having about 2.5 to 3; it is not reached if the
+triangulation or maxDegree do not leave enough edges.
+- maxDegree: The largest number of roads meeting at an intersection, at least 3.
+- seed: The seed of the random number generator; the same seed gives the same network.
+
+Returns:
+- r: The network. Nodes are placed on a square grid of unit cells, ceil(sqrt(n)) columns wide, filled row by row,
+each one moved at random by up to 0.4 in both directions. The length of every road is its Euclidean length increased
+by up to 15%, so that the Euclidean distance is an admissible A* heuristic.
+- err: An error if a parameter is out of range.
+
+Description:
+The roads are edges of the Delaunay triangulation of the intersections, so the network is planar. A spanning tree
+is first grown by scanning the edges of the triangulation by increasing length and keeping those that join two trees
+without exceeding maxDegree, in the manner of Kruskal; trees that can only be joined through a saturated
+intersection, which is rare, are then joined by their shortest edges regardless of the degree bound. Further edges
+are added by increasing length until the average degree is reached, skipping those that would exceed maxDegree or
+meet another road at an angle below 30 degrees.
+*/
+func RandomRoadNetwork(numberOfNodes int, averageDegree float64, maxDegree int, seed int64) (*RoadNetwork, error) {
+	if numberOfNodes < 1 || averageDegree < 0 || maxDegree < 3 {
+		return nil, fmt.Errorf("expected at least one node, a non-negative average degree and a maximum degree of at least 3, got %d, %v and %d", numberOfNodes, averageDegree, maxDegree)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	cols := int(math.Ceil(math.Sqrt(float64(numberOfNodes))))
+	positions := make(map[Node]Point, numberOfNodes)
+	for i := 0; i < numberOfNodes; i++ {
+		positions[Node(i)] = Point{
+			X: float64(i%cols) + roadJitter*(2*rng.Float64()-1),
+			Y: float64(i/cols) + roadJitter*(2*rng.Float64()-1),
+		}
+	}
+	triangulation := DelaunayGraph(positions)
+	edges := sortedEdgeList(triangulation.Graph)
+	sort.SliceStable(edges, func(i, j int) bool {
+		return triangulation.EuclideanWeight(edges[i].Node1, edges[i].Node2) < triangulation.EuclideanWeight(edges[j].Node1, edges[j].Node2)
+	})
+
+	s := NewSpatialGraph(positions)
+	parent := make(map[Node]Node, numberOfNodes)
+	for node := range positions {
+		parent[node] = node
+	}
+	root := func(node Node) Node {
+		for parent[node] != node {
+			parent[node] = parent[parent[node]]
+			node = parent[node]
+		}
+		return node
+	}
+	join := func(edge Edge, boundDegree bool) {
+		first, second := root(edge.Node1), root(edge.Node2)
+		if first == second || (boundDegree && (s.Graph.NodeDegree(edge.Node1) >= maxDegree || s.Graph.NodeDegree(edge.Node2) >= maxDegree)) {
+			return
+		}
+		parent[first] = second
+		s.Graph.AddEdge(edge)
+	}
+	for _, edge := range edges {
+		join(edge, true)
+	}
+	for _, edge := range edges {
+		join(edge, false)
+	}
+
+	// The smallest angle between the direction from u to v and the roads already leaving u
+	sharpest := func(u, v Node) float64 {
+		direction := math.Atan2(positions[v].Y-positions[u].Y, positions[v].X-positions[u].X)
+		angle := math.Pi
+		for _, neighbor := range s.Graph.Edges[u] {
+			other := math.Atan2(positions[neighbor].Y-positions[u].Y, positions[neighbor].X-positions[u].X)
+			difference := math.Abs(direction - other)
+			angle = math.Min(angle, math.Min(difference, 2*math.Pi-difference))
+		}
+		return angle
+	}
+	target := int(math.Round(averageDegree * float64(numberOfNodes) / 2))
+	for _, edge := range edges {
+		if s.Graph.NumberOfEdges() >= target {
+			break
+		}
+		u, v := edge.Node1, edge.Node2
+		if s.Graph.HasEdge(u, v) || s.Graph.NodeDegree(u) >= maxDegree || s.Graph.NodeDegree(v) >= maxDegree {
+			continue
+		}
+		if sharpest(u, v) < roadMinAngle || sharpest(v, u) < roadMinAngle {
+			continue
+		}
+		s.Graph.AddEdge(edge)
+	}
+
+	r := &RoadNetwork{SpatialGraph: s, Lengths: make(map[Edge]float64)}
+	for _, edge := range sortedEdgeList(s.Graph) {
+		r.Lengths[edge] = s.EuclideanWeight(edge.Node1, edge.Node2) * (1 + roadDetour*rng.Float64())
+	}
+	return r, nil
+}
@@ -0,0 +1,101 @@
+package model
+
+import "testing"
+
+// segmentsCross reports whether the open segments pq and rs cross at a single interior point.
+func segmentsCross(p, q, r, s Point) bool {
+	orientation := func(a, b, c Point) float64 { return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X) }
+	return orientation(p, q, r)*orientation(p, q, s) < 0 && orientation(r, s, p)*orientation(r, s, q) < 0
+}
+
+// isPlaneDrawing reports whether no two edges of a spatial graph cross.
+func isPlaneDrawing(s *SpatialGraph) bool {
+	edges := sortedEdgeList(s.Graph)
+	for i, e := range edges {
+		for _, f := range edges[i+1:] {
+			if segmentsCross(s.Positions[e.Node1], s.Positions[e.Node2], s.Positions[f.Node1], s.Positions[f.Node2]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestRandomMaximalPlanarGraph(t *testing.T) {
+	for _, n := range []int{3, 4, 10, 60} {
+		s, err := RandomMaximalPlanarGraph(n, int64(n))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(s.Graph.Nodes) != n || s.Graph.NumberOfEdges() != 3*n-6 {
+			t.Errorf("Expected %d nodes and %d edges, but got %d and %d", n, 3*n-6, len(s.Graph.Nodes), s.Graph.NumberOfEdges())
+		}
+		if !isPlaneDrawing(s) {
+			t.Errorf("Expected a plane drawing for %d nodes", n)
+		}
+	}
+	if _, err := RandomMaximalPlanarGraph(2, 1); err == nil {
+		t.Errorf("Expected an error for 2 nodes")
+	}
+}
+
+func TestRandomPlanarGraph(t *testing.T) {
+	s, err := RandomPlanarGraph(50, 70, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s.Graph.NumberOfEdges() != 70 || !isPlaneDrawing(s) || len(ConnectedComponents(s.Graph).ComponentsArray) != 1 {
+		t.Errorf("Expected a connected plane drawing with 70 edges, but got %d edges", s.Graph.NumberOfEdges())
+	}
+
+	s, err = RandomPlanarGraph(50, 20, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if s.Graph.NumberOfEdges() != 20 || len(s.Graph.Nodes) != 50 {
+		t.Errorf("Expected 50 nodes and 20 edges, but got %d and %d", len(s.Graph.Nodes), s.Graph.NumberOfEdges())
+	}
+
+	if _, err := RandomPlanarGraph(10, 25, 1); err == nil {
+		t.Errorf("Expected an error for more than 3n - 6 edges")
+	}
+}
+
+func TestRandomRoadNetwork(t *testing.T) {
+	r, err := RandomRoadNetwork(200, 2.6, 4, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(r.Graph.Nodes) != 200 || len(ConnectedComponents(r.Graph).ComponentsArray) != 1 {
+		t.Errorf("Expected a connected network of 200 nodes, but got %d nodes", len(r.Graph.Nodes))
+	}
+	if !isPlaneDrawing(r.SpatialGraph) {
+		t.Errorf("Expected a plane drawing")
+	}
+	if average := 2 * float64(r.Graph.NumberOfEdges()) / 200; average < 2.4 || average > 2.8 {
+		t.Errorf("Expected an average degree close to 2.6, but got %v", average)
+	}
+	for node := range r.Graph.Nodes {
+		if r.Graph.NodeDegree(node) > 4 {
+			t.Errorf("Expected a degree of at most 4, but node %d has %d", node, r.Graph.NodeDegree(node))
+		}
+	}
+	for _, edge := range sortedEdgeList(r.Graph) {
+		length, euclidean := r.Length(edge.Node2, edge.Node1), r.EuclideanWeight(edge.Node1, edge.Node2)
+		if length < euclidean || length > 1.15*euclidean {
+			t.Errorf("Expected the length of %v to be within 15%% above %v, but got %v", edge, euclidean, length)
+		}
+	}
+
+	again, _ := RandomRoadNetwork(200, 2.6, 4, 3)
+	if again.Graph.NumberOfEdges() != r.Graph.NumberOfEdges() || again.Length(0, 1) != r.Length(0, 1) {
+		t.Errorf("Expected the same network for the same seed")
+	}
+
+	if _, err := RandomRoadNetwork(10, 2.5, 2, 1); err == nil {
+		t.Errorf("Expected an error for a maximum degree of 2")
+	}
+	if _, err := RandomRoadNetwork(0, 2.5, 4, 1); err == nil {
+		t.Errorf("Expected an error for no nodes")
+	}
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"fmt"
 	"math"
 	"math/rand"
 )
@@ -144,3 +145,332 @@ func WattsStrogatzRandomGraph(numberOfNodes int, nearestNeighboursCount int, edg
 
 	return g
 }
+
+/*
+GnpRandomGraph generates an Erdős-Rényi G(n, p) random graph, in which every pair of nodes is linked independently
+with probability p.
+
+Parameters:
+- numberOfNodes: The number of nodes, numbered from 0.
+- probability: The probability of every edge, in [0, 1].
+- seed: The seed of the random number generator; the same seed gives the same graph.
+
+Returns:
+- g: The random graph, whose nodes are all present even if isolated.
+- err: An error if the number of nodes is negative or the probability is outside [0, 1].
+
+Description:
+The pairs are visited in order and the gaps between successive edges are drawn from a geometric distribution, in
+O(n + m) time, as in FastGNPRandomGraph.
+
+Reference: V. Batagelj and U. Brandes, "Efficient generation of large random networks", Phys. Rev. E 71, 036113, 2005.
+*/
+func GnpRandomGraph(numberOfNodes int, probability float64, seed int64) (*UndirectedGraph, error) {
+	if numberOfNodes < 0 || probability < 0 || probability > 1 {
+		return nil, fmt.Errorf("the number of nodes must not be negative and the probability must be in [0, 1]")
+	}
+	rng := rand.New(rand.NewSource(seed))
+	g := emptyRandomGraph(numberOfNodes)
+	if probability == 0 {
+		return g, nil
+	}
+	if probability == 1 {
+		for u := 0; u < numberOfNodes; u++ {
+			for v := u + 1; v < numberOfNodes; v++ {
+				g.AddEdge(Edge{Node1: Node(u), Node2: Node(v)})
+			}
+		}
+		return g, nil
+	}
+	logComplement := math.Log(1 - probability)
+	for v, w := 1, -1; v < numberOfNodes; {
+		w += 1 + int(math.Log(1-rng.Float64())/logComplement)
+		for w >= v && v < numberOfNodes {
+			w -= v
+			v++
+		}
+		if v < numberOfNodes {
+			g.AddEdge(Edge{Node1: Node(v), Node2: Node(w)})
+		}
+	}
+	return g, nil
+}
+
+/*
+GnmRandomGraph generates an Erdős-Rényi G(n, m) random graph, chosen uniformly among the graphs with n nodes and m edges.
+
+Parameters:
+- numberOfNodes: The number of nodes, numbered from 0.
+- numberOfEdges: The number of edges, at most n(n-1)/2.
+- seed: The seed of the random number generator; the same seed gives the same graph.
+
+Returns:
+- g: The random graph, whose nodes are all present even if isolated.
+- err: An error if a parameter is negative or there are more edges than pairs of nodes.
+
+Description:
+Random pairs are drawn until m distinct ones are found. Beyond half of the pairs, the missing edges are drawn instead,
+so the expected number of draws stays below 2m.
+*/
+func GnmRandomGraph(numberOfNodes, numberOfEdges int, seed int64) (*UndirectedGraph, error) {
+	pairs := numberOfNodes * (numberOfNodes - 1) / 2
+	if numberOfNodes < 0 || numberOfEdges < 0 || numberOfEdges > pairs {
+		return nil, fmt.Errorf("cannot place %d edges among %d nodes", numberOfEdges, numberOfNodes)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	g := emptyRandomGraph(numberOfNodes)
+	dense := 2*numberOfEdges > pairs
+	draws := numberOfEdges
+	if dense {
+		draws = pairs - numberOfEdges
+	}
+	drawn := make(map[Edge]bool, draws)
+	var order []Edge
+	for len(drawn) < draws {
+		edge := orderedEdge(Node(rng.Intn(numberOfNodes)), Node(rng.Intn(numberOfNodes)))
+		if edge.Node1 != edge.Node2 && !drawn[edge] {
+			drawn[edge] = true
+			order = append(order, edge)
+		}
+	}
+	if !dense {
+		for _, edge := range order {
+			g.AddEdge(edge)
+		}
+		return g, nil
+	}
+	for u := 0; u < numberOfNodes; u++ {
+		for v := u + 1; v < numberOfNodes; v++ {
+			if edge := (Edge{Node1: Node(u), Node2: Node(v)}); !drawn[edge] {
+				g.AddEdge(edge)
+			}
+		}
+	}
+	return g, nil
+}
+
+/*
+BarabasiAlbertGraph generates a scale-free random graph by preferential attachment.
+
+Parameters:
+- numberOfNodes: The number of nodes, numbered from 0.
+- edgesPerNode: The number of edges m linking every new node to existing ones, with 1 <= m < numberOfNodes.
+- seed: The seed of the random number generator; the same seed gives the same graph.
+
+Returns:
+- g: The random graph, with m(n - m - 1) + m edges.
+- err: An error if m is out of range.
+
+Description:
+The graph starts as a star with center 0 and m leaves. Every further node links to m distinct existing nodes drawn
+with probability proportional to their degree, so the degree distribution follows a power law of exponent 3.
+
+Reference: A.-L. Barabási and R. Albert, "Emergence of scaling in random networks", Science 286, 1999.
+*/
+func BarabasiAlbertGraph(numberOfNodes, edgesPerNode int, seed int64) (*UndirectedGraph, error) {
+	if edgesPerNode < 1 || edgesPerNode >= numberOfNodes {
+		return nil, fmt.Errorf("the number of edges per node must be in [1, %d), got %d", numberOfNodes, edgesPerNode)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	g := emptyRandomGraph(numberOfNodes)
+	// Every node appears in endpoints once per incident edge, so a uniform draw from it is proportional to the degree
+	endpoints := make([]Node, 0, 2*edgesPerNode*numberOfNodes)
+	for leaf := 1; leaf <= edgesPerNode; leaf++ {
+		g.AddEdge(Edge{Node1: 0, Node2: Node(leaf)})
+		endpoints = append(endpoints, 0, Node(leaf))
+	}
+	for node := edgesPerNode + 1; node < numberOfNodes; node++ {
+		targets := make([]Node, 0, edgesPerNode)
+		chosen := make(map[Node]bool, edgesPerNode)
+		for len(targets) < edgesPerNode {
+			target := endpoints[rng.Intn(len(endpoints))]
+			if !chosen[target] {
+				chosen[target] = true
+				targets = append(targets, target)
+			}
+		}
+		for _, target := range targets {
+			g.AddEdge(Edge{Node1: Node(node), Node2: target})
+			endpoints = append(endpoints, Node(node), target)
+		}
+	}
+	return g, nil
+}
+
+/*
+WattsStrogatzGraph generates a small-world random graph by rewiring a ring lattice.
+
+Parameters:
+- numberOfNodes: The number of nodes, numbered from 0 around the ring.
+- nearestNeighbors: The number k of nearest neighbors every node is linked to in the lattice, k/2 on each side; an
+odd k is rounded down. It must be smaller than numberOfNodes.
+- probability: The probability of rewiring every lattice edge, in [0, 1].
+- seed: The seed of the random number generator; the same seed gives the same graph.
+
+Returns:
+- g: The random graph, with as many edges as the lattice.
+- err: An error if a parameter is out of range.
+
+Description:
+Every lattice edge (u, u + j), for j from 1 to k/2 and u around the ring, is replaced with probability p by an edge
+from u to a uniformly chosen node that is neither u nor already a neighbor of u. Edges of nodes already linked to all
+the others are left in place. Low p keeps the high clustering of the lattice while shortening the paths.
+
+Reference: D. J. Watts and S. H. Strogatz, "Collective dynamics of 'small-world' networks", Nature 393, 1998.
+*/
+func WattsStrogatzGraph(numberOfNodes, nearestNeighbors int, probability float64, seed int64) (*UndirectedGraph, error) {
+	if numberOfNodes < 0 || nearestNeighbors < 0 || (numberOfNodes > 0 && nearestNeighbors >= numberOfNodes) ||
+		probability < 0 || probability > 1 {
+		return nil, fmt.Errorf("the number of neighbors must be in [0, %d) and the probability in [0, 1]", numberOfNodes)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	g := emptyRandomGraph(numberOfNodes)
+	for j := 1; j <= nearestNeighbors/2; j++ {
+		for u := 0; u < numberOfNodes; u++ {
+			g.AddEdge(Edge{Node1: Node(u), Node2: Node((u + j) % numberOfNodes)})
+		}
+	}
+	for j := 1; j <= nearestNeighbors/2; j++ {
+		for u := 0; u < numberOfNodes; u++ {
+			v := Node((u + j) % numberOfNodes)
+			if rng.Float64() >= probability || g.NodeDegree(Node(u)) >= numberOfNodes-1 || !g.HasEdge(Node(u), v) {
+				continue
+			}
+			w := Node(rng.Intn(numberOfNodes))
+			for w == Node(u) || g.HasEdge(Node(u), w) {
+				w = Node(rng.Intn(numberOfNodes))
+			}
+			g.RemoveEdge(Edge{Node1: Node(u), Node2: v})
+			g.AddEdge(Edge{Node1: Node(u), Node2: w})
+		}
+	}
+	return g, nil
+}
+
+// emptyRandomGraph returns a graph with nodes 0 to numberOfNodes-1 and no edges.
+func emptyRandomGraph(numberOfNodes int) *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool, numberOfNodes), Edges: make(map[Node][]Node)}
+	for i := 0; i < numberOfNodes; i++ {
+		g.AddNode(Node(i))
+	}
+	return g
+}
+
+/*
+StochasticBlockModelGraph generates a random graph from a stochastic block model, in which every pair of nodes is linked
+independently with a probability depending only on their blocks.
+
+Parameters:
+- sizes: The number of nodes of every block; the nodes are numbered from 0 block after block.
+- probabilities: The symmetric matrix of the probabilities of an edge between a node of block r and a node of block s,
+in [0, 1].
+- seed: The seed of the random number generator; the same seed gives the same graph.
+
+Returns:
+- g: The random graph, whose nodes are all present even if isolated.
+- err: An error if a size is negative or the matrix is not a symmetric matrix of probabilities of the size of sizes.
+
+Reference: P. W. Holland, K. B. Laskey and S. Leinhardt, "Stochastic blockmodels: first steps", Social Networks 5, 1983.
+*/
+func StochasticBlockModelGraph(sizes []int, probabilities [][]float64, seed int64) (*UndirectedGraph, error) {
+	if len(probabilities) != len(sizes) {
+		return nil, fmt.Errorf("expected a %d x %d probability matrix, got %d rows", len(sizes), len(sizes), len(probabilities))
+	}
+	blocks := make([]int, 0)
+	for r, size := range sizes {
+		if size < 0 || len(probabilities[r]) != len(sizes) {
+			return nil, fmt.Errorf("block %d has a negative size or a row of the wrong length", r)
+		}
+		for s, probability := range probabilities[r] {
+			if probability < 0 || probability > 1 || probability != probabilities[s][r] {
+				return nil, fmt.Errorf("the probabilities must be symmetric and in [0, 1], got %v between blocks %d and %d", probability, r, s)
+			}
+		}
+		for i := 0; i < size; i++ {
+			blocks = append(blocks, r)
+		}
+	}
+	rng := rand.New(rand.NewSource(seed))
+	g := emptyRandomGraph(len(blocks))
+	for u := range blocks {
+		for v := u + 1; v < len(blocks); v++ {
+			if rng.Float64() < probabilities[blocks[u]][blocks[v]] {
+				g.AddEdge(Edge{Node1: Node(u), Node2: Node(v)})
+			}
+		}
+	}
+	return g, nil
+}
+
+/*
+DegreeCorrectedSBMGraph generates a random graph from the degree-corrected stochastic block model, in which nodes of
+the same block may have very different degrees, as in real networks with communities.
+
+Parameters:
+- sizes: The number of nodes of every block; the nodes are numbered from 0 block after block.
+- weights: The positive degree propensity of every node, such as a draw from a power law for heavy-tailed degrees.
+Only their ratios within a block matter.
+- edges: The symmetric matrix of the expected number of edges between a node of block r and a node of block s, the
+diagonal giving the edges inside every block.
+- seed: The seed of the random number generator; the same seed gives the same graph.
+
+Returns:
+- g: The random graph, whose nodes are all present even if isolated.
+- err: An error if a size is negative, the weights do not match the sizes, or the matrix is not a symmetric matrix
+of non-negative numbers of the size of sizes.
+
+Description:
+The weights are normalized to a sum of 1 in every block, giving θ_u, and every pair of nodes u in block r and v in
+block s is linked independently with probability θ_u θ_v edges[r][s], doubled when r = s, capped at 1. The expected
+degree of a node is then θ_u times the total number of edge endpoints of its block, and the expected numbers of edges
+match the matrix up to the capped probabilities and the missing self-loops. Like StochasticBlockModelGraph, it takes
+O(n²) time.
+
+Reference: B. Karrer and M. E. J. Newman, "Stochastic blockmodels and community structure in networks", Phys. Rev. E
+83, 016107, 2011.
+*/
+func DegreeCorrectedSBMGraph(sizes []int, weights []float64, edges [][]float64, seed int64) (*UndirectedGraph, error) {
+	if len(edges) != len(sizes) {
+		return nil, fmt.Errorf("expected a %d x %d matrix of edge counts, got %d rows", len(sizes), len(sizes), len(edges))
+	}
+	blocks := make([]int, 0, len(weights))
+	for r, size := range sizes {
+		if size < 0 || len(edges[r]) != len(sizes) {
+			return nil, fmt.Errorf("block %d has a negative size or a row of the wrong length", r)
+		}
+		for s, count := range edges[r] {
+			if count < 0 || count != edges[s][r] {
+				return nil, fmt.Errorf("the edge counts must be symmetric and non-negative, got %v between blocks %d and %d", count, r, s)
+			}
+		}
+		for i := 0; i < size; i++ {
+			blocks = append(blocks, r)
+		}
+	}
+	if len(weights) != len(blocks) {
+		return nil, fmt.Errorf("expected %d weights, one per node, got %d", len(blocks), len(weights))
+	}
+	totals := make([]float64, len(sizes))
+	for u, weight := range weights {
+		if !(weight > 0) {
+			return nil, fmt.Errorf("the weights must be positive, got %v for node %d", weight, u)
+		}
+		totals[blocks[u]] += weight
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	g := emptyRandomGraph(len(blocks))
+	for u := range blocks {
+		for v := u + 1; v < len(blocks); v++ {
+			r, s := blocks[u], blocks[v]
+			probability := weights[u] / totals[r] * weights[v] / totals[s] * edges[r][s]
+			if r == s {
+				probability *= 2
+			}
+			if rng.Float64() < probability {
+				g.AddEdge(Edge{Node1: Node(u), Node2: Node(v)})
+			}
+		}
+	}
+	return g, nil
+}
@@ -0,0 +1,187 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGnpRandomGraph(t *testing.T) {
+	g, err := GnpRandomGraph(200, 0.05, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The expected number of edges is 0.05 * 19900 = 995, with a standard deviation of about 31
+	if edges := g.NumberOfEdges(); len(g.Nodes) != 200 || edges < 850 || edges > 1150 {
+		t.Errorf("Expected 200 nodes and about 995 edges, but got %d nodes and %d edges", len(g.Nodes), edges)
+	}
+	again, _ := GnpRandomGraph(200, 0.05, 1)
+	if !again.Equals(g) {
+		t.Errorf("Expected the same graph for the same seed")
+	}
+	if other, _ := GnpRandomGraph(200, 0.05, 2); other.Equals(g) {
+		t.Errorf("Expected different graphs for different seeds")
+	}
+
+	empty, _ := GnpRandomGraph(5, 0, 1)
+	full, _ := GnpRandomGraph(5, 1, 1)
+	if len(empty.Nodes) != 5 || empty.NumberOfEdges() != 0 || !full.Equals(CompleteGraph(5)) {
+		t.Errorf("Expected an empty and a complete graph, but got %v and %v", empty, full)
+	}
+	if _, err := GnpRandomGraph(5, 1.5, 1); err == nil {
+		t.Errorf("Expected an error for a probability above 1")
+	}
+}
+
+func TestGnmRandomGraph(t *testing.T) {
+	for _, edges := range []int{0, 10, 40, 45} {
+		g, err := GnmRandomGraph(10, edges, 3)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(g.Nodes) != 10 || g.NumberOfEdges() != edges {
+			t.Errorf("Expected 10 nodes and %d edges, but got %d nodes and %d edges", edges, len(g.Nodes), g.NumberOfEdges())
+		}
+		again, _ := GnmRandomGraph(10, edges, 3)
+		if !again.Equals(g) {
+			t.Errorf("Expected the same graph for the same seed")
+		}
+	}
+	if _, err := GnmRandomGraph(10, 46, 1); err == nil {
+		t.Errorf("Expected an error for more edges than pairs")
+	}
+}
+
+func TestBarabasiAlbertGraph(t *testing.T) {
+	g, err := BarabasiAlbertGraph(500, 3, 7)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := 3*(500-3-1) + 3; len(g.Nodes) != 500 || g.NumberOfEdges() != expected {
+		t.Errorf("Expected 500 nodes and %d edges, but got %d nodes and %d edges", expected, len(g.Nodes), g.NumberOfEdges())
+	}
+	largest := 0
+	for node := range g.Nodes {
+		if node > 3 && g.NodeDegree(node) < 3 {
+			t.Errorf("Expected every added node to have degree at least 3, but node %d has %d", node, g.NodeDegree(node))
+		}
+		largest = max(largest, g.NodeDegree(node))
+	}
+	// Preferential attachment grows hubs far above the average degree of 6
+	if largest < 30 {
+		t.Errorf("Expected a hub, but the largest degree is %d", largest)
+	}
+	again, _ := BarabasiAlbertGraph(500, 3, 7)
+	if !again.Equals(g) {
+		t.Errorf("Expected the same graph for the same seed")
+	}
+	if _, err := BarabasiAlbertGraph(5, 5, 1); err == nil {
+		t.Errorf("Expected an error for as many edges per node as nodes")
+	}
+}
+
+func TestWattsStrogatzGraph(t *testing.T) {
+	lattice, err := WattsStrogatzGraph(20, 4, 0, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for node := range lattice.Nodes {
+		if lattice.NodeDegree(node) != 4 || !lattice.HasEdge(node, (node+2)%20) {
+			t.Errorf("Expected a ring lattice, but node %d has neighbors %v", node, lattice.Edges[node])
+		}
+	}
+
+	g, err := WattsStrogatzGraph(100, 6, 0.3, 5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(g.Nodes) != 100 || g.NumberOfEdges() != 300 || g.Equals(lattice) {
+		t.Errorf("Expected 100 nodes and 300 edges, but got %d nodes and %d edges", len(g.Nodes), g.NumberOfEdges())
+	}
+	for node, neighbors := range g.Edges {
+		for _, neighbor := range neighbors {
+			if neighbor == node {
+				t.Errorf("Expected no self-loop, but node %d has one", node)
+			}
+		}
+	}
+	again, _ := WattsStrogatzGraph(100, 6, 0.3, 5)
+	if !again.Equals(g) {
+		t.Errorf("Expected the same graph for the same seed")
+	}
+	if _, err := WattsStrogatzGraph(5, 5, 0.1, 1); err == nil {
+		t.Errorf("Expected an error for as many neighbors as nodes")
+	}
+}
+
+func TestStochasticBlockModelGraph(t *testing.T) {
+	g, err := StochasticBlockModelGraph([]int{3, 4}, [][]float64{{1, 0}, {0, 1}}, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := CompleteGraph(3)
+	for i := 3; i < 7; i++ {
+		for j := i + 1; j < 7; j++ {
+			expected.AddEdge(Edge{Node1: Node(i), Node2: Node(j)})
+		}
+	}
+	if !g.Equals(expected) {
+		t.Errorf("Expected two cliques, but got %v", g)
+	}
+
+	g, _ = StochasticBlockModelGraph([]int{50, 50}, [][]float64{{0.3, 0.02}, {0.02, 0.3}}, 2)
+	within, between := 0, 0
+	for _, edge := range sortedEdgeList(g) {
+		if (edge.Node1 < 50) == (edge.Node2 < 50) {
+			within++
+		} else {
+			between++
+		}
+	}
+	// 735 expected edges within the blocks and 50 between them
+	if within < 600 || within > 870 || between > 90 {
+		t.Errorf("Expected about 735 edges within the blocks and 50 between, but got %d and %d", within, between)
+	}
+
+	if _, err := StochasticBlockModelGraph([]int{2, 2}, [][]float64{{0.5, 0.1}, {0.2, 0.5}}, 1); err == nil {
+		t.Errorf("Expected an error for an asymmetric matrix")
+	}
+}
+
+func TestDegreeCorrectedSBMGraph(t *testing.T) {
+	// Two blocks of 100 nodes with weights decreasing as a power law of the rank
+	sizes := []int{100, 100}
+	weights := make([]float64, 200)
+	for u := range weights {
+		weights[u] = math.Pow(float64(u%100+1), -0.7)
+	}
+	g, err := DegreeCorrectedSBMGraph(sizes, weights, [][]float64{{400, 50}, {50, 400}}, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	within, between := 0, 0
+	for _, edge := range sortedEdgeList(g) {
+		if (edge.Node1 < 100) == (edge.Node2 < 100) {
+			within++
+		} else {
+			between++
+		}
+	}
+	// Capping the probabilities of the heaviest pairs loses a few of the 800 edges expected within the blocks
+	if within < 650 || within > 850 || between < 30 || between > 70 {
+		t.Errorf("Expected about 800 edges within the blocks and 50 between, but got %d and %d", within, between)
+	}
+	hubs, tail := g.NodeDegree(0)+g.NodeDegree(100), g.NodeDegree(99)+g.NodeDegree(199)
+	if hubs < 5*tail {
+		t.Errorf("Expected the heaviest nodes to have far larger degrees than the lightest, but got %d and %d", hubs, tail)
+	}
+
+	if _, err := DegreeCorrectedSBMGraph([]int{2, 2}, []float64{1, 1, 1}, [][]float64{{1, 1}, {1, 1}}, 1); err == nil {
+		t.Errorf("Expected an error for a missing weight")
+	}
+	if _, err := DegreeCorrectedSBMGraph([]int{2, 2}, []float64{1, 1, 1, 0}, [][]float64{{1, 1}, {1, 1}}, 1); err == nil {
+		t.Errorf("Expected an error for a zero weight")
+	}
+	if _, err := DegreeCorrectedSBMGraph([]int{2, 2}, []float64{1, 1, 1, 1}, [][]float64{{1, 2}, {1, 1}}, 1); err == nil {
+		t.Errorf("Expected an error for an asymmetric matrix")
+	}
+}
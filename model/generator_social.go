@@ -0,0 +1,151 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// SocialNetworkConfig describes the attributed social graphs of AttributedSocialGraph.
+type SocialNetworkConfig struct {
+	// NumberOfNodes is the number of people.
+	NumberOfNodes int
+	// AverageDegree is the expected average number of friends.
+	AverageDegree float64
+	// GroupWeights gives the relative size of every group of the attribute, such as []float64{3, 1} for a majority
+	// three times larger than the minority.
+	GroupWeights []float64
+	// Homophily is the expected fraction of edges inside a group, in [0, 1]. The fraction expected from random mixing,
+	// the sum of the squared group fractions, gives a graph without homophily; more gives a homophilous graph and less
+	// a heterophilous one.
+	Homophily float64
+	// FeatureDimension is the number of numeric features of every node, 0 for none.
+	FeatureDimension int
+	// FeatureNoise is the standard deviation of the features around the center of the group of the node.
+	FeatureNoise float64
+}
+
+// LabeledGraph is a graph whose nodes have a class label and, optionally, a feature vector, as used to train and
+// evaluate node classifiers.
+type LabeledGraph struct {
+	Graph    *UndirectedGraph
+	Labels   map[Node]int
+	Features map[Node][]float64
+}
+
+/*
+AttributedSocialGraph generates a social graph whose nodes belong to groups, such as the gender or the school of
+people, and link more or less often inside their group.
+
+Parameters:
+- config: The size of the graph and of the groups, the homophily and the features.
+- seed: The seed of the random number generator; the same seed gives the same graph.
+
+Returns:
+- g: The graph, with the group of every node, numbered from 0 in the order of GroupWeights, as label. The nodes are
+numbered group after group. Features, if requested, are drawn around a random center per group, drawn from a standard
+normal distribution.
+- err: An error if a parameter is out of range or the homophily cannot be reached with the given degree, which would
+need an edge probability above 1.
+
+Description:
+The graph is drawn from a stochastic block model with one probability p_in for the pairs inside a group and one
+probability p_out for the other pairs, chosen so that the expected number of edges is n d / 2 and the expected
+fraction of them inside a group is the homophily. The homophily of the result can be checked with
+AttributeAssortativity.
+*/
+func AttributedSocialGraph(config SocialNetworkConfig, seed int64) (*LabeledGraph, error) {
+	n := config.NumberOfNodes
+	if n < 0 || config.AverageDegree < 0 || len(config.GroupWeights) == 0 || config.Homophily < 0 || config.Homophily > 1 {
+		return nil, fmt.Errorf("expected a non-negative number of nodes and degree, at least one group and a homophily in [0, 1]")
+	}
+	if config.FeatureDimension < 0 || config.FeatureNoise < 0 {
+		return nil, fmt.Errorf("the feature dimension and noise must be non-negative, got %d and %v", config.FeatureDimension, config.FeatureNoise)
+	}
+	totalWeight := 0.0
+	for _, weight := range config.GroupWeights {
+		if weight < 0 {
+			return nil, fmt.Errorf("group weights must be non-negative, got %v", weight)
+		}
+		totalWeight += weight
+	}
+	if totalWeight == 0 {
+		return nil, fmt.Errorf("group weights must not all be zero")
+	}
+
+	// Group sizes by largest remainders, so that they add up to n
+	sizes := make([]int, len(config.GroupWeights))
+	assigned := 0
+	remainders := make([]float64, len(sizes))
+	for i, weight := range config.GroupWeights {
+		exact := float64(n) * weight / totalWeight
+		sizes[i] = int(exact)
+		remainders[i] = exact - float64(sizes[i])
+		assigned += sizes[i]
+	}
+	for ; assigned < n; assigned++ {
+		best := 0
+		for i := range remainders {
+			if remainders[i] > remainders[best] {
+				best = i
+			}
+		}
+		sizes[best]++
+		remainders[best] = -1
+	}
+
+	insidePairs, totalPairs := 0.0, float64(n)*float64(n-1)/2
+	for _, size := range sizes {
+		insidePairs += float64(size) * float64(size-1) / 2
+	}
+	edges := float64(n) * config.AverageDegree / 2
+	pIn, pOut := 0.0, 0.0
+	if insidePairs > 0 {
+		pIn = config.Homophily * edges / insidePairs
+	}
+	if totalPairs > insidePairs {
+		pOut = (1 - config.Homophily) * edges / (totalPairs - insidePairs)
+	}
+	if pIn > 1 || pOut > 1 || (insidePairs == 0 && config.Homophily*edges > 0) || (totalPairs == insidePairs && (1-config.Homophily)*edges > 0) {
+		return nil, fmt.Errorf("a homophily of %v cannot be reached with an average degree of %v", config.Homophily, config.AverageDegree)
+	}
+	probabilities := make([][]float64, len(sizes))
+	for r := range probabilities {
+		probabilities[r] = make([]float64, len(sizes))
+		for s := range probabilities[r] {
+			probabilities[r][s] = pOut
+		}
+		probabilities[r][r] = pIn
+	}
+	g, err := StochasticBlockModelGraph(sizes, probabilities, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	labeled := &LabeledGraph{Graph: g, Labels: make(map[Node]int, n)}
+	node := Node(0)
+	for group, size := range sizes {
+		for i := 0; i < size; i++ {
+			labeled.Labels[node] = group
+			node++
+		}
+	}
+	if config.FeatureDimension > 0 {
+		rng := rand.New(rand.NewSource(seed + 1))
+		centers := make([][]float64, len(sizes))
+		for group := range centers {
+			centers[group] = make([]float64, config.FeatureDimension)
+			for k := range centers[group] {
+				centers[group][k] = rng.NormFloat64()
+			}
+		}
+		labeled.Features = make(map[Node][]float64, n)
+		for node := Node(0); node < Node(n); node++ {
+			features := make([]float64, config.FeatureDimension)
+			for k := range features {
+				features[k] = centers[labeled.Labels[node]][k] + config.FeatureNoise*rng.NormFloat64()
+			}
+			labeled.Features[node] = features
+		}
+	}
+	return labeled, nil
+}
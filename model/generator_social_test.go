@@ -0,0 +1,73 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAttributedSocialGraph(t *testing.T) {
+	for _, test := range []struct {
+		homophily, assortativity float64
+	}{
+		{0.9, 0.8}, {0.5, 0}, {0.1, -0.8},
+	} {
+		config := SocialNetworkConfig{NumberOfNodes: 1000, AverageDegree: 10, GroupWeights: []float64{1, 1}, Homophily: test.homophily}
+		social, err := AttributedSocialGraph(config, 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(social.Graph.Nodes) != 1000 || math.Abs(float64(social.Graph.NumberOfEdges())-5000) > 300 {
+			t.Errorf("Expected about 5000 edges, but got %d", social.Graph.NumberOfEdges())
+		}
+		if r := AttributeAssortativity(social.Graph, social.Labels); math.Abs(r-test.assortativity) > 0.05 {
+			t.Errorf("Expected an assortativity of about %v for a homophily of %v, but got %v", test.assortativity, test.homophily, r)
+		}
+		if social.Features != nil {
+			t.Errorf("Expected no features")
+		}
+	}
+}
+
+func TestAttributedSocialGraphGroupsAndFeatures(t *testing.T) {
+	config := SocialNetworkConfig{
+		NumberOfNodes:    10,
+		AverageDegree:    2,
+		GroupWeights:     []float64{2, 1, 0},
+		Homophily:        0.7,
+		FeatureDimension: 3,
+		FeatureNoise:     0,
+	}
+	social, err := AttributedSocialGraph(config, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	counts := make(map[int]int)
+	for _, label := range social.Labels {
+		counts[label]++
+	}
+	if counts[0] != 7 || counts[1] != 3 || counts[2] != 0 {
+		t.Errorf("Expected groups of 7 and 3 nodes, but got %v", counts)
+	}
+	// Without noise the features are the centers of the groups
+	for node := Node(0); node < 10; node++ {
+		if len(social.Features[node]) != 3 {
+			t.Fatalf("Expected 3 features, but got %v", social.Features[node])
+		}
+		same := social.Features[node][0] == social.Features[0][0]
+		if same != (social.Labels[node] == social.Labels[0]) {
+			t.Errorf("Expected the features of node %d to be those of its group", node)
+		}
+	}
+
+	for _, invalid := range []SocialNetworkConfig{
+		{NumberOfNodes: 10, AverageDegree: 2, Homophily: 0.5},
+		{NumberOfNodes: 10, AverageDegree: 2, GroupWeights: []float64{1}, Homophily: 1.5},
+		{NumberOfNodes: 10, AverageDegree: 2, GroupWeights: []float64{1, -1}, Homophily: 0.5},
+		{NumberOfNodes: 10, AverageDegree: 8, GroupWeights: []float64{1, 1}, Homophily: 1},
+		{NumberOfNodes: 10, AverageDegree: 2, GroupWeights: []float64{1}, Homophily: 0.5},
+	} {
+		if _, err := AttributedSocialGraph(invalid, 1); err == nil {
+			t.Errorf("Expected an error for %+v", invalid)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// The road classes of the transport network generators: local roads, and the arterial roads joining the hubs, which
+// carry more traffic faster. Travel times vary by up to transportCongestion above the free-flow time.
+const (
+	localRoadCapacity   = 1.0
+	localRoadSpeed      = 1.0
+	arterialCapacity    = 3.0
+	arterialSpeed       = 2.0
+	transportCongestion = 0.2
+)
+
+// TransportNetwork is a synthetic road network: a spatial graph whose edges have a capacity and a travel time, and a
+// few hubs, such as city centers or depots, between which traffic flows.
+type TransportNetwork struct {
+	*SpatialGraph
+	// Hubs lists the hubs in the order they were placed, the most central first.
+	Hubs []Node
+	// Capacities and TravelTimes hold the capacity and the travel time of every edge, keyed with Node1 <= Node2.
+	Capacities  map[Edge]float64
+	TravelTimes map[Edge]float64
+}
+
+// Capacity returns the capacity of the edge between u and v, or 0 if there is none. It can be used as a WeightFunc.
+func (t *TransportNetwork) Capacity(u, v Node) float64 {
+	return t.Capacities[orderedEdge(u, v)]
+}
+
+// TravelTime returns the travel time of the edge between u and v, or 0 if there is none. It can be used as a
+// WeightFunc.
+func (t *TransportNetwork) TravelTime(u, v Node) float64 {
+	return t.TravelTimes[orderedEdge(u, v)]
+}
+
+/*
+GridTransportNetwork generates a transport network on a grid of streets, such as the center of a planned city.
+
+Parameters:
+- rows, cols: The size of the grid, see GridGraph and GridPositions.
+- numberOfHubs: The number of hubs, at most rows x cols.
+- seed: The seed of the random number generator.
+
+Returns:
+- t: The network, see PlanarTransportNetwork for its capacities and travel times.
+- err: An error if the grid is empty or numberOfHubs is out of range.
+*/
+func GridTransportNetwork(rows, cols, numberOfHubs int, seed int64) (*TransportNetwork, error) {
+	if rows < 1 || cols < 1 {
+		return nil, fmt.Errorf("a grid needs at least one row and one column, got %d x %d", rows, cols)
+	}
+	s := NewSpatialGraph(GridPositions(rows, cols))
+	for _, edge := range sortedEdgeList(GridGraph(rows, cols)) {
+		s.Graph.AddEdge(edge)
+	}
+	return newTransportNetwork(s, numberOfHubs, seed)
+}
+
+/*
+PlanarTransportNetwork generates a transport network on a random planar graph, such as a regional road network.
+
+Parameters:
+- numberOfNodes: The number of intersections, at least 3.
+- numberOfEdges: The number of roads, between numberOfNodes - 1 and 3n - 6 for a connected network; see
+RandomPlanarGraph.
+- numberOfHubs: The number of hubs, at most numberOfNodes.
+- seed: The seed of the random number generator.
+
+Returns:
+- t: The network.
+- err: An error if a parameter is out of range.
+
+Description:
+The hubs are spread over the network: the first is the node closest to the centroid of the positions and every
+other one is the node farthest from the hubs placed so far, ties broken by node id. The edges of the shortest paths
+between hubs, by Euclidean length, become arterial roads of capacity 3 and speed 2, and the other edges local roads
+of capacity 1 and speed 1. The travel time of an edge is its length divided by its speed, increased by a random
+congestion of up to 20%.
+*/
+func PlanarTransportNetwork(numberOfNodes, numberOfEdges, numberOfHubs int, seed int64) (*TransportNetwork, error) {
+	s, err := RandomPlanarGraph(numberOfNodes, numberOfEdges, seed)
+	if err != nil {
+		return nil, err
+	}
+	return newTransportNetwork(s, numberOfHubs, seed)
+}
+
+// newTransportNetwork places the hubs on a spatial graph and sets the capacities and travel times of its edges.
+func newTransportNetwork(s *SpatialGraph, numberOfHubs int, seed int64) (*TransportNetwork, error) {
+	nodes := sortedNodes(s.Graph.Nodes)
+	if numberOfHubs < 0 || numberOfHubs > len(nodes) {
+		return nil, fmt.Errorf("the number of hubs must be between 0 and %d, got %d", len(nodes), numberOfHubs)
+	}
+	t := &TransportNetwork{SpatialGraph: s, Capacities: make(map[Edge]float64), TravelTimes: make(map[Edge]float64)}
+
+	// Farthest-point placement, from the node closest to the centroid
+	var centroid Point
+	for _, node := range nodes {
+		centroid.X += s.Positions[node].X / float64(len(nodes))
+		centroid.Y += s.Positions[node].Y / float64(len(nodes))
+	}
+	closest := make(map[Node]float64, len(nodes))
+	for _, node := range nodes {
+		closest[node] = math.Inf(1)
+	}
+	for len(t.Hubs) < numberOfHubs {
+		best, bestScore := nodes[0], math.Inf(-1)
+		for _, node := range nodes {
+			score := closest[node]
+			if len(t.Hubs) == 0 {
+				score = -s.Positions[node].Distance(centroid)
+			}
+			if score > bestScore {
+				best, bestScore = node, score
+			}
+		}
+		t.Hubs = append(t.Hubs, best)
+		for _, node := range nodes {
+			closest[node] = math.Min(closest[node], s.Positions[node].Distance(s.Positions[best]))
+		}
+	}
+
+	arterials := make(map[Edge]bool)
+	for i, hub := range t.Hubs {
+		distances, parents, _, err := dijkstra(s.Graph.Edges, hub, s.EuclideanWeight)
+		if err != nil {
+			return nil, err
+		}
+		for _, other := range t.Hubs[i+1:] {
+			if _, reachable := distances[other]; !reachable {
+				continue
+			}
+			for node := other; node != hub; node = parents[node] {
+				arterials[orderedEdge(node, parents[node])] = true
+			}
+		}
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	for _, edge := range sortedEdgeList(s.Graph) {
+		capacity, speed := localRoadCapacity, localRoadSpeed
+		if arterials[edge] {
+			capacity, speed = arterialCapacity, arterialSpeed
+		}
+		t.Capacities[edge] = capacity
+		t.TravelTimes[edge] = s.EuclideanWeight(edge.Node1, edge.Node2) / speed * (1 + transportCongestion*rng.Float64())
+	}
+	return t, nil
+}
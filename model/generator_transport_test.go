@@ -0,0 +1,75 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGridTransportNetwork(t *testing.T) {
+	network, err := GridTransportNetwork(5, 5, 2, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The center first, then the first of the farthest corners
+	if !reflect.DeepEqual(network.Hubs, []Node{12, 0}) {
+		t.Errorf("Expected the hubs [12 0], but got %v", network.Hubs)
+	}
+	arterials := 0
+	for _, edge := range sortedEdgeList(network.Graph) {
+		capacity, travelTime := network.Capacity(edge.Node2, edge.Node1), network.TravelTime(edge.Node2, edge.Node1)
+		switch capacity {
+		case arterialCapacity:
+			arterials++
+			if travelTime < 0.5 || travelTime > 0.6 {
+				t.Errorf("Expected an arterial travel time in [0.5, 0.6], but got %v", travelTime)
+			}
+		case localRoadCapacity:
+			if travelTime < 1 || travelTime > 1.2 {
+				t.Errorf("Expected a local travel time in [1, 1.2], but got %v", travelTime)
+			}
+		default:
+			t.Errorf("Unexpected capacity %v", capacity)
+		}
+	}
+	if arterials != 4 {
+		t.Errorf("Expected 4 arterial edges between the hubs, but got %d", arterials)
+	}
+
+	again, _ := GridTransportNetwork(5, 5, 2, 1)
+	if !reflect.DeepEqual(network.TravelTimes, again.TravelTimes) {
+		t.Errorf("Expected the same travel times for the same seed")
+	}
+	if _, err := GridTransportNetwork(0, 5, 0, 1); err == nil {
+		t.Errorf("Expected an error for an empty grid")
+	}
+	if _, err := GridTransportNetwork(2, 2, 5, 1); err == nil {
+		t.Errorf("Expected an error for too many hubs")
+	}
+}
+
+func TestPlanarTransportNetwork(t *testing.T) {
+	network, err := PlanarTransportNetwork(40, 70, 4, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(network.Graph.Nodes) != 40 || network.Graph.NumberOfEdges() != 70 || !IsConnected(network.Graph) {
+		t.Errorf("Expected a connected network of 40 nodes and 70 edges, but got %v", network.Graph)
+	}
+	if len(network.Hubs) != 4 || len(network.Capacities) != 70 || len(network.TravelTimes) != 70 {
+		t.Errorf("Expected 4 hubs and 70 weighted edges, but got %v", network.Hubs)
+	}
+
+	// Here the fastest route between the first two hubs leaves on an arterial road
+	paths, err := Dijkstra(network.Graph, network.Hubs[0], network.TravelTime)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	path := paths.PathTo(network.Hubs[1])
+	if network.Capacity(path[0], path[1]) != arterialCapacity {
+		t.Errorf("Expected the route %v to start on an arterial road", path)
+	}
+
+	if _, err := PlanarTransportNetwork(2, 1, 0, 3); err == nil {
+		t.Errorf("Expected an error for too few nodes")
+	}
+}
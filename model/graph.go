@@ -24,6 +24,16 @@ type Edge struct {
 	Node2 Node
 }
 
+// WeightFunc returns the weight of the edge between u and v. Algorithms that
+// work on weighted graphs take a WeightFunc so that weights can come from any
+// source (a lookup table, node attributes, a constant, ...).
+type WeightFunc func(u, v Node) float64
+
+// UnitWeight is a WeightFunc assigning weight 1 to every edge.
+func UnitWeight(u, v Node) float64 {
+	return 1
+}
+
 type UndirectedGraph struct {
 	Nodes map[Node]bool
 	Edges map[Node][]Node
@@ -449,9 +459,11 @@ func (g *UndirectedGraph) RemoveNode(node Node) {
 	// Remove the node from the Nodes map
 	delete(g.Nodes, node)
 
-	// Remove the node from the Edges map and update neighbors' adjacency lists
-	for neighbor, edges := range g.Edges {
-		g.Edges[neighbor] = DeleteFromSlice(edges, node)
+	// Update the adjacency lists of the neighbors, which are the only ones listing the node
+	for _, neighbor := range g.Edges[node] {
+		if neighbor != node {
+			g.Edges[neighbor] = DeleteFromSlice(g.Edges[neighbor], node)
+		}
 	}
 
 	// Delete the entry for the removed node from the Edges map
@@ -479,28 +491,37 @@ func (g *UndirectedGraph) ContractNode(node Node) {
 	delete(g.Edges, node)
 }
 
+/*
+ContractEdge contracts an edge by merging Node1 into Node2.
+
+Parameters:
+- edge: The edge to contract; Node1 is removed and Node2 is kept.
+
+Description:
+Every edge of Node1 is moved to Node2, without creating parallel edges. The contracted edge itself disappears rather
+than becoming a self-loop, while a self-loop of Node1 becomes a self-loop of Node2. Contracting a self-loop does
+nothing. The nodes need not be adjacent, in which case they are simply merged.
+*/
 func (g *UndirectedGraph) ContractEdge(edge Edge) {
 	node1 := edge.Node1
 	node2 := edge.Node2
-	//assign all of edges directed towards node1 to node2
-	neighbors := g.Edges[node1]
-	for i := 0; i < len(neighbors); i++ {
-		g.AddEdge(Edge{
-			Node1: neighbors[i],
-			Node2: node2,
-		})
+	if node1 == node2 || !g.Nodes[node1] {
+		return
 	}
+	neighbors := g.Edges[node1]
 
-	// Remove the node from the Nodes map
-	delete(g.Nodes, node1)
-
-	// Remove the node from the Edges map and update neighbors' adjacency lists
-	for neighbor, edges := range g.Edges {
-		g.Edges[neighbor] = DeleteFromSlice(edges, node1)
+	// Remove node1 before moving its edges, so that they are not listed twice
+	g.RemoveNode(node1)
+	g.AddNode(node2)
+	for _, neighbor := range neighbors {
+		switch neighbor {
+		case node2:
+		case node1:
+			g.AddEdge(Edge{Node1: node2, Node2: node2})
+		default:
+			g.AddEdge(Edge{Node1: neighbor, Node2: node2})
+		}
 	}
-
-	// Delete the entry for the removed node from the Edges map
-	delete(g.Edges, node1)
 }
 
 // ConnectedComponents finds the connected components in an undirected graph.
@@ -0,0 +1,133 @@
+package model
+
+import "slices"
+
+/*
+GraphBuilder builds a large UndirectedGraph from a stream of edges faster than AddEdge, by an order of magnitude for
+dense graphs.
+
+AddEdge on an UndirectedGraph scans the adjacency list of a node to skip duplicate edges and grows the lists one
+append at a time, so that building a dense graph takes quadratic time and many allocations. A GraphBuilder instead
+records the edges and the degrees of their nodes, then Build lays out all the adjacency lists in a single array with
+preallocatedGraph, sorts them and removes the duplicate edges at once. The graph returned is an ordinary
+UndirectedGraph.
+
+Nodes are best numbered densely from 0, as by the generators: the degrees of such nodes are kept in a slice indexed by
+node, while the few nodes with negative or very large ids are kept in a map.
+
+Example:
+
+	b := NewGraphBuilder(3, 2)
+	b.AddEdges([]Edge{{Node1: 0, Node2: 1}, {Node1: 2, Node2: 1}, {Node1: 1, Node2: 0}})
+	g := b.Build()
+
+	fmt.Println(g.Edges) // Output: map[0:[1] 1:[0 2] 2:[1]]
+*/
+type GraphBuilder struct {
+	edges []Edge
+	// present and degrees are indexed by node for the dense nodes, and sparse holds the degree of the others.
+	present []bool
+	degrees []int
+	sparse  map[Node]int
+}
+
+// NewGraphBuilder returns an empty GraphBuilder with room for the given numbers of nodes and edges.
+func NewGraphBuilder(expectedNodes, expectedEdges int) *GraphBuilder {
+	return &GraphBuilder{
+		edges:   make([]Edge, 0, max(expectedEdges, 0)),
+		present: make([]bool, 0, max(expectedNodes, 0)),
+		degrees: make([]int, 0, max(expectedNodes, 0)),
+		sparse:  make(map[Node]int),
+	}
+}
+
+// AddNode adds a node, which is also added by the edges it belongs to.
+func (b *GraphBuilder) AddNode(node Node) {
+	b.count(node, 0)
+}
+
+// AddEdge adds an edge and its nodes. Edges added several times, in either direction, appear once in the graph.
+func (b *GraphBuilder) AddEdge(edge Edge) {
+	b.edges = append(b.edges, edge)
+	b.count(edge.Node1, 1)
+	b.count(edge.Node2, 1)
+}
+
+// AddEdges adds edges and their nodes, see AddEdge.
+func (b *GraphBuilder) AddEdges(edges []Edge) {
+	b.edges = append(b.edges, edges...)
+	for _, edge := range edges {
+		b.count(edge.Node1, 1)
+		b.count(edge.Node2, 1)
+	}
+}
+
+// count marks a node as present and adds to its degree, growing the dense slices for nodes up to twice their length.
+// A node first kept in the map stays there.
+func (b *GraphBuilder) count(node Node, degree int) {
+	if _, ok := b.sparse[node]; ok {
+		b.sparse[node] += degree
+		return
+	}
+	if node >= 0 && int(node) < max(2*len(b.present), cap(b.present), 1024) {
+		for int(node) >= len(b.present) {
+			b.present = append(b.present, false)
+			b.degrees = append(b.degrees, 0)
+		}
+		b.present[node] = true
+		b.degrees[node] += degree
+		return
+	}
+	b.sparse[node] += degree
+}
+
+// Build returns the graph of the nodes and edges added so far, whose adjacency lists are sorted and list a self-loop
+// twice as AddEdge does, and empties the builder.
+func (b *GraphBuilder) Build() *UndirectedGraph {
+	// The dense nodes in ascending order, followed by the sparse nodes in ascending order
+	sparseNodes := make([]Node, 0, len(b.sparse))
+	for node := range b.sparse {
+		sparseNodes = append(sparseNodes, node)
+	}
+	slices.Sort(sparseNodes)
+	nodes := make([]Node, 0, len(b.present)+len(sparseNodes))
+	degrees := make([]int, 0, cap(nodes))
+	for i, present := range b.present {
+		if present {
+			nodes = append(nodes, Node(i))
+			degrees = append(degrees, b.degrees[i])
+		}
+	}
+	for _, node := range sparseNodes {
+		nodes = append(nodes, node)
+		degrees = append(degrees, b.sparse[node])
+	}
+
+	// The lists have room for every edge recorded, duplicates included, and a self-loop is appended twice like AddEdge
+	g := preallocatedGraphOf(nodes, degrees)
+	for _, edge := range b.edges {
+		g.Edges[edge.Node1] = append(g.Edges[edge.Node1], edge.Node2)
+		g.Edges[edge.Node2] = append(g.Edges[edge.Node2], edge.Node1)
+	}
+	for node, neighbors := range g.Edges {
+		neighbors = deduplicateNeighbors(node, neighbors)
+		g.Edges[node] = neighbors[:len(neighbors):len(neighbors)]
+	}
+
+	*b = GraphBuilder{sparse: make(map[Node]int)}
+	return g
+}
+
+// deduplicateNeighbors sorts the neighbors of a node in place and removes the duplicates, keeping a self-loop twice.
+func deduplicateNeighbors(node Node, neighbors []Node) []Node {
+	slices.Sort(neighbors)
+	kept := 0
+	for i, neighbor := range neighbors {
+		if i > 0 && neighbor == neighbors[i-1] && (neighbor != node || kept >= 2 && neighbors[kept-2] == node) {
+			continue
+		}
+		neighbors[kept] = neighbor
+		kept++
+	}
+	return neighbors[:kept]
+}
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestGraphBuilder(t *testing.T) {
+	b := NewGraphBuilder(4, 8)
+	b.AddEdges([]Edge{{Node1: 0, Node2: 1}, {Node1: 2, Node2: 1}, {Node1: 1, Node2: 0}, {Node1: 3, Node2: 3}})
+	b.AddEdge(Edge{Node1: 3, Node2: 3})
+	b.AddEdge(Edge{Node1: -5, Node2: 1})
+	b.AddEdge(Edge{Node1: 1 << 40, Node2: 0})
+	b.AddNode(7)
+	g := b.Build()
+
+	expected := map[Node][]Node{
+		-5:      {1},
+		0:       {1, 1 << 40},
+		1:       {-5, 0, 2},
+		2:       {1},
+		3:       {3, 3},
+		1 << 40: {0},
+	}
+	if !reflect.DeepEqual(g.Edges, expected) {
+		t.Errorf("Expected %v, but got %v", expected, g.Edges)
+	}
+	if len(g.Nodes) != 7 || !g.Nodes[7] || g.NumberOfEdges() != 5 {
+		t.Errorf("Expected 7 nodes and 5 edges, but got %v", g)
+	}
+
+	// Growing a list must not overwrite the next one, and the builder starts over
+	g.AddEdge(Edge{Node1: 0, Node2: 2})
+	if !reflect.DeepEqual(g.Edges[1], []Node{-5, 0, 2}) {
+		t.Errorf("Expected the neighbors of 1 to be unchanged, but got %v", g.Edges[1])
+	}
+	if empty := b.Build(); len(empty.Nodes) != 0 {
+		t.Errorf("Expected an empty graph, but got %v", empty)
+	}
+}
+
+func TestGraphBuilderMatchesAddEdge(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	expected := &UndirectedGraph{}
+	b := NewGraphBuilder(0, 0)
+	for i := 0; i < 5000; i++ {
+		edge := Edge{Node1: Node(rng.Intn(3000)), Node2: Node(rng.Intn(300))}
+		expected.AddEdge(edge)
+		b.AddEdge(edge)
+	}
+	if g := b.Build(); !g.Equals(expected) || g.NumberOfEdges() != expected.NumberOfEdges() {
+		t.Errorf("Expected the graph built with AddEdge")
+	}
+}
+
+// randomEdges returns m random edges between n nodes, for the benchmarks.
+func randomEdges(n, m int) []Edge {
+	rng := rand.New(rand.NewSource(1))
+	edges := make([]Edge, m)
+	for i := range edges {
+		edges[i] = Edge{Node1: Node(rng.Intn(n)), Node2: Node(rng.Intn(n))}
+	}
+	return edges
+}
+
+func BenchmarkGraphBuilder(b *testing.B) {
+	edges := randomEdges(10000, 200000)
+	b.Run("AddEdge", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			g := &UndirectedGraph{}
+			for _, edge := range edges {
+				g.AddEdge(edge)
+			}
+		}
+	})
+	b.Run("GraphBuilder", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			builder := NewGraphBuilder(10000, len(edges))
+			builder.AddEdges(edges)
+			builder.Build()
+		}
+	})
+}
+
+func BenchmarkGraphBuilderComplete(b *testing.B) {
+	n := 2000
+	edges := make([]Edge, 0, n*(n-1)/2)
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			edges = append(edges, Edge{Node1: Node(u), Node2: Node(v)})
+		}
+	}
+	b.Run("AddEdge", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			g := &UndirectedGraph{}
+			for _, edge := range edges {
+				g.AddEdge(edge)
+			}
+		}
+	})
+	b.Run("GraphBuilder", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			builder := NewGraphBuilder(n, len(edges))
+			builder.AddEdges(edges)
+			builder.Build()
+		}
+	})
+}
@@ -0,0 +1,303 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+/*
+GraphFourierBasis is the eigendecomposition of the Laplacian of a graph, on which signals, values given to the nodes,
+are decomposed into graph frequencies.
+
+Frequencies holds the eigenvalues of the Laplacian in increasing order and Modes[k][i] is the value at Nodes[i] of the
+unit eigenvector of Frequencies[k]. The low frequencies vary slowly along the edges: the first one is 0, with a
+mode constant on every component of the graph.
+*/
+type GraphFourierBasis struct {
+	Nodes       []Node
+	Frequencies []float64
+	Modes       [][]float64
+}
+
+/*
+NewGraphFourierBasis computes the eigendecomposition of the Laplacian of an UndirectedGraph.
+
+Parameters:
+- g: The graph.
+- weight: The weight of the edges, UnitWeight for an unweighted graph.
+- normalized: Whether to decompose the normalized Laplacian I - D^-1/2 W D^-1/2, whose frequencies lie in [0, 2],
+instead of the combinatorial Laplacian D - W.
+
+Returns:
+- basis: The frequencies and modes of the graph.
+- err: An error if a weight is negative or NaN.
+
+Description:
+Self-loops are ignored and isolated nodes have frequency 0 in both Laplacians. The dense Laplacian is diagonalized by
+the cyclic Jacobi method, in O(n^3) time per sweep and O(n^2) memory, which is meant for graphs of up to a few
+thousand nodes. The sign of every mode is chosen so that its first entry that is not zero is positive.
+
+Reference: D. I. Shuman, S. K. Narang, P. Frossard, A. Ortega and P. Vandergheynst, "The emerging field of signal
+processing on graphs", IEEE Signal Processing Magazine 30(3), 2013.
+*/
+func NewGraphFourierBasis(g *UndirectedGraph, weight WeightFunc, normalized bool) (*GraphFourierBasis, error) {
+	nodes := sortedNodes(g.Nodes)
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	laplacian := make([][]float64, len(nodes))
+	for i := range laplacian {
+		laplacian[i] = make([]float64, len(nodes))
+	}
+	for i, node := range nodes {
+		for _, neighbor := range g.Edges[node] {
+			if neighbor == node {
+				continue
+			}
+			w := weight(node, neighbor)
+			if w < 0 || math.IsNaN(w) {
+				return nil, fmt.Errorf("the weight of edge (%d, %d) is %v", node, neighbor, w)
+			}
+			laplacian[i][index[neighbor]] -= w
+			laplacian[i][i] += w
+		}
+	}
+	if normalized {
+		scales := make([]float64, len(nodes))
+		for i := range nodes {
+			if laplacian[i][i] > 0 {
+				scales[i] = 1 / math.Sqrt(laplacian[i][i])
+			}
+		}
+		for i := range laplacian {
+			for j := range laplacian[i] {
+				laplacian[i][j] *= scales[i] * scales[j]
+			}
+		}
+	}
+
+	frequencies, modes := symmetricEigen(laplacian)
+	for k, frequency := range frequencies {
+		// Rounding leaves the smallest eigenvalues of a positive semidefinite matrix slightly negative
+		frequencies[k] = math.Max(frequency, 0)
+	}
+	return &GraphFourierBasis{Nodes: nodes, Frequencies: frequencies, Modes: modes}, nil
+}
+
+// Transform returns the graph Fourier transform of a signal, its coefficient on every mode of the basis. Nodes
+// missing from the signal count as 0 and nodes outside the graph are ignored.
+func (b *GraphFourierBasis) Transform(signal map[Node]float64) []float64 {
+	coefficients := make([]float64, len(b.Modes))
+	for k, mode := range b.Modes {
+		for i, node := range b.Nodes {
+			coefficients[k] += mode[i] * signal[node]
+		}
+	}
+	return coefficients
+}
+
+// InverseTransform returns the signal of the given coefficients on the modes of the basis, as returned by Transform.
+func (b *GraphFourierBasis) InverseTransform(coefficients []float64) (map[Node]float64, error) {
+	if len(coefficients) != len(b.Modes) {
+		return nil, fmt.Errorf("expected %d coefficients, got %d", len(b.Modes), len(coefficients))
+	}
+	signal := make(map[Node]float64, len(b.Nodes))
+	for i, node := range b.Nodes {
+		value := 0.0
+		for k, mode := range b.Modes {
+			value += coefficients[k] * mode[i]
+		}
+		signal[node] = value
+	}
+	return signal, nil
+}
+
+// Filter multiplies the coefficient of every frequency of a signal by response(frequency) and returns the filtered
+// signal.
+func (b *GraphFourierBasis) Filter(signal map[Node]float64, response func(frequency float64) float64) map[Node]float64 {
+	coefficients := b.Transform(signal)
+	for k, frequency := range b.Frequencies {
+		coefficients[k] *= response(frequency)
+	}
+	filtered, _ := b.InverseTransform(coefficients)
+	return filtered
+}
+
+// HeatKernel diffuses a signal along the edges for time t >= 0, damping every frequency λ by exp(-tλ). It is the
+// solution at time t of the heat equation dx/dt = -Lx started from the signal.
+func (b *GraphFourierBasis) HeatKernel(signal map[Node]float64, t float64) (map[Node]float64, error) {
+	if t < 0 {
+		return nil, fmt.Errorf("diffusion time must be non-negative, got %v", t)
+	}
+	return b.Filter(signal, func(frequency float64) float64 { return math.Exp(-t * frequency) }), nil
+}
+
+// LowPass keeps the frequencies of a signal up to cutoff and removes the higher ones, the ideal low-pass filter.
+func (b *GraphFourierBasis) LowPass(signal map[Node]float64, cutoff float64) map[Node]float64 {
+	return b.Filter(signal, func(frequency float64) float64 {
+		if frequency <= cutoff {
+			return 1
+		}
+		return 0
+	})
+}
+
+// Convolve returns the spectral convolution of two signals, whose graph Fourier transform is the product of theirs.
+func (b *GraphFourierBasis) Convolve(signal, kernel map[Node]float64) map[Node]float64 {
+	coefficients, kernelCoefficients := b.Transform(signal), b.Transform(kernel)
+	for k := range coefficients {
+		coefficients[k] *= kernelCoefficients[k]
+	}
+	convolved, _ := b.InverseTransform(coefficients)
+	return convolved
+}
+
+/*
+GraphConvolution propagates vectors of node features through one graph convolutional layer, without activation.
+
+Parameters:
+- g: The graph.
+- weight: The weight of the edges, UnitWeight for an unweighted graph.
+- features: The feature vector of every node, all of the same length f.
+- weights: The f x f' matrix of the layer, mixing the features.
+
+Returns:
+- output: The vector of f' features of every node, Â X W with X the features and Â = D̃^-1/2 (W + I) D̃^-1/2 the
+weighted adjacency matrix with a self-loop added to every node, symmetrically normalized by the degrees D̃.
+- err: An error if a node has no features, the lengths do not match weights, or an edge weight is negative.
+
+Description:
+Every node averages the features of its closed neighborhood, which is a first order approximation of a low-pass
+spectral filter, before they are mixed by weights. Self-loops of g are ignored. It takes O((n + m) f + n f f') time.
+
+Reference: T. N. Kipf and M. Welling, "Semi-supervised classification with graph convolutional networks", ICLR 2017.
+*/
+func GraphConvolution(g *UndirectedGraph, weight WeightFunc, features map[Node][]float64, weights [][]float64) (map[Node][]float64, error) {
+	inputs := len(weights)
+	if inputs == 0 {
+		return nil, fmt.Errorf("the weight matrix is empty")
+	}
+	outputs := len(weights[0])
+	for _, row := range weights {
+		if len(row) != outputs {
+			return nil, fmt.Errorf("the rows of the weight matrix must have the same length")
+		}
+	}
+	nodes := sortedNodes(g.Nodes)
+	degrees := make(map[Node]float64, len(nodes))
+	for _, node := range nodes {
+		if len(features[node]) != inputs {
+			return nil, fmt.Errorf("node %d has %d features, expected %d", node, len(features[node]), inputs)
+		}
+		degrees[node] = 1
+		for _, neighbor := range g.Edges[node] {
+			if neighbor == node {
+				continue
+			}
+			w := weight(node, neighbor)
+			if w < 0 || math.IsNaN(w) {
+				return nil, fmt.Errorf("the weight of edge (%d, %d) is %v", node, neighbor, w)
+			}
+			degrees[node] += w
+		}
+	}
+
+	output := make(map[Node][]float64, len(nodes))
+	aggregated := make([]float64, inputs)
+	for _, node := range nodes {
+		scale := 1 / degrees[node]
+		for j, value := range features[node] {
+			aggregated[j] = scale * value
+		}
+		for _, neighbor := range g.Edges[node] {
+			if neighbor == node {
+				continue
+			}
+			scale := weight(node, neighbor) / math.Sqrt(degrees[node]*degrees[neighbor])
+			for j, value := range features[neighbor] {
+				aggregated[j] += scale * value
+			}
+		}
+		output[node] = make([]float64, outputs)
+		for j, value := range aggregated {
+			for k, w := range weights[j] {
+				output[node][k] += value * w
+			}
+		}
+	}
+	return output, nil
+}
+
+// symmetricEigen returns the eigenvalues of the symmetric matrix a, which it overwrites, in increasing order, with
+// their unit eigenvectors, using cyclic Jacobi rotations.
+func symmetricEigen(a [][]float64) ([]float64, [][]float64) {
+	n := len(a)
+	// The columns of v accumulate the rotations
+	v := make([][]float64, n)
+	for i := range v {
+		v[i] = make([]float64, n)
+		v[i][i] = 1
+	}
+	norm := 0.0
+	for i := range a {
+		for j := range a[i] {
+			norm += a[i][j] * a[i][j]
+		}
+	}
+	for sweep := 0; sweep < 100; sweep++ {
+		off := 0.0
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				off += a[p][q] * a[p][q]
+			}
+		}
+		if off <= 1e-30*norm {
+			break
+		}
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] == 0 {
+					continue
+				}
+				theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+				t := math.Copysign(1, theta) / (math.Abs(theta) + math.Hypot(theta, 1))
+				c := 1 / math.Hypot(t, 1)
+				s := t * c
+				for k := 0; k < n; k++ {
+					a[k][p], a[k][q] = c*a[k][p]-s*a[k][q], s*a[k][p]+c*a[k][q]
+				}
+				for k := 0; k < n; k++ {
+					a[p][k], a[q][k] = c*a[p][k]-s*a[q][k], s*a[p][k]+c*a[q][k]
+				}
+				for k := 0; k < n; k++ {
+					v[k][p], v[k][q] = c*v[k][p]-s*v[k][q], s*v[k][p]+c*v[k][q]
+				}
+			}
+		}
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return a[order[i]][order[i]] < a[order[j]][order[j]] })
+	eigenvalues := make([]float64, n)
+	eigenvectors := make([][]float64, n)
+	for k, column := range order {
+		eigenvalues[k] = a[column][column]
+		eigenvectors[k] = make([]float64, n)
+		sign := 0.0
+		for i := 0; i < n; i++ {
+			eigenvectors[k][i] = v[i][column]
+			if sign == 0 && math.Abs(v[i][column]) > 1e-9 {
+				sign = math.Copysign(1, v[i][column])
+			}
+		}
+		for i := range eigenvectors[k] {
+			eigenvectors[k][i] *= sign
+		}
+	}
+	return eigenvalues, eigenvectors
+}
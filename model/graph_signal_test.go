@@ -0,0 +1,170 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewGraphFourierBasis(t *testing.T) {
+	// The Laplacian of the path on n nodes has eigenvalues 2 - 2cos(kπ/n)
+	n := 6
+	basis, err := NewGraphFourierBasis(PathGraph(n), UnitWeight, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for k, frequency := range basis.Frequencies {
+		if expected := 2 - 2*math.Cos(float64(k)*math.Pi/float64(n)); math.Abs(frequency-expected) > 1e-9 {
+			t.Errorf("Expected frequency %d to be %v, but got %v", k, expected, frequency)
+		}
+	}
+	for k, mode := range basis.Modes {
+		// L x = λ x and the modes are orthonormal
+		for i := range mode {
+			lx := 0.0
+			for _, neighbor := range PathGraph(n).Edges[basis.Nodes[i]] {
+				lx += mode[i] - mode[neighbor]
+			}
+			if math.Abs(lx-basis.Frequencies[k]*mode[i]) > 1e-9 {
+				t.Errorf("Expected mode %d to be an eigenvector, but got %v", k, mode)
+				break
+			}
+		}
+		for l, other := range basis.Modes {
+			dot := 0.0
+			for i := range mode {
+				dot += mode[i] * other[i]
+			}
+			if expected := map[bool]float64{true: 1, false: 0}[k == l]; math.Abs(dot-expected) > 1e-9 {
+				t.Errorf("Expected modes %d and %d to have dot product %v, but got %v", k, l, expected, dot)
+			}
+		}
+	}
+	if basis.Modes[0][0] <= 0 {
+		t.Errorf("Expected the first entry of the constant mode to be positive, but got %v", basis.Modes[0])
+	}
+
+	normalized, _ := NewGraphFourierBasis(StarGraph(5), UnitWeight, true)
+	expected := []float64{0, 1, 1, 1, 2}
+	for k, frequency := range normalized.Frequencies {
+		if math.Abs(frequency-expected[k]) > 1e-9 {
+			t.Errorf("Expected the normalized frequencies %v, but got %v", expected, normalized.Frequencies)
+			break
+		}
+	}
+
+	if _, err := NewGraphFourierBasis(PathGraph(3), func(u, v Node) float64 { return -1 }, false); err == nil {
+		t.Errorf("Expected an error for negative weights")
+	}
+}
+
+func TestGraphFourierTransform(t *testing.T) {
+	basis, _ := NewGraphFourierBasis(CycleGraph(8), UnitWeight, false)
+	signal := map[Node]float64{0: 3, 1: -1, 4: 2, 7: 0.5}
+	inverse, err := basis.InverseTransform(basis.Transform(signal))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for node := range basis.Nodes {
+		if math.Abs(inverse[Node(node)]-signal[Node(node)]) > 1e-9 {
+			t.Errorf("Expected the inverse transform to give back %v, but got %v", signal, inverse)
+			break
+		}
+	}
+	if _, err := basis.InverseTransform([]float64{1}); err == nil {
+		t.Errorf("Expected an error for too few coefficients")
+	}
+
+	// A constant signal is a single coefficient
+	constant := map[Node]float64{}
+	for node := range basis.Nodes {
+		constant[Node(node)] = 2
+	}
+	coefficients := basis.Transform(constant)
+	if math.Abs(coefficients[0]-2*math.Sqrt(8)) > 1e-9 {
+		t.Errorf("Expected the first coefficient to be %v, but got %v", 2*math.Sqrt(8), coefficients)
+	}
+	for _, coefficient := range coefficients[1:] {
+		if math.Abs(coefficient) > 1e-9 {
+			t.Errorf("Expected the other coefficients to be 0, but got %v", coefficients)
+			break
+		}
+	}
+}
+
+func TestGraphFilters(t *testing.T) {
+	basis, _ := NewGraphFourierBasis(CycleGraph(8), UnitWeight, false)
+	impulse := map[Node]float64{0: 1}
+
+	heat, err := basis.HeatKernel(impulse, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if math.Abs(heat[0]-1) > 1e-9 || math.Abs(heat[1]) > 1e-9 {
+		t.Errorf("Expected no diffusion at time 0, but got %v", heat)
+	}
+	heat, _ = basis.HeatKernel(impulse, 1)
+	total := 0.0
+	for _, value := range heat {
+		total += value
+	}
+	if math.Abs(total-1) > 1e-9 || heat[0] >= 1 || heat[1] <= heat[2] || math.Abs(heat[1]-heat[7]) > 1e-9 {
+		t.Errorf("Expected the heat to spread symmetrically and be conserved, but got %v", heat)
+	}
+	if _, err := basis.HeatKernel(impulse, -1); err == nil {
+		t.Errorf("Expected an error for a negative time")
+	}
+
+	// Keeping only frequency 0 averages the signal
+	smooth := basis.LowPass(impulse, 0.1)
+	for node, value := range smooth {
+		if math.Abs(value-0.125) > 1e-9 {
+			t.Errorf("Expected node %d to have the average 0.125, but got %v", node, value)
+		}
+	}
+	all := basis.LowPass(impulse, 5)
+	if math.Abs(all[0]-1) > 1e-9 || math.Abs(all[3]) > 1e-9 {
+		t.Errorf("Expected the signal to be unchanged, but got %v", all)
+	}
+
+	// Convolution commutes, and with a constant signal it spreads the sum of the signal over the nodes
+	signal := map[Node]float64{2: 1, 3: -2}
+	convolved, commuted := basis.Convolve(signal, heat), basis.Convolve(heat, signal)
+	spread := basis.Convolve(signal, map[Node]float64{0: 1, 1: 1, 2: 1, 3: 1, 4: 1, 5: 1, 6: 1, 7: 1})
+	for node := range basis.Nodes {
+		if math.Abs(convolved[Node(node)]-commuted[Node(node)]) > 1e-9 {
+			t.Errorf("Expected convolution to commute, but got %v and %v", convolved, commuted)
+			break
+		}
+		if math.Abs(spread[Node(node)]+1/math.Sqrt(8)) > 1e-9 {
+			t.Errorf("Expected every node to have %v, but got %v", -1/math.Sqrt(8), spread)
+			break
+		}
+	}
+}
+
+func TestGraphConvolution(t *testing.T) {
+	g := PathGraph(3)
+	features := map[Node][]float64{0: {1, 0}, 1: {0, 1}, 2: {2, 2}}
+	output, err := GraphConvolution(g, UnitWeight, features, [][]float64{{1}, {1}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Degrees with self-loops are 2, 3 and 2
+	expected := map[Node]float64{
+		0: 1.0/2 + 1/math.Sqrt(6),
+		1: 1.0/math.Sqrt(6) + 1.0/3 + 4/math.Sqrt(6),
+		2: 1/math.Sqrt(6) + 4.0/2,
+	}
+	for node, value := range expected {
+		if len(output[node]) != 1 || math.Abs(output[node][0]-value) > 1e-9 {
+			t.Errorf("Expected node %d to have %v, but got %v", node, value, output[node])
+		}
+	}
+
+	if _, err := GraphConvolution(g, UnitWeight, map[Node][]float64{0: {1, 0}}, [][]float64{{1}, {1}}); err == nil {
+		t.Errorf("Expected an error for missing features")
+	}
+	if _, err := GraphConvolution(g, UnitWeight, features, [][]float64{{1}, {1, 2}}); err == nil {
+		t.Errorf("Expected an error for a ragged weight matrix")
+	}
+}
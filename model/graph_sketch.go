@@ -0,0 +1,174 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+)
+
+const (
+	// sketchDepth is the number of rows of the degree counters, which fail their error bound with probability e^-4.
+	sketchDepth = 4
+	// sketchPrecision is the number of hash bits selecting a register of the node counter, whose 2^12 registers give
+	// a relative standard error of about 1.6%.
+	sketchPrecision = 12
+)
+
+/*
+GraphSketch summarizes an unbounded stream of edges in bounded memory, for graphs too large to be stored, such as the
+edges read by io.ReadEdgeStream.
+
+It keeps:
+  - a uniform sample of the edges, by reservoir sampling, on which Sample and Connected work,
+  - Count-Min counters of the degrees, which overestimate every degree by at most ε·2m with probability 1 - e^-4,
+  - a HyperLogLog counter of the distinct nodes.
+
+Every edge of the stream is assumed to be new: an edge given twice is counted twice. The memory depends only on the
+parameters of NewGraphSketch, not on the length of the stream.
+
+Reference: J. S. Vitter, "Random sampling with a reservoir", ACM Trans. Math. Softw. 11(1), 1985; G. Cormode and
+S. Muthukrishnan, "An improved data stream summary: the count-min sketch and its applications", J. Algorithms 55(1),
+2005; P. Flajolet, É. Fusy, O. Gandouet and F. Meunier, "HyperLogLog: the analysis of a near-optimal cardinality
+estimation algorithm", AofA 2007.
+*/
+type GraphSketch struct {
+	reservoir []Edge
+	capacity  int
+	edges     int64
+	counters  [sketchDepth][]int64
+	registers []uint8
+	seeds     [sketchDepth + 1]uint64
+	rng       *rand.Rand
+}
+
+// NewGraphSketch returns an empty GraphSketch sampling up to reservoirSize edges, whose degree estimates are off by
+// at most relativeError times the sum of the degrees, or an error if reservoirSize is not positive or relativeError is
+// not in (0, 1).
+func NewGraphSketch(reservoirSize int, relativeError float64, seed int64) (*GraphSketch, error) {
+	if reservoirSize < 1 || !(relativeError > 0 && relativeError < 1) {
+		return nil, fmt.Errorf("expected a positive reservoir size and a relative error in (0, 1), got %d and %v", reservoirSize, relativeError)
+	}
+	s := &GraphSketch{
+		reservoir: make([]Edge, 0, reservoirSize),
+		capacity:  reservoirSize,
+		registers: make([]uint8, 1<<sketchPrecision),
+		rng:       rand.New(rand.NewSource(seed)),
+	}
+	width := int(math.Ceil(math.E / relativeError))
+	for row := range s.counters {
+		s.counters[row] = make([]int64, width)
+	}
+	for i := range s.seeds {
+		s.seeds[i] = s.rng.Uint64()
+	}
+	return s, nil
+}
+
+// AddEdge adds an edge of the stream to the summary.
+func (s *GraphSketch) AddEdge(edge Edge) {
+	s.edges++
+	if len(s.reservoir) < s.capacity {
+		s.reservoir = append(s.reservoir, edge)
+	} else if i := s.rng.Int63n(s.edges); i < int64(s.capacity) {
+		s.reservoir[i] = edge
+	}
+	for _, node := range [2]Node{edge.Node1, edge.Node2} {
+		for row := range s.counters {
+			s.counters[row][sketchHash(node, s.seeds[row])%uint64(len(s.counters[row]))]++
+		}
+		hash := sketchHash(node, s.seeds[sketchDepth])
+		register := hash >> (64 - sketchPrecision)
+		rank := uint8(bits.LeadingZeros64(hash<<sketchPrecision|1<<(sketchPrecision-1)) + 1)
+		s.registers[register] = max(s.registers[register], rank)
+	}
+}
+
+// NumberOfEdges returns the number of edges of the stream so far.
+func (s *GraphSketch) NumberOfEdges() int64 {
+	return s.edges
+}
+
+// Degree returns an estimate of the degree of a node, never below the true degree.
+func (s *GraphSketch) Degree(node Node) int64 {
+	estimate := int64(math.MaxInt64)
+	for row := range s.counters {
+		estimate = min(estimate, s.counters[row][sketchHash(node, s.seeds[row])%uint64(len(s.counters[row]))])
+	}
+	return estimate
+}
+
+// NumberOfNodes returns an estimate of the number of distinct nodes of the stream so far.
+func (s *GraphSketch) NumberOfNodes() float64 {
+	m := float64(len(s.registers))
+	sum, zeros := 0.0, 0
+	for _, rank := range s.registers {
+		sum += math.Ldexp(1, -int(rank))
+		if rank == 0 {
+			zeros++
+		}
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// Linear counting is more accurate for small cardinalities
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return estimate
+}
+
+// AverageDegree returns an estimate of the average degree, 2m / n.
+func (s *GraphSketch) AverageDegree() float64 {
+	if n := s.NumberOfNodes(); n > 0 {
+		return 2 * float64(s.edges) / n
+	}
+	return 0
+}
+
+// Density returns an estimate of the density, the fraction of the pairs of nodes that are linked.
+func (s *GraphSketch) Density() float64 {
+	if n := s.NumberOfNodes(); n > 1 {
+		return math.Min(1, 2*float64(s.edges)/(n*(n-1)))
+	}
+	return 0
+}
+
+// Sample returns the graph of the sampled edges, a uniform sample of the edges of the stream.
+func (s *GraphSketch) Sample() *UndirectedGraph {
+	b := NewGraphBuilder(2*len(s.reservoir), len(s.reservoir))
+	b.AddEdges(s.reservoir)
+	return b.Build()
+}
+
+// Connected reports whether two nodes are connected in the graph of the sampled edges. Since the sampled edges belong
+// to the graph, true means the nodes are connected in the graph, while false means that the sample does not tell.
+func (s *GraphSketch) Connected(u, v Node) bool {
+	if u == v {
+		return true
+	}
+	parent := make(map[Node]Node, 2*len(s.reservoir))
+	var find func(node Node) Node
+	find = func(node Node) Node {
+		p, ok := parent[node]
+		if !ok || p == node {
+			return node
+		}
+		root := find(p)
+		parent[node] = root
+		return root
+	}
+	for _, edge := range s.reservoir {
+		if a, b := find(edge.Node1), find(edge.Node2); a != b {
+			parent[a] = b
+		}
+	}
+	return find(u) == find(v)
+}
+
+// sketchHash mixes a node with a seed into 64 well-distributed bits, with the finalizer of SplitMix64.
+func sketchHash(node Node, seed uint64) uint64 {
+	x := uint64(node) ^ seed
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
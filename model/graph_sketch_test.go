@@ -0,0 +1,66 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGraphSketch(t *testing.T) {
+	g, err := BarabasiAlbertGraph(5000, 3, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	s, err := NewGraphSketch(2000, 0.001, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	edges := sortedEdgeList(g)
+	for _, edge := range edges {
+		s.AddEdge(edge)
+	}
+
+	if s.NumberOfEdges() != int64(len(edges)) {
+		t.Errorf("Expected %d edges, but got %d", len(edges), s.NumberOfEdges())
+	}
+	if n := s.NumberOfNodes(); math.Abs(n-5000) > 250 {
+		t.Errorf("Expected about 5000 nodes, but got %v", n)
+	}
+	expectedDensity := 2 * float64(len(edges)) / (5000 * 4999)
+	if density := s.Density(); math.Abs(density-expectedDensity) > 0.1*expectedDensity {
+		t.Errorf("Expected a density of about %v, but got %v", expectedDensity, density)
+	}
+	if average := s.AverageDegree(); math.Abs(average-6) > 0.5 {
+		t.Errorf("Expected an average degree of about 6, but got %v", average)
+	}
+	// The error is at most 0.001 * 2m, about 30, with high probability
+	for node := range g.Nodes {
+		degree := int64(g.NodeDegree(node))
+		if estimate := s.Degree(node); estimate < degree || estimate > degree+60 {
+			t.Fatalf("Expected a degree estimate between %d and %d for %d, but got %d", degree, degree+60, node, estimate)
+		}
+	}
+
+	sample := s.Sample()
+	if sample.NumberOfEdges() != 2000 {
+		t.Errorf("Expected 2000 sampled edges, but got %d", sample.NumberOfEdges())
+	}
+	for _, edge := range sortedEdgeList(sample) {
+		if !g.HasEdge(edge.Node1, edge.Node2) {
+			t.Fatalf("Expected sampled edges to belong to the graph, but got %v", edge)
+		}
+		if !s.Connected(edge.Node1, edge.Node2) {
+			t.Errorf("Expected the ends of a sampled edge to be connected")
+		}
+	}
+	// About 2000 / 15000 of the edges are sampled, so the old nodes, of high degree, are in the sample
+	if !s.Connected(0, 1) {
+		t.Errorf("Expected the hubs 0 and 1 to be connected in the sample")
+	}
+
+	if _, err := NewGraphSketch(0, 0.1, 1); err == nil {
+		t.Errorf("Expected an error for an empty reservoir")
+	}
+	if _, err := NewGraphSketch(10, 1, 1); err == nil {
+		t.Errorf("Expected an error for a relative error of 1")
+	}
+}
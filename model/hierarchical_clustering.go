@@ -0,0 +1,207 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// DistanceFunc gives the distance between two nodes, symmetric and zero from a node to itself.
+type DistanceFunc func(u, v Node) float64
+
+// Linkage tells how HierarchicalClustering measures the distance between two clusters from the distances of their nodes.
+type Linkage int
+
+const (
+	// SingleLinkage uses the distance of the closest pair of nodes.
+	SingleLinkage Linkage = iota
+	// CompleteLinkage uses the distance of the farthest pair of nodes.
+	CompleteLinkage
+	// AverageLinkage uses the average distance of all the pairs of nodes (UPGMA).
+	AverageLinkage
+)
+
+// Merge is a step of agglomerative clustering, which joins two clusters into a new one.
+type Merge struct {
+	// First and Second are the merged clusters, First < Second, numbered as in Dendrogram.
+	First, Second int
+	// Height is the linkage distance between the merged clusters.
+	Height float64
+	// Size is the number of nodes of the new cluster.
+	Size int
+}
+
+/*
+Dendrogram records the successive merges of agglomerative clustering, as the linkage matrix of SciPy does.
+
+Cluster i < len(Leaves) holds the single node Leaves[i], and cluster len(Leaves) + j is the one created by Merges[j].
+The heights of the merges never decrease.
+*/
+type Dendrogram struct {
+	Leaves []Node
+	Merges []Merge
+}
+
+/*
+HierarchicalClustering clusters the nodes of an UndirectedGraph bottom-up, repeatedly merging the two closest clusters.
+
+Parameters:
+- g: The graph.
+- distance: The distance between two nodes, such as HopDistance or JaccardDistance.
+- linkage: How the distance between two clusters is derived from the distances of their nodes.
+
+Returns:
+- dendrogram: The n - 1 merges joining all the nodes into one cluster.
+- err: An error if linkage is unknown or a distance is negative or NaN.
+
+Description:
+The distances between clusters are kept in a matrix updated with the Lance-Williams formula, in O(n^3) time and
+O(n^2) space. Ties are broken in favor of the clusters holding the smallest nodes. Infinite distances, between the
+components of a graph with HopDistance, are merged last.
+*/
+func HierarchicalClustering(g *UndirectedGraph, distance DistanceFunc, linkage Linkage) (*Dendrogram, error) {
+	if linkage < SingleLinkage || linkage > AverageLinkage {
+		return nil, fmt.Errorf("unknown linkage %d", linkage)
+	}
+	nodes := sortedNodes(g.Nodes)
+	n := len(nodes)
+	distances := make([][]float64, n)
+	for i := range distances {
+		distances[i] = make([]float64, n)
+		for j := 0; j < i; j++ {
+			d := distance(nodes[i], nodes[j])
+			if d < 0 || math.IsNaN(d) {
+				return nil, fmt.Errorf("the distance between nodes %d and %d is %v", nodes[j], nodes[i], d)
+			}
+			distances[i][j], distances[j][i] = d, d
+		}
+	}
+
+	// Row i of the matrix holds the cluster ids[i] of sizes[i] nodes, until it is merged away
+	ids := make([]int, n)
+	sizes := make([]int, n)
+	active := make([]bool, n)
+	for i := range ids {
+		ids[i], sizes[i], active[i] = i, 1, true
+	}
+	dendrogram := &Dendrogram{Leaves: nodes, Merges: make([]Merge, 0, max(n-1, 0))}
+	for step := 0; step < n-1; step++ {
+		first, second := -1, -1
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n && active[i]; j++ {
+				if active[j] && (first < 0 || distances[i][j] < distances[first][second]) {
+					first, second = i, j
+				}
+			}
+		}
+		height := distances[first][second]
+		dendrogram.Merges = append(dendrogram.Merges, Merge{
+			First:  min(ids[first], ids[second]),
+			Second: max(ids[first], ids[second]),
+			Height: height,
+			Size:   sizes[first] + sizes[second],
+		})
+
+		for k := 0; k < n; k++ {
+			if !active[k] || k == first || k == second {
+				continue
+			}
+			a, b := distances[first][k], distances[second][k]
+			var merged float64
+			switch linkage {
+			case SingleLinkage:
+				merged = math.Min(a, b)
+			case CompleteLinkage:
+				merged = math.Max(a, b)
+			case AverageLinkage:
+				merged = (float64(sizes[first])*a + float64(sizes[second])*b) / float64(sizes[first]+sizes[second])
+			}
+			distances[first][k], distances[k][first] = merged, merged
+		}
+		ids[first] = n + step
+		sizes[first] += sizes[second]
+		active[second] = false
+	}
+	return dendrogram, nil
+}
+
+// CutAtK returns the k clusters left before the last k - 1 merges, each sorted, ordered by their smallest node. It
+// returns an error if k is not between 1 and the number of leaves.
+func (d *Dendrogram) CutAtK(k int) ([][]Node, error) {
+	if k < 1 || k > len(d.Leaves) {
+		return nil, fmt.Errorf("the number of clusters must be in [1, %d], got %d", len(d.Leaves), k)
+	}
+	return d.clusters(len(d.Leaves) - k), nil
+}
+
+// CutAtHeight returns the clusters formed by the merges of height at most height, each sorted, ordered by their
+// smallest node.
+func (d *Dendrogram) CutAtHeight(height float64) [][]Node {
+	merges := 0
+	for merges < len(d.Merges) && d.Merges[merges].Height <= height {
+		merges++
+	}
+	return d.clusters(merges)
+}
+
+// clusters returns the clusters formed by the first merges.
+func (d *Dendrogram) clusters(merges int) [][]Node {
+	members := make(map[int][]Node, len(d.Leaves)+merges)
+	for i, leaf := range d.Leaves {
+		members[i] = []Node{leaf}
+	}
+	for j, merge := range d.Merges[:merges] {
+		members[len(d.Leaves)+j] = append(members[merge.First], members[merge.Second]...)
+		delete(members, merge.First)
+		delete(members, merge.Second)
+	}
+	clusters := make([][]Node, 0, len(members))
+	for _, cluster := range members {
+		set := make(map[Node]bool, len(cluster))
+		for _, node := range cluster {
+			set[node] = true
+		}
+		clusters = append(clusters, sortedNodes(set))
+	}
+	sortBlocks(clusters)
+	return clusters
+}
+
+// HopDistance returns the DistanceFunc giving the number of edges of a shortest path between two nodes of g, or +Inf
+// if they are not connected. The distances are computed once, by a breadth-first search from every node.
+func HopDistance(g *UndirectedGraph) DistanceFunc {
+	distances := make(map[Node]map[Node]int, len(g.Nodes))
+	for node := range g.Nodes {
+		distances[node] = bfsDistances(g, node)
+	}
+	return func(u, v Node) float64 {
+		if distance, ok := distances[u][v]; ok {
+			return float64(distance)
+		}
+		return math.Inf(1)
+	}
+}
+
+// JaccardDistance returns the DistanceFunc giving one minus the Jaccard similarity of the closed neighborhoods of two
+// nodes of g: 0 for nodes with the same neighbors, up to 1 for nodes with no common neighbor that are not adjacent.
+func JaccardDistance(g *UndirectedGraph) DistanceFunc {
+	return func(u, v Node) float64 {
+		if u == v {
+			return 0
+		}
+		closed := func(node Node) map[Node]bool {
+			neighborhood := map[Node]bool{node: true}
+			for _, neighbor := range g.Edges[node] {
+				neighborhood[neighbor] = true
+			}
+			return neighborhood
+		}
+		a, b := closed(u), closed(v)
+		shared := 0
+		for node := range a {
+			if b[node] {
+				shared++
+			}
+		}
+		return 1 - float64(shared)/float64(len(a)+len(b)-shared)
+	}
+}
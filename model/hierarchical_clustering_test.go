@@ -0,0 +1,96 @@
+package model
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// twoTriangles returns the triangles 0-1-2 and 3-4-5 joined by the edge 2-3.
+func twoTriangles() *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 2}, {2, 3}, {3, 4}, {3, 5}, {4, 5}})
+	return g
+}
+
+func TestHierarchicalClustering(t *testing.T) {
+	g := twoTriangles()
+	triangles := [][]Node{{0, 1, 2}, {3, 4, 5}}
+	testCases := []struct {
+		name    string
+		linkage Linkage
+		top     float64
+	}{
+		{"complete", CompleteLinkage, 3},
+		{"average", AverageLinkage, 21.0 / 9},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dendrogram, err := HierarchicalClustering(g, HopDistance(g), tc.linkage)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(dendrogram.Merges) != 5 {
+				t.Fatalf("Expected 5 merges, but got %v", dendrogram.Merges)
+			}
+			last := dendrogram.Merges[4]
+			if math.Abs(last.Height-tc.top) > 1e-9 || last.Size != 6 || last.First != 7 || last.Second != 9 {
+				t.Errorf("Expected the triangles 7 and 9 to merge last at %v, but got %+v", tc.top, last)
+			}
+			if clusters, _ := dendrogram.CutAtK(2); !reflect.DeepEqual(clusters, triangles) {
+				t.Errorf("Expected %v, but got %v", triangles, clusters)
+			}
+			if clusters := dendrogram.CutAtHeight(1); !reflect.DeepEqual(clusters, triangles) {
+				t.Errorf("Expected %v, but got %v", triangles, clusters)
+			}
+		})
+	}
+
+	// Single linkage on hop distances merges every edge at height 1
+	dendrogram, _ := HierarchicalClustering(g, HopDistance(g), SingleLinkage)
+	for _, merge := range dendrogram.Merges {
+		if merge.Height != 1 {
+			t.Errorf("Expected every merge at height 1, but got %+v", merge)
+		}
+	}
+	if clusters := dendrogram.CutAtHeight(0.5); len(clusters) != 6 {
+		t.Errorf("Expected singletons, but got %v", clusters)
+	}
+	if clusters, _ := dendrogram.CutAtK(1); !reflect.DeepEqual(clusters, [][]Node{{0, 1, 2, 3, 4, 5}}) {
+		t.Errorf("Expected one cluster, but got %v", clusters)
+	}
+	if _, err := dendrogram.CutAtK(7); err == nil {
+		t.Errorf("Expected an error for more clusters than nodes")
+	}
+	if _, err := HierarchicalClustering(g, HopDistance(g), Linkage(7)); err == nil {
+		t.Errorf("Expected an error for an unknown linkage")
+	}
+}
+
+func TestHierarchicalClusteringDisconnected(t *testing.T) {
+	g := PathGraph(2)
+	g.AddEdge(Edge{Node1: 5, Node2: 6})
+	dendrogram, err := HierarchicalClustering(g, HopDistance(g), AverageLinkage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !math.IsInf(dendrogram.Merges[2].Height, 1) {
+		t.Errorf("Expected the components to merge at infinity, but got %+v", dendrogram.Merges)
+	}
+	if clusters := dendrogram.CutAtHeight(10); !reflect.DeepEqual(clusters, [][]Node{{0, 1}, {5, 6}}) {
+		t.Errorf("Expected the components, but got %v", clusters)
+	}
+}
+
+func TestJaccardDistance(t *testing.T) {
+	distance := JaccardDistance(twoTriangles())
+	if d := distance(0, 1); d != 0 {
+		t.Errorf("Expected 0, but got %v", d)
+	}
+	if d := distance(2, 3); math.Abs(d-2.0/3) > 1e-12 {
+		t.Errorf("Expected 2/3, but got %v", d)
+	}
+	if d := distance(0, 5); d != 1 {
+		t.Errorf("Expected 1, but got %v", d)
+	}
+}
@@ -0,0 +1,130 @@
+package model
+
+import "sort"
+
+// IntersectionGraph returns the graph with one node per set, node i standing for sets[i], and an edge between every
+// two sets sharing an element.
+func IntersectionGraph[T comparable](sets [][]T) *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool, len(sets)), Edges: make(map[Node][]Node)}
+	members := make(map[T][]Node)
+	for i, set := range sets {
+		g.AddNode(Node(i))
+		seen := make(map[T]bool, len(set))
+		for _, element := range set {
+			if !seen[element] {
+				seen[element] = true
+				members[element] = append(members[element], Node(i))
+			}
+		}
+	}
+	for _, nodes := range members {
+		for i, u := range nodes {
+			for _, v := range nodes[i+1:] {
+				g.AddEdge(Edge{Node1: u, Node2: v})
+			}
+		}
+	}
+	return g
+}
+
+/*
+IntervalGraph returns the intersection graph of closed intervals of the real line.
+
+Parameters:
+- intervals: The intervals [start, end]; node i stands for intervals[i]. Intervals with start > end are empty.
+
+Returns:
+- g: The graph with an edge between every two intervals that intersect, touching endpoints included.
+
+Description:
+The intervals are swept by increasing start, keeping the intervals still open, in O(n log n + m) time.
+*/
+func IntervalGraph(intervals [][2]float64) *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool, len(intervals)), Edges: make(map[Node][]Node)}
+	order := make([]int, 0, len(intervals))
+	for i, interval := range intervals {
+		g.AddNode(Node(i))
+		if interval[0] <= interval[1] {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool { return intervals[order[a]][0] < intervals[order[b]][0] })
+
+	var open []int
+	for _, i := range order {
+		start := intervals[i][0]
+		kept := open[:0]
+		for _, j := range open {
+			if intervals[j][1] >= start {
+				kept = append(kept, j)
+				g.AddEdge(Edge{Node1: Node(j), Node2: Node(i)})
+			}
+		}
+		open = append(kept, i)
+	}
+	return g
+}
+
+/*
+IsIntervalGraph checks if an UndirectedGraph is the intersection graph of a family of intervals of the real line.
+
+Description:
+By the theorem of Lekkerkerker and Boland, a graph is an interval graph if and only if it is chordal (see IsChordal)
+and has no asteroidal triple: three nodes such that every two of them are joined by a path avoiding the neighbourhood
+of the third. Triples are checked using the connected components of the graph minus the closed neighbourhood of
+every node, in O(n(n + m) + n^3) time.
+*/
+func IsIntervalGraph(g *UndirectedGraph) bool {
+	if !IsChordal(g) {
+		return false
+	}
+
+	// component[z][x] identifies the component of x in g minus the closed neighbourhood of z, or is absent if x is in it
+	nodes := sortedNodes(g.Nodes)
+	component := make(map[Node]map[Node]int, len(nodes))
+	for _, z := range nodes {
+		removed := map[Node]bool{z: true}
+		for _, neighbor := range g.Edges[z] {
+			removed[neighbor] = true
+		}
+		labels := make(map[Node]int)
+		for _, start := range nodes {
+			if removed[start] {
+				continue
+			}
+			if _, labeled := labels[start]; labeled {
+				continue
+			}
+			labels[start] = int(start)
+			stack := []Node{start}
+			for len(stack) > 0 {
+				node := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				for _, neighbor := range g.Edges[node] {
+					if _, labeled := labels[neighbor]; !labeled && !removed[neighbor] {
+						labels[neighbor] = int(start)
+						stack = append(stack, neighbor)
+					}
+				}
+			}
+		}
+		component[z] = labels
+	}
+
+	connectedAvoiding := func(x, y, z Node) bool {
+		cx, okx := component[z][x]
+		cy, oky := component[z][y]
+		return okx && oky && cx == cy
+	}
+	for i, x := range nodes {
+		for j := i + 1; j < len(nodes); j++ {
+			y := nodes[j]
+			for _, z := range nodes[j+1:] {
+				if connectedAvoiding(x, y, z) && connectedAvoiding(x, z, y) && connectedAvoiding(y, z, x) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestIntersectionGraph(t *testing.T) {
+	g := IntersectionGraph([][]string{{"a", "b"}, {"b", "c"}, {"d"}, {"a", "a"}})
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 3}})
+	expected.AddNode(2)
+	if !g.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, g)
+	}
+}
+
+func TestIntervalGraph(t *testing.T) {
+	g := IntervalGraph([][2]float64{{0, 2}, {1, 3}, {3, 4}, {5, 6}, {0, 10}, {7, 6}})
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {0, 4}, {1, 4}, {2, 4}, {3, 4}})
+	expected.AddNode(5)
+	if !g.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, g)
+	}
+
+	// The same graph as the intersection graph of the integer points of the intervals
+	sets := make([][]int, 5)
+	for i, interval := range [][2]int{{0, 2}, {1, 3}, {3, 4}, {5, 6}, {0, 10}} {
+		for x := interval[0]; x <= interval[1]; x++ {
+			sets[i] = append(sets[i], x)
+		}
+	}
+	expected.RemoveNode(5)
+	if h := IntersectionGraph(sets); !h.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, h)
+	}
+}
+
+func TestIsIntervalGraph(t *testing.T) {
+	// A subdivided claw is chordal but its three leaves form an asteroidal triple
+	spider := &UndirectedGraph{}
+	spider.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {0, 3}, {3, 4}, {0, 5}, {5, 6}})
+
+	testCases := []struct {
+		name     string
+		g        *UndirectedGraph
+		expected bool
+	}{
+		{"path", PathGraph(6), true},
+		{"star", StarGraph(6), true},
+		{"complete", CompleteGraph(5), true},
+		{"cycle", CycleGraph(4), false},
+		{"subdivided claw", spider, false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if result := IsIntervalGraph(testCase.g); result != testCase.expected {
+				t.Errorf("Expected %v, but got %v", testCase.expected, result)
+			}
+		})
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	intervals := make([][2]float64, 30)
+	for i := range intervals {
+		start := rng.Float64() * 10
+		intervals[i] = [2]float64{start, start + rng.Float64()*2}
+	}
+	if !IsIntervalGraph(IntervalGraph(intervals)) {
+		t.Errorf("Expected a random interval graph to be recognized")
+	}
+}
@@ -0,0 +1,246 @@
+package model
+
+import "sort"
+
+// IsIsomorphic reports whether g1 and g2 are isomorphic, see FindIsomorphism.
+func IsIsomorphic(g1, g2 *UndirectedGraph) bool {
+	_, ok := FindIsomorphism(g1, g2)
+	return ok
+}
+
+/*
+FindIsomorphism finds an isomorphism between two graphs: a bijection between their nodes that maps edges onto edges
+and non-edges onto non-edges, self-loops included.
+
+Parameters:
+- g1, g2: The graphs.
+
+Returns:
+- mapping: The node of g2 of every node of g1, or nil if the graphs are not isomorphic.
+- ok: Whether the graphs are isomorphic.
+
+Description:
+The graphs are first compared by their numbers of nodes and edges and by the colors of color refinement (see
+Automorphisms), which isomorphisms preserve. The nodes of g1 are then matched one by one in the manner of VF2: every
+node after the first of its component is matched among the neighbors of the image of an already matched neighbor,
+with the same color and degree, so that the partial mapping stays an isomorphism of the matched nodes. The running
+time can be exponential, for instance on large strongly regular graphs, and is meant for graphs of modest size.
+
+Reference: L. P. Cordella, P. Foggia, C. Sansone and M. Vento, "A (sub)graph isomorphism algorithm for matching large
+graphs", IEEE Trans. Pattern Anal. Mach. Intell. 26(10), 2004.
+*/
+func FindIsomorphism(g1, g2 *UndirectedGraph) (map[Node]Node, bool) {
+	if len(g1.Nodes) != len(g2.Nodes) || g1.NumberOfEdges() != g2.NumberOfEdges() {
+		return nil, false
+	}
+	m := newMatcher(g1, g2, true, true)
+	m.patternColors = refineColors(m.pattern.neighbors, make([]int, len(m.pattern.nodes)), -1)
+	m.targetColors = refineColors(m.target.neighbors, make([]int, len(m.target.nodes)), -1)
+	if !sameColorCounts(m.patternColors, m.targetColors) {
+		return nil, false
+	}
+	var mapping map[Node]Node
+	m.match(0, func(found map[Node]Node) bool {
+		mapping = found
+		return false
+	})
+	return mapping, mapping != nil
+}
+
+/*
+SubgraphIsomorphisms finds every occurrence of a pattern as an induced subgraph of a target, as in motif detection.
+
+Parameters:
+- pattern: The graph searched for.
+- target: The graph searched in.
+
+Returns:
+- mappings: The node of target of every node of pattern, for every injective mapping under which two nodes of pattern
+are adjacent exactly when their images are. A subgraph matched in several ways, by the symmetries of pattern, appears
+once per way. The mappings are in the lexicographic order of the images of the nodes of pattern in ascending order.
+
+Description:
+The matching is that of FindIsomorphism, without the colors, nodes of target of degree at least that of the pattern
+node being candidates.
+*/
+func SubgraphIsomorphisms(pattern, target *UndirectedGraph) []map[Node]Node {
+	return newMatcher(pattern, target, true, false).all()
+}
+
+// SubgraphMonomorphisms finds every occurrence of a pattern as a subgraph of a target, not necessarily induced: every
+// edge of pattern must be mapped onto an edge of target, but non-adjacent nodes may be mapped onto adjacent ones. See
+// SubgraphIsomorphisms for the order of the mappings.
+func SubgraphMonomorphisms(pattern, target *UndirectedGraph) []map[Node]Node {
+	return newMatcher(pattern, target, false, false).all()
+}
+
+// indexedGraph numbers the nodes of a graph in ascending order. neighbors lists the other endpoint of every edge, a
+// self-loop twice as in the adjacency lists, while adjacent and degree ignore self-loops, recorded in loop.
+type indexedGraph struct {
+	nodes     []Node
+	neighbors [][]int
+	adjacent  []map[int]bool
+	degree    []int
+	loop      []bool
+}
+
+func newIndexedGraph(g *UndirectedGraph) indexedGraph {
+	nodes := sortedNodes(g.Nodes)
+	index := nodeIndices(nodes)
+	ig := indexedGraph{
+		nodes:     nodes,
+		neighbors: make([][]int, len(nodes)),
+		adjacent:  make([]map[int]bool, len(nodes)),
+		degree:    make([]int, len(nodes)),
+		loop:      make([]bool, len(nodes)),
+	}
+	for i, node := range nodes {
+		ig.adjacent[i] = make(map[int]bool, len(g.Edges[node]))
+		for _, neighbor := range g.Edges[node] {
+			j := index[neighbor]
+			ig.neighbors[i] = append(ig.neighbors[i], j)
+			if j == i {
+				ig.loop[i] = true
+			} else if !ig.adjacent[i][j] {
+				ig.adjacent[i][j] = true
+				ig.degree[i]++
+			}
+		}
+	}
+	return ig
+}
+
+// matcher extends partial mappings from the nodes of a pattern to those of a target, the pattern nodes being matched
+// in order. Induced matchings also map non-edges onto non-edges, and exact ones require equal degrees and colors.
+type matcher struct {
+	pattern, target             indexedGraph
+	induced, exact              bool
+	patternColors, targetColors []int
+	order                       []int
+	anchor                      []int
+	mapping                     []int
+	used                        []bool
+}
+
+func newMatcher(pattern, target *UndirectedGraph, induced, exact bool) *matcher {
+	m := &matcher{pattern: newIndexedGraph(pattern), target: newIndexedGraph(target), induced: induced, exact: exact}
+	n := len(m.pattern.nodes)
+	m.mapping = make([]int, n)
+	for i := range m.mapping {
+		m.mapping[i] = -1
+	}
+	m.used = make([]bool, len(m.target.nodes))
+
+	// Match next the node with the most matched neighbors, then the largest degree, then the smallest number, and
+	// anchor it to its first matched neighbor
+	ordered := make([]bool, n)
+	matchedNeighbors := make([]int, n)
+	for len(m.order) < n {
+		best := -1
+		for v := 0; v < n; v++ {
+			if ordered[v] {
+				continue
+			}
+			if best < 0 || matchedNeighbors[v] > matchedNeighbors[best] ||
+				matchedNeighbors[v] == matchedNeighbors[best] && m.pattern.degree[v] > m.pattern.degree[best] {
+				best = v
+			}
+		}
+		anchor := -1
+		for _, u := range m.order {
+			if m.pattern.adjacent[best][u] {
+				anchor = u
+				break
+			}
+		}
+		ordered[best] = true
+		m.order = append(m.order, best)
+		m.anchor = append(m.anchor, anchor)
+		for neighbor := range m.pattern.adjacent[best] {
+			matchedNeighbors[neighbor]++
+		}
+	}
+	return m
+}
+
+// all returns every complete mapping, see SubgraphIsomorphisms.
+func (m *matcher) all() []map[Node]Node {
+	mappings := []map[Node]Node{}
+	if len(m.pattern.nodes) > len(m.target.nodes) {
+		return mappings
+	}
+	m.match(0, func(found map[Node]Node) bool {
+		mappings = append(mappings, found)
+		return true
+	})
+	sort.SliceStable(mappings, func(i, j int) bool {
+		for _, node := range m.pattern.nodes {
+			if a, b := mappings[i][node], mappings[j][node]; a != b {
+				return a < b
+			}
+		}
+		return false
+	})
+	return mappings
+}
+
+// match extends the mapping of the first depth nodes of the order and calls visit with every complete mapping until
+// it returns false, which match then returns as well.
+func (m *matcher) match(depth int, visit func(map[Node]Node) bool) bool {
+	if depth == len(m.order) {
+		found := make(map[Node]Node, len(m.mapping))
+		for p, t := range m.mapping {
+			found[m.pattern.nodes[p]] = m.target.nodes[t]
+		}
+		return visit(found)
+	}
+	p := m.order[depth]
+	var candidates []int
+	if anchor := m.anchor[depth]; anchor >= 0 {
+		for t := range m.target.adjacent[m.mapping[anchor]] {
+			candidates = append(candidates, t)
+		}
+		sort.Ints(candidates)
+	} else {
+		candidates = make([]int, len(m.target.nodes))
+		for t := range candidates {
+			candidates[t] = t
+		}
+	}
+	for _, t := range candidates {
+		if !m.feasible(depth, p, t) {
+			continue
+		}
+		m.mapping[p], m.used[t] = t, true
+		more := m.match(depth+1, visit)
+		m.mapping[p], m.used[t] = -1, false
+		if !more {
+			return false
+		}
+	}
+	return true
+}
+
+// feasible reports whether pattern node p, at the given depth of the order, can be mapped onto target node t.
+func (m *matcher) feasible(depth, p, t int) bool {
+	if m.used[t] {
+		return false
+	}
+	if m.exact {
+		if m.pattern.degree[p] != m.target.degree[t] || m.patternColors[p] != m.targetColors[t] {
+			return false
+		}
+	} else if m.pattern.degree[p] > m.target.degree[t] {
+		return false
+	}
+	if m.pattern.loop[p] && !m.target.loop[t] || m.induced && m.target.loop[t] && !m.pattern.loop[p] {
+		return false
+	}
+	for _, q := range m.order[:depth] {
+		edge, image := m.pattern.adjacent[p][q], m.target.adjacent[t][m.mapping[q]]
+		if edge && !image || m.induced && image && !edge {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,119 @@
+package model
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// relabel returns a copy of g whose nodes are permuted at random.
+func relabel(g *UndirectedGraph, seed int64) *UndirectedGraph {
+	nodes := sortedNodes(g.Nodes)
+	permutation := rand.New(rand.NewSource(seed)).Perm(len(nodes))
+	image := make(map[Node]Node, len(nodes))
+	for i, node := range nodes {
+		image[node] = Node(100 + permutation[i])
+	}
+	h := &UndirectedGraph{}
+	for _, node := range nodes {
+		h.AddNode(image[node])
+	}
+	for _, edge := range sortedEdgeList(g) {
+		h.AddEdge(Edge{Node1: image[edge.Node1], Node2: image[edge.Node2]})
+	}
+	return h
+}
+
+// isIsomorphism reports whether mapping is an isomorphism from g1 to g2.
+func isIsomorphism(g1, g2 *UndirectedGraph, mapping map[Node]Node) bool {
+	if len(mapping) != len(g1.Nodes) {
+		return false
+	}
+	seen := make(map[Node]bool)
+	for u, image := range mapping {
+		if !g2.Nodes[image] || seen[image] {
+			return false
+		}
+		seen[image] = true
+		for v := range g1.Nodes {
+			if g1.HasEdge(u, v) != g2.HasEdge(image, mapping[v]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestIsIsomorphic(t *testing.T) {
+	tree, err := BinomialTree(4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	withLoop := CycleGraph(5)
+	withLoop.AddEdge(Edge{Node1: 2, Node2: 2})
+	for name, g := range map[string]*UndirectedGraph{
+		"petersen":  petersenGraph(),
+		"cycle":     CycleGraph(9),
+		"wheel":     WheelGraph(8),
+		"tree":      tree,
+		"self-loop": withLoop,
+	} {
+		h := relabel(g, 7)
+		mapping, ok := FindIsomorphism(g, h)
+		if !ok || !isIsomorphism(g, h, mapping) {
+			t.Errorf("Expected an isomorphism of the %s graph, but got %v", name, mapping)
+		}
+	}
+
+	// Same numbers of nodes, edges and degrees
+	twoTriangles := CycleGraph(3)
+	twoTriangles.AddEdgesFromIntTupleList([][2]int{{3, 4}, {4, 5}, {5, 3}})
+	if IsIsomorphic(CycleGraph(6), twoTriangles) {
+		t.Errorf("Expected a 6-cycle and two triangles not to be isomorphic")
+	}
+	if IsIsomorphic(withLoop, CycleGraph(5)) || IsIsomorphic(CycleGraph(4), PathGraph(4)) {
+		t.Errorf("Expected graphs with different edges not to be isomorphic")
+	}
+	if !IsIsomorphic(&UndirectedGraph{}, &UndirectedGraph{}) {
+		t.Errorf("Expected empty graphs to be isomorphic")
+	}
+}
+
+func TestSubgraphIsomorphisms(t *testing.T) {
+	triangle := CompleteGraph(3)
+	if mappings := SubgraphIsomorphisms(triangle, CompleteGraph(4)); len(mappings) != 24 {
+		t.Errorf("Expected 24 triangles in K4 counting symmetries, but got %d", len(mappings))
+	}
+
+	// The path on 3 nodes is induced in a 5-cycle but not in K4, where it is only a subgraph
+	path := PathGraph(3)
+	if mappings := SubgraphIsomorphisms(path, CompleteGraph(4)); len(mappings) != 0 {
+		t.Errorf("Expected no induced path in K4, but got %v", mappings)
+	}
+	if mappings := SubgraphMonomorphisms(path, CompleteGraph(4)); len(mappings) != 24 {
+		t.Errorf("Expected 24 paths in K4, but got %d", len(mappings))
+	}
+	mappings := SubgraphIsomorphisms(path, CycleGraph(5))
+	if len(mappings) != 10 {
+		t.Fatalf("Expected 10 paths in a 5-cycle, but got %d", len(mappings))
+	}
+	if expected := map[Node]Node{0: 0, 1: 1, 2: 2}; !reflect.DeepEqual(mappings[0], expected) {
+		t.Errorf("Expected %v, but got %v", expected, mappings[0])
+	}
+	for _, mapping := range mappings {
+		if mapping[0] == mapping[2] || !CycleGraph(5).HasEdge(mapping[0], mapping[1]) {
+			t.Errorf("Unexpected mapping %v", mapping)
+		}
+	}
+
+	if mappings := SubgraphIsomorphisms(CompleteGraph(5), CompleteGraph(4)); len(mappings) != 0 {
+		t.Errorf("Expected no mapping into a smaller graph, but got %v", mappings)
+	}
+	// Two isolated nodes map onto any two non-adjacent nodes
+	isolated := &UndirectedGraph{}
+	isolated.AddNode(0)
+	isolated.AddNode(1)
+	if mappings := SubgraphIsomorphisms(isolated, StarGraph(4)); len(mappings) != 6 {
+		t.Errorf("Expected 6 ordered pairs of leaves, but got %d", len(mappings))
+	}
+}
@@ -0,0 +1,193 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// JointDegreeMatrix counts edges by the degrees of their endpoints: J[k][l] is the number of edges between a node of
+// degree k and a node of degree l. It is symmetric, J[k][l] == J[l][k], and J[k][k] counts every edge once.
+type JointDegreeMatrix map[int]map[int]int
+
+// JointDegrees returns the joint degree matrix of a graph. Self-loops are ignored.
+func JointDegrees(g *UndirectedGraph) JointDegreeMatrix {
+	jdm := make(JointDegreeMatrix)
+	for _, edge := range sortedEdgeList(g) {
+		if edge.Node1 == edge.Node2 {
+			continue
+		}
+		k, l := g.NodeDegree(edge.Node1), g.NodeDegree(edge.Node2)
+		jdm.add(k, l)
+		if k != l {
+			jdm.add(l, k)
+		}
+	}
+	return jdm
+}
+
+func (j JointDegreeMatrix) add(k, l int) {
+	if j[k] == nil {
+		j[k] = make(map[int]int)
+	}
+	j[k][l]++
+}
+
+// NodesPerDegree returns the number of nodes of every degree realized by the matrix, or an error if the matrix is not
+// symmetric or some degree class does not have a whole number of nodes.
+func (j JointDegreeMatrix) NodesPerDegree() (map[int]int, error) {
+	counts := make(map[int]int, len(j))
+	for k, row := range j {
+		stubs := 0
+		for l, edges := range row {
+			if edges < 0 || k <= 0 {
+				return nil, fmt.Errorf("invalid entry %d for degrees (%d, %d)", edges, k, l)
+			}
+			if j[l][k] != edges {
+				return nil, fmt.Errorf("entries (%d, %d) and (%d, %d) differ", k, l, l, k)
+			}
+			stubs += edges
+			if l == k {
+				stubs += edges
+			}
+		}
+		if stubs%k != 0 {
+			return nil, fmt.Errorf("%d edge endpoints cannot be split among nodes of degree %d", stubs, k)
+		}
+		if stubs > 0 {
+			counts[k] = stubs / k
+		}
+	}
+	return counts, nil
+}
+
+/*
+JointDegreeGraph generates a random simple graph realizing a joint degree matrix.
+
+Parameters:
+- jdm: The joint degree matrix, for example computed from a real network with JointDegrees.
+- seed: The seed of the random number generator.
+
+Returns:
+- g: A graph whose joint degree matrix is jdm. Nodes are numbered by increasing degree, from 0.
+- err: An error if the matrix is not realizable: not symmetric, with a fractional number of nodes of some degree, or with more edges between two degree classes than node pairs.
+
+Description:
+The algorithm of Gjoka, Tillman and Markopoulou adds the edges of every pair of degree classes between random nodes
+of those classes. When a chosen node has no free endpoint left, one of its edges is moved to a node of the same class
+that has one, which keeps the degrees of the other nodes unchanged.
+*/
+func JointDegreeGraph(jdm JointDegreeMatrix, seed int64) (*UndirectedGraph, error) {
+	counts, err := jdm.NodesPerDegree()
+	if err != nil {
+		return nil, err
+	}
+	degrees := make([]int, 0, len(counts))
+	for k := range counts {
+		degrees = append(degrees, k)
+	}
+	sort.Ints(degrees)
+
+	g := &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	classes := make(map[int][]Node, len(degrees))
+	residual := make(map[Node]int)
+	next := Node(0)
+	for _, k := range degrees {
+		for i := 0; i < counts[k]; i++ {
+			g.AddNode(next)
+			classes[k] = append(classes[k], next)
+			residual[next] = k
+			next++
+		}
+	}
+	for _, k := range degrees {
+		for _, l := range degrees {
+			if l < k {
+				continue
+			}
+			pairs := counts[k] * counts[l]
+			if k == l {
+				pairs = counts[k] * (counts[k] - 1) / 2
+			}
+			if jdm[k][l] > pairs {
+				return nil, fmt.Errorf("%d edges between degrees %d and %d exceed the %d node pairs", jdm[k][l], k, l, pairs)
+			}
+		}
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	for _, k := range degrees {
+		for _, l := range degrees {
+			if l < k {
+				continue
+			}
+			unsaturatedK := unsaturatedNodes(classes[k], residual)
+			unsaturatedL := unsaturatedK
+			if k != l {
+				unsaturatedL = unsaturatedNodes(classes[l], residual)
+			}
+			for added := 0; added < jdm[k][l]; {
+				v, w := classes[k][rng.Intn(len(classes[k]))], classes[l][rng.Intn(len(classes[l]))]
+				if v == w || g.HasEdge(v, w) {
+					continue
+				}
+				if residual[v] == 0 {
+					neighborSwitch(g, v, unsaturatedK, residual, v)
+				}
+				if residual[w] == 0 {
+					neighborSwitch(g, w, unsaturatedL, residual, v)
+				}
+				g.AddEdge(Edge{Node1: v, Node2: w})
+				for _, node := range []Node{v, w} {
+					residual[node]--
+					if residual[node] == 0 {
+						delete(unsaturatedK, node)
+						delete(unsaturatedL, node)
+					}
+				}
+				added++
+			}
+		}
+	}
+	return g, nil
+}
+
+func unsaturatedNodes(class []Node, residual map[Node]int) map[Node]bool {
+	unsaturated := make(map[Node]bool)
+	for _, node := range class {
+		if residual[node] > 0 {
+			unsaturated[node] = true
+		}
+	}
+	return unsaturated
+}
+
+// neighborSwitch frees an endpoint of the saturated node w by moving one of its edges to the smallest unsaturated
+// node of its class, avoiding the node w is about to be linked to when that node has a single free endpoint.
+func neighborSwitch(g *UndirectedGraph, w Node, unsaturated map[Node]bool, residual map[Node]int, avoid Node) {
+	var target Node
+	found := false
+	for _, node := range sortedNodes(unsaturated) {
+		if node != w && (node != avoid || residual[avoid] > 1) {
+			target, found = node, true
+			break
+		}
+	}
+	if !found {
+		return
+	}
+	neighbors := append([]Node{}, g.Edges[w]...)
+	sort.Slice(neighbors, func(i, j int) bool { return neighbors[i] < neighbors[j] })
+	for _, neighbor := range neighbors {
+		if neighbor != target && !g.HasEdge(target, neighbor) {
+			g.RemoveEdge(Edge{Node1: w, Node2: neighbor})
+			g.AddEdge(Edge{Node1: target, Node2: neighbor})
+			residual[w]++
+			residual[target]--
+			if residual[target] == 0 {
+				delete(unsaturated, target)
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestJointDegrees(t *testing.T) {
+	expected := JointDegreeMatrix{1: {3: 3}, 3: {1: 3}}
+	if jdm := JointDegrees(StarGraph(4)); !reflect.DeepEqual(jdm, expected) {
+		t.Errorf("Expected %v, but got %v", expected, jdm)
+	}
+	counts, err := JointDegrees(LollipopGraph(4, 3)).NodesPerDegree()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := map[int]int{1: 1, 2: 2, 3: 3, 4: 1}; !reflect.DeepEqual(counts, expected) {
+		t.Errorf("Expected %v, but got %v", expected, counts)
+	}
+}
+
+func TestJointDegreeGraph(t *testing.T) {
+	graphs := []*UndirectedGraph{
+		LollipopGraph(5, 4),
+		petersenGraph(),
+		RewiringNullModel(10)(WattsStrogatzRandomGraph(60, 6, 0), rand.New(rand.NewSource(1))),
+		ConfigurationNullModel(StarGraph(30), rand.New(rand.NewSource(2))),
+	}
+	graphs[3].AddEdgesFromIntTupleList([][2]int{{1, 2}, {2, 3}, {3, 4}, {4, 1}, {5, 6}})
+	for i, g := range graphs {
+		jdm := JointDegrees(g)
+		for seed := int64(0); seed < 5; seed++ {
+			generated, err := JointDegreeGraph(jdm, seed)
+			if err != nil {
+				t.Fatalf("Test case %d failed: Unexpected error: %v", i+1, err)
+			}
+			if result := JointDegrees(generated); !reflect.DeepEqual(result, jdm) {
+				t.Errorf("Test case %d failed: Expected %v, but got %v", i+1, jdm, result)
+			}
+		}
+	}
+
+	invalid := []JointDegreeMatrix{
+		{1: {2: 1}}, // not symmetric
+		{2: {2: 1}}, // one edge cannot join nodes of degree 2 only
+		{3: {3: 3}}, // three edges between two nodes of degree 3
+		{3: {1: 3}, 1: {3: 2}},
+	}
+	for _, jdm := range invalid {
+		if _, err := JointDegreeGraph(jdm, 1); err == nil {
+			t.Errorf("Expected an error for %v", jdm)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// labelSeparator joins labels into signatures. It is a control character so
+// that it cannot clash with ordinary user supplied labels.
+const labelSeparator = "\x1f"
+
+/*
+WeisfeilerLehmanKernel computes the Weisfeiler–Lehman subtree kernel matrix of a collection of graphs.
+
+Parameters:
+- graphs: The graphs to compare.
+- labels: Optional initial node labels, one map per graph. Pass nil to give every node the same label; nodes missing from a map also get the empty label.
+- iterations: The number of relabelling rounds (the height of the compared subtrees).
+
+Returns:
+- kernel: A symmetric matrix where kernel[i][j] is the number of matching subtree patterns between graphs[i] and graphs[j].
+- err: An error if the parameters are invalid.
+
+Description:
+At every iteration each node is relabelled with a compressed label of its own label and the sorted labels of its neighbours, using a dictionary shared by all graphs. The kernel is the dot product of the label histograms accumulated over all iterations.
+
+Reference: N. Shervashidze et al., "Weisfeiler-Lehman Graph Kernels", JMLR 12, 2011.
+*/
+func WeisfeilerLehmanKernel(graphs []*UndirectedGraph, labels []map[Node]string, iterations int) ([][]float64, error) {
+	if iterations < 0 {
+		return nil, fmt.Errorf("iterations must be non-negative")
+	}
+	if labels != nil && len(labels) != len(graphs) {
+		return nil, fmt.Errorf("expected %d label maps, got %d", len(graphs), len(labels))
+	}
+	return kernelMatrix(weisfeilerLehmanFeatures(graphs, labels, iterations)), nil
+}
+
+/*
+ShortestPathKernel computes the shortest-path kernel matrix of a collection of graphs.
+
+Parameters:
+- graphs: The graphs to compare.
+- labels: Optional node labels, one map per graph. Pass nil to compare path lengths only.
+
+Returns:
+- kernel: A symmetric matrix where kernel[i][j] counts the pairs of shortest paths, one from each graph, having the same (hop) length and the same endpoint labels.
+- err: An error if the parameters are invalid.
+
+Reference: K. M. Borgwardt and H.-P. Kriegel, "Shortest-path kernels on graphs", ICDM 2005.
+*/
+func ShortestPathKernel(graphs []*UndirectedGraph, labels []map[Node]string) ([][]float64, error) {
+	if labels != nil && len(labels) != len(graphs) {
+		return nil, fmt.Errorf("expected %d label maps, got %d", len(graphs), len(labels))
+	}
+	return kernelMatrix(shortestPathFeatures(graphs, labels)), nil
+}
+
+// WeisfeilerLehmanSimilarity returns the normalized Weisfeiler–Lehman subtree kernel of two unlabelled graphs, a value in [0, 1].
+func WeisfeilerLehmanSimilarity(g1, g2 *UndirectedGraph, iterations int) (float64, error) {
+	kernel, err := WeisfeilerLehmanKernel([]*UndirectedGraph{g1, g2}, nil, iterations)
+	if err != nil {
+		return 0, err
+	}
+	return NormalizeKernelMatrix(kernel)[0][1], nil
+}
+
+// ShortestPathSimilarity returns the normalized shortest-path kernel of two unlabelled graphs, a value in [0, 1].
+func ShortestPathSimilarity(g1, g2 *UndirectedGraph) (float64, error) {
+	kernel, err := ShortestPathKernel([]*UndirectedGraph{g1, g2}, nil)
+	if err != nil {
+		return 0, err
+	}
+	return NormalizeKernelMatrix(kernel)[0][1], nil
+}
+
+// NormalizeKernelMatrix returns a copy of kernel where every entry K[i][j] is divided by sqrt(K[i][i] * K[j][j]).
+// Entries involving a graph with a zero self-similarity are set to zero.
+func NormalizeKernelMatrix(kernel [][]float64) [][]float64 {
+	normalized := make([][]float64, len(kernel))
+	for i := range kernel {
+		normalized[i] = make([]float64, len(kernel[i]))
+		for j := range kernel[i] {
+			denominator := math.Sqrt(kernel[i][i] * kernel[j][j])
+			if denominator > 0 {
+				normalized[i][j] = kernel[i][j] / denominator
+			}
+		}
+	}
+	return normalized
+}
+
+// weisfeilerLehmanFeatures returns, for each graph, the histogram of the labels produced by every WL iteration.
+func weisfeilerLehmanFeatures(graphs []*UndirectedGraph, labels []map[Node]string, iterations int) []map[string]float64 {
+	features := make([]map[string]float64, len(graphs))
+	current := make([]map[Node]string, len(graphs))
+	for i, g := range graphs {
+		features[i] = make(map[string]float64)
+		current[i] = make(map[Node]string, len(g.Nodes))
+		for node := range g.Nodes {
+			current[i][node] = nodeLabel(labels, i, node)
+			features[i]["0"+labelSeparator+current[i][node]]++
+		}
+	}
+
+	for iteration := 1; iteration <= iterations; iteration++ {
+		dictionary := make(map[string]string)
+		next := make([]map[Node]string, len(graphs))
+		for i, g := range graphs {
+			next[i] = make(map[Node]string, len(g.Nodes))
+			for _, node := range sortedNodes(g.Nodes) {
+				neighbourLabels := make([]string, 0, len(g.Edges[node]))
+				for _, neighbour := range g.Edges[node] {
+					neighbourLabels = append(neighbourLabels, current[i][neighbour])
+				}
+				sort.Strings(neighbourLabels)
+				signature := current[i][node] + labelSeparator + strings.Join(neighbourLabels, labelSeparator)
+
+				compressed, ok := dictionary[signature]
+				if !ok {
+					compressed = strconv.Itoa(len(dictionary))
+					dictionary[signature] = compressed
+				}
+				next[i][node] = compressed
+				features[i][strconv.Itoa(iteration)+labelSeparator+compressed]++
+			}
+		}
+		current = next
+	}
+	return features
+}
+
+// shortestPathFeatures returns, for each graph, the histogram of (endpoint labels, length) triples over all shortest paths.
+func shortestPathFeatures(graphs []*UndirectedGraph, labels []map[Node]string) []map[string]float64 {
+	features := make([]map[string]float64, len(graphs))
+	for i, g := range graphs {
+		features[i] = make(map[string]float64)
+		for source := range g.Nodes {
+			for target, distance := range bfsDistances(g, source) {
+				if target <= source {
+					continue
+				}
+				endpoints := []string{nodeLabel(labels, i, source), nodeLabel(labels, i, target)}
+				sort.Strings(endpoints)
+				features[i][endpoints[0]+labelSeparator+endpoints[1]+labelSeparator+strconv.Itoa(distance)]++
+			}
+		}
+	}
+	return features
+}
+
+// kernelMatrix returns the matrix of pairwise dot products between feature histograms.
+func kernelMatrix(features []map[string]float64) [][]float64 {
+	kernel := make([][]float64, len(features))
+	for i := range kernel {
+		kernel[i] = make([]float64, len(features))
+	}
+	for i := range features {
+		for j := i; j < len(features); j++ {
+			value := 0.0
+			for key, count := range features[i] {
+				value += count * features[j][key]
+			}
+			kernel[i][j] = value
+			kernel[j][i] = value
+		}
+	}
+	return kernel
+}
+
+// nodeLabel returns the label of node in the i-th label map, or the empty label if there is none.
+func nodeLabel(labels []map[Node]string, i int, node Node) string {
+	if labels == nil || labels[i] == nil {
+		return ""
+	}
+	return labels[i][node]
+}
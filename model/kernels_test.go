@@ -0,0 +1,76 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWeisfeilerLehmanKernel(t *testing.T) {
+	graphs := []*UndirectedGraph{CycleGraph(6), CycleGraph(6), PathGraph(6)}
+
+	kernel, err := WeisfeilerLehmanKernel(graphs, nil, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if kernel[0][1] != kernel[0][0] {
+		t.Errorf("Expected identical graphs to have kernel %v, but got %v", kernel[0][0], kernel[0][1])
+	}
+	if kernel[0][2] >= kernel[0][0] {
+		t.Errorf("Expected a cycle and a path to be less similar than two cycles, got %v >= %v", kernel[0][2], kernel[0][0])
+	}
+	if kernel[1][2] != kernel[2][1] {
+		t.Errorf("Expected a symmetric kernel matrix, got %v and %v", kernel[1][2], kernel[2][1])
+	}
+
+	// With zero iterations only the initial labels are compared
+	labels := []map[Node]string{
+		{0: "a", 1: "a", 2: "b"},
+		{0: "a", 1: "b", 2: "b"},
+	}
+	kernel, err = WeisfeilerLehmanKernel([]*UndirectedGraph{PathGraph(3), PathGraph(3)}, labels, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if kernel[0][1] != 4 {
+		t.Errorf("Expected kernel 4, but got %v", kernel[0][1])
+	}
+
+	if _, err := WeisfeilerLehmanKernel(graphs, nil, -1); err == nil {
+		t.Errorf("Expected an error for negative iterations, but got nil")
+	}
+	if _, err := WeisfeilerLehmanKernel(graphs, labels, 1); err == nil {
+		t.Errorf("Expected an error for mismatching labels, but got nil")
+	}
+}
+
+func TestShortestPathKernel(t *testing.T) {
+	// A path on 3 nodes has two paths of length 1 and one of length 2
+	kernel, err := ShortestPathKernel([]*UndirectedGraph{PathGraph(3), CompleteGraph(3)}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if kernel[0][0] != 5 {
+		t.Errorf("Expected kernel 5, but got %v", kernel[0][0])
+	}
+	if kernel[0][1] != 6 {
+		t.Errorf("Expected kernel 6, but got %v", kernel[0][1])
+	}
+}
+
+func TestGraphSimilarity(t *testing.T) {
+	similarity, err := WeisfeilerLehmanSimilarity(StarGraph(5), StarGraph(5), 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if math.Abs(similarity-1) > 1e-9 {
+		t.Errorf("Expected similarity 1, but got %v", similarity)
+	}
+
+	similarity, err = ShortestPathSimilarity(StarGraph(5), PathGraph(5))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if similarity <= 0 || similarity >= 1 {
+		t.Errorf("Expected similarity in (0, 1), but got %v", similarity)
+	}
+}
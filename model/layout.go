@@ -0,0 +1,225 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// CircularLayout places the nodes on a circle of the given radius centered at the origin, in ascending order counterclockwise from (radius, 0).
+func CircularLayout(g *UndirectedGraph, radius float64) map[Node]Point {
+	nodes := sortedNodes(g.Nodes)
+	positions := make(map[Node]Point, len(nodes))
+	for i, node := range nodes {
+		angle := 2 * math.Pi * float64(i) / float64(len(nodes))
+		positions[node] = Point{X: radius * math.Cos(angle), Y: radius * math.Sin(angle)}
+	}
+	return positions
+}
+
+/*
+SpringLayout computes node positions with the force-directed algorithm of Fruchterman and Reingold.
+
+Parameters:
+- g: The graph.
+- iterations: The number of iterations of the simulation.
+- seed: The seed of the random initial positions.
+
+Returns:
+- positions: The position of every node, inside the unit square [0, 1] x [0, 1].
+
+Description:
+Every pair of nodes repels with force k²/d and every pair of adjacent nodes attracts with force d²/k, where d is
+their distance and k = sqrt(1/n) the ideal edge length. Nodes move along the resulting force by at most a temperature
+that decreases linearly to zero over the iterations, and are kept inside the unit square. See
+ConstrainedSpringLayout to pin nodes, vary the edge lengths or keep groups together.
+*/
+func SpringLayout(g *UndirectedGraph, iterations int, seed int64) map[Node]Point {
+	positions, _ := ConstrainedSpringLayout(g, LayoutConstraints{}, iterations, seed)
+	return positions
+}
+
+// LayoutConstraints adapts the forces of ConstrainedSpringLayout to a domain. The zero value adds no constraint.
+type LayoutConstraints struct {
+	// Pinned holds the nodes whose position is fixed, for instance the geographic position of backbone routers.
+	Pinned map[Node]Point
+	// EdgeLength gives the ideal length of every edge as a multiple of the default length, 1 if nil. It can be an
+	// edge weight, such as a distance or a latency, or its inverse for a strength.
+	EdgeLength WeightFunc
+	// Groups gives the group of the nodes, such as a community, and GroupStrength how strongly the nodes of every group
+	// are pulled toward its center; nodes without a group are not pulled.
+	Groups        map[Node]int
+	GroupStrength float64
+}
+
+/*
+ConstrainedSpringLayout computes node positions with the force-directed algorithm of SpringLayout, under constraints.
+
+Parameters:
+- g: The graph.
+- constraints: The pinned nodes, ideal edge lengths and groups.
+- iterations: The number of iterations of the simulation.
+- seed: The seed of the random initial positions.
+
+Returns:
+- positions: The position of every node. Pinned nodes keep their position; without them the nodes are inside the unit
+square, and with them inside the square spanned by the bounding box of the pinned positions.
+- err: An error if an edge length is not positive or GroupStrength is negative.
+
+Description:
+An edge of ideal length l attracts with force d²k²/(lk)³, which balances the repulsion k²/d at distance lk. Every
+node of a group is also pulled toward the centroid of its group with force GroupStrength d²/k, d being its distance to
+the centroid. Pinned positions are scaled into the unit square for the simulation, in which they do not move, and the
+other nodes are scaled back with them. With no constraint, the positions are those of SpringLayout.
+*/
+func ConstrainedSpringLayout(g *UndirectedGraph, constraints LayoutConstraints, iterations int, seed int64) (map[Node]Point, error) {
+	if constraints.GroupStrength < 0 {
+		return nil, fmt.Errorf("group strength must be non-negative, got %v", constraints.GroupStrength)
+	}
+	rng := rand.New(rand.NewSource(seed))
+	nodes := sortedNodes(g.Nodes)
+	k := math.Sqrt(1 / math.Max(float64(len(nodes)), 1))
+	lengths := make(map[Edge]float64)
+	for _, u := range nodes {
+		for _, v := range g.Edges[u] {
+			if u >= v {
+				continue
+			}
+			lengths[Edge{Node1: u, Node2: v}] = k
+			if constraints.EdgeLength != nil {
+				length := constraints.EdgeLength(u, v)
+				if !(length > 0) || math.IsInf(length, 1) {
+					return nil, fmt.Errorf("the ideal length of edge (%d, %d) must be positive, got %v", u, v, length)
+				}
+				lengths[Edge{Node1: u, Node2: v}] = length * k
+			}
+		}
+	}
+
+	// Pinned positions are mapped into the unit square by origin + scale * p
+	origin, scale := Point{}, 1.0
+	if len(constraints.Pinned) > 0 {
+		first := true
+		var low, high Point
+		for node, position := range constraints.Pinned {
+			if !g.Nodes[node] {
+				continue
+			}
+			if first {
+				low, high, first = position, position, false
+			}
+			low = Point{X: math.Min(low.X, position.X), Y: math.Min(low.Y, position.Y)}
+			high = Point{X: math.Max(high.X, position.X), Y: math.Max(high.Y, position.Y)}
+		}
+		if extent := math.Max(high.X-low.X, high.Y-low.Y); extent > 0 {
+			scale = extent
+		}
+		origin = low
+	}
+	positions := make(map[Node]Point, len(nodes))
+	for _, node := range nodes {
+		positions[node] = Point{X: rng.Float64(), Y: rng.Float64()}
+		if pinned, ok := constraints.Pinned[node]; ok {
+			positions[node] = Point{X: (pinned.X - origin.X) / scale, Y: (pinned.Y - origin.Y) / scale}
+		}
+	}
+	if len(nodes) < 2 {
+		return unscaleLayout(positions, constraints.Pinned, origin, scale), nil
+	}
+
+	displacements := make(map[Node]Point, len(nodes))
+	for iteration := 0; iteration < iterations; iteration++ {
+		for _, node := range nodes {
+			displacements[node] = Point{}
+		}
+		for i, u := range nodes {
+			for _, v := range nodes[i+1:] {
+				dx, dy, d := separation(positions[u], positions[v])
+				force := k * k / d
+				moveApart(displacements, u, v, dx/d*force, dy/d*force)
+			}
+		}
+		for _, u := range nodes {
+			for _, v := range g.Edges[u] {
+				if u < v {
+					dx, dy, d := separation(positions[u], positions[v])
+					length := lengths[Edge{Node1: u, Node2: v}]
+					force := d * d / k
+					if length != k {
+						force = d * d * k * k / (length * length * length)
+					}
+					moveApart(displacements, u, v, -dx/d*force, -dy/d*force)
+				}
+			}
+		}
+		if constraints.GroupStrength > 0 {
+			centroids := make(map[int]Point)
+			sizes := make(map[int]float64)
+			for _, node := range nodes {
+				if group, ok := constraints.Groups[node]; ok {
+					centroid := centroids[group]
+					centroids[group] = Point{X: centroid.X + positions[node].X, Y: centroid.Y + positions[node].Y}
+					sizes[group]++
+				}
+			}
+			for _, node := range nodes {
+				group, ok := constraints.Groups[node]
+				if !ok {
+					continue
+				}
+				centroid := Point{X: centroids[group].X / sizes[group], Y: centroids[group].Y / sizes[group]}
+				dx, dy := centroid.X-positions[node].X, centroid.Y-positions[node].Y
+				d := math.Hypot(dx, dy)
+				force := constraints.GroupStrength * d / k
+				displacement := displacements[node]
+				displacements[node] = Point{X: displacement.X + dx*force, Y: displacement.Y + dy*force}
+			}
+		}
+
+		temperature := 0.1 * (1 - float64(iteration)/float64(iterations))
+		for _, node := range nodes {
+			if _, pinned := constraints.Pinned[node]; pinned {
+				continue
+			}
+			displacement := displacements[node]
+			length := math.Hypot(displacement.X, displacement.Y)
+			if length == 0 {
+				continue
+			}
+			step := min(length, temperature)
+			position := positions[node]
+			positions[node] = Point{
+				X: min(max(position.X+displacement.X/length*step, 0), 1),
+				Y: min(max(position.Y+displacement.Y/length*step, 0), 1),
+			}
+		}
+	}
+	return unscaleLayout(positions, constraints.Pinned, origin, scale), nil
+}
+
+// unscaleLayout maps the positions of a simulation back to the frame of the pinned nodes, which get their exact position.
+func unscaleLayout(positions, pinned map[Node]Point, origin Point, scale float64) map[Node]Point {
+	if len(pinned) == 0 {
+		return positions
+	}
+	for node, position := range positions {
+		positions[node] = Point{X: origin.X + position.X*scale, Y: origin.Y + position.Y*scale}
+		if p, ok := pinned[node]; ok {
+			positions[node] = p
+		}
+	}
+	return positions
+}
+
+// separation returns the vector from q to p and its length, bounded away from zero so that coincident nodes still repel.
+func separation(p, q Point) (dx, dy, d float64) {
+	dx, dy = p.X-q.X, p.Y-q.Y
+	return dx, dy, math.Max(math.Hypot(dx, dy), 1e-6)
+}
+
+// moveApart adds (fx, fy) to the displacement of u and subtracts it from the displacement of v.
+func moveApart(displacements map[Node]Point, u, v Node, fx, fy float64) {
+	du, dv := displacements[u], displacements[v]
+	displacements[u] = Point{X: du.X + fx, Y: du.Y + fy}
+	displacements[v] = Point{X: dv.X - fx, Y: dv.Y - fy}
+}
@@ -0,0 +1,117 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCircularLayout(t *testing.T) {
+	positions := CircularLayout(CycleGraph(4), 2)
+	expected := map[Node]Point{0: {X: 2, Y: 0}, 1: {X: 0, Y: 2}, 2: {X: -2, Y: 0}, 3: {X: 0, Y: -2}}
+	for node, position := range expected {
+		if position.Distance(positions[node]) > 1e-9 {
+			t.Errorf("Expected node %d at %v, but got %v", node, position, positions[node])
+		}
+	}
+}
+
+func TestSpringLayout(t *testing.T) {
+	// Two triangles joined by a long path: nodes of the same triangle end up closer than the two triangles
+	g := CycleGraph(3)
+	g.AddEdgesFromIntTupleList([][2]int{{2, 3}, {3, 4}, {4, 5}, {5, 6}, {6, 7}, {7, 8}, {8, 6}})
+	positions := SpringLayout(g, 200, 1)
+	if len(positions) != 9 {
+		t.Fatalf("Expected 9 positions, but got %d", len(positions))
+	}
+	for node, position := range positions {
+		if position.X < 0 || position.X > 1 || position.Y < 0 || position.Y > 1 || math.IsNaN(position.X) {
+			t.Errorf("Expected node %d inside the unit square, but got %v", node, position)
+		}
+	}
+	if within, across := positions[0].Distance(positions[1]), positions[0].Distance(positions[7]); within >= across {
+		t.Errorf("Expected neighbors closer than distant nodes, but got %v and %v", within, across)
+	}
+
+	// The layout is reproducible
+	again := SpringLayout(g, 200, 1)
+	for node := range positions {
+		if positions[node] != again[node] {
+			t.Errorf("Expected the same positions for the same seed, but got %v and %v", positions[node], again[node])
+		}
+	}
+}
+
+func TestConstrainedSpringLayout(t *testing.T) {
+	g := CycleGraph(3)
+	g.AddEdgesFromIntTupleList([][2]int{{2, 3}, {3, 4}, {4, 5}, {5, 6}, {6, 7}, {7, 8}, {8, 6}})
+
+	// Without constraints, the layout is the spring layout
+	plain := SpringLayout(g, 100, 3)
+	unconstrained, err := ConstrainedSpringLayout(g, LayoutConstraints{}, 100, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for node := range plain {
+		if plain[node] != unconstrained[node] {
+			t.Errorf("Expected the spring layout, but got %v instead of %v for node %d", unconstrained[node], plain[node], node)
+		}
+	}
+
+	// Pinned nodes keep their geographic position and the others are placed in their frame
+	pinned := map[Node]Point{0: {X: 10, Y: 45}, 8: {X: 14, Y: 47}}
+	positions, err := ConstrainedSpringLayout(g, LayoutConstraints{Pinned: pinned}, 200, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for node, position := range pinned {
+		if positions[node] != position {
+			t.Errorf("Expected node %d pinned at %v, but got %v", node, position, positions[node])
+		}
+	}
+	for node, position := range positions {
+		if position.X < 10 || position.X > 14 || position.Y < 45 || position.Y > 49 {
+			t.Errorf("Expected node %d in the frame of the pinned nodes, but got %v", node, position)
+		}
+	}
+
+	// Longer edges are laid out longer
+	path := PathGraph(3)
+	stretched, _ := ConstrainedSpringLayout(path, LayoutConstraints{EdgeLength: func(u, v Node) float64 {
+		if u+v == 1 {
+			return 3
+		}
+		return 1
+	}}, 300, 1)
+	if long, short := stretched[0].Distance(stretched[1]), stretched[1].Distance(stretched[2]); long <= 1.5*short {
+		t.Errorf("Expected the edge of length 3 to be longer, but got %v and %v", long, short)
+	}
+
+	// Group attraction brings the members of a group together
+	empty := &UndirectedGraph{Nodes: map[Node]bool{}, Edges: map[Node][]Node{}}
+	groups := map[Node]int{}
+	for node := 0; node < 8; node++ {
+		empty.AddNode(Node(node))
+		groups[Node(node)] = node % 2
+	}
+	spread, _ := ConstrainedSpringLayout(empty, LayoutConstraints{}, 200, 1)
+	grouped, _ := ConstrainedSpringLayout(empty, LayoutConstraints{Groups: groups, GroupStrength: 5}, 200, 1)
+	diameter := func(positions map[Node]Point) float64 {
+		largest := 0.0
+		for u := Node(0); u < 8; u += 2 {
+			for v := Node(0); v < 8; v += 2 {
+				largest = math.Max(largest, positions[u].Distance(positions[v]))
+			}
+		}
+		return largest
+	}
+	if diameter(grouped) >= diameter(spread) {
+		t.Errorf("Expected a tighter group, but got a diameter of %v against %v", diameter(grouped), diameter(spread))
+	}
+
+	if _, err := ConstrainedSpringLayout(path, LayoutConstraints{EdgeLength: func(u, v Node) float64 { return 0 }}, 10, 1); err == nil {
+		t.Errorf("Expected an error for a zero edge length")
+	}
+	if _, err := ConstrainedSpringLayout(path, LayoutConstraints{GroupStrength: -1}, 10, 1); err == nil {
+		t.Errorf("Expected an error for a negative group strength")
+	}
+}
@@ -0,0 +1,213 @@
+package model
+
+import "sort"
+
+/*
+MaxMatching returns a maximum matching of a graph: a largest set of edges no two of which share a node, such as the
+pairs of a tournament round or of a kidney exchange.
+
+Parameters:
+- g: The graph. Self-loops are ignored.
+
+Returns:
+- matching: The edges of the matching, with their smaller node first, in ascending order.
+
+Description:
+Edmonds' blossom algorithm grows alternating trees from every unmatched node by breadth-first search, contracting the
+odd cycles, or blossoms, it meets, until it finds an augmenting path, and flips the path. It takes O(n³) time. The
+nodes are visited in ascending order, so that the matching is deterministic. For bipartite graphs, HopcroftKarp is
+faster.
+
+Reference: J. Edmonds, "Paths, trees, and flowers", Canad. J. Math. 17, 1965.
+*/
+func MaxMatching(g *UndirectedGraph) []Edge {
+	nodes := sortedNodes(g.Nodes)
+	index := nodeIndices(nodes)
+	n := len(nodes)
+	adjacency := make([][]int, n)
+	for i, node := range nodes {
+		for _, neighbor := range g.Edges[node] {
+			if neighbor != node {
+				adjacency[i] = append(adjacency[i], index[neighbor])
+			}
+		}
+		sort.Ints(adjacency[i])
+	}
+
+	match := make([]int, n)
+	parent := make([]int, n)
+	base := make([]int, n)
+	used := make([]bool, n)
+	blossom := make([]bool, n)
+	for i := range match {
+		match[i] = -1
+	}
+
+	lowestCommonAncestor := func(a, b int) int {
+		onPath := make([]bool, n)
+		for {
+			a = base[a]
+			onPath[a] = true
+			if match[a] < 0 {
+				break
+			}
+			a = parent[match[a]]
+		}
+		for {
+			b = base[b]
+			if onPath[b] {
+				return b
+			}
+			b = parent[match[b]]
+		}
+	}
+	markPath := func(v, b, child int) {
+		for base[v] != b {
+			blossom[base[v]], blossom[base[match[v]]] = true, true
+			parent[v] = child
+			child = match[v]
+			v = parent[match[v]]
+		}
+	}
+	// findPath returns the end of an augmenting path from root, or -1
+	findPath := func(root int) int {
+		for i := range used {
+			used[i], parent[i], base[i] = false, -1, i
+		}
+		used[root] = true
+		queue := []int{root}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			for _, to := range adjacency[v] {
+				if base[v] == base[to] || match[v] == to {
+					continue
+				}
+				if to == root || match[to] >= 0 && parent[match[to]] >= 0 {
+					// An odd cycle: contract the blossom into its base
+					current := lowestCommonAncestor(v, to)
+					for i := range blossom {
+						blossom[i] = false
+					}
+					markPath(v, current, to)
+					markPath(to, current, v)
+					for i := range base {
+						if blossom[base[i]] {
+							base[i] = current
+							if !used[i] {
+								used[i] = true
+								queue = append(queue, i)
+							}
+						}
+					}
+				} else if parent[to] < 0 {
+					parent[to] = v
+					if match[to] < 0 {
+						return to
+					}
+					used[match[to]] = true
+					queue = append(queue, match[to])
+				}
+			}
+		}
+		return -1
+	}
+
+	for root := 0; root < n; root++ {
+		if match[root] >= 0 {
+			continue
+		}
+		for v := findPath(root); v >= 0; {
+			previous := match[parent[v]]
+			match[v], match[parent[v]] = parent[v], v
+			v = previous
+		}
+	}
+
+	var matching []Edge
+	for i, j := range match {
+		if i < j {
+			matching = append(matching, Edge{Node1: nodes[i], Node2: nodes[j]})
+		}
+	}
+	return matching
+}
+
+/*
+HopcroftKarp returns a maximum matching of a bipartite graph, such as an assignment of workers to the tasks they can do.
+
+Parameters:
+- b: The bipartite graph.
+
+Returns:
+- matching: The edges of the matching, with the top node as Node1, in ascending order of the top nodes.
+
+Description:
+Every phase finds a maximal set of disjoint shortest augmenting paths, with a breadth-first search from the unmatched
+top nodes followed by depth-first searches, and flips them. O(√n) phases are enough, for O(m√n) time in all.
+
+Reference: J. E. Hopcroft and R. M. Karp, "An n^5/2 algorithm for maximum matchings in bipartite graphs", SIAM J.
+Comput. 2(4), 1973.
+*/
+func HopcroftKarp(b *BipartiteGraph) []Edge {
+	top := b.TopNodes()
+	matchTop := make(map[Node]Node, len(top))
+	matchBottom := make(map[Node]Node, len(b.Bottom))
+	distance := make(map[Node]int, len(top))
+	const infinity = int(^uint(0) >> 1)
+
+	// bfs layers the top nodes by their distance from the unmatched ones and reports whether an augmenting path exists
+	bfs := func() bool {
+		var queue []Node
+		for _, u := range top {
+			if _, matched := matchTop[u]; matched {
+				distance[u] = infinity
+			} else {
+				distance[u] = 0
+				queue = append(queue, u)
+			}
+		}
+		found := false
+		for ; len(queue) > 0; queue = queue[1:] {
+			u := queue[0]
+			for _, v := range b.Graph.Edges[u] {
+				next, matched := matchBottom[v]
+				if !matched {
+					found = true
+				} else if distance[next] == infinity {
+					distance[next] = distance[u] + 1
+					queue = append(queue, next)
+				}
+			}
+		}
+		return found
+	}
+	var dfs func(u Node) bool
+	dfs = func(u Node) bool {
+		for _, v := range b.Graph.Edges[u] {
+			next, matched := matchBottom[v]
+			if !matched || distance[next] == distance[u]+1 && dfs(next) {
+				matchTop[u], matchBottom[v] = v, u
+				return true
+			}
+		}
+		distance[u] = infinity
+		return false
+	}
+
+	for bfs() {
+		for _, u := range top {
+			if _, matched := matchTop[u]; !matched {
+				dfs(u)
+			}
+		}
+	}
+
+	var matching []Edge
+	for _, u := range top {
+		if v, matched := matchTop[u]; matched {
+			matching = append(matching, Edge{Node1: u, Node2: v})
+		}
+	}
+	return matching
+}
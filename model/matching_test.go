@@ -0,0 +1,103 @@
+package model
+
+import "testing"
+
+// isMatching reports whether the edges of a matching belong to g and share no node.
+func isMatching(g *UndirectedGraph, matching []Edge) bool {
+	matched := make(map[Node]bool)
+	for _, edge := range matching {
+		if !g.HasEdge(edge.Node1, edge.Node2) || edge.Node1 == edge.Node2 || matched[edge.Node1] || matched[edge.Node2] {
+			return false
+		}
+		matched[edge.Node1], matched[edge.Node2] = true, true
+	}
+	return true
+}
+
+func TestMaxMatching(t *testing.T) {
+	// A triangle with a pendant path, whose maximum matching needs an augmenting path through the blossom
+	blossom := &UndirectedGraph{}
+	blossom.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {0, 5}})
+	testCases := []struct {
+		name string
+		g    *UndirectedGraph
+		size int
+	}{
+		{"blossom", blossom, 3},
+		{"petersen", petersenGraph(), 5},
+		{"odd cycle", CycleGraph(7), 3},
+		{"star", StarGraph(6), 1},
+		{"complete", CompleteGraph(9), 4},
+		{"empty", &UndirectedGraph{}, 0},
+	}
+	for _, testCase := range testCases {
+		matching := MaxMatching(testCase.g)
+		if len(matching) != testCase.size || !isMatching(testCase.g, matching) {
+			t.Errorf("Expected a matching of %d edges in the %s graph, but got %v", testCase.size, testCase.name, matching)
+		}
+	}
+
+	// A graph with a perfect matching, found whatever the relabeling
+	g, err := GnpRandomGraph(60, 0.1, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for i := 0; i < 60; i += 2 {
+		g.AddEdge(Edge{Node1: Node(i), Node2: Node(i + 1)})
+	}
+	if matching := MaxMatching(relabel(g, 3)); len(matching) != 30 {
+		t.Errorf("Expected a perfect matching of 30 edges, but got %d", len(matching))
+	}
+
+	for seed := int64(0); seed < 30; seed++ {
+		small, err := GnpRandomGraph(10, 0.3, seed)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got, expected := len(MaxMatching(small)), bruteForceMatchingSize(sortedEdgeList(small)); got != expected {
+			t.Errorf("Expected %d edges for seed %d, but got %d", expected, seed, got)
+		}
+	}
+}
+
+// bruteForceMatchingSize returns the size of a maximum matching of the edges by trying every subset.
+func bruteForceMatchingSize(edges []Edge) int {
+	if len(edges) == 0 {
+		return 0
+	}
+	best := bruteForceMatchingSize(edges[1:])
+	var rest []Edge
+	for _, edge := range edges[1:] {
+		if edge.Node1 != edges[0].Node1 && edge.Node1 != edges[0].Node2 && edge.Node2 != edges[0].Node1 && edge.Node2 != edges[0].Node2 {
+			rest = append(rest, edge)
+		}
+	}
+	return max(best, 1+bruteForceMatchingSize(rest))
+}
+
+func TestHopcroftKarp(t *testing.T) {
+	b := NewBipartiteGraph()
+	for _, edge := range [][2]int{{0, 10}, {0, 11}, {1, 10}, {2, 11}, {2, 12}, {3, 12}} {
+		b.AddEdge(Node(edge[0]), Node(edge[1]))
+	}
+	matching := HopcroftKarp(b)
+	if len(matching) != 3 || !isMatching(b.Graph, matching) {
+		t.Errorf("Expected a matching of 3 edges, but got %v", matching)
+	}
+	for _, edge := range matching {
+		if !b.Top[edge.Node1] {
+			t.Errorf("Expected top nodes first, but got %v", edge)
+		}
+	}
+
+	random, err := RandomBipartiteGraph(80, 100, 0.04, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got, expected := len(HopcroftKarp(random)), len(MaxMatching(random.Graph)); got != expected {
+		t.Errorf("Expected %d edges as with the blossom algorithm, but got %d", expected, got)
+	}
+	if matching := HopcroftKarp(CompleteBipartiteGraph(3, 5)); len(matching) != 3 {
+		t.Errorf("Expected 3 edges, but got %v", matching)
+	}
+}
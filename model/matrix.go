@@ -0,0 +1,119 @@
+package model
+
+import "fmt"
+
+// The dense conversions index the rows and columns of a matrix by the nodes of the graph in ascending order, like
+// AdjacencyCSR, and the graphs built from a matrix have the nodes 0 to n-1. A self-loop is a single entry on the
+// diagonal.
+
+// ToAdjacencyMatrix returns the adjacency matrix of g, whose entry (i, j) is 1 if nodes[i] and nodes[j] are adjacent
+// and 0 otherwise, and the node of every row and column.
+func ToAdjacencyMatrix(g *UndirectedGraph) ([][]int, []Node) {
+	nodes := sortedNodes(g.Nodes)
+	index := nodeIndices(nodes)
+	matrix := make([][]int, len(nodes))
+	for i, node := range nodes {
+		matrix[i] = make([]int, len(nodes))
+		for _, neighbor := range g.Edges[node] {
+			matrix[i][index[neighbor]] = 1
+		}
+	}
+	return matrix, nodes
+}
+
+// ToWeightedAdjacencyMatrix returns the adjacency matrix of g with the weight of every edge as entry, 0 standing for
+// no edge, and the node of every row and column.
+func ToWeightedAdjacencyMatrix(g *UndirectedGraph, weight WeightFunc) ([][]float64, []Node) {
+	nodes := sortedNodes(g.Nodes)
+	index := nodeIndices(nodes)
+	matrix := make([][]float64, len(nodes))
+	for i, node := range nodes {
+		matrix[i] = make([]float64, len(nodes))
+		for _, neighbor := range g.Edges[node] {
+			matrix[i][index[neighbor]] = weight(node, neighbor)
+		}
+	}
+	return matrix, nodes
+}
+
+// FromAdjacencyMatrix returns the graph on the nodes 0 to n-1 of a symmetric n x n matrix, with an edge for every
+// non-zero entry.
+func FromAdjacencyMatrix(matrix [][]int) (*UndirectedGraph, error) {
+	if err := checkAdjacencyMatrix(len(matrix), func(i int) int { return len(matrix[i]) }, func(i, j int) bool { return matrix[i][j] == matrix[j][i] }); err != nil {
+		return nil, err
+	}
+	g := emptyRandomGraph(len(matrix))
+	for i := range matrix {
+		for j := i; j < len(matrix); j++ {
+			if matrix[i][j] != 0 {
+				g.AddEdge(Edge{Node1: Node(i), Node2: Node(j)})
+			}
+		}
+	}
+	return g, nil
+}
+
+// FromWeightedAdjacencyMatrix returns the weighted graph on the nodes 0 to n-1 of a symmetric n x n matrix, with an
+// edge weighted by every non-zero entry.
+func FromWeightedAdjacencyMatrix(matrix [][]float64) (*WeightedUndirectedGraph, error) {
+	if err := checkAdjacencyMatrix(len(matrix), func(i int) int { return len(matrix[i]) }, func(i, j int) bool { return matrix[i][j] == matrix[j][i] }); err != nil {
+		return nil, err
+	}
+	w := NewWeightedUndirectedGraph()
+	for i := range matrix {
+		w.AddNode(Node(i))
+		for j := i; j < len(matrix); j++ {
+			if matrix[i][j] != 0 {
+				w.AddWeightedEdge(Edge{Node1: Node(i), Node2: Node(j)}, matrix[i][j])
+			}
+		}
+	}
+	return w, nil
+}
+
+// ToEdgeList returns every edge of g once, its smaller node first, in ascending order.
+func ToEdgeList(g *UndirectedGraph) []Edge {
+	var edges []Edge
+	for _, edge := range sortedEdgeList(g) {
+		if len(edges) == 0 || edges[len(edges)-1] != edge {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+// FromEdgeList returns the graph formed by edges and their endpoints, given in either orientation.
+func FromEdgeList(edges []Edge) *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	for _, edge := range edges {
+		g.AddEdge(edge)
+	}
+	return g
+}
+
+// nodeIndices returns the index of every node in nodes.
+func nodeIndices(nodes []Node) map[Node]int {
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	return index
+}
+
+// checkAdjacencyMatrix checks that an n x n matrix, whose row lengths and symmetry are given by the functions, is
+// square and symmetric.
+func checkAdjacencyMatrix(n int, rowLength func(i int) int, symmetric func(i, j int) bool) error {
+	for i := 0; i < n; i++ {
+		if rowLength(i) != n {
+			return fmt.Errorf("an adjacency matrix must be square, but row %d has %d entries instead of %d", i, rowLength(i), n)
+		}
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if !symmetric(i, j) {
+				return fmt.Errorf("an adjacency matrix must be symmetric, but entries (%d, %d) and (%d, %d) differ", i, j, j, i)
+			}
+		}
+	}
+	return nil
+}
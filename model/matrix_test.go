@@ -0,0 +1,72 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAdjacencyMatrix(t *testing.T) {
+	g := PathGraph(3)
+	g.AddEdge(Edge{Node1: 2, Node2: 2})
+	g.AddNode(7)
+	matrix, nodes := ToAdjacencyMatrix(g)
+	expected := [][]int{{0, 1, 0, 0}, {1, 0, 1, 0}, {0, 1, 1, 0}, {0, 0, 0, 0}}
+	if !reflect.DeepEqual(matrix, expected) || !reflect.DeepEqual(nodes, []Node{0, 1, 2, 7}) {
+		t.Errorf("Expected %v on [0 1 2 7], but got %v on %v", expected, matrix, nodes)
+	}
+
+	back, err := FromAdjacencyMatrix(matrix)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	relabelled := PathGraph(3)
+	relabelled.AddEdge(Edge{Node1: 2, Node2: 2})
+	relabelled.AddNode(3)
+	if !back.Equals(relabelled) {
+		t.Errorf("Expected %v, but got %v", relabelled, back)
+	}
+
+	for _, invalid := range [][][]int{{{0, 1}, {0, 0}}, {{0, 1}, {1}}} {
+		if _, err := FromAdjacencyMatrix(invalid); err == nil {
+			t.Errorf("Expected an error for %v", invalid)
+		}
+	}
+}
+
+func TestWeightedAdjacencyMatrix(t *testing.T) {
+	w := NewWeightedUndirectedGraph()
+	w.AddWeightedEdge(Edge{Node1: 5, Node2: 3}, 2.5)
+	w.AddWeightedEdge(Edge{Node1: 3, Node2: 4}, -1)
+	matrix, nodes := ToWeightedAdjacencyMatrix(w.Graph, w.EdgeWeight)
+	expected := [][]float64{{0, -1, 2.5}, {-1, 0, 0}, {2.5, 0, 0}}
+	if !reflect.DeepEqual(matrix, expected) || !reflect.DeepEqual(nodes, []Node{3, 4, 5}) {
+		t.Errorf("Expected %v on [3 4 5], but got %v on %v", expected, matrix, nodes)
+	}
+
+	back, err := FromWeightedAdjacencyMatrix(matrix)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(back.Graph.Nodes) != 3 || back.EdgeWeight(2, 0) != 2.5 || back.EdgeWeight(0, 1) != -1 || back.Graph.NumberOfEdges() != 2 {
+		t.Errorf("Expected the weighted graph back, but got %v", back.Weights)
+	}
+	if _, err := FromWeightedAdjacencyMatrix([][]float64{{0, 1}, {2, 0}}); err == nil {
+		t.Errorf("Expected an error for an asymmetric matrix")
+	}
+}
+
+func TestEdgeList(t *testing.T) {
+	g := CycleGraph(4)
+	g.AddEdge(Edge{Node1: 1, Node2: 1})
+	edges := ToEdgeList(g)
+	expected := []Edge{{0, 1}, {0, 3}, {1, 1}, {1, 2}, {2, 3}}
+	if !reflect.DeepEqual(edges, expected) {
+		t.Errorf("Expected %v, but got %v", expected, edges)
+	}
+	if back := FromEdgeList(edges); !back.Equals(g) {
+		t.Errorf("Expected %v, but got %v", g, back)
+	}
+	if empty := FromEdgeList(nil); len(empty.Nodes) != 0 || ToEdgeList(empty) != nil {
+		t.Errorf("Expected an empty graph, but got %v", empty)
+	}
+}
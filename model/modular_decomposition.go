@@ -0,0 +1,244 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ModuleKind identifies the kind of a node of a modular decomposition tree.
+type ModuleKind int
+
+const (
+	// LeafModule is a single node of the graph.
+	LeafModule ModuleKind = iota
+	// ParallelModule is a module whose children are the connected components of the subgraph it induces.
+	ParallelModule
+	// SeriesModule is a module whose children are the connected components of the complement of the subgraph it induces.
+	SeriesModule
+	// PrimeModule is a module whose children are its maximal proper modules, the quotient graph being prime.
+	PrimeModule
+)
+
+var moduleKindNames = map[ModuleKind]string{
+	LeafModule:     "leaf",
+	ParallelModule: "parallel",
+	SeriesModule:   "series",
+	PrimeModule:    "prime",
+}
+
+func (kind ModuleKind) String() string {
+	if name, ok := moduleKindNames[kind]; ok {
+		return name
+	}
+	return fmt.Sprintf("ModuleKind(%d)", int(kind))
+}
+
+// Module is a node of a modular decomposition tree: a strong module of the graph, i.e. a set of nodes that every
+// other node is adjacent to either entirely or not at all, and that overlaps no other module.
+type Module struct {
+	Kind ModuleKind
+	// Nodes holds the graph nodes of the module, sorted.
+	Nodes []Node
+	// Children holds the maximal strong submodules, ordered by their smallest node. Leaves have none.
+	Children []*Module
+}
+
+/*
+ModularDecomposition returns the modular decomposition tree of an UndirectedGraph.
+
+Parameters:
+- g: The graph.
+
+Returns:
+- root: The module of all the nodes, or nil for a graph without nodes.
+
+Description:
+The tree is built top-down. A set of nodes inducing a disconnected subgraph is a parallel module split into its
+components, one whose complement is disconnected is a series module split into the components of the complement, and
+otherwise it is a prime module whose maximal proper modules partition it. The maximal proper module of a node v is v
+together with every u such that the smallest module containing v and u, found by repeatedly adding the nodes that
+distinguish its members, is not the whole set. This takes polynomial time, O(n^4) in the worst case, and is meant for
+graphs of up to a few hundred nodes.
+*/
+func ModularDecomposition(g *UndirectedGraph) *Module {
+	if len(g.Nodes) == 0 {
+		return nil
+	}
+	return decomposeModule(g, sortedNodes(g.Nodes))
+}
+
+func decomposeModule(g *UndirectedGraph, nodes []Node) *Module {
+	module := &Module{Nodes: nodes}
+	if len(nodes) == 1 {
+		return module
+	}
+
+	var parts [][]Node
+	if parts = moduleComponents(g, nodes, false); len(parts) > 1 {
+		module.Kind = ParallelModule
+	} else if parts = moduleComponents(g, nodes, true); len(parts) > 1 {
+		module.Kind = SeriesModule
+	} else {
+		module.Kind = PrimeModule
+		parts = maximalProperModules(g, nodes)
+	}
+	for _, part := range parts {
+		module.Children = append(module.Children, decomposeModule(g, part))
+	}
+	return module
+}
+
+// moduleComponents returns the connected components of the subgraph induced by nodes, or of its complement, each
+// sorted and ordered by their smallest node.
+func moduleComponents(g *UndirectedGraph, nodes []Node, complement bool) [][]Node {
+	remaining := make(map[Node]bool, len(nodes))
+	for _, node := range nodes {
+		remaining[node] = true
+	}
+	var components [][]Node
+	for _, start := range nodes {
+		if !remaining[start] {
+			continue
+		}
+		delete(remaining, start)
+		component := []Node{start}
+		for i := 0; i < len(component); i++ {
+			for _, node := range sortedNodes(remaining) {
+				if g.HasEdge(component[i], node) != complement {
+					delete(remaining, node)
+					component = append(component, node)
+				}
+			}
+		}
+		sort.Slice(component, func(a, b int) bool { return component[a] < component[b] })
+		components = append(components, component)
+	}
+	return components
+}
+
+// maximalProperModules partitions nodes, whose subgraph and its complement are connected, into its maximal proper modules.
+func maximalProperModules(g *UndirectedGraph, nodes []Node) [][]Node {
+	assigned := make(map[Node]bool, len(nodes))
+	var modules [][]Node
+	for _, v := range nodes {
+		if assigned[v] {
+			continue
+		}
+		module := []Node{v}
+		assigned[v] = true
+		for _, u := range nodes {
+			if !assigned[u] && len(moduleClosure(g, nodes, v, u)) < len(nodes) {
+				module = append(module, u)
+				assigned[u] = true
+			}
+		}
+		modules = append(modules, module)
+	}
+	return modules
+}
+
+// moduleClosure returns the smallest module of the subgraph induced by nodes containing u and v.
+func moduleClosure(g *UndirectedGraph, nodes []Node, u, v Node) map[Node]bool {
+	module := map[Node]bool{u: true, v: true}
+	queue := []Node{v}
+	for len(queue) > 0 {
+		member := queue[0]
+		queue = queue[1:]
+		// A node outside the module splits it if it is adjacent to exactly one of u and the new member
+		for _, node := range nodes {
+			if !module[node] && g.HasEdge(node, u) != g.HasEdge(node, member) {
+				module[node] = true
+				queue = append(queue, node)
+			}
+		}
+	}
+	return module
+}
+
+// IsCograph checks if an UndirectedGraph has no induced path on four nodes, i.e. if its modular decomposition has no prime module.
+func IsCograph(g *UndirectedGraph) bool {
+	_, err := Cotree(g)
+	return err == nil
+}
+
+// Cotree returns the modular decomposition of a cograph, whose internal modules are all parallel or series, or an
+// error if the graph is not a cograph.
+func Cotree(g *UndirectedGraph) (*Module, error) {
+	root := ModularDecomposition(g)
+	var check func(module *Module) error
+	check = func(module *Module) error {
+		if module.Kind == PrimeModule {
+			return fmt.Errorf("the nodes %v form a prime module", module.Nodes)
+		}
+		for _, child := range module.Children {
+			if err := check(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if root != nil {
+		if err := check(root); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+// CographMaximumClique returns a maximum clique of a cograph from its cotree: the union of the cliques of the
+// children of series modules and the largest clique among the children of parallel modules.
+func CographMaximumClique(cotree *Module) []Node {
+	if cotree == nil {
+		return nil
+	}
+	switch cotree.Kind {
+	case LeafModule:
+		return []Node{cotree.Nodes[0]}
+	case SeriesModule:
+		var clique []Node
+		for _, child := range cotree.Children {
+			clique = append(clique, CographMaximumClique(child)...)
+		}
+		sort.Slice(clique, func(i, j int) bool { return clique[i] < clique[j] })
+		return clique
+	default:
+		var best []Node
+		for _, child := range cotree.Children {
+			if clique := CographMaximumClique(child); len(clique) > len(best) {
+				best = clique
+			}
+		}
+		return best
+	}
+}
+
+// CographColoring returns an optimal proper coloring of a cograph from its cotree, with colors from 0: the children
+// of parallel modules reuse the same colors while those of series modules get disjoint ones. The number of colors
+// equals the size of a maximum clique.
+func CographColoring(cotree *Module) map[Node]int {
+	colors := make(map[Node]int)
+	var color func(module *Module, offset int) int
+	color = func(module *Module, offset int) int {
+		switch module.Kind {
+		case LeafModule:
+			colors[module.Nodes[0]] = offset
+			return 1
+		case SeriesModule:
+			used := 0
+			for _, child := range module.Children {
+				used += color(child, offset+used)
+			}
+			return used
+		default:
+			used := 0
+			for _, child := range module.Children {
+				used = max(used, color(child, offset))
+			}
+			return used
+		}
+	}
+	if cotree != nil {
+		color(cotree, 0)
+	}
+	return colors
+}
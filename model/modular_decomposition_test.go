@@ -0,0 +1,124 @@
+package model
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// moduleShape writes a decomposition tree as nested kinds and leaves, e.g. "series(parallel(0 1) 2)".
+func moduleShape(module *Module) string {
+	if module.Kind == LeafModule {
+		return string(rune('0' + module.Nodes[0]))
+	}
+	shape := module.Kind.String() + "("
+	for i, child := range module.Children {
+		if i > 0 {
+			shape += " "
+		}
+		shape += moduleShape(child)
+	}
+	return shape + ")"
+}
+
+func TestModularDecomposition(t *testing.T) {
+	// A path on four nodes whose second node is doubled into adjacent twins
+	g := PathGraph(4)
+	g.AddEdgesFromIntTupleList([][2]int{{0, 4}, {2, 4}, {1, 4}})
+	bipartite := &UndirectedGraph{}
+	bipartite.AddEdgesFromIntTupleList([][2]int{{0, 2}, {0, 3}, {0, 4}, {1, 2}, {1, 3}, {1, 4}})
+	star := StarGraph(3)
+	star.AddNode(3)
+
+	testCases := []struct {
+		name     string
+		g        *UndirectedGraph
+		expected string
+	}{
+		{"path", PathGraph(4), "prime(0 1 2 3)"},
+		{"twins in a path", g, "prime(0 series(1 4) 2 3)"},
+		{"complete bipartite", bipartite, "series(parallel(0 1) parallel(2 3 4))"},
+		{"star and isolated node", star, "parallel(series(0 parallel(1 2)) 3)"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if shape := moduleShape(ModularDecomposition(testCase.g)); shape != testCase.expected {
+				t.Errorf("Expected %s, but got %s", testCase.expected, shape)
+			}
+		})
+	}
+
+	if root := ModularDecomposition(&UndirectedGraph{}); root != nil {
+		t.Errorf("Expected no decomposition for an empty graph, but got %v", root)
+	}
+}
+
+func TestCotree(t *testing.T) {
+	if IsCograph(PathGraph(4)) || IsCograph(CycleGraph(5)) {
+		t.Errorf("Expected graphs with an induced path on four nodes not to be cographs")
+	}
+	if !IsCograph(CycleGraph(4)) || !IsCograph(CompleteGraph(5)) {
+		t.Errorf("Expected a square and a complete graph to be cographs")
+	}
+
+	// Random cographs built by disjoint unions and joins of relabeled copies
+	rng := rand.New(rand.NewSource(1))
+	graphs := []*UndirectedGraph{}
+	for i := 0; i < 12; i++ {
+		g := &UndirectedGraph{}
+		g.AddNode(Node(i))
+		graphs = append(graphs, g)
+	}
+	for len(graphs) > 1 {
+		a, b := graphs[0], graphs[1]
+		joined := copyGraph(a)
+		for node := range b.Nodes {
+			joined.AddNode(node)
+		}
+		for _, edge := range b.GetEdgeTuples() {
+			joined.AddEdge(edge)
+		}
+		if rng.Intn(2) == 0 {
+			for u := range a.Nodes {
+				for v := range b.Nodes {
+					joined.AddEdge(Edge{Node1: u, Node2: v})
+				}
+			}
+		}
+		graphs = append(graphs[2:], joined)
+	}
+	g := graphs[0]
+
+	cotree, err := Cotree(g)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	clique := CographMaximumClique(cotree)
+	for i, u := range clique {
+		for _, v := range clique[i+1:] {
+			if !g.HasEdge(u, v) {
+				t.Errorf("Expected a clique, but %d and %d are not adjacent in %v", u, v, clique)
+			}
+		}
+	}
+	colors := CographColoring(cotree)
+	used := make(map[int]bool)
+	for _, edge := range g.GetEdgeTuples() {
+		if colors[edge.Node1] == colors[edge.Node2] {
+			t.Errorf("Expected a proper coloring, but %d and %d share color %d", edge.Node1, edge.Node2, colors[edge.Node1])
+		}
+	}
+	for _, color := range colors {
+		used[color] = true
+	}
+	if len(colors) != 12 || len(used) != len(clique) {
+		t.Errorf("Expected 12 nodes colored with %d colors, but got %v", len(clique), colors)
+	}
+
+	square := CycleGraph(4)
+	cotree, _ = Cotree(square)
+	if clique := CographMaximumClique(cotree); !reflect.DeepEqual(clique, []Node{0, 1}) {
+		t.Errorf("Expected the clique [0 1], but got %v", clique)
+	}
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+/*
+MorphMutations returns a random sequence of small mutations turning one graph into another, for testing incremental
+algorithms on a stream of changes or animating the transition between two graphs.
+
+Parameters:
+- g1: The graph the sequence starts from.
+- g2: The graph the sequence leads to.
+- seed: The seed of the random number generator; the same seed gives the same sequence.
+
+Returns:
+- mutations: The mutations, which applied in order to a copy of g1 with a VersionedGraph give g2. The nodes of g2
+missing from g1 are added first, in ascending order; then the edges of g1 missing from g2 are removed and those of g2
+missing from g1 added, interleaved in a random order; finally the nodes of g1 missing from g2, left without edges, are
+removed in ascending order. Edges have their smaller node first.
+*/
+func MorphMutations(g1, g2 *UndirectedGraph, seed int64) []Mutation {
+	var mutations []Mutation
+	for _, node := range sortedNodes(g2.Nodes) {
+		if !g1.Nodes[node] {
+			mutations = append(mutations, Mutation{Kind: AddNodeMutation, Node: node})
+		}
+	}
+	var edits []Mutation
+	for _, edge := range missingEdges(g1, g2) {
+		edits = append(edits, Mutation{Kind: RemoveEdgeMutation, Edge: edge})
+	}
+	for _, edge := range missingEdges(g2, g1) {
+		edits = append(edits, Mutation{Kind: AddEdgeMutation, Edge: edge})
+	}
+	rng := rand.New(rand.NewSource(seed))
+	rng.Shuffle(len(edits), func(i, j int) { edits[i], edits[j] = edits[j], edits[i] })
+	mutations = append(mutations, edits...)
+	for _, node := range sortedNodes(g1.Nodes) {
+		if !g2.Nodes[node] {
+			mutations = append(mutations, Mutation{Kind: RemoveNodeMutation, Node: node})
+		}
+	}
+	return mutations
+}
+
+/*
+MorphGraphs interpolates between two graphs with the mutations of MorphMutations.
+
+Parameters:
+- g1, g2: The first and the last graph, left unchanged.
+- steps: The number of steps, at least 1.
+- seed: The seed of the random number generator; the same seed gives the same graphs.
+
+Returns:
+- graphs: steps + 1 graphs, the first a copy of g1 and the last a copy of g2, every step applying about the same
+number of mutations to the previous graph.
+- err: An error if steps is not positive.
+*/
+func MorphGraphs(g1, g2 *UndirectedGraph, steps int, seed int64) ([]*UndirectedGraph, error) {
+	if steps < 1 {
+		return nil, fmt.Errorf("the number of steps must be positive, got %d", steps)
+	}
+	mutations := MorphMutations(g1, g2, seed)
+	current := copyGraph(g1)
+	graphs := []*UndirectedGraph{copyGraph(current)}
+	for step := 1; step <= steps; step++ {
+		for _, m := range mutations[(step-1)*len(mutations)/steps : step*len(mutations)/steps] {
+			m.apply(current)
+		}
+		graphs = append(graphs, copyGraph(current))
+	}
+	return graphs, nil
+}
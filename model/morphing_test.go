@@ -0,0 +1,64 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMorphMutations(t *testing.T) {
+	g1 := CycleGraph(6)
+	g1.AddEdge(Edge{Node1: 2, Node2: 2})
+	g2 := CompleteGraph(4)
+	g2.AddEdge(Edge{Node1: 3, Node2: 9})
+
+	mutations := MorphMutations(g1, g2, 1)
+	// 9 is added; 4 edges are removed and 4 added; 4 and 5 are removed
+	if len(mutations) != 11 {
+		t.Fatalf("Expected 11 mutations, but got %v", mutations)
+	}
+	if first := mutations[0]; first != (Mutation{Kind: AddNodeMutation, Node: 9}) {
+		t.Errorf("Expected node 9 to be added first, but got %v", first)
+	}
+	if last := mutations[9:]; last[0].Node != 4 || last[1].Node != 5 || last[1].Kind != RemoveNodeMutation {
+		t.Errorf("Expected nodes 4 and 5 to be removed last, but got %v", last)
+	}
+
+	g := NewVersionedGraph(g1, nil)
+	for _, m := range mutations {
+		if err := g.Apply(m); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if !g.Graph.Equals(g2) || len(g.Graph.Nodes) != len(g2.Nodes) {
+		t.Errorf("Expected %v, but got %v", g2, g.Graph)
+	}
+	if !reflect.DeepEqual(MorphMutations(g1, g2, 1), mutations) {
+		t.Errorf("Expected the same mutations with the same seed")
+	}
+}
+
+func TestMorphGraphs(t *testing.T) {
+	g1 := PathGraph(20)
+	g2 := StarGraph(20)
+	graphs, err := MorphGraphs(g1, g2, 4, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(graphs) != 5 || !graphs[0].Equals(g1) || !graphs[4].Equals(g2) {
+		t.Fatalf("Expected 5 graphs from the path to the star, but got %d", len(graphs))
+	}
+	// 36 mutations in all, 9 per step
+	for step := 1; step < len(graphs); step++ {
+		diff := len(missingEdges(graphs[step-1], graphs[step])) + len(missingEdges(graphs[step], graphs[step-1]))
+		if diff != 9 {
+			t.Errorf("Expected 9 edges to change at step %d, but got %d", step, diff)
+		}
+	}
+	if !PathGraph(20).Equals(g1) {
+		t.Errorf("Expected the first graph to be unchanged")
+	}
+
+	if _, err := MorphGraphs(g1, g2, 0, 1); err == nil {
+		t.Errorf("Expected an error for no steps")
+	}
+}
@@ -0,0 +1,138 @@
+package model
+
+import "fmt"
+
+/*
+RelabelNodes returns a copy of a graph whose nodes are renamed.
+
+Parameters:
+- g: The graph, left unchanged.
+- mapping: The new name of every renamed node; nodes absent from mapping keep their name.
+
+Returns:
+- relabeled: The relabeled graph, whose adjacency lists follow the order of those of g.
+- err: An error if two nodes would get the same name.
+
+Description:
+The mapping may swap names, as in {1: 2, 2: 1}, since the nodes are renamed all at once.
+*/
+func RelabelNodes(g *UndirectedGraph, mapping map[Node]Node) (*UndirectedGraph, error) {
+	image, err := relabeling(g.Nodes, mapping)
+	if err != nil {
+		return nil, err
+	}
+	relabeled := &UndirectedGraph{Nodes: make(map[Node]bool, len(g.Nodes)), Edges: make(map[Node][]Node, len(g.Edges))}
+	for node := range g.Nodes {
+		relabeled.Nodes[image[node]] = true
+	}
+	for node, neighbors := range g.Edges {
+		if !g.Nodes[node] {
+			continue
+		}
+		renamed := make([]Node, len(neighbors))
+		for i, neighbor := range neighbors {
+			renamed[i] = image[neighbor]
+		}
+		relabeled.Edges[image[node]] = renamed
+	}
+	return relabeled, nil
+}
+
+// relabeling returns the new name of every node, checking that no two nodes get the same name.
+func relabeling(nodes map[Node]bool, mapping map[Node]Node) (map[Node]Node, error) {
+	image := make(map[Node]Node, len(nodes))
+	owner := make(map[Node]Node, len(nodes))
+	for _, node := range sortedNodes(nodes) {
+		name, ok := mapping[node]
+		if !ok {
+			name = node
+		}
+		if other, taken := owner[name]; taken {
+			return nil, fmt.Errorf("nodes %d and %d are both relabeled %d", other, node, name)
+		}
+		image[node], owner[name] = name, node
+	}
+	return image, nil
+}
+
+// RemoveNode removes a node with its edges and their attributes.
+func (a *AttributedGraph) RemoveNode(node Node) {
+	for _, neighbor := range a.Graph.Edges[node] {
+		delete(a.EdgeAttributes, orderedEdge(node, neighbor))
+	}
+	a.Graph.RemoveNode(node)
+	delete(a.NodeAttributes, node)
+}
+
+// RemoveEdge removes an edge and its attributes.
+func (a *AttributedGraph) RemoveEdge(u, v Node) {
+	a.Graph.RemoveEdge(Edge{Node1: u, Node2: v})
+	delete(a.EdgeAttributes, orderedEdge(u, v))
+}
+
+/*
+ContractEdge merges node u into node v, see UndirectedGraph.ContractEdge.
+
+Parameters:
+- u: The node removed, whose attributes are dropped.
+- v: The node kept, whose attributes are preserved.
+
+Description:
+The attributes of an edge of u move with it to v, unless v already had the same edge, whose attributes are then kept.
+The attributes of the contracted edge are dropped.
+*/
+func (a *AttributedGraph) ContractEdge(u, v Node) {
+	if u == v || !a.Graph.Nodes[u] {
+		return
+	}
+	moved := make(map[Edge]map[string]float64)
+	for _, neighbor := range a.Graph.Edges[u] {
+		edge := orderedEdge(u, neighbor)
+		attributes, ok := a.EdgeAttributes[edge]
+		delete(a.EdgeAttributes, edge)
+		if !ok || neighbor == v {
+			continue
+		}
+		if neighbor == u {
+			neighbor = v
+		}
+		if target := orderedEdge(neighbor, v); !a.Graph.HasEdge(neighbor, v) {
+			moved[target] = attributes
+		}
+	}
+	a.Graph.ContractEdge(Edge{Node1: u, Node2: v})
+	delete(a.NodeAttributes, u)
+	for edge, attributes := range moved {
+		a.EdgeAttributes[edge] = attributes
+	}
+}
+
+// RelabelNodes returns a copy of the graph and its attributes whose nodes are renamed, see RelabelNodes.
+func (a *AttributedGraph) RelabelNodes(mapping map[Node]Node) (*AttributedGraph, error) {
+	g, err := RelabelNodes(a.Graph, mapping)
+	if err != nil {
+		return nil, err
+	}
+	image, _ := relabeling(a.Graph.Nodes, mapping)
+	relabeled := NewAttributedGraph(g)
+	for node, attributes := range a.NodeAttributes {
+		if a.Graph.Nodes[node] {
+			relabeled.NodeAttributes[image[node]] = copyAttributes(attributes)
+		}
+	}
+	for edge, attributes := range a.EdgeAttributes {
+		if a.Graph.Nodes[edge.Node1] && a.Graph.Nodes[edge.Node2] {
+			relabeled.EdgeAttributes[orderedEdge(image[edge.Node1], image[edge.Node2])] = copyAttributes(attributes)
+		}
+	}
+	return relabeled, nil
+}
+
+// copyAttributes returns a copy of the attributes of a node or an edge.
+func copyAttributes(attributes map[string]float64) map[string]float64 {
+	copied := make(map[string]float64, len(attributes))
+	for name, value := range attributes {
+		copied[name] = value
+	}
+	return copied
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRelabelNodes(t *testing.T) {
+	g := PathGraph(3)
+	g.AddNode(7)
+	relabeled, err := RelabelNodes(g, map[Node]Node{0: 2, 2: 0, 7: 10})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := &UndirectedGraph{
+		Nodes: map[Node]bool{0: true, 1: true, 2: true, 10: true},
+		Edges: map[Node][]Node{2: {1}, 1: {2, 0}, 0: {1}},
+	}
+	if !reflect.DeepEqual(relabeled, expected) {
+		t.Errorf("Expected %v, but got %v", expected, relabeled)
+	}
+	if !reflect.DeepEqual(g, func() *UndirectedGraph { p := PathGraph(3); p.AddNode(7); return p }()) {
+		t.Errorf("Expected the original graph to be unchanged, but got %v", g)
+	}
+
+	if _, err := RelabelNodes(g, map[Node]Node{0: 1}); err == nil {
+		t.Errorf("Expected an error for two nodes with the same name")
+	}
+}
+
+func TestUndirectedGraph_ContractEdge(t *testing.T) {
+	g := CycleGraph(4)
+	g.AddEdge(Edge{Node1: 0, Node2: 0})
+	g.ContractEdge(Edge{Node1: 0, Node2: 1})
+
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{1, 2}, {2, 3}, {3, 1}, {1, 1}})
+	if !g.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, g)
+	}
+
+	// Contracting a self-loop leaves the graph unchanged
+	g.ContractEdge(Edge{Node1: 1, Node2: 1})
+	if !g.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, g)
+	}
+}
+
+func TestAttributedGraph_Mutations(t *testing.T) {
+	a := NewAttributedGraph(CycleGraph(4))
+	for node := Node(0); node < 4; node++ {
+		a.SetNodeAttribute(node, "weight", float64(node))
+		a.SetEdgeAttribute(node, (node+1)%4, "length", float64(10+node))
+	}
+	a.Graph.AddEdge(Edge{Node1: 1, Node2: 3})
+	a.SetEdgeAttribute(1, 3, "length", 20)
+
+	// Edge (0, 3) moves to (1, 3), which exists, and edge (0, 1) disappears
+	a.ContractEdge(0, 1)
+	if a.Graph.HasNode(0) || a.Graph.NumberOfEdges() != 3 {
+		t.Errorf("Unexpected graph after contraction %v", a.Graph)
+	}
+	expectedEdges := map[Edge]map[string]float64{{1, 2}: {"length": 11}, {2, 3}: {"length": 12}, {1, 3}: {"length": 20}}
+	if !reflect.DeepEqual(a.EdgeAttributes, expectedEdges) {
+		t.Errorf("Expected %v, but got %v", expectedEdges, a.EdgeAttributes)
+	}
+	if _, ok := a.NodeAttribute(0, "weight"); ok {
+		t.Errorf("Expected the attributes of the contracted node to be dropped")
+	}
+
+	relabeled, err := a.RelabelNodes(map[Node]Node{1: 5})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if value, ok := relabeled.EdgeAttribute(3, 5, "length"); !ok || value != 20 {
+		t.Errorf("Expected 20, but got %v", value)
+	}
+	if value, ok := relabeled.NodeAttribute(5, "weight"); !ok || value != 1 {
+		t.Errorf("Expected 1, but got %v", value)
+	}
+
+	a.RemoveEdge(2, 1)
+	a.RemoveNode(3)
+	if a.Graph.NumberOfEdges() != 0 || len(a.EdgeAttributes) != 0 || len(a.NodeAttributes) != 2 {
+		t.Errorf("Expected no edges and the attributes of nodes 1 and 2, but got %v and %v", a.EdgeAttributes, a.NodeAttributes)
+	}
+}
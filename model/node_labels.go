@@ -0,0 +1,153 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// NodeLabels maps real-world identifiers, such as hostnames, IP addresses or names, to nodes and back. Every label
+// names at most one node and every node has at most one label.
+type NodeLabels struct {
+	nodes  map[string]Node
+	labels map[Node]string
+}
+
+// NewNodeLabels returns an empty NodeLabels.
+func NewNodeLabels() *NodeLabels {
+	return &NodeLabels{nodes: make(map[string]Node), labels: make(map[Node]string)}
+}
+
+// SetLabel gives node a label, replacing its previous one; it returns an error if another node has the label.
+func (l *NodeLabels) SetLabel(node Node, label string) error {
+	if other, ok := l.nodes[label]; ok && other != node {
+		return fmt.Errorf("label %q already names node %d", label, other)
+	}
+	if previous, ok := l.labels[node]; ok {
+		delete(l.nodes, previous)
+	}
+	l.nodes[label] = node
+	l.labels[node] = label
+	return nil
+}
+
+// Remove removes the label of node, if any.
+func (l *NodeLabels) Remove(node Node) {
+	if label, ok := l.labels[node]; ok {
+		delete(l.nodes, label)
+		delete(l.labels, node)
+	}
+}
+
+// NodeByLabel returns the node named by label, and false if there is none.
+func (l *NodeLabels) NodeByLabel(label string) (Node, bool) {
+	node, ok := l.nodes[label]
+	return node, ok
+}
+
+// Label returns the label of node, and false if it has none.
+func (l *NodeLabels) Label(node Node) (string, bool) {
+	label, ok := l.labels[node]
+	return label, ok
+}
+
+// Len returns the number of labelled nodes.
+func (l *NodeLabels) Len() int {
+	return len(l.labels)
+}
+
+// LabelsOf returns the labels of nodes, such as the result of an algorithm, in the same order. Nodes without a label
+// are given their number.
+func (l *NodeLabels) LabelsOf(nodes []Node) []string {
+	labels := make([]string, len(nodes))
+	for i, node := range nodes {
+		label, ok := l.labels[node]
+		if !ok {
+			label = strconv.Itoa(int(node))
+		}
+		labels[i] = label
+	}
+	return labels
+}
+
+// NodesOf returns the nodes named by labels, in the same order, or an error if a label names no node.
+func (l *NodeLabels) NodesOf(labels []string) ([]Node, error) {
+	nodes := make([]Node, len(labels))
+	for i, label := range labels {
+		node, ok := l.nodes[label]
+		if !ok {
+			return nil, fmt.Errorf("no node is labelled %q", label)
+		}
+		nodes[i] = node
+	}
+	return nodes, nil
+}
+
+/*
+NamedGraph is an UndirectedGraph whose nodes can be created and found by label, so that graphs of real-world entities
+do not need a separate table of node numbers.
+
+Algorithms and generators work on Graph as usual, and their results are translated with Labels.LabelsOf and
+Labels.NodesOf. A generated graph can be wrapped with NewNamedGraph and labelled with Labels.SetLabel. Nodes removed
+through RemoveNodeWithLabel lose their label, while those removed from Graph directly keep it until Labels.Remove.
+*/
+type NamedGraph struct {
+	Graph  *UndirectedGraph
+	Labels *NodeLabels
+	next   Node
+}
+
+// NewNamedGraph wraps g, or an empty graph if g is nil, without labels.
+func NewNamedGraph(g *UndirectedGraph) *NamedGraph {
+	if g == nil {
+		g = &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	}
+	n := &NamedGraph{Graph: g, Labels: NewNodeLabels()}
+	for node := range g.Nodes {
+		n.next = max(n.next, node+1)
+	}
+	return n
+}
+
+// AddNodeWithLabel returns the node named by label, adding it with the next unused node number if it is new.
+func (n *NamedGraph) AddNodeWithLabel(label string) Node {
+	if node, ok := n.Labels.NodeByLabel(label); ok {
+		n.Graph.AddNode(node)
+		return node
+	}
+	for n.Graph.Nodes[n.next] {
+		n.next++
+	}
+	node := n.next
+	n.next++
+	n.Graph.AddNode(node)
+	n.Labels.SetLabel(node, label)
+	return node
+}
+
+// AddEdgeWithLabels adds an edge between the nodes named by two labels, adding them if they are new, and returns it.
+func (n *NamedGraph) AddEdgeWithLabels(label1, label2 string) Edge {
+	edge := Edge{Node1: n.AddNodeWithLabel(label1), Node2: n.AddNodeWithLabel(label2)}
+	n.Graph.AddEdge(edge)
+	return edge
+}
+
+// RemoveNodeWithLabel removes the node named by label, its edges and its label, and reports whether it existed.
+func (n *NamedGraph) RemoveNodeWithLabel(label string) bool {
+	node, ok := n.Labels.NodeByLabel(label)
+	if !ok {
+		return false
+	}
+	n.Graph.RemoveNode(node)
+	n.Labels.Remove(node)
+	return true
+}
+
+// NodeByLabel returns the node named by label, and false if there is none.
+func (n *NamedGraph) NodeByLabel(label string) (Node, bool) {
+	return n.Labels.NodeByLabel(label)
+}
+
+// Label returns the label of node, and false if it has none.
+func (n *NamedGraph) Label(node Node) (string, bool) {
+	return n.Labels.Label(node)
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNodeLabels(t *testing.T) {
+	l := NewNodeLabels()
+	if err := l.SetLabel(3, "router"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := l.SetLabel(5, "router"); err == nil {
+		t.Errorf("Expected an error for a label naming two nodes")
+	}
+	if err := l.SetLabel(3, "switch"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, ok := l.NodeByLabel("router"); ok {
+		t.Errorf("Expected the previous label to be released")
+	}
+	if node, ok := l.NodeByLabel("switch"); !ok || node != 3 || l.Len() != 1 {
+		t.Errorf("Expected switch to name node 3, but got %d", node)
+	}
+	if labels := l.LabelsOf([]Node{3, 4}); !reflect.DeepEqual(labels, []string{"switch", "4"}) {
+		t.Errorf("Expected [switch 4], but got %v", labels)
+	}
+	if _, err := l.NodesOf([]string{"switch", "router"}); err == nil {
+		t.Errorf("Expected an error for an unknown label")
+	}
+	l.Remove(3)
+	if _, ok := l.Label(3); ok || l.Len() != 0 {
+		t.Errorf("Expected node 3 to have no label")
+	}
+}
+
+func TestNamedGraph(t *testing.T) {
+	n := NewNamedGraph(PathGraph(3))
+	edge := n.AddEdgeWithLabels("10.0.0.1", "10.0.0.2")
+	if edge != (Edge{Node1: 3, Node2: 4}) {
+		t.Errorf("Expected the new nodes 3 and 4, but got %v", edge)
+	}
+	n.AddEdgeWithLabels("10.0.0.2", "10.0.0.3")
+	if node := n.AddNodeWithLabel("10.0.0.1"); node != 3 {
+		t.Errorf("Expected the existing node 3, but got %d", node)
+	}
+	if len(n.Graph.Nodes) != 6 || n.Graph.NumberOfEdges() != 4 {
+		t.Errorf("Expected 6 nodes and 4 edges, but got %d and %d", len(n.Graph.Nodes), n.Graph.NumberOfEdges())
+	}
+
+	// Algorithms run on the graph and their results are translated back
+	paths, err := BFSShortestPaths(n.Graph, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if labels := n.Labels.LabelsOf(paths.PathTo(5)); !reflect.DeepEqual(labels, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}) {
+		t.Errorf("Expected the path through 10.0.0.2, but got %v", labels)
+	}
+
+	if !n.RemoveNodeWithLabel("10.0.0.2") || n.RemoveNodeWithLabel("10.0.0.2") {
+		t.Errorf("Expected 10.0.0.2 to be removed once")
+	}
+	if _, ok := n.NodeByLabel("10.0.0.2"); ok || n.Graph.HasNode(4) {
+		t.Errorf("Expected node 4 and its label to be gone")
+	}
+	if node := n.AddNodeWithLabel("10.0.0.4"); node != 6 {
+		t.Errorf("Expected the next unused node 6, but got %d", node)
+	}
+}
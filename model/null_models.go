@@ -0,0 +1,78 @@
+package model
+
+import "math/rand"
+
+// NullModel draws a random graph resembling g, keeping some of its properties fixed. It must draw all its randomness from rng.
+type NullModel func(g *UndirectedGraph, rng *rand.Rand) *UndirectedGraph
+
+// GNMNullModel draws a graph with the nodes of g and as many edges, chosen uniformly at random: the G(n, m) model.
+func GNMNullModel(g *UndirectedGraph, rng *rand.Rand) *UndirectedGraph {
+	nodes := sortedNodes(g.Nodes)
+	sample := &UndirectedGraph{Nodes: make(map[Node]bool, len(nodes)), Edges: make(map[Node][]Node)}
+	sample.AddNodes(nodes)
+	edges := min(g.NumberOfEdges(), len(nodes)*(len(nodes)-1)/2)
+	for sample.NumberOfEdges() < edges {
+		u, v := nodes[rng.Intn(len(nodes))], nodes[rng.Intn(len(nodes))]
+		if u != v && !sample.HasEdge(u, v) {
+			sample.AddEdge(Edge{Node1: u, Node2: v})
+		}
+	}
+	return sample
+}
+
+/*
+ConfigurationNullModel draws a graph with the nodes of g by randomly matching the edge endpoints of g: the erased
+configuration model.
+
+Every node gets as many stubs as its degree, the stubs are shuffled and paired, and the self-loops and multiple edges
+this creates are dropped, so that nodes may end up with a slightly lower degree than in g.
+*/
+func ConfigurationNullModel(g *UndirectedGraph, rng *rand.Rand) *UndirectedGraph {
+	nodes := sortedNodes(g.Nodes)
+	sample := &UndirectedGraph{Nodes: make(map[Node]bool, len(nodes)), Edges: make(map[Node][]Node)}
+	sample.AddNodes(nodes)
+	var stubs []Node
+	for _, node := range nodes {
+		for i := 0; i < g.NodeDegree(node); i++ {
+			stubs = append(stubs, node)
+		}
+	}
+	rng.Shuffle(len(stubs), func(i, j int) { stubs[i], stubs[j] = stubs[j], stubs[i] })
+	for i := 0; i+1 < len(stubs); i += 2 {
+		if stubs[i] != stubs[i+1] {
+			sample.AddEdge(Edge{Node1: stubs[i], Node2: stubs[i+1]})
+		}
+	}
+	return sample
+}
+
+/*
+RewiringNullModel returns a null model that shuffles a copy of g with degree-preserving double edge swaps.
+
+At every step two edges a - b and c - d are drawn at random and replaced by a - d and c - b, unless this creates a
+self-loop or an existing edge. swapsPerEdge * m swaps are attempted, m being the number of edges of g. The degree of
+every node is preserved exactly.
+*/
+func RewiringNullModel(swapsPerEdge int) NullModel {
+	return func(g *UndirectedGraph, rng *rand.Rand) *UndirectedGraph {
+		sample := copyGraph(g)
+		edges := sortedEdgeList(sample)
+		for attempt := 0; attempt < swapsPerEdge*len(edges) && len(edges) >= 2; attempt++ {
+			i, j := rng.Intn(len(edges)), rng.Intn(len(edges))
+			a, b := edges[i].Node1, edges[i].Node2
+			c, d := edges[j].Node1, edges[j].Node2
+			if rng.Intn(2) == 0 {
+				c, d = d, c
+			}
+			if a == b || c == d || a == d || c == b || sample.HasEdge(a, d) || sample.HasEdge(c, b) {
+				continue
+			}
+			sample.RemoveEdge(Edge{Node1: a, Node2: b})
+			sample.RemoveEdge(Edge{Node1: c, Node2: d})
+			sample.AddEdge(Edge{Node1: a, Node2: d})
+			sample.AddEdge(Edge{Node1: c, Node2: b})
+			edges[i], edges[j] = Edge{Node1: a, Node2: d}, Edge{Node1: c, Node2: b}
+		}
+		return sample
+	}
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGNMNullModel(t *testing.T) {
+	g := LollipopGraph(5, 4)
+	sample := GNMNullModel(g, rand.New(rand.NewSource(1)))
+	if len(sample.Nodes) != len(g.Nodes) || sample.NumberOfEdges() != g.NumberOfEdges() {
+		t.Errorf("Expected %d nodes and %d edges, but got %d and %d", len(g.Nodes), g.NumberOfEdges(), len(sample.Nodes), sample.NumberOfEdges())
+	}
+}
+
+func TestConfigurationNullModel(t *testing.T) {
+	g := StarGraph(6)
+	g.AddEdgesFromIntTupleList([][2]int{{1, 2}, {3, 4}})
+	sample := ConfigurationNullModel(g, rand.New(rand.NewSource(1)))
+	if len(sample.Nodes) != len(g.Nodes) {
+		t.Errorf("Expected %d nodes, but got %d", len(g.Nodes), len(sample.Nodes))
+	}
+	for node := range g.Nodes {
+		if sample.NodeDegree(node) > g.NodeDegree(node) || sample.HasEdge(node, node) {
+			t.Errorf("Expected node %d to have at most degree %d and no self-loop, but got %v", node, g.NodeDegree(node), sample.Edges[node])
+		}
+	}
+}
+
+func TestRewiringNullModel(t *testing.T) {
+	g := LadderGraph(8)
+	sample := RewiringNullModel(10)(g, rand.New(rand.NewSource(1)))
+	for node := range g.Nodes {
+		if sample.NodeDegree(node) != g.NodeDegree(node) || sample.HasEdge(node, node) {
+			t.Errorf("Expected node %d to keep degree %d without self-loop, but got %v", node, g.NodeDegree(node), sample.Edges[node])
+		}
+	}
+	if sample.Equals(g) {
+		t.Errorf("Expected the edges to be rewired")
+	}
+	if g.NumberOfEdges() != 22 {
+		t.Errorf("Expected the original graph to be unchanged, but got %d edges", g.NumberOfEdges())
+	}
+}
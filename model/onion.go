@@ -0,0 +1,70 @@
+package model
+
+/*
+OnionDecomposition computes the core number and the onion layer of every node.
+
+Parameters:
+- g: The graph.
+
+Returns:
+- cores: The core number of every node, the largest k such that the node belongs to the k-core.
+- layers: The onion layer of every node, starting at 1.
+
+Description:
+Nodes are peeled in rounds. At every round, all the remaining nodes whose remaining degree is at most the current
+core number k are removed together and form a layer; when none is left, k is raised to the minimum remaining degree.
+Layers refine the k-shells: nodes peeled early within a shell are closer to its periphery.
+
+Example:
+
+	g := LollipopGraph(4, 2)
+	cores, layers := OnionDecomposition(g)
+*/
+func OnionDecomposition(g *UndirectedGraph) (cores map[Node]int, layers map[Node]int) {
+	cores = make(map[Node]int, len(g.Nodes))
+	layers = make(map[Node]int, len(g.Nodes))
+
+	degrees := make(map[Node]int, len(g.Nodes))
+	for node := range g.Nodes {
+		degrees[node] = g.NodeDegree(node)
+	}
+
+	k, layer := 0, 1
+	for len(degrees) > 0 {
+		var peeled []Node
+		minDegree := -1
+		for node, degree := range degrees {
+			if degree <= k {
+				peeled = append(peeled, node)
+			}
+			if minDegree < 0 || degree < minDegree {
+				minDegree = degree
+			}
+		}
+		if len(peeled) == 0 {
+			k = minDegree
+			continue
+		}
+
+		for _, node := range peeled {
+			cores[node] = k
+			layers[node] = layer
+			delete(degrees, node)
+		}
+		for _, node := range peeled {
+			for _, neighbor := range g.Edges[node] {
+				if _, remaining := degrees[neighbor]; remaining {
+					degrees[neighbor]--
+				}
+			}
+		}
+		layer++
+	}
+	return cores, layers
+}
+
+// CoreNumbers returns the core number of every node, see OnionDecomposition.
+func CoreNumbers(g *UndirectedGraph) map[Node]int {
+	cores, _ := OnionDecomposition(g)
+	return cores
+}
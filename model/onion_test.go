@@ -0,0 +1,27 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOnionDecomposition(t *testing.T) {
+	g := CompleteGraph(4)
+	g.AddEdgesFromIntTupleList([][2]int{{3, 4}, {4, 5}})
+	g.AddNode(9)
+
+	cores, layers := OnionDecomposition(g)
+	expectedCores := map[Node]int{0: 3, 1: 3, 2: 3, 3: 3, 4: 1, 5: 1, 9: 0}
+	expectedLayers := map[Node]int{0: 4, 1: 4, 2: 4, 3: 4, 4: 3, 5: 2, 9: 1}
+	if !reflect.DeepEqual(cores, expectedCores) {
+		t.Errorf("Expected cores %v, but got %v", expectedCores, cores)
+	}
+	if !reflect.DeepEqual(layers, expectedLayers) {
+		t.Errorf("Expected layers %v, but got %v", expectedLayers, layers)
+	}
+
+	// Every node of a cycle is in the 2-core and the same layer
+	if cores := CoreNumbers(CycleGraph(5)); !reflect.DeepEqual(cores, map[Node]int{0: 2, 1: 2, 2: 2, 3: 2, 4: 2}) {
+		t.Errorf("Expected every core number to be 2, but got %v", cores)
+	}
+}
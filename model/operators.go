@@ -0,0 +1,213 @@
+package model
+
+import "fmt"
+
+/*
+Subdivide replaces every edge of a graph by a path of k edges.
+
+Parameters:
+- g: The graph. It is not modified.
+- k: The number of edges of every path, at least 1; k = 1 returns a copy of g.
+
+Returns:
+- subdivided: The graph with the nodes of g and k - 1 new nodes per edge. The new nodes are numbered from the
+largest node of g plus one, edge after edge in ascending order of the edges (smaller node first), from Node1 to Node2.
+- err: An error if k is smaller than 1.
+*/
+func Subdivide(g *UndirectedGraph, k int) (*UndirectedGraph, error) {
+	if k < 1 {
+		return nil, fmt.Errorf("edges must be replaced by paths of at least one edge, got %d", k)
+	}
+	subdivided := &UndirectedGraph{Nodes: make(map[Node]bool, len(g.Nodes)), Edges: make(map[Node][]Node)}
+	next := Node(0)
+	for node := range g.Nodes {
+		subdivided.AddNode(node)
+		next = max(next, node+1)
+	}
+	for _, edge := range sortedEdgeList(g) {
+		previous := edge.Node1
+		for i := 1; i < k; i++ {
+			subdivided.AddEdge(Edge{Node1: previous, Node2: next})
+			previous = next
+			next++
+		}
+		subdivided.AddEdge(Edge{Node1: previous, Node2: edge.Node2})
+	}
+	return subdivided, nil
+}
+
+/*
+GraphPower returns the k-th power of a graph, linking every two nodes at distance at most k.
+
+Parameters:
+- g: The graph. It is not modified.
+- k: The largest distance between linked nodes, at least 1; k = 1 returns a copy of g without self-loops.
+
+Returns:
+- power: The graph with the nodes of g and an edge between every two distinct nodes joined by a path of at most k edges in g.
+- err: An error if k is smaller than 1.
+
+Description:
+A breadth-first search limited to depth k is run from every node, in O(n (n + m)) time in the worst case.
+*/
+func GraphPower(g *UndirectedGraph, k int) (*UndirectedGraph, error) {
+	if k < 1 {
+		return nil, fmt.Errorf("the power must be at least 1, got %d", k)
+	}
+	power := &UndirectedGraph{Nodes: make(map[Node]bool, len(g.Nodes)), Edges: make(map[Node][]Node)}
+	for _, source := range sortedNodes(g.Nodes) {
+		power.AddNode(source)
+		distances := map[Node]int{source: 0}
+		queue := []Node{source}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			if distances[node] == k {
+				continue
+			}
+			for _, neighbor := range g.Edges[node] {
+				if _, seen := distances[neighbor]; !seen {
+					distances[neighbor] = distances[node] + 1
+					queue = append(queue, neighbor)
+					if source < neighbor {
+						power.AddEdge(Edge{Node1: source, Node2: neighbor})
+					}
+				}
+			}
+		}
+	}
+	return power, nil
+}
+
+// Union returns the graph with the nodes and edges of both graphs, nodes with the same number being the same node.
+// See DisjointUnion to keep the nodes of the two graphs apart.
+func Union(g1, g2 *UndirectedGraph) *UndirectedGraph {
+	union := &UndirectedGraph{Nodes: make(map[Node]bool, len(g1.Nodes)+len(g2.Nodes)), Edges: make(map[Node][]Node)}
+	for _, g := range []*UndirectedGraph{g1, g2} {
+		for node := range g.Nodes {
+			union.AddNode(node)
+		}
+		for _, edge := range sortedEdgeList(g) {
+			union.AddEdge(edge)
+		}
+	}
+	return union
+}
+
+// DisjointUnion returns the union of copies of two graphs with distinct nodes: the nodes of g1 are numbered from 0 in
+// ascending order, followed by those of g2, from len(g1.Nodes).
+func DisjointUnion(g1, g2 *UndirectedGraph) *UndirectedGraph {
+	union := &UndirectedGraph{Nodes: make(map[Node]bool, len(g1.Nodes)+len(g2.Nodes)), Edges: make(map[Node][]Node)}
+	offset := 0
+	for _, g := range []*UndirectedGraph{g1, g2} {
+		rank := nodeRanks(g)
+		for _, node := range sortedNodes(g.Nodes) {
+			union.AddNode(Node(offset + rank[node]))
+		}
+		for _, edge := range sortedEdgeList(g) {
+			union.AddEdge(Edge{Node1: Node(offset + rank[edge.Node1]), Node2: Node(offset + rank[edge.Node2])})
+		}
+		offset += len(g.Nodes)
+	}
+	return union
+}
+
+// Intersection returns the graph with the nodes and the edges that belong to both graphs.
+func Intersection(g1, g2 *UndirectedGraph) *UndirectedGraph {
+	intersection := &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	for _, node := range sortedNodes(g1.Nodes) {
+		if g2.Nodes[node] {
+			intersection.AddNode(node)
+		}
+	}
+	for _, edge := range sortedEdgeList(g1) {
+		if g2.HasEdge(edge.Node1, edge.Node2) {
+			intersection.AddEdge(edge)
+		}
+	}
+	return intersection
+}
+
+// Complement returns the graph with the nodes of g and an edge between every two distinct nodes that are not adjacent
+// in g. It has no self-loops.
+func Complement(g *UndirectedGraph) *UndirectedGraph {
+	complement := &UndirectedGraph{Nodes: make(map[Node]bool, len(g.Nodes)), Edges: make(map[Node][]Node)}
+	nodes := sortedNodes(g.Nodes)
+	for i, u := range nodes {
+		complement.AddNode(u)
+		adjacent := make(map[Node]bool, len(g.Edges[u]))
+		for _, neighbor := range g.Edges[u] {
+			adjacent[neighbor] = true
+		}
+		for _, v := range nodes[i+1:] {
+			if !adjacent[v] {
+				complement.AddEdge(Edge{Node1: u, Node2: v})
+			}
+		}
+	}
+	return complement
+}
+
+/*
+CartesianProduct returns the Cartesian product of two graphs, such as the grid of two paths or the prism of a cycle.
+
+Parameters:
+- g1, g2: The graphs. They are not modified.
+
+Returns:
+- product: The graph whose nodes are the pairs (u, v) of a node u of g1 and a node v of g2, (u, v) and (u', v') being
+adjacent if u = u' and v, v' are adjacent in g2, or if v = v' and u, u' are adjacent in g1. The pair (u, v) is numbered
+i len(g2.Nodes) + j, where i and j are the ranks of u and v among the nodes of their graph in ascending order.
+*/
+func CartesianProduct(g1, g2 *UndirectedGraph) *UndirectedGraph {
+	product := emptyProduct(g1, g2)
+	rank1, rank2, n2 := nodeRanks(g1), nodeRanks(g2), len(g2.Nodes)
+	pair := func(u, v Node) Node { return Node(rank1[u]*n2 + rank2[v]) }
+	for _, u := range sortedNodes(g1.Nodes) {
+		for _, edge := range sortedEdgeList(g2) {
+			product.AddEdge(Edge{Node1: pair(u, edge.Node1), Node2: pair(u, edge.Node2)})
+		}
+	}
+	for _, edge := range sortedEdgeList(g1) {
+		for _, v := range sortedNodes(g2.Nodes) {
+			product.AddEdge(Edge{Node1: pair(edge.Node1, v), Node2: pair(edge.Node2, v)})
+		}
+	}
+	return product
+}
+
+// TensorProduct returns the tensor (categorical) product of two graphs, in which (u, v) and (u', v') are adjacent if
+// u, u' are adjacent in g1 and v, v' are adjacent in g2. The pairs are numbered as in CartesianProduct; a pair has a
+// self-loop if both of its nodes have one.
+func TensorProduct(g1, g2 *UndirectedGraph) *UndirectedGraph {
+	product := emptyProduct(g1, g2)
+	rank1, rank2, n2 := nodeRanks(g1), nodeRanks(g2), len(g2.Nodes)
+	pair := func(u, v Node) Node { return Node(rank1[u]*n2 + rank2[v]) }
+	edges2 := sortedEdgeList(g2)
+	for _, a := range sortedEdgeList(g1) {
+		for _, b := range edges2 {
+			product.AddEdge(Edge{Node1: pair(a.Node1, b.Node1), Node2: pair(a.Node2, b.Node2)})
+			product.AddEdge(Edge{Node1: pair(a.Node1, b.Node2), Node2: pair(a.Node2, b.Node1)})
+		}
+	}
+	return product
+}
+
+// emptyProduct returns the graph with the len(g1.Nodes) len(g2.Nodes) nodes of a product and no edges.
+func emptyProduct(g1, g2 *UndirectedGraph) *UndirectedGraph {
+	n := len(g1.Nodes) * len(g2.Nodes)
+	product := &UndirectedGraph{Nodes: make(map[Node]bool, n), Edges: make(map[Node][]Node)}
+	for node := 0; node < n; node++ {
+		product.AddNode(Node(node))
+	}
+	return product
+}
+
+// nodeRanks returns the rank of every node of g in ascending order, from 0.
+func nodeRanks(g *UndirectedGraph) map[Node]int {
+	ranks := make(map[Node]int, len(g.Nodes))
+	for i, node := range sortedNodes(g.Nodes) {
+		ranks[node] = i
+	}
+	return ranks
+}
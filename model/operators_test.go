@@ -0,0 +1,137 @@
+package model
+
+import "testing"
+
+func TestSubdivide(t *testing.T) {
+	g := PathGraph(3)
+	subdivided, err := Subdivide(g, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 3}, {3, 4}, {4, 1}, {1, 5}, {5, 6}, {6, 2}})
+	if !subdivided.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, subdivided)
+	}
+
+	// Subdividing a complete graph adds one node and one edge per edge
+	subdivided, err = Subdivide(CompleteGraph(4), 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(subdivided.Nodes) != 10 || subdivided.NumberOfEdges() != 12 {
+		t.Errorf("Expected 10 nodes and 12 edges, but got %d and %d", len(subdivided.Nodes), subdivided.NumberOfEdges())
+	}
+
+	if same, _ := Subdivide(g, 1); !same.Equals(g) {
+		t.Errorf("Expected a copy of the graph, but got %v", same)
+	}
+	if _, err := Subdivide(g, 0); err == nil {
+		t.Errorf("Expected an error for k = 0")
+	}
+}
+
+func TestGraphPower(t *testing.T) {
+	square, err := GraphPower(CycleGraph(6), 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := CycleGraph(6)
+	for i := 0; i < 6; i++ {
+		expected.AddEdge(Edge{Node1: Node(i), Node2: Node((i + 2) % 6)})
+	}
+	if !square.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, square)
+	}
+
+	// A path becomes complete once the power reaches its length
+	g := PathGraph(5)
+	g.AddNode(7)
+	power, err := GraphPower(g, 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected = CompleteGraph(5)
+	expected.AddNode(7)
+	if !power.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, power)
+	}
+
+	if _, err := GraphPower(g, 0); err == nil {
+		t.Errorf("Expected an error for k = 0")
+	}
+}
+
+func TestUnionAndIntersection(t *testing.T) {
+	g1 := PathGraph(3)
+	g2 := &UndirectedGraph{}
+	g2.AddEdgesFromIntTupleList([][2]int{{1, 2}, {2, 3}})
+	g2.AddNode(7)
+
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 3}})
+	expected.AddNode(7)
+	if union := Union(g1, g2); !union.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, union)
+	}
+
+	expected = &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{1, 2}})
+	if intersection := Intersection(g1, g2); !intersection.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, intersection)
+	}
+
+	// The nodes of g2 are renumbered after those of g1: 1, 2, 3 and 7 become 3, 4, 5 and 6
+	expected = PathGraph(3)
+	expected.AddEdgesFromIntTupleList([][2]int{{3, 4}, {4, 5}})
+	expected.AddNode(6)
+	if union := DisjointUnion(g1, g2); !union.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, union)
+	}
+}
+
+func TestComplement(t *testing.T) {
+	if complement := Complement(CycleGraph(5)); !complement.Equals(CirculantGraph(5, 2)) {
+		t.Errorf("Expected the pentagram, but got %v", complement)
+	}
+	complement := Complement(CompleteGraph(4))
+	if len(complement.Nodes) != 4 || complement.NumberOfEdges() != 0 {
+		t.Errorf("Expected 4 isolated nodes, but got %v", complement)
+	}
+	if twice := Complement(Complement(PathGraph(4))); !twice.Equals(PathGraph(4)) {
+		t.Errorf("Expected the complement of the complement to be the graph, but got %v", twice)
+	}
+}
+
+func TestGraphProducts(t *testing.T) {
+	if grid := CartesianProduct(PathGraph(2), PathGraph(3)); !grid.Equals(GridGraph(2, 3)) {
+		t.Errorf("Expected the 2 x 3 grid, but got %v", grid)
+	}
+	// The product of two edges is a square
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 3}, {3, 2}, {2, 0}})
+	if square := CartesianProduct(PathGraph(2), PathGraph(2)); !square.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, square)
+	}
+
+	// The tensor product of two triangles is the rook's complement on a 3 x 3 board
+	tensor := TensorProduct(CompleteGraph(3), CompleteGraph(3))
+	if expected := Complement(RookGraph(3, 3)); !tensor.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, tensor)
+	}
+	// The tensor product of an edge and an odd cycle is an even cycle
+	tensor = TensorProduct(PathGraph(2), CycleGraph(3))
+	if len(tensor.Nodes) != 6 || tensor.NumberOfEdges() != 6 || !IsConnected(tensor) {
+		t.Errorf("Expected a hexagon, but got %v", tensor)
+	}
+	looped := PathGraph(2)
+	looped.AddEdge(Edge{Node1: 0, Node2: 0})
+	if tensor = TensorProduct(looped, looped); !tensor.HasEdge(0, 0) || tensor.HasEdge(3, 3) {
+		t.Errorf("Expected a self-loop on the pair of looped nodes only, but got %v", tensor)
+	}
+
+	empty := CartesianProduct(NullGraph(), PathGraph(3))
+	if len(empty.Nodes) != 0 {
+		t.Errorf("Expected the null graph, but got %v", empty)
+	}
+}
@@ -0,0 +1,184 @@
+package model
+
+import "sort"
+
+/*
+LexBFS returns a lexicographic breadth-first search ordering of the nodes of an UndirectedGraph.
+
+Parameters:
+- g: The graph to order.
+- start: The first node of the ordering. If it is not part of the graph, the node with the smallest id is used.
+
+Returns:
+- ordering: All the nodes of g. Every node is visited before the nodes whose set of already visited neighbours is lexicographically smaller, which is what chordality and interval recognition algorithms rely on.
+
+Description:
+The ordering is computed by partition refinement in O(n^2) time. Ties are broken by node id, so the result is deterministic. Disconnected graphs are handled by continuing with the remaining nodes once a component is exhausted.
+
+Reference: D. J. Rose, R. E. Tarjan and G. S. Lueker, "Algorithmic aspects of vertex elimination on graphs", SIAM Journal on Computing 5, 1976.
+*/
+func LexBFS(g *UndirectedGraph, start Node) []Node {
+	nodes := sortedNodes(g.Nodes)
+	if g.HasNode(start) {
+		for i, node := range nodes {
+			if node == start {
+				copy(nodes[1:i+1], nodes[:i])
+				nodes[0] = start
+				break
+			}
+		}
+	}
+
+	// classes is an ordered partition of the unvisited nodes; the next node is always taken from the first class
+	var classes [][]Node
+	if len(nodes) > 0 {
+		classes = append(classes, nodes)
+	}
+	ordering := make([]Node, 0, len(nodes))
+	for len(classes) > 0 {
+		node := classes[0][0]
+		classes[0] = classes[0][1:]
+		ordering = append(ordering, node)
+
+		neighbors := make(map[Node]bool, len(g.Edges[node]))
+		for _, neighbor := range g.Edges[node] {
+			neighbors[neighbor] = true
+		}
+
+		refined := make([][]Node, 0, len(classes)+1)
+		for _, class := range classes {
+			var inside, outside []Node
+			for _, member := range class {
+				if neighbors[member] {
+					inside = append(inside, member)
+				} else {
+					outside = append(outside, member)
+				}
+			}
+			if len(inside) > 0 {
+				refined = append(refined, inside)
+			}
+			if len(outside) > 0 {
+				refined = append(refined, outside)
+			}
+		}
+		classes = refined
+	}
+	return ordering
+}
+
+/*
+IsChordal checks if every cycle of length four or more in an UndirectedGraph has a chord.
+
+Description:
+A graph is chordal if and only if the reverse of a LexBFS ordering is a perfect elimination ordering, i.e. the neighbours of every node that come after it in the ordering form a clique.
+*/
+func IsChordal(g *UndirectedGraph) bool {
+	ordering := LexBFS(g, 0)
+	for i, j := 0, len(ordering)-1; i < j; i, j = i+1, j-1 {
+		ordering[i], ordering[j] = ordering[j], ordering[i]
+	}
+	return IsPerfectEliminationOrdering(g, ordering)
+}
+
+// IsPerfectEliminationOrdering checks if, for every node, its neighbours that come later in the ordering form a clique.
+func IsPerfectEliminationOrdering(g *UndirectedGraph, ordering []Node) bool {
+	position := make(map[Node]int, len(ordering))
+	for i, node := range ordering {
+		position[node] = i
+	}
+
+	for i, node := range ordering {
+		// the later neighbours must all be adjacent to the earliest of them
+		var later []Node
+		for _, neighbor := range g.Edges[node] {
+			if position[neighbor] > i {
+				later = append(later, neighbor)
+			}
+		}
+		if len(later) < 2 {
+			continue
+		}
+		sort.Slice(later, func(a, b int) bool { return position[later[a]] < position[later[b]] })
+		for _, other := range later[1:] {
+			if !g.HasEdge(later[0], other) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+/*
+CuthillMcKeeOrdering returns the Cuthill–McKee ordering of the nodes of an UndirectedGraph.
+
+Description:
+Each connected component is traversed breadth-first from one of its nodes of minimum degree, visiting the neighbours of every node by increasing degree. Numbering the nodes in this order concentrates the non-zero entries of the adjacency matrix close to the diagonal. Ties are broken by node id.
+
+Reference: E. Cuthill and J. McKee, "Reducing the bandwidth of sparse symmetric matrices", ACM National Conference, 1969.
+*/
+func CuthillMcKeeOrdering(g *UndirectedGraph) []Node {
+	byDegree := func(nodes []Node) {
+		sort.SliceStable(nodes, func(i, j int) bool {
+			di, dj := g.NodeDegree(nodes[i]), g.NodeDegree(nodes[j])
+			if di == dj {
+				return nodes[i] < nodes[j]
+			}
+			return di < dj
+		})
+	}
+
+	candidates := sortedNodes(g.Nodes)
+	byDegree(candidates)
+
+	visited := make(map[Node]bool, len(candidates))
+	ordering := make([]Node, 0, len(candidates))
+	for _, start := range candidates {
+		if visited[start] {
+			continue
+		}
+		visited[start] = true
+		queue := []Node{start}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			ordering = append(ordering, node)
+
+			var next []Node
+			for _, neighbor := range g.Edges[node] {
+				if !visited[neighbor] {
+					visited[neighbor] = true
+					next = append(next, neighbor)
+				}
+			}
+			byDegree(next)
+			queue = append(queue, next...)
+		}
+	}
+	return ordering
+}
+
+// ReverseCuthillMcKeeOrdering returns the reverse of the Cuthill–McKee ordering, which usually produces a smaller profile (fill-in) for sparse factorizations.
+func ReverseCuthillMcKeeOrdering(g *UndirectedGraph) []Node {
+	ordering := CuthillMcKeeOrdering(g)
+	for i, j := 0, len(ordering)-1; i < j; i, j = i+1, j-1 {
+		ordering[i], ordering[j] = ordering[j], ordering[i]
+	}
+	return ordering
+}
+
+// Bandwidth returns the largest difference between the positions of the endpoints of an edge when the nodes of g are numbered in the given ordering.
+func Bandwidth(g *UndirectedGraph, ordering []Node) int {
+	position := make(map[Node]int, len(ordering))
+	for i, node := range ordering {
+		position[node] = i
+	}
+
+	bandwidth := 0
+	for _, edge := range g.GetEdgeTuples() {
+		if distance := position[edge.Node1] - position[edge.Node2]; distance > bandwidth {
+			bandwidth = distance
+		}
+	}
+	return bandwidth
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLexBFS(t *testing.T) {
+	// Test case 1: Path graph
+	ordering1 := LexBFS(PathGraph(4), 0)
+	if !reflect.DeepEqual(ordering1, []Node{0, 1, 2, 3}) {
+		t.Errorf("Test case 1 failed: Expected [0 1 2 3], but got %v", ordering1)
+	}
+
+	// Test case 2: Nodes seen from earlier visited nodes come first
+	ordering2 := LexBFS(CycleGraph(4), 0)
+	if !reflect.DeepEqual(ordering2, []Node{0, 1, 3, 2}) {
+		t.Errorf("Test case 2 failed: Expected [0 1 3 2], but got %v", ordering2)
+	}
+
+	// Test case 3: Disconnected graph with a custom start
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {5, 6}})
+	ordering3 := LexBFS(g, 6)
+	if !reflect.DeepEqual(ordering3, []Node{6, 5, 0, 1}) {
+		t.Errorf("Test case 3 failed: Expected [6 5 0 1], but got %v", ordering3)
+	}
+
+	// Test case 4: Empty graph
+	if ordering4 := LexBFS(&UndirectedGraph{}, 0); len(ordering4) != 0 {
+		t.Errorf("Test case 4 failed: Expected an empty ordering, but got %v", ordering4)
+	}
+}
+
+func TestIsChordal(t *testing.T) {
+	chordedCycle := CycleGraph(4)
+	chordedCycle.AddEdge(Edge{Node1: 0, Node2: 2})
+	wheel := CycleGraph(5)
+	wheel.AddEdgesFromIntEdgeList(5, []Node{0, 1, 2, 3, 4})
+
+	testCases := []struct {
+		name     string
+		graph    *UndirectedGraph
+		expected bool
+	}{
+		{name: "Square", graph: CycleGraph(4), expected: false},
+		{name: "Square with a chord", graph: chordedCycle, expected: true},
+		{name: "Complete graph", graph: CompleteGraph(5), expected: true},
+		{name: "Star graph", graph: StarGraph(6), expected: true},
+		{name: "Wheel graph", graph: wheel, expected: false},
+		{name: "Empty graph", graph: &UndirectedGraph{}, expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := IsChordal(tc.graph); result != tc.expected {
+				t.Errorf("Expected %v, but got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestCuthillMcKeeOrdering(t *testing.T) {
+	// A path whose labels are scattered along it
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 5}, {5, 1}, {1, 4}, {4, 2}, {2, 3}})
+	if bandwidth := Bandwidth(g, sortedNodes(g.Nodes)); bandwidth != 5 {
+		t.Errorf("Expected the identity ordering to have bandwidth 5, but got %d", bandwidth)
+	}
+
+	ordering := CuthillMcKeeOrdering(g)
+	if !reflect.DeepEqual(ordering, []Node{0, 5, 1, 4, 2, 3}) {
+		t.Errorf("Expected [0 5 1 4 2 3], but got %v", ordering)
+	}
+	if bandwidth := Bandwidth(g, ordering); bandwidth != 1 {
+		t.Errorf("Expected bandwidth 1, but got %d", bandwidth)
+	}
+
+	reversed := ReverseCuthillMcKeeOrdering(g)
+	if !reflect.DeepEqual(reversed, []Node{3, 2, 4, 1, 5, 0}) {
+		t.Errorf("Expected [3 2 4 1 5 0], but got %v", reversed)
+	}
+}
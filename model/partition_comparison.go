@@ -0,0 +1,141 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// partitionContingency holds the sizes of the parts of two partitions of the same nodes and of their intersections.
+type partitionContingency struct {
+	nodes  int
+	first  map[int]int
+	second map[int]int
+	joint  map[[2]int]int
+}
+
+// newPartitionContingency counts the nodes in every part of two partitions, given as the part of every node.
+func newPartitionContingency(a, b map[Node]int) (*partitionContingency, error) {
+	if len(a) == 0 || len(a) != len(b) {
+		return nil, fmt.Errorf("the partitions must cover the same nodes, got %d and %d nodes", len(a), len(b))
+	}
+	c := &partitionContingency{nodes: len(a), first: make(map[int]int), second: make(map[int]int), joint: make(map[[2]int]int)}
+	for node, part := range a {
+		other, ok := b[node]
+		if !ok {
+			return nil, fmt.Errorf("node %d is in the first partition only", node)
+		}
+		c.first[part]++
+		c.second[other]++
+		c.joint[[2]int{part, other}]++
+	}
+	return c, nil
+}
+
+// entropies returns the entropies of both partitions and their mutual information, in nats.
+func (c *partitionContingency) entropies() (float64, float64, float64) {
+	n := float64(c.nodes)
+	entropy := func(sizes map[int]int) float64 {
+		h := 0.0
+		for _, size := range sizes {
+			p := float64(size) / n
+			h -= p * math.Log(p)
+		}
+		return h
+	}
+	mutual := 0.0
+	for parts, size := range c.joint {
+		p := float64(size) / n
+		mutual += p * math.Log(p*n*n/(float64(c.first[parts[0]])*float64(c.second[parts[1]])))
+	}
+	return entropy(c.first), entropy(c.second), math.Max(mutual, 0)
+}
+
+/*
+NormalizedMutualInformation compares two partitions of the same nodes, such as detected and planted communities.
+
+Parameters:
+- a, b: The part of every node in each partition; the part labels need not match.
+
+Returns:
+- nmi: The mutual information of the partitions divided by the mean of their entropies, from 0 for independent
+partitions to 1 for identical ones. Two partitions with a single part each have 1.
+- err: An error if the partitions are empty or do not cover the same nodes.
+
+Reference: L. Danon, A. Díaz-Guilera, J. Duch and A. Arenas, "Comparing community structure identification",
+J. Stat. Mech. P09008, 2005.
+*/
+func NormalizedMutualInformation(a, b map[Node]int) (float64, error) {
+	c, err := newPartitionContingency(a, b)
+	if err != nil {
+		return 0, err
+	}
+	ha, hb, mutual := c.entropies()
+	if ha+hb == 0 {
+		return 1, nil
+	}
+	return math.Min(2*mutual/(ha+hb), 1), nil
+}
+
+/*
+AdjustedRandIndex compares two partitions of the same nodes by the pairs of nodes they put together, corrected for chance.
+
+Parameters:
+- a, b: The part of every node in each partition; the part labels need not match.
+
+Returns:
+- ari: 1 for identical partitions, about 0 for random ones, and possibly negative. If both partitions put all the
+nodes together, or both put every node apart, it is 1.
+- err: An error if the partitions are empty or do not cover the same nodes.
+
+Reference: L. Hubert and P. Arabie, "Comparing partitions", Journal of Classification 2, 1985.
+*/
+func AdjustedRandIndex(a, b map[Node]int) (float64, error) {
+	c, err := newPartitionContingency(a, b)
+	if err != nil {
+		return 0, err
+	}
+	pairs := func(size int) float64 {
+		return float64(size) * float64(size-1) / 2
+	}
+	index, first, second := 0.0, 0.0, 0.0
+	for _, size := range c.joint {
+		index += pairs(size)
+	}
+	for _, size := range c.first {
+		first += pairs(size)
+	}
+	for _, size := range c.second {
+		second += pairs(size)
+	}
+	if c.nodes < 2 {
+		return 1, nil
+	}
+	expected := first * second / pairs(c.nodes)
+	maximum := (first + second) / 2
+	if maximum == expected {
+		return 1, nil
+	}
+	return (index - expected) / (maximum - expected), nil
+}
+
+/*
+VariationOfInformation measures the distance between two partitions of the same nodes: the information lost and
+gained when going from one to the other.
+
+Parameters:
+- a, b: The part of every node in each partition; the part labels need not match.
+
+Returns:
+- vi: H(a) + H(b) - 2 I(a, b) in nats, 0 for identical partitions and at most log n. It is a metric on partitions.
+- err: An error if the partitions are empty or do not cover the same nodes.
+
+Reference: M. Meilă, "Comparing clusterings—an information based distance", Journal of Multivariate Analysis 98, 2007.
+*/
+func VariationOfInformation(a, b map[Node]int) (float64, error) {
+	c, err := newPartitionContingency(a, b)
+	if err != nil {
+		return 0, err
+	}
+	ha, hb, mutual := c.entropies()
+	return math.Max(ha+hb-2*mutual, 0), nil
+}
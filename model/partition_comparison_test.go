@@ -0,0 +1,52 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+// partitionFromLabels returns the partition of nodes 0 to len(labels)-1 putting node i in part labels[i].
+func partitionFromLabels(labels ...int) map[Node]int {
+	partition := make(map[Node]int, len(labels))
+	for node, label := range labels {
+		partition[Node(node)] = label
+	}
+	return partition
+}
+
+func TestPartitionComparison(t *testing.T) {
+	testCases := []struct {
+		name         string
+		a, b         map[Node]int
+		nmi, ari, vi float64
+	}{
+		{"identical up to labels", partitionFromLabels(0, 0, 1, 1, 2), partitionFromLabels(5, 5, 3, 3, 4), 1, 1, 0},
+		{"refinement", partitionFromLabels(0, 0, 0, 1, 1, 1), partitionFromLabels(0, 0, 1, 1, 2, 2), 0.5158037429793888, 0.24242424242424243, 0.8675632284814613},
+		{"single parts", partitionFromLabels(0, 0, 0), partitionFromLabels(1, 1, 1), 1, 1, 0},
+		{"all apart against all together", partitionFromLabels(0, 1, 2, 3), partitionFromLabels(0, 0, 0, 0), 0, 0, math.Log(4)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nmi, err := NormalizedMutualInformation(tc.a, tc.b)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			ari, _ := AdjustedRandIndex(tc.a, tc.b)
+			vi, _ := VariationOfInformation(tc.a, tc.b)
+			if math.Abs(nmi-tc.nmi) > 1e-9 || math.Abs(ari-tc.ari) > 1e-9 || math.Abs(vi-tc.vi) > 1e-9 {
+				t.Errorf("Expected %v, %v and %v, but got %v, %v and %v", tc.nmi, tc.ari, tc.vi, nmi, ari, vi)
+			}
+			// All three measures are symmetric
+			if reversed, _ := AdjustedRandIndex(tc.b, tc.a); math.Abs(reversed-ari) > 1e-12 {
+				t.Errorf("Expected %v, but got %v", ari, reversed)
+			}
+		})
+	}
+
+	if _, err := NormalizedMutualInformation(partitionFromLabels(0, 1), partitionFromLabels(0, 1, 1)); err == nil {
+		t.Errorf("Expected an error for partitions of different nodes")
+	}
+	if _, err := VariationOfInformation(map[Node]int{}, map[Node]int{}); err == nil {
+		t.Errorf("Expected an error for empty partitions")
+	}
+}
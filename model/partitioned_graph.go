@@ -0,0 +1,114 @@
+package model
+
+import "fmt"
+
+/*
+GraphPartition is the shard of a PartitionedGraph owned by one partition.
+
+Graph contains the nodes owned by the partition, the ghost nodes (the neighbors of owned nodes that live in other
+partitions) and every edge incident to an owned node. Edges between two ghost nodes are not replicated, so the
+adjacency of a ghost node in Graph is only partial; the complete adjacency lives in the partition that owns it.
+*/
+type GraphPartition struct {
+	ID     int
+	Graph  *UndirectedGraph
+	Owned  map[Node]bool
+	Ghosts map[Node]int // ghost node -> partition that owns it
+}
+
+// PartitionedGraph is an UndirectedGraph split into shards that can be processed independently.
+type PartitionedGraph struct {
+	Partitions []*GraphPartition
+	Owner      map[Node]int
+}
+
+// HashPartition assigns every node of g to one of k partitions by its id modulo k.
+func HashPartition(g *UndirectedGraph, k int) map[Node]int {
+	assignment := make(map[Node]int, len(g.Nodes))
+	if k <= 0 {
+		return assignment
+	}
+	for node := range g.Nodes {
+		assignment[node] = ((int(node) % k) + k) % k
+	}
+	return assignment
+}
+
+/*
+PartitionGraph splits a graph into shards according to a node assignment.
+
+Parameters:
+- g: The graph to split.
+- assignment: The partition of every node of g. Partitions are numbered from 0, and their count is the largest partition number plus one.
+
+Returns:
+- pg: The PartitionedGraph, with the ghost nodes of every partition.
+- err: An error if a node of g is not assigned or is assigned to a negative partition.
+*/
+func PartitionGraph(g *UndirectedGraph, assignment map[Node]int) (*PartitionedGraph, error) {
+	count := 0
+	for node := range g.Nodes {
+		partition, ok := assignment[node]
+		if !ok {
+			return nil, fmt.Errorf("node %d is not assigned to a partition", node)
+		}
+		if partition < 0 {
+			return nil, fmt.Errorf("node %d is assigned to negative partition %d", node, partition)
+		}
+		count = max(count, partition+1)
+	}
+
+	pg := &PartitionedGraph{Partitions: make([]*GraphPartition, count), Owner: make(map[Node]int, len(g.Nodes))}
+	for i := range pg.Partitions {
+		pg.Partitions[i] = &GraphPartition{
+			ID:     i,
+			Graph:  &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)},
+			Owned:  make(map[Node]bool),
+			Ghosts: make(map[Node]int),
+		}
+	}
+
+	for _, node := range sortedNodes(g.Nodes) {
+		partition := pg.Partitions[assignment[node]]
+		pg.Owner[node] = partition.ID
+		partition.Owned[node] = true
+		partition.Graph.AddNode(node)
+		for _, neighbor := range g.Edges[node] {
+			partition.Graph.AddEdge(Edge{Node1: node, Node2: neighbor})
+			if owner := assignment[neighbor]; owner != partition.ID {
+				partition.Ghosts[neighbor] = owner
+			}
+		}
+	}
+	return pg, nil
+}
+
+// EdgeCut returns the number of edges whose endpoints are owned by different partitions.
+func (pg *PartitionedGraph) EdgeCut() int {
+	cut := 0
+	for _, partition := range pg.Partitions {
+		for node := range partition.Owned {
+			for _, neighbor := range partition.Graph.Edges[node] {
+				if pg.Owner[neighbor] != partition.ID {
+					cut++
+				}
+			}
+		}
+	}
+	// Every cut edge is seen once from each side
+	return cut / 2
+}
+
+// Merge rebuilds the whole graph from its partitions.
+func (pg *PartitionedGraph) Merge() *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	for _, partition := range pg.Partitions {
+		for _, node := range sortedNodes(partition.Owned) {
+			g.AddNode(node)
+			for _, neighbor := range partition.Graph.Edges[node] {
+				g.AddEdge(Edge{Node1: node, Node2: neighbor})
+			}
+		}
+	}
+	return g
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPartitionGraph(t *testing.T) {
+	g := PathGraph(4)
+	pg, err := PartitionGraph(g, map[Node]int{0: 0, 1: 0, 2: 1, 3: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(pg.Partitions) != 2 {
+		t.Fatalf("Expected 2 partitions, but got %d", len(pg.Partitions))
+	}
+
+	expectedGhosts := []map[Node]int{{2: 1}, {1: 0}}
+	for i, partition := range pg.Partitions {
+		if !reflect.DeepEqual(partition.Ghosts, expectedGhosts[i]) {
+			t.Errorf("Expected the ghosts of partition %d to be %v, but got %v", i, expectedGhosts[i], partition.Ghosts)
+		}
+	}
+	if !pg.Partitions[0].Graph.HasEdge(1, 2) || pg.Partitions[0].Graph.HasNode(3) {
+		t.Errorf("Expected partition 0 to hold the cut edge and no other remote node, but got %v", pg.Partitions[0].Graph)
+	}
+
+	if cut := pg.EdgeCut(); cut != 1 {
+		t.Errorf("Expected an edge cut of 1, but got %d", cut)
+	}
+	if merged := pg.Merge(); !merged.Equals(g) {
+		t.Errorf("Expected the merged graph to be %v, but got %v", g, merged)
+	}
+
+	if _, err := PartitionGraph(g, map[Node]int{0: 0}); err == nil {
+		t.Errorf("Expected an error for unassigned nodes, but got nil")
+	}
+}
+
+func TestHashPartition(t *testing.T) {
+	expected := map[Node]int{0: 0, 1: 1, 2: 2, 3: 0, 4: 1}
+	if assignment := HashPartition(PathGraph(5), 3); !reflect.DeepEqual(assignment, expected) {
+		t.Errorf("Expected %v, but got %v", expected, assignment)
+	}
+}
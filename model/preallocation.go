@@ -0,0 +1,39 @@
+package model
+
+// preallocatedGraph returns a graph on the nodes 0 to len(degrees)-1 without edges, whose adjacency lists are carved
+// out of a single array with room for the degree of every node. Generators that know their degree sequence fill it
+// with addSimpleEdge, allocating a few large blocks instead of growing one slice per node. Every list is capped at
+// the degree of its node, so that adding more edges later reallocates it rather than overwriting its neighbor.
+func preallocatedGraph(degrees []int) *UndirectedGraph {
+	nodes := make([]Node, len(degrees))
+	for i := range nodes {
+		nodes[i] = Node(i)
+	}
+	return preallocatedGraphOf(nodes, degrees)
+}
+
+// preallocatedGraphOf is preallocatedGraph on any nodes, with room for degrees[i] neighbors of nodes[i].
+func preallocatedGraphOf(nodes []Node, degrees []int) *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool, len(nodes)), Edges: make(map[Node][]Node, len(nodes))}
+	total := 0
+	for _, degree := range degrees {
+		total += degree
+	}
+	backing := make([]Node, total)
+	offset := 0
+	for i, node := range nodes {
+		g.Nodes[node] = true
+		if degree := degrees[i]; degree > 0 {
+			g.Edges[node] = backing[offset : offset : offset+degree]
+			offset += degree
+		}
+	}
+	return g
+}
+
+// addSimpleEdge adds an edge between two nodes of g that are known to be distinct and not yet adjacent, skipping the
+// checks of AddEdge.
+func (g *UndirectedGraph) addSimpleEdge(u, v Node) {
+	g.Edges[u] = append(g.Edges[u], v)
+	g.Edges[v] = append(g.Edges[v], u)
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPreallocatedGraph(t *testing.T) {
+	g := preallocatedGraph([]int{1, 2, 1, 0})
+	g.addSimpleEdge(0, 1)
+	g.addSimpleEdge(1, 2)
+	// Growing a full list must not overwrite the list of the next node
+	g.AddEdge(Edge{Node1: 0, Node2: 2})
+	expected := map[Node][]Node{0: {1, 2}, 1: {0, 2}, 2: {1, 0}}
+	if !reflect.DeepEqual(g.Edges, expected) || len(g.Nodes) != 4 {
+		t.Errorf("Expected %v on 4 nodes, but got %v on %d", expected, g.Edges, len(g.Nodes))
+	}
+}
+
+func TestCompleteGraphAllocations(t *testing.T) {
+	naive := testing.AllocsPerRun(5, func() {
+		g := &UndirectedGraph{}
+		for i := 0; i < 200; i++ {
+			for j := i + 1; j < 200; j++ {
+				g.AddEdge(Edge{Node1: Node(i), Node2: Node(j)})
+			}
+		}
+	})
+	preallocated := testing.AllocsPerRun(5, func() { CompleteGraph(200) })
+	if preallocated*10 > naive {
+		t.Errorf("Expected at least 10 times fewer allocations than %v, but got %v", naive, preallocated)
+	}
+}
+
+func BenchmarkCompleteGraph(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		CompleteGraph(1000)
+	}
+}
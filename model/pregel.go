@@ -0,0 +1,183 @@
+package model
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Vertex is the state of a node seen by a VertexProgram during a superstep.
+type Vertex[V, M any] struct {
+	ID        Node
+	Value     V
+	Neighbors []Node
+	halted    bool
+	worker    *pregelWorker[V, M]
+}
+
+// SendMessage sends a message to a node, delivered at the next superstep.
+func (v *Vertex[V, M]) SendMessage(to Node, message M) {
+	v.worker.send(to, message)
+}
+
+// SendMessageToNeighbors sends a message to every neighbor of the vertex.
+func (v *Vertex[V, M]) SendMessageToNeighbors(message M) {
+	for _, neighbor := range v.Neighbors {
+		v.worker.send(neighbor, message)
+	}
+}
+
+// VoteToHalt deactivates the vertex until it receives a message.
+func (v *Vertex[V, M]) VoteToHalt() {
+	v.halted = true
+}
+
+// VertexProgram is a vertex-centric algorithm in the Pregel model. Compute is called at every superstep for every
+// active vertex with the messages sent to it during the previous superstep; it may update the value of the vertex,
+// send messages and vote to halt.
+type VertexProgram[V, M any] interface {
+	Compute(superstep int, vertex *Vertex[V, M], messages []M)
+}
+
+// VertexProgramFunc adapts a function to the VertexProgram interface.
+type VertexProgramFunc[V, M any] func(superstep int, vertex *Vertex[V, M], messages []M)
+
+// Compute calls f(superstep, vertex, messages).
+func (f VertexProgramFunc[V, M]) Compute(superstep int, vertex *Vertex[V, M], messages []M) {
+	f(superstep, vertex, messages)
+}
+
+type pregelMessage[M any] struct {
+	to      Node
+	message M
+}
+
+// pregelWorker runs the vertices owned by one partition.
+type pregelWorker[V, M any] struct {
+	vertices []*Vertex[V, M]
+	inbox    map[Node][]M
+	outboxes [][]pregelMessage[M] // outgoing messages per destination partition
+	owner    map[Node]int
+	err      error
+}
+
+func (w *pregelWorker[V, M]) send(to Node, message M) {
+	partition, ok := w.owner[to]
+	if !ok {
+		if w.err == nil {
+			w.err = fmt.Errorf("message sent to unknown node %d", to)
+		}
+		return
+	}
+	w.outboxes[partition] = append(w.outboxes[partition], pregelMessage[M]{to: to, message: message})
+}
+
+// superstep runs the active vertices of the worker and reports whether any of them stayed active.
+func (w *pregelWorker[V, M]) superstep(superstep int, program VertexProgram[V, M]) bool {
+	active := false
+	for _, vertex := range w.vertices {
+		messages := w.inbox[vertex.ID]
+		if vertex.halted && len(messages) == 0 {
+			continue
+		}
+		vertex.halted = false
+		program.Compute(superstep, vertex, messages)
+		active = active || !vertex.halted
+	}
+	return active
+}
+
+/*
+RunPregel runs a vertex program on a PartitionedGraph with the bulk synchronous parallel model.
+
+Parameters:
+- pg: The partitioned graph. Every partition is processed by its own goroutine.
+- program: The vertex program.
+- initial: The initial value of every vertex.
+- maxSupersteps: The maximum number of supersteps to run.
+
+Returns:
+- values: The value of every vertex when the computation stopped.
+- supersteps: The number of supersteps run.
+- err: An error if maxSupersteps is not positive or a message was sent to a node outside the graph.
+
+Description:
+At every superstep the partitions run their active vertices in parallel, in ascending node order within a partition.
+Messages sent to vertices of the same or another partition (the ghost nodes) are exchanged at the barrier that ends
+the superstep and are delivered ordered by source partition, then by sending order. All vertices are active at the
+first superstep. The computation stops when every vertex has voted to halt and no message is in flight, or after
+maxSupersteps supersteps.
+*/
+func RunPregel[V, M any](pg *PartitionedGraph, program VertexProgram[V, M], initial func(Node) V, maxSupersteps int) (map[Node]V, int, error) {
+	if maxSupersteps <= 0 {
+		return nil, 0, fmt.Errorf("the maximum number of supersteps must be positive")
+	}
+
+	workers := make([]*pregelWorker[V, M], len(pg.Partitions))
+	for i, partition := range pg.Partitions {
+		worker := &pregelWorker[V, M]{
+			inbox:    make(map[Node][]M),
+			outboxes: make([][]pregelMessage[M], len(pg.Partitions)),
+			owner:    pg.Owner,
+		}
+		for _, node := range sortedNodes(partition.Owned) {
+			worker.vertices = append(worker.vertices, &Vertex[V, M]{
+				ID:        node,
+				Value:     initial(node),
+				Neighbors: partition.Graph.Edges[node],
+				worker:    worker,
+			})
+		}
+		workers[i] = worker
+	}
+
+	superstep := 0
+	for superstep < maxSupersteps {
+		active := make([]bool, len(workers))
+		var wg sync.WaitGroup
+		for i, worker := range workers {
+			wg.Add(1)
+			go func(i int, worker *pregelWorker[V, M]) {
+				defer wg.Done()
+				active[i] = worker.superstep(superstep, program)
+			}(i, worker)
+		}
+		wg.Wait()
+		superstep++
+
+		running := false
+		for i, worker := range workers {
+			if worker.err != nil {
+				return nil, superstep, worker.err
+			}
+			running = running || active[i]
+		}
+
+		// Exchange the messages at the barrier
+		for i, destination := range workers {
+			destination.inbox = make(map[Node][]M)
+			for _, source := range workers {
+				for _, message := range source.outboxes[i] {
+					destination.inbox[message.to] = append(destination.inbox[message.to], message.message)
+				}
+				running = running || len(source.outboxes[i]) > 0
+			}
+		}
+		for _, worker := range workers {
+			for i := range worker.outboxes {
+				worker.outboxes[i] = nil
+			}
+		}
+
+		if !running {
+			break
+		}
+	}
+
+	values := make(map[Node]V, len(pg.Owner))
+	for _, worker := range workers {
+		for _, vertex := range worker.vertices {
+			values[vertex.ID] = vertex.Value
+		}
+	}
+	return values, superstep, nil
+}
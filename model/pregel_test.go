@@ -0,0 +1,40 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRunPregel(t *testing.T) {
+	g := PathGraph(5)
+	g.AddEdge(Edge{Node1: 7, Node2: 8})
+	pg, err := PartitionGraph(g, HashPartition(g, 3))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	labels, supersteps, err := RunPregel[Node, Node](pg, ConnectedComponentsVertexProgram{}, func(node Node) Node { return node }, 100)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := map[Node]Node{0: 0, 1: 0, 2: 0, 3: 0, 4: 0, 7: 7, 8: 7}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Errorf("Expected %v, but got %v", expected, labels)
+	}
+	// The label of 0 needs 4 supersteps to reach 4, plus one to notice nothing changed
+	if supersteps != 6 {
+		t.Errorf("Expected 6 supersteps, but got %d", supersteps)
+	}
+
+	// The superstep limit is honoured
+	if _, supersteps, _ := RunPregel[Node, Node](pg, ConnectedComponentsVertexProgram{}, func(node Node) Node { return node }, 2); supersteps != 2 {
+		t.Errorf("Expected 2 supersteps, but got %d", supersteps)
+	}
+
+	unknown := VertexProgramFunc[int, int](func(superstep int, vertex *Vertex[int, int], messages []int) {
+		vertex.SendMessage(42, 1)
+	})
+	if _, _, err := RunPregel[int, int](pg, unknown, func(Node) int { return 0 }, 10); err == nil {
+		t.Errorf("Expected an error for a message to an unknown node, but got nil")
+	}
+}
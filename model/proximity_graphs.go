@@ -0,0 +1,165 @@
+package model
+
+import "math"
+
+type delaunayTriangle struct {
+	a, b, c int
+}
+
+/*
+DelaunayGraph returns the Delaunay triangulation of a set of points as a SpatialGraph.
+
+Parameters:
+- positions: The position of every node.
+
+Returns:
+- s: A SpatialGraph with an edge between every pair of nodes sharing a triangle of the triangulation.
+
+Description:
+The triangulation is built incrementally with the Bowyer-Watson algorithm, in ascending node order. Nodes sharing the
+position of a node with a smaller id are left isolated. If all the points are collinear the triangulation has no
+triangle and consecutive points along the line are linked instead.
+*/
+func DelaunayGraph(positions map[Node]Point) *SpatialGraph {
+	s := NewSpatialGraph(positions)
+
+	var nodes []Node
+	seen := make(map[Point]bool)
+	for _, node := range sortedNodes(s.Graph.Nodes) {
+		if !seen[positions[node]] {
+			seen[positions[node]] = true
+			nodes = append(nodes, node)
+		}
+	}
+	if len(nodes) < 2 {
+		return s
+	}
+
+	points := make([]Point, len(nodes), len(nodes)+3)
+	minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for i, node := range nodes {
+		points[i] = positions[node]
+		minX, maxX = math.Min(minX, points[i].X), math.Max(maxX, points[i].X)
+		minY, maxY = math.Min(minY, points[i].Y), math.Max(maxY, points[i].Y)
+	}
+
+	// A super triangle far enough to contain all the points and not disturb the hull
+	span := math.Max(maxX-minX, maxY-minY)
+	if span == 0 {
+		span = 1
+	}
+	centerX, centerY := (minX+maxX)/2, (minY+maxY)/2
+	super := len(points)
+	points = append(points,
+		Point{X: centerX - 1000*span, Y: centerY - 1000*span},
+		Point{X: centerX + 1000*span, Y: centerY - 1000*span},
+		Point{X: centerX, Y: centerY + 1000*span},
+	)
+
+	triangles := []delaunayTriangle{{a: super, b: super + 1, c: super + 2}}
+	for i := 0; i < super; i++ {
+		var kept []delaunayTriangle
+		boundary := make(map[Edge]int)
+		for _, triangle := range triangles {
+			if !inCircumcircle(points[triangle.a], points[triangle.b], points[triangle.c], points[i]) {
+				kept = append(kept, triangle)
+				continue
+			}
+			for _, edge := range []Edge{{Node1: Node(triangle.a), Node2: Node(triangle.b)}, {Node1: Node(triangle.b), Node2: Node(triangle.c)}, {Node1: Node(triangle.c), Node2: Node(triangle.a)}} {
+				boundary[orderedEdge(edge.Node1, edge.Node2)]++
+			}
+		}
+		for edge, count := range boundary {
+			// Edges shared by two removed triangles are inside the cavity
+			if count == 1 {
+				kept = append(kept, delaunayTriangle{a: int(edge.Node1), b: int(edge.Node2), c: i})
+			}
+		}
+		triangles = kept
+	}
+
+	for _, triangle := range triangles {
+		for _, pair := range [][2]int{{triangle.a, triangle.b}, {triangle.b, triangle.c}, {triangle.c, triangle.a}} {
+			if pair[0] < super && pair[1] < super && !hasPointOnSegment(s, nodes[pair[0]], nodes[pair[1]]) {
+				s.Graph.AddEdge(Edge{Node1: nodes[pair[0]], Node2: nodes[pair[1]]})
+			}
+		}
+	}
+	return s
+}
+
+// inCircumcircle reports whether d lies strictly inside the circumcircle of the triangle abc.
+func inCircumcircle(a, b, c, d Point) bool {
+	// Orient the triangle counterclockwise
+	if (b.X-a.X)*(c.Y-a.Y)-(b.Y-a.Y)*(c.X-a.X) < 0 {
+		b, c = c, b
+	}
+	ax, ay := a.X-d.X, a.Y-d.Y
+	bx, by := b.X-d.X, b.Y-d.Y
+	cx, cy := c.X-d.X, c.Y-d.Y
+	determinant := (ax*ax+ay*ay)*(bx*cy-cx*by) - (bx*bx+by*by)*(ax*cy-cx*ay) + (cx*cx+cy*cy)*(ax*by-bx*ay)
+	return determinant > 0
+}
+
+// hasPointOnSegment reports whether a node other than u and v lies on the open segment between them. Such edges only
+// come from the triangles of the super triangle when points are collinear.
+func hasPointOnSegment(s *SpatialGraph, u, v Node) bool {
+	p, q := s.Positions[u], s.Positions[v]
+	center := Point{X: (p.X + q.X) / 2, Y: (p.Y + q.Y) / 2}
+	for _, node := range s.WithinRadius(center, p.Distance(q)/2) {
+		r := s.Positions[node]
+		if r == p || r == q {
+			continue
+		}
+		if (q.X-p.X)*(r.Y-p.Y)-(q.Y-p.Y)*(r.X-p.X) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GabrielGraph returns the Gabriel graph of a set of points: two nodes are linked if no other node lies in the closed
+// disk whose diameter is the segment between them. It is a subgraph of the Delaunay triangulation.
+func GabrielGraph(positions map[Node]Point) *SpatialGraph {
+	return filterDelaunayEdges(positions, func(s *SpatialGraph, edge Edge) bool {
+		p, q := s.Positions[edge.Node1], s.Positions[edge.Node2]
+		center := Point{X: (p.X + q.X) / 2, Y: (p.Y + q.Y) / 2}
+		radius := p.Distance(q) / 2
+		for _, witness := range s.WithinRadius(center, radius) {
+			if position := s.Positions[witness]; position != p && position != q {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// RelativeNeighborhoodGraph returns the relative neighborhood graph of a set of points: two nodes are linked if no
+// other node is strictly closer to both of them than they are to each other. It is a subgraph of the Gabriel graph.
+func RelativeNeighborhoodGraph(positions map[Node]Point) *SpatialGraph {
+	return filterDelaunayEdges(positions, func(s *SpatialGraph, edge Edge) bool {
+		p, q := s.Positions[edge.Node1], s.Positions[edge.Node2]
+		distance := p.Distance(q)
+		for _, witness := range s.WithinRadius(p, distance) {
+			if witness == edge.Node1 || witness == edge.Node2 {
+				continue
+			}
+			if s.Positions[witness].Distance(p) < distance && s.Positions[witness].Distance(q) < distance {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// filterDelaunayEdges keeps the edges of the Delaunay triangulation accepted by keep.
+func filterDelaunayEdges(positions map[Node]Point, keep func(s *SpatialGraph, edge Edge) bool) *SpatialGraph {
+	delaunay := DelaunayGraph(positions)
+	s := NewSpatialGraph(positions)
+	for _, edge := range delaunay.Graph.GetEdgeTuples() {
+		if edge.Node1 < edge.Node2 && keep(delaunay, edge) {
+			s.Graph.AddEdge(edge)
+		}
+	}
+	return s
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func randomPositions(n int, seed int64) map[Node]Point {
+	rng := rand.New(rand.NewSource(seed))
+	positions := make(map[Node]Point, n)
+	for i := 0; i < n; i++ {
+		positions[Node(i)] = Point{X: rng.Float64(), Y: rng.Float64()}
+	}
+	return positions
+}
+
+func TestDelaunayGraph(t *testing.T) {
+	// Test case 1: A square with its center
+	positions := map[Node]Point{0: {X: 0, Y: 0}, 1: {X: 2, Y: 0}, 2: {X: 2, Y: 2}, 3: {X: 0, Y: 2}, 4: {X: 1, Y: 1}}
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {4, 0}, {4, 1}, {4, 2}, {4, 3}})
+	if delaunay := DelaunayGraph(positions); !delaunay.Graph.Equals(expected) {
+		t.Errorf("Test case 1 failed: Expected %v, but got %v", expected, delaunay.Graph)
+	}
+
+	// Test case 2: Collinear points are linked along the line and duplicates are isolated
+	positions = map[Node]Point{0: {X: 0, Y: 0}, 1: {X: 2, Y: 2}, 2: {X: 1, Y: 1}, 3: {X: 1, Y: 1}}
+	expected = &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 2}, {2, 1}})
+	expected.AddNode(3)
+	if delaunay := DelaunayGraph(positions); !delaunay.Graph.Equals(expected) {
+		t.Errorf("Test case 2 failed: Expected %v, but got %v", expected, delaunay.Graph)
+	}
+
+	// Test case 3: Random points have 3n - 3 - h edges, h being the number of hull points
+	positions = randomPositions(60, 1)
+	delaunay := DelaunayGraph(positions)
+	hull := 0
+	for node := range positions {
+		if isHullPoint(positions, node) {
+			hull++
+		}
+	}
+	if edges := delaunay.Graph.NumberOfEdges(); edges != 3*60-3-hull {
+		t.Errorf("Test case 3 failed: Expected %d edges, but got %d", 3*60-3-hull, edges)
+	}
+}
+
+// isHullPoint reports whether a point is a vertex of the convex hull, by checking that the other points fit in an
+// angular sector of less than 180 degrees around it.
+func isHullPoint(positions map[Node]Point, node Node) bool {
+	p := positions[node]
+	for v, q := range positions {
+		if v == node {
+			continue
+		}
+		// Candidate supporting line through p and q: every other point must be on one side
+		left, right := false, false
+		for w, r := range positions {
+			if w == node || w == v {
+				continue
+			}
+			cross := (q.X-p.X)*(r.Y-p.Y) - (q.Y-p.Y)*(r.X-p.X)
+			left = left || cross > 0
+			right = right || cross < 0
+		}
+		if !left || !right {
+			return true
+		}
+	}
+	return false
+}
+
+func TestGabrielAndRelativeNeighborhoodGraphs(t *testing.T) {
+	positions := randomPositions(40, 2)
+	gabriel := GabrielGraph(positions)
+	rng := RelativeNeighborhoodGraph(positions)
+
+	for u, p := range positions {
+		for v, q := range positions {
+			if u >= v {
+				continue
+			}
+			center := Point{X: (p.X + q.X) / 2, Y: (p.Y + q.Y) / 2}
+			isGabriel, isRNG := true, true
+			for w, r := range positions {
+				if w == u || w == v {
+					continue
+				}
+				isGabriel = isGabriel && r.Distance(center) > p.Distance(q)/2
+				isRNG = isRNG && !(r.Distance(p) < p.Distance(q) && r.Distance(q) < p.Distance(q))
+			}
+			if gabriel.Graph.HasEdge(u, v) != isGabriel {
+				t.Errorf("Expected Gabriel edge (%d, %d) to be %v", u, v, isGabriel)
+			}
+			if rng.Graph.HasEdge(u, v) != isRNG {
+				t.Errorf("Expected relative neighborhood edge (%d, %d) to be %v", u, v, isRNG)
+			}
+		}
+	}
+}
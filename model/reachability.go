@@ -0,0 +1,164 @@
+package model
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+/*
+BFSShortestPathTree returns the breadth-first shortest-path tree of an UndirectedGraph rooted at source.
+
+Parameters:
+- g: The graph to traverse.
+- source: The root of the tree.
+
+Returns:
+- tree: A DirectedGraph containing every node reachable from source, with an edge from each node to the nodes it discovered. Following the edges from source yields a shortest (minimum hop) path to every node in the tree.
+
+Description:
+If source is not part of the graph an empty DirectedGraph is returned.
+
+Example:
+
+	g := PathGraph(4)
+	tree := BFSShortestPathTree(g, 1)
+
+	fmt.Println(tree.Successors) // Output: map[1:[0 2] 2:[3]]
+*/
+func BFSShortestPathTree(g *UndirectedGraph, source Node) *DirectedGraph {
+	tree := &DirectedGraph{}
+	if !g.HasNode(source) {
+		return tree
+	}
+
+	tree.AddNode(source)
+	queue := []Node{source}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range g.Edges[node] {
+			if !tree.HasNode(neighbor) {
+				tree.AddEdge(Edge{Node1: node, Node2: neighbor})
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	return tree
+}
+
+/*
+DijkstraShortestPathTree returns the shortest-path tree of a weighted UndirectedGraph rooted at source.
+
+Parameters:
+- g: The graph to traverse.
+- source: The root of the tree.
+- weight: A WeightFunc giving the length of every edge. Use UnitWeight for hop distances.
+
+Returns:
+- tree: A DirectedGraph containing every node reachable from source, with an edge from each node's predecessor on its shortest path to the node itself.
+- err: An error if a negative edge weight is encountered.
+
+Description:
+Ties between equally short paths are broken in favour of the path discovered first. If source is not part of the graph an empty DirectedGraph is returned.
+*/
+func DijkstraShortestPathTree(g *UndirectedGraph, source Node, weight WeightFunc) (*DirectedGraph, error) {
+	tree := &DirectedGraph{}
+	if !g.HasNode(source) {
+		return tree, nil
+	}
+
+	_, parents, order, err := dijkstra(g.Edges, source, weight)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range order {
+		if parent, ok := parents[node]; ok {
+			tree.AddEdge(Edge{Node1: parent, Node2: node})
+		} else {
+			tree.AddNode(node)
+		}
+	}
+	return tree, nil
+}
+
+// dijkstra runs Dijkstra's algorithm from source over the given adjacency lists, such as the Edges of an
+// UndirectedGraph or the Successors of a DirectedGraph. It returns the distance to and the predecessor of every
+// reachable node, together with the order in which the nodes were settled.
+func dijkstra(adjacency map[Node][]Node, source Node, weight WeightFunc) (map[Node]float64, map[Node]Node, []Node, error) {
+	distances := map[Node]float64{source: 0}
+	parents := make(map[Node]Node)
+	settled := make(map[Node]bool)
+	order := make([]Node, 0, len(adjacency))
+	pq := &nodePriorityQueue{{node: source, priority: 0}}
+
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(nodePriorityItem)
+		node := item.node
+		if settled[node] {
+			continue
+		}
+		settled[node] = true
+		order = append(order, node)
+
+		for _, neighbor := range adjacency[node] {
+			w := weight(node, neighbor)
+			if w < 0 {
+				return nil, nil, nil, fmt.Errorf("negative weight %v on edge (%d, %d)", w, node, neighbor)
+			}
+			if settled[neighbor] {
+				continue
+			}
+			newDistance := item.priority + w
+			if current, ok := distances[neighbor]; !ok || newDistance < current {
+				distances[neighbor] = newDistance
+				parents[neighbor] = node
+				heap.Push(pq, nodePriorityItem{node: neighbor, priority: newDistance})
+			}
+		}
+	}
+	return distances, parents, order, nil
+}
+
+// Descendants returns the set of nodes reachable from source in a DirectedGraph, excluding source itself.
+func Descendants(g *DirectedGraph, source Node) map[Node]bool {
+	return reachableFrom(g.Successors, source)
+}
+
+// Ancestors returns the set of nodes from which source is reachable in a DirectedGraph, excluding source itself.
+func Ancestors(g *DirectedGraph, source Node) map[Node]bool {
+	return reachableFrom(g.Predecessors, source)
+}
+
+// reachableFrom collects every node reachable from source following the given adjacency lists.
+func reachableFrom(adjacency map[Node][]Node, source Node) map[Node]bool {
+	reached := make(map[Node]bool)
+	stack := []Node{source}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, next := range adjacency[node] {
+			if next != source && !reached[next] {
+				reached[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+	return reached
+}
+
+// bfsDistances returns the hop distance from source to every node reachable from it.
+func bfsDistances(g *UndirectedGraph, source Node) map[Node]int {
+	distances := map[Node]int{source: 0}
+	queue := []Node{source}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range g.Edges[node] {
+			if _, seen := distances[neighbor]; !seen {
+				distances[neighbor] = distances[node] + 1
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	return distances
+}
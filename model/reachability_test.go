@@ -0,0 +1,82 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBFSShortestPathTree(t *testing.T) {
+	// Test case 1: Path graph rooted in the middle
+	tree1 := BFSShortestPathTree(PathGraph(4), 1)
+	expectedSuccessors1 := map[Node][]Node{1: {0, 2}, 2: {3}}
+	if !reflect.DeepEqual(tree1.Successors, expectedSuccessors1) {
+		t.Errorf("Test case 1 failed: Expected %v, but got %v", expectedSuccessors1, tree1.Successors)
+	}
+
+	// Test case 2: Every node of a cycle is reached with n-1 tree edges
+	tree2 := BFSShortestPathTree(CycleGraph(6), 0)
+	if len(tree2.Nodes) != 6 || tree2.NumberOfEdges() != 5 {
+		t.Errorf("Test case 2 failed: Expected 6 nodes and 5 edges, but got %d nodes and %d edges", len(tree2.Nodes), tree2.NumberOfEdges())
+	}
+	if !reflect.DeepEqual(tree2.Predecessors[3], []Node{2}) {
+		t.Errorf("Test case 2 failed: Expected node 3 to be reached from 2, but got %v", tree2.Predecessors[3])
+	}
+
+	// Test case 3: Missing source
+	tree3 := BFSShortestPathTree(PathGraph(3), 10)
+	if len(tree3.Nodes) != 0 {
+		t.Errorf("Test case 3 failed: Expected an empty tree, but got %v", tree3.Nodes)
+	}
+}
+
+func TestDijkstraShortestPathTree(t *testing.T) {
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {0, 2}, {2, 3}})
+	weights := map[Edge]float64{
+		{Node1: 0, Node2: 1}: 1,
+		{Node1: 1, Node2: 2}: 1,
+		{Node1: 0, Node2: 2}: 5,
+		{Node1: 2, Node2: 3}: 1,
+	}
+	weight := func(u, v Node) float64 {
+		if w, ok := weights[Edge{Node1: u, Node2: v}]; ok {
+			return w
+		}
+		return weights[Edge{Node1: v, Node2: u}]
+	}
+
+	tree, err := DijkstraShortestPathTree(g, 0, weight)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectedPredecessors := map[Node][]Node{1: {0}, 2: {1}, 3: {2}}
+	if !reflect.DeepEqual(tree.Predecessors, expectedPredecessors) {
+		t.Errorf("Expected %v, but got %v", expectedPredecessors, tree.Predecessors)
+	}
+
+	weights[Edge{Node1: 2, Node2: 3}] = -1
+	if _, err := DijkstraShortestPathTree(g, 0, weight); err == nil {
+		t.Errorf("Expected an error for a negative weight, but got nil")
+	}
+}
+
+func TestDescendantsAndAncestors(t *testing.T) {
+	g := &DirectedGraph{}
+	for _, edge := range []Edge{{1, 2}, {2, 3}, {3, 1}, {3, 4}, {5, 4}} {
+		g.AddEdge(edge)
+	}
+
+	expectedDescendants := map[Node]bool{2: true, 3: true, 4: true}
+	if descendants := Descendants(g, 1); !reflect.DeepEqual(descendants, expectedDescendants) {
+		t.Errorf("Expected descendants %v, but got %v", expectedDescendants, descendants)
+	}
+
+	expectedAncestors := map[Node]bool{1: true, 2: true, 3: true, 5: true}
+	if ancestors := Ancestors(g, 4); !reflect.DeepEqual(ancestors, expectedAncestors) {
+		t.Errorf("Expected ancestors %v, but got %v", expectedAncestors, ancestors)
+	}
+
+	if ancestors := Ancestors(g, 5); len(ancestors) != 0 {
+		t.Errorf("Expected no ancestors, but got %v", ancestors)
+	}
+}
@@ -0,0 +1,282 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+)
+
+// AttributedGraph is an UndirectedGraph with numeric attributes on its nodes and edges. Edge attributes are keyed with the smaller node first.
+type AttributedGraph struct {
+	Graph          *UndirectedGraph
+	NodeAttributes map[Node]map[string]float64
+	EdgeAttributes map[Edge]map[string]float64
+}
+
+// NewAttributedGraph wraps a graph without attributes.
+func NewAttributedGraph(g *UndirectedGraph) *AttributedGraph {
+	return &AttributedGraph{Graph: g, NodeAttributes: make(map[Node]map[string]float64), EdgeAttributes: make(map[Edge]map[string]float64)}
+}
+
+// NodeAttribute returns an attribute of a node and whether it is set.
+func (a *AttributedGraph) NodeAttribute(node Node, name string) (float64, bool) {
+	value, ok := a.NodeAttributes[node][name]
+	return value, ok
+}
+
+// EdgeAttribute returns an attribute of an edge and whether it is set.
+func (a *AttributedGraph) EdgeAttribute(u, v Node, name string) (float64, bool) {
+	value, ok := a.EdgeAttributes[orderedEdge(u, v)][name]
+	return value, ok
+}
+
+// SetNodeAttribute sets an attribute of a node.
+func (a *AttributedGraph) SetNodeAttribute(node Node, name string, value float64) {
+	if a.NodeAttributes[node] == nil {
+		a.NodeAttributes[node] = make(map[string]float64)
+	}
+	a.NodeAttributes[node][name] = value
+}
+
+// SetEdgeAttribute sets an attribute of an edge.
+func (a *AttributedGraph) SetEdgeAttribute(u, v Node, name string, value float64) {
+	edge := orderedEdge(u, v)
+	if a.EdgeAttributes[edge] == nil {
+		a.EdgeAttributes[edge] = make(map[string]float64)
+	}
+	a.EdgeAttributes[edge][name] = value
+}
+
+// Match binds the nodes of a rule pattern to nodes of a host graph.
+type Match struct {
+	Nodes map[Node]Node // pattern node -> host node
+	Host  *AttributedGraph
+}
+
+// NodeAttribute returns an attribute of the host node bound to a pattern node.
+func (m *Match) NodeAttribute(patternNode Node, name string) (float64, bool) {
+	return m.Host.NodeAttribute(m.Nodes[patternNode], name)
+}
+
+// EdgeAttribute returns an attribute of the host edge bound to a pattern edge.
+func (m *Match) EdgeAttribute(patternU, patternV Node, name string) (float64, bool) {
+	return m.Host.EdgeAttribute(m.Nodes[patternU], m.Nodes[patternV], name)
+}
+
+// Degree returns the degree in the host graph of the node bound to a pattern node.
+func (m *Match) Degree(patternNode Node) int {
+	return m.Host.Graph.NodeDegree(m.Nodes[patternNode])
+}
+
+/*
+RewriteRule replaces occurrences of a pattern subgraph by a replacement subgraph.
+
+The nodes of Pattern and Replacement are variables. A match binds every pattern node to a distinct host node such that
+every pattern edge is a host edge; Where may reject matches with further conditions. Applying the rule to a match:
+  - deletes the host nodes bound to pattern nodes absent from Replacement, with all their edges,
+  - deletes the host edges bound to pattern edges absent from Replacement,
+  - creates a host node for every replacement node absent from Pattern, with the smallest unused ids,
+  - adds the host edges of the replacement edges,
+  - sets the attributes returned by NodeAttributes and EdgeAttributes, keyed by replacement nodes and edges.
+
+Attributes of kept nodes and edges are preserved unless overwritten.
+*/
+type RewriteRule struct {
+	Name           string
+	Pattern        *UndirectedGraph
+	Replacement    *UndirectedGraph
+	Where          func(m *Match) bool
+	NodeAttributes func(m *Match) map[Node]map[string]float64
+	EdgeAttributes func(m *Match) map[Edge]map[string]float64
+}
+
+// FindMatch returns the first match of the rule in the host graph, or nil. Matches are searched in ascending order of host nodes.
+func (r *RewriteRule) FindMatch(host *AttributedGraph) *Match {
+	order := patternOrder(r.Pattern)
+	match := &Match{Nodes: make(map[Node]Node, len(order)), Host: host}
+	used := make(map[Node]bool, len(order))
+	hostNodes := sortedNodes(host.Graph.Nodes)
+
+	var search func(position int) bool
+	search = func(position int) bool {
+		if position == len(order) {
+			return r.Where == nil || r.Where(match)
+		}
+		variable := order[position]
+		candidates := hostNodes
+		for _, neighbor := range r.Pattern.Edges[variable] {
+			if bound, ok := match.Nodes[neighbor]; ok {
+				candidates = host.Graph.Edges[bound]
+				break
+			}
+		}
+		for _, candidate := range candidates {
+			if used[candidate] || host.Graph.NodeDegree(candidate) < r.Pattern.NodeDegree(variable) {
+				continue
+			}
+			consistent := true
+			for _, neighbor := range r.Pattern.Edges[variable] {
+				if bound, ok := match.Nodes[neighbor]; ok && !host.Graph.HasEdge(candidate, bound) {
+					consistent = false
+					break
+				}
+			}
+			if !consistent {
+				continue
+			}
+			match.Nodes[variable] = candidate
+			used[candidate] = true
+			if search(position + 1) {
+				return true
+			}
+			delete(match.Nodes, variable)
+			delete(used, candidate)
+		}
+		return false
+	}
+
+	if !search(0) {
+		return nil
+	}
+	return match
+}
+
+// patternOrder lists the pattern nodes so that every node after the first of its component has an earlier neighbor.
+func patternOrder(pattern *UndirectedGraph) []Node {
+	var order []Node
+	visited := make(map[Node]bool, len(pattern.Nodes))
+	for _, start := range sortedNodes(pattern.Nodes) {
+		if visited[start] {
+			continue
+		}
+		visited[start] = true
+		queue := []Node{start}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			order = append(order, node)
+			neighbors := append([]Node{}, pattern.Edges[node]...)
+			slices.Sort(neighbors)
+			for _, neighbor := range neighbors {
+				if !visited[neighbor] {
+					visited[neighbor] = true
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+	}
+	return order
+}
+
+// Apply rewrites the host graph at a match of the rule, see RewriteRule.
+func (r *RewriteRule) Apply(host *AttributedGraph, match *Match) {
+	bindings := make(map[Node]Node, len(r.Replacement.Nodes))
+	for variable, node := range match.Nodes {
+		bindings[variable] = node
+	}
+
+	// Compute the attributes before the matched elements change
+	var nodeAttributes map[Node]map[string]float64
+	var edgeAttributes map[Edge]map[string]float64
+	if r.NodeAttributes != nil {
+		nodeAttributes = r.NodeAttributes(match)
+	}
+	if r.EdgeAttributes != nil {
+		edgeAttributes = r.EdgeAttributes(match)
+	}
+
+	for _, edge := range r.Pattern.GetEdgeTuples() {
+		if !r.Replacement.HasEdge(edge.Node1, edge.Node2) {
+			host.RemoveEdge(bindings[edge.Node1], bindings[edge.Node2])
+		}
+	}
+	for variable := range r.Pattern.Nodes {
+		if !r.Replacement.HasNode(variable) {
+			host.RemoveNode(bindings[variable])
+		}
+	}
+
+	next := Node(0)
+	for node := range host.Graph.Nodes {
+		next = max(next, node+1)
+	}
+	for _, variable := range sortedNodes(r.Replacement.Nodes) {
+		if _, bound := bindings[variable]; !bound {
+			bindings[variable] = next
+			host.Graph.AddNode(next)
+			next++
+		}
+	}
+	for _, edge := range r.Replacement.GetEdgeTuples() {
+		host.Graph.AddEdge(Edge{Node1: bindings[edge.Node1], Node2: bindings[edge.Node2]})
+	}
+
+	for variable, attributes := range nodeAttributes {
+		for name, value := range attributes {
+			host.SetNodeAttribute(bindings[variable], name, value)
+		}
+	}
+	for edge, attributes := range edgeAttributes {
+		for name, value := range attributes {
+			host.SetEdgeAttribute(bindings[edge.Node1], bindings[edge.Node2], name, value)
+		}
+	}
+}
+
+/*
+Rewrite applies rules to a graph until none of them matches.
+
+Parameters:
+- host: The graph to transform in place.
+- rules: The rules, tried in order at every step; the first rule with a match is applied once.
+- maxSteps: The maximum number of rewriting steps, which guards against rule sets that never terminate.
+
+Returns:
+- steps: The number of rewriting steps applied.
+- err: An error if some rule still matches after maxSteps steps.
+*/
+func Rewrite(host *AttributedGraph, rules []RewriteRule, maxSteps int) (int, error) {
+	for steps := 0; ; steps++ {
+		applied := false
+		for i := range rules {
+			if match := rules[i].FindMatch(host); match != nil {
+				if steps == maxSteps {
+					return steps, fmt.Errorf("rule %q still matches after %d steps", rules[i].Name, maxSteps)
+				}
+				rules[i].Apply(host, match)
+				applied = true
+				break
+			}
+		}
+		if !applied {
+			return steps, nil
+		}
+	}
+}
+
+// DegreeTwoChainRule returns a rule that replaces a node of degree 2 and its two edges by a single edge between its
+// neighbors, whose attribute weightAttribute is the sum of the two replaced ones (missing weights count as 1). Nodes
+// whose neighbors are already adjacent are left alone so that no parallel edge is lost.
+func DegreeTwoChainRule(weightAttribute string) RewriteRule {
+	pattern := &UndirectedGraph{}
+	pattern.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}})
+	replacement := &UndirectedGraph{}
+	replacement.AddEdge(Edge{Node1: 0, Node2: 2})
+
+	weight := func(m *Match, u, v Node) float64 {
+		if w, ok := m.EdgeAttribute(u, v, weightAttribute); ok {
+			return w
+		}
+		return 1
+	}
+	return RewriteRule{
+		Name:        "degree-two chain",
+		Pattern:     pattern,
+		Replacement: replacement,
+		Where: func(m *Match) bool {
+			return m.Degree(1) == 2 && !m.Host.Graph.HasEdge(m.Nodes[0], m.Nodes[2])
+		},
+		EdgeAttributes: func(m *Match) map[Edge]map[string]float64 {
+			return map[Edge]map[string]float64{{Node1: 0, Node2: 2}: {weightAttribute: weight(m, 0, 1) + weight(m, 1, 2)}}
+		},
+	}
+}
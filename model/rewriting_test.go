@@ -0,0 +1,92 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRewriteDegreeTwoChains(t *testing.T) {
+	// Test case 1: A weighted path collapses into a single edge
+	host := NewAttributedGraph(PathGraph(5))
+	for i := 0; i < 4; i++ {
+		host.SetEdgeAttribute(Node(i), Node(i+1), "weight", float64(i+1))
+	}
+	host.SetNodeAttribute(0, "label", 7)
+
+	steps, err := Rewrite(host, []RewriteRule{DegreeTwoChainRule("weight")}, 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := &UndirectedGraph{}
+	expected.AddEdge(Edge{Node1: 0, Node2: 4})
+	if steps != 3 || !host.Graph.Equals(expected) {
+		t.Errorf("Test case 1 failed: Expected %v after 3 steps, but got %v after %d", expected, host.Graph, steps)
+	}
+	if weight, _ := host.EdgeAttribute(4, 0, "weight"); weight != 10 {
+		t.Errorf("Test case 1 failed: Expected weight 10, but got %v", weight)
+	}
+	if len(host.EdgeAttributes) != 1 {
+		t.Errorf("Test case 1 failed: Expected the attributes of removed edges to be dropped, but got %v", host.EdgeAttributes)
+	}
+	if label, ok := host.NodeAttribute(0, "label"); !ok || label != 7 {
+		t.Errorf("Test case 1 failed: Expected the attributes of kept nodes to be preserved")
+	}
+
+	// Test case 2: A cycle stops shrinking at a triangle
+	host = NewAttributedGraph(CycleGraph(6))
+	if _, err := Rewrite(host, []RewriteRule{DegreeTwoChainRule("weight")}, 10); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(host.Graph.Nodes) != 3 || host.Graph.NumberOfEdges() != 3 {
+		t.Errorf("Test case 2 failed: Expected a triangle, but got %v", host.Graph)
+	}
+}
+
+func TestRewriteRuleBindings(t *testing.T) {
+	// Subdivide heavy edges, the new node carrying the weight of the edge
+	pattern := &UndirectedGraph{}
+	pattern.AddEdge(Edge{Node1: 0, Node2: 1})
+	replacement := &UndirectedGraph{}
+	replacement.AddEdgesFromIntTupleList([][2]int{{0, 2}, {2, 1}})
+	subdivide := RewriteRule{
+		Name:        "subdivide",
+		Pattern:     pattern,
+		Replacement: replacement,
+		Where: func(m *Match) bool {
+			weight, _ := m.EdgeAttribute(0, 1, "weight")
+			return weight > 1
+		},
+		NodeAttributes: func(m *Match) map[Node]map[string]float64 {
+			weight, _ := m.EdgeAttribute(0, 1, "weight")
+			return map[Node]map[string]float64{2: {"load": weight}}
+		},
+	}
+
+	host := NewAttributedGraph(PathGraph(3))
+	host.SetEdgeAttribute(1, 2, "weight", 5)
+	host.SetEdgeAttribute(0, 1, "weight", 1)
+
+	match := subdivide.FindMatch(host)
+	if match == nil || !reflect.DeepEqual(match.Nodes, map[Node]Node{0: 1, 1: 2}) {
+		t.Fatalf("Expected the match {0: 1, 1: 2}, but got %v", match)
+	}
+	subdivide.Apply(host, match)
+
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 3}, {3, 2}})
+	if !host.Graph.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, host.Graph)
+	}
+	if load, _ := host.NodeAttribute(3, "load"); load != 5 {
+		t.Errorf("Expected the new node to carry load 5, but got %v", load)
+	}
+	if subdivide.FindMatch(host) != nil {
+		t.Errorf("Expected no further match")
+	}
+
+	// A rule that always matches is stopped
+	always := RewriteRule{Name: "always", Pattern: pattern, Replacement: replacement}
+	if _, err := Rewrite(host, []RewriteRule{always}, 5); err == nil {
+		t.Errorf("Expected an error for a non-terminating rule, but got nil")
+	}
+}
@@ -0,0 +1,272 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// SBMFit is a stochastic block model fitted to a graph, see FitStochasticBlockModel.
+type SBMFit struct {
+	// Blocks gives the block of every node, numbered from 0 in increasing order of the smallest node of the blocks.
+	Blocks map[Node]int
+	// Sizes holds the number of nodes of every block.
+	Sizes []int
+	// Probabilities[r][s] is the fitted probability of an edge between a node of block r and a node of block s.
+	Probabilities [][]float64
+	// LogLikelihood is the log-likelihood of the graph under the fitted model.
+	LogLikelihood float64
+	// ICL is the integrated classification likelihood criterion of the fit, higher being better; see
+	// SelectStochasticBlockModel.
+	ICL float64
+}
+
+/*
+FitStochasticBlockModel infers the block of every node of an UndirectedGraph and the probabilities of the edges between
+blocks, for a given number of blocks.
+
+Parameters:
+- g: The graph.
+- blocks: The number of blocks, between 1 and the number of nodes.
+- restarts: The number of random initial assignments to fit from, at least 1; the most likely fit is kept.
+- seed: The seed of the random number generator.
+
+Returns:
+- fit: The blocks, the probability matrix and the quality of the fit.
+- err: An error if a parameter is out of range.
+
+Description:
+For given blocks, the most likely probabilities are the densities of the edges between them, so the fit maximizes the
+profile log-likelihood Σ_{r<=s} e_rs log p_rs + (n_rs - e_rs) log(1 - p_rs), with e_rs the edges between blocks r and
+s, n_rs the pairs of their nodes and p_rs = e_rs / n_rs. From every random assignment, the nodes are visited in random
+order and moved to the block that increases the likelihood most, until a pass moves no node (at most 100 passes), in
+O(B (B + degree)) time per node for B blocks. Blocks are never emptied.
+
+Reference: T. A. B. Snijders and K. Nowicki, "Estimation and prediction for stochastic blockmodels for graphs with
+latent block structure", Journal of Classification 14, 1997.
+*/
+func FitStochasticBlockModel(g *UndirectedGraph, blocks, restarts int, seed int64) (*SBMFit, error) {
+	if blocks < 1 || blocks > len(g.Nodes) || restarts < 1 {
+		return nil, fmt.Errorf("the number of blocks must be in [1, %d] and of restarts positive, got %d and %d", len(g.Nodes), blocks, restarts)
+	}
+	return fitStochasticBlockModel(g, blocks, restarts, rand.New(rand.NewSource(seed))), nil
+}
+
+/*
+SelectStochasticBlockModel fits stochastic block models with 1 to maxBlocks blocks to an UndirectedGraph and returns the
+one of highest integrated classification likelihood (ICL).
+
+Parameters:
+- g: The graph.
+- maxBlocks: The largest number of blocks to try, at least 1; it is capped at the number of nodes.
+- restarts: The number of random initial assignments per number of blocks, see FitStochasticBlockModel.
+- seed: The seed of the random number generator.
+
+Returns:
+- fit: The selected fit.
+- err: An error if a parameter is out of range.
+
+Description:
+The ICL of a fit with B blocks of n_r nodes is its log-likelihood plus Σ_r n_r log(n_r / n) for the assignment of the
+nodes, minus B(B+1)/4 log(n(n-1)/2) for the probabilities and (B-1)/2 log n for the block proportions. It penalizes
+the extra blocks that would otherwise always raise the likelihood.
+
+Reference: J.-J. Daudin, F. Picard and S. Robin, "A mixture model for random graphs", Statistics and Computing 18, 2008.
+*/
+func SelectStochasticBlockModel(g *UndirectedGraph, maxBlocks, restarts int, seed int64) (*SBMFit, error) {
+	if maxBlocks < 1 || restarts < 1 || len(g.Nodes) == 0 {
+		return nil, fmt.Errorf("the graph must have nodes and the number of blocks and of restarts must be positive")
+	}
+	rng := rand.New(rand.NewSource(seed))
+	var best *SBMFit
+	for blocks := 1; blocks <= min(maxBlocks, len(g.Nodes)); blocks++ {
+		if fit := fitStochasticBlockModel(g, blocks, restarts, rng); best == nil || fit.ICL > best.ICL {
+			best = fit
+		}
+	}
+	return best, nil
+}
+
+// sbmState holds an assignment of the nodes, indexed from 0, to blocks with the number of edges between every pair of
+// blocks, edges[r][r] counting the edges inside block r.
+type sbmState struct {
+	neighbors [][]int
+	blocks    []int
+	sizes     []int
+	edges     [][]int
+	counts    []int
+}
+
+func fitStochasticBlockModel(g *UndirectedGraph, blocks, restarts int, rng *rand.Rand) *SBMFit {
+	nodes := sortedNodes(g.Nodes)
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+	neighbors := make([][]int, len(nodes))
+	for i, node := range nodes {
+		for _, neighbor := range g.Edges[node] {
+			if neighbor != node {
+				neighbors[i] = append(neighbors[i], index[neighbor])
+			}
+		}
+	}
+
+	var best *sbmState
+	bestLikelihood := math.Inf(-1)
+	for restart := 0; restart < restarts; restart++ {
+		// Deal the nodes into the blocks in random order so that none is empty
+		assignment := make([]int, len(nodes))
+		for position, i := range rng.Perm(len(nodes)) {
+			assignment[i] = position % blocks
+		}
+		state := newSBMState(neighbors, assignment, blocks)
+		state.optimize(rng)
+		if likelihood := state.logLikelihood(); likelihood > bestLikelihood {
+			best, bestLikelihood = state, likelihood
+		}
+	}
+
+	labels := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		labels[node] = best.blocks[i]
+	}
+	labels = renumberCommunities(nodes, labels)
+	order := make([]int, blocks)
+	for i, node := range nodes {
+		order[labels[node]] = best.blocks[i]
+	}
+	fit := &SBMFit{Blocks: labels, Sizes: make([]int, blocks), Probabilities: make([][]float64, blocks), LogLikelihood: bestLikelihood}
+	for r := range order {
+		fit.Sizes[r] = best.sizes[order[r]]
+		fit.Probabilities[r] = make([]float64, blocks)
+		for s := range order {
+			if pairs := best.pairs(order[r], order[s]); pairs > 0 {
+				fit.Probabilities[r][s] = float64(best.edges[order[r]][order[s]]) / pairs
+			}
+		}
+	}
+	n := float64(len(nodes))
+	fit.ICL = bestLikelihood - float64(blocks*(blocks+1))/4*math.Log(math.Max(n*(n-1)/2, 1)) - float64(blocks-1)/2*math.Log(n)
+	for _, size := range fit.Sizes {
+		fit.ICL += float64(size) * math.Log(float64(size)/n)
+	}
+	return fit
+}
+
+func newSBMState(neighbors [][]int, assignment []int, blocks int) *sbmState {
+	s := &sbmState{neighbors: neighbors, blocks: assignment, sizes: make([]int, blocks), edges: make([][]int, blocks), counts: make([]int, blocks)}
+	for r := range s.edges {
+		s.edges[r] = make([]int, blocks)
+	}
+	for i, block := range assignment {
+		s.sizes[block]++
+		for _, j := range neighbors[i] {
+			if i < j {
+				s.edges[block][assignment[j]]++
+				if block != assignment[j] {
+					s.edges[assignment[j]][block]++
+				}
+			}
+		}
+	}
+	return s
+}
+
+// pairs returns the number of pairs of nodes between blocks r and t, or inside r if r = t.
+func (s *sbmState) pairs(r, t int) float64 {
+	if r == t {
+		return float64(s.sizes[r]) * float64(s.sizes[r]-1) / 2
+	}
+	return float64(s.sizes[r]) * float64(s.sizes[t])
+}
+
+// term returns the contribution of the pair of blocks r and t to the profile log-likelihood.
+func (s *sbmState) term(r, t int) float64 {
+	pairs, edges := s.pairs(r, t), float64(s.edges[r][t])
+	if edges == 0 || edges == pairs {
+		return 0
+	}
+	p := edges / pairs
+	return edges*math.Log(p) + (pairs-edges)*math.Log(1-p)
+}
+
+func (s *sbmState) logLikelihood() float64 {
+	total := 0.0
+	for r := range s.sizes {
+		for t := r; t < len(s.sizes); t++ {
+			total += s.term(r, t)
+		}
+	}
+	return total
+}
+
+// affected sums the terms of the pairs of blocks involving r or t.
+func (s *sbmState) affected(r, t int) float64 {
+	total := 0.0
+	for u := range s.sizes {
+		total += s.term(r, u)
+		if u != r {
+			total += s.term(t, u)
+		}
+	}
+	return total
+}
+
+// move moves node i to block to, updating the sizes and edge counts.
+func (s *sbmState) move(i, to int) {
+	from := s.blocks[i]
+	clear(s.counts)
+	for _, j := range s.neighbors[i] {
+		s.counts[s.blocks[j]]++
+	}
+	for u, count := range s.counts {
+		s.edges[from][u] -= count
+		if u != from {
+			s.edges[u][from] -= count
+		}
+	}
+	s.blocks[i] = to
+	s.sizes[from]--
+	s.sizes[to]++
+	// The edges to the old block now leave the new one
+	for u, count := range s.counts {
+		s.edges[to][u] += count
+		if u != to {
+			s.edges[u][to] += count
+		}
+	}
+}
+
+// optimize moves nodes to the block that increases the likelihood most until no move helps.
+func (s *sbmState) optimize(rng *rand.Rand) {
+	for pass := 0; pass < 100; pass++ {
+		moved := false
+		for _, i := range rng.Perm(len(s.blocks)) {
+			from := s.blocks[i]
+			if s.sizes[from] == 1 {
+				continue
+			}
+			bestBlock, bestGain := from, 1e-9
+			for to := range s.sizes {
+				if to == from {
+					continue
+				}
+				before := s.affected(from, to)
+				s.move(i, to)
+				gain := s.affected(from, to) - before
+				s.move(i, from)
+				if gain > bestGain {
+					bestBlock, bestGain = to, gain
+				}
+			}
+			if bestBlock != from {
+				s.move(i, bestBlock)
+				moved = true
+			}
+		}
+		if !moved {
+			return
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func plantedBlocks(sizes []int) map[Node]int {
+	blocks := make(map[Node]int)
+	node := 0
+	for block, size := range sizes {
+		for i := 0; i < size; i++ {
+			blocks[Node(node)] = block
+			node++
+		}
+	}
+	return blocks
+}
+
+func TestFitStochasticBlockModel(t *testing.T) {
+	sizes := []int{20, 20, 20}
+	probabilities := [][]float64{{0.5, 0.02, 0.02}, {0.02, 0.5, 0.02}, {0.02, 0.02, 0.5}}
+	g, err := StochasticBlockModelGraph(sizes, probabilities, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fit, err := FitStochasticBlockModel(g, 3, 5, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	ari, _ := AdjustedRandIndex(fit.Blocks, plantedBlocks(sizes))
+	if ari != 1 {
+		t.Errorf("Expected the planted blocks, but got an adjusted Rand index of %v", ari)
+	}
+	for r := range fit.Sizes {
+		if fit.Sizes[r] != 20 {
+			t.Errorf("Expected blocks of 20 nodes, but got %v", fit.Sizes)
+		}
+		for s := range fit.Sizes {
+			if math.Abs(fit.Probabilities[r][s]-probabilities[r][s]) > 0.1 {
+				t.Errorf("Expected probabilities close to %v, but got %v", probabilities, fit.Probabilities)
+			}
+		}
+	}
+	if fit.LogLikelihood >= 0 || fit.ICL >= fit.LogLikelihood {
+		t.Errorf("Expected a negative log-likelihood above the ICL, but got %v and %v", fit.LogLikelihood, fit.ICL)
+	}
+
+	// Two disjoint cliques are fitted exactly
+	cliques, _ := StochasticBlockModelGraph([]int{4, 5}, [][]float64{{1, 0}, {0, 1}}, 1)
+	fit, _ = FitStochasticBlockModel(cliques, 2, 3, 2)
+	if fit.LogLikelihood != 0 || fit.Blocks[0] != 0 || fit.Blocks[8] != 1 {
+		t.Errorf("Expected the two cliques with likelihood 1, but got %v with log-likelihood %v", fit.Blocks, fit.LogLikelihood)
+	}
+
+	for _, blocks := range []int{0, 10} {
+		if _, err := FitStochasticBlockModel(cliques, blocks, 1, 1); err == nil {
+			t.Errorf("Expected an error for %d blocks", blocks)
+		}
+	}
+	if _, err := FitStochasticBlockModel(cliques, 2, 0, 1); err == nil {
+		t.Errorf("Expected an error for no restart")
+	}
+}
+
+func TestSelectStochasticBlockModel(t *testing.T) {
+	sizes := []int{20, 20, 20}
+	probabilities := [][]float64{{0.5, 0.02, 0.02}, {0.02, 0.5, 0.02}, {0.02, 0.02, 0.5}}
+	g, _ := StochasticBlockModelGraph(sizes, probabilities, 3)
+	fit, err := SelectStochasticBlockModel(g, 6, 5, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(fit.Sizes) != 3 {
+		t.Errorf("Expected 3 blocks, but got %d", len(fit.Sizes))
+	}
+
+	random, _ := GnpRandomGraph(40, 0.2, 1)
+	fit, _ = SelectStochasticBlockModel(random, 4, 3, 1)
+	if len(fit.Sizes) != 1 {
+		t.Errorf("Expected a single block for a G(n, p) graph, but got %d", len(fit.Sizes))
+	}
+
+	if _, err := SelectStochasticBlockModel(NullGraph(), 3, 1, 1); err == nil {
+		t.Errorf("Expected an error for the null graph")
+	}
+}
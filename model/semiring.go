@@ -0,0 +1,209 @@
+package model
+
+import (
+	"math"
+	"sort"
+)
+
+// Semiring defines the addition and multiplication used by the sparse matrix products. Zero is the identity of Add
+// and annihilates Multiply; the products never store it in their results, but their operands may hold stored entries
+// equal to Zero, such as the zero weights of AdjacencyCSR.
+type Semiring struct {
+	Add      func(a, b float64) float64
+	Multiply func(a, b float64) float64
+	Zero     float64
+}
+
+var (
+	// PlusTimesSemiring is the usual arithmetic.
+	PlusTimesSemiring = Semiring{
+		Add:      func(a, b float64) float64 { return a + b },
+		Multiply: func(a, b float64) float64 { return a * b },
+		Zero:     0,
+	}
+
+	// MinPlusSemiring is the tropical semiring used for shortest paths.
+	MinPlusSemiring = Semiring{
+		Add:      math.Min,
+		Multiply: func(a, b float64) float64 { return a + b },
+		Zero:     math.Inf(1),
+	}
+
+	// BooleanSemiring is the or-and semiring on {0, 1} used for reachability.
+	BooleanSemiring = Semiring{
+		Add:      func(a, b float64) float64 { return math.Max(a, b) },
+		Multiply: func(a, b float64) float64 { return math.Min(a, b) },
+		Zero:     0,
+	}
+)
+
+// MultiplyVector returns the product m * x over the semiring, x being dense.
+func (m *CSRMatrix) MultiplyVector(x []float64, s Semiring) ([]float64, error) {
+	if err := checkDimensions(m.Cols, len(x)); err != nil {
+		return nil, err
+	}
+	y := make([]float64, m.Rows)
+	for i := range y {
+		y[i] = s.Zero
+		for k := m.RowOffsets[i]; k < m.RowOffsets[i+1]; k++ {
+			if x[m.ColIndices[k]] != s.Zero {
+				y[i] = s.Add(y[i], s.Multiply(m.Values[k], x[m.ColIndices[k]]))
+			}
+		}
+	}
+	return y, nil
+}
+
+// MultiplyMatrix returns the sparse product m * other over the semiring.
+func (m *CSRMatrix) MultiplyMatrix(other *CSRMatrix, s Semiring) (*CSRMatrix, error) {
+	return m.maskedProduct(other, nil, s)
+}
+
+// MaskedMultiplyMatrix returns the product m * other over the semiring, restricted to the entries stored in mask.
+func (m *CSRMatrix) MaskedMultiplyMatrix(other, mask *CSRMatrix, s Semiring) (*CSRMatrix, error) {
+	if err := checkDimensions(mask.Rows, m.Rows); err != nil {
+		return nil, err
+	}
+	if err := checkDimensions(mask.Cols, other.Cols); err != nil {
+		return nil, err
+	}
+	return m.maskedProduct(other, mask, s)
+}
+
+// maskedProduct computes m * other row by row with a sparse accumulator, skipping the entries absent from mask if it is not nil.
+func (m *CSRMatrix) maskedProduct(other, mask *CSRMatrix, s Semiring) (*CSRMatrix, error) {
+	if err := checkDimensions(m.Cols, other.Rows); err != nil {
+		return nil, err
+	}
+
+	result := &CSRMatrix{Rows: m.Rows, Cols: other.Cols, RowOffsets: make([]int, m.Rows+1)}
+	accumulator := make(map[int]float64)
+	allowed := make(map[int]bool)
+	for i := 0; i < m.Rows; i++ {
+		if mask != nil {
+			for k := mask.RowOffsets[i]; k < mask.RowOffsets[i+1]; k++ {
+				allowed[mask.ColIndices[k]] = true
+			}
+		}
+		for k := m.RowOffsets[i]; k < m.RowOffsets[i+1]; k++ {
+			middle := m.ColIndices[k]
+			for l := other.RowOffsets[middle]; l < other.RowOffsets[middle+1]; l++ {
+				column := other.ColIndices[l]
+				if mask != nil && !allowed[column] {
+					continue
+				}
+				product := s.Multiply(m.Values[k], other.Values[l])
+				if current, ok := accumulator[column]; ok {
+					accumulator[column] = s.Add(current, product)
+				} else {
+					accumulator[column] = product
+				}
+			}
+		}
+
+		columns := make([]int, 0, len(accumulator))
+		for column, value := range accumulator {
+			if value != s.Zero {
+				columns = append(columns, column)
+			}
+		}
+		sort.Ints(columns)
+		for _, column := range columns {
+			result.ColIndices = append(result.ColIndices, column)
+			result.Values = append(result.Values, accumulator[column])
+		}
+		result.RowOffsets[i+1] = len(result.ColIndices)
+		clear(accumulator)
+		clear(allowed)
+	}
+	return result, nil
+}
+
+// AlgebraicBFS returns the number of hops from source to every reachable node, computed by repeated boolean
+// matrix-vector products masked by the visited nodes.
+func AlgebraicBFS(g *UndirectedGraph, source Node) map[Node]int {
+	levels := make(map[Node]int)
+	if !g.HasNode(source) {
+		return levels
+	}
+	adjacency, nodes := AdjacencyCSR(g, UnitWeight)
+
+	frontier := make([]float64, len(nodes))
+	frontier[sort.Search(len(nodes), func(i int) bool { return nodes[i] >= source })] = 1
+	for level := 0; ; level++ {
+		empty := true
+		for i, value := range frontier {
+			if value != 0 {
+				levels[nodes[i]] = level
+				empty = false
+			}
+		}
+		if empty {
+			return levels
+		}
+		// The adjacency matrix is symmetric, so A * frontier expands the frontier by one hop
+		next, _ := adjacency.MultiplyVector(frontier, BooleanSemiring)
+		for i := range next {
+			if _, visited := levels[nodes[i]]; visited {
+				next[i] = 0
+			}
+		}
+		frontier = next
+	}
+}
+
+// AlgebraicShortestPaths returns the weighted distance from source to every node, +Inf for unreachable nodes, computed
+// by Bellman-Ford iterations of min-plus matrix-vector products. Weights must not be negative.
+func AlgebraicShortestPaths(g *UndirectedGraph, source Node, weight WeightFunc) map[Node]float64 {
+	adjacency, nodes := AdjacencyCSR(g, weight)
+	distances := make([]float64, len(nodes))
+	for i, node := range nodes {
+		distances[i] = math.Inf(1)
+		if node == source {
+			distances[i] = 0
+		}
+	}
+
+	for iteration := 0; iteration < len(nodes); iteration++ {
+		relaxed, _ := adjacency.MultiplyVector(distances, MinPlusSemiring)
+		changed := false
+		for i := range relaxed {
+			if relaxed[i] < distances[i] {
+				distances[i] = relaxed[i]
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	result := make(map[Node]float64, len(nodes))
+	for i, node := range nodes {
+		result[node] = distances[i]
+	}
+	return result
+}
+
+// AlgebraicTriangleCount returns the number of triangles of g, computed as the sum of (L * L) masked by L, L being the
+// strictly lower triangular part of the adjacency matrix.
+func AlgebraicTriangleCount(g *UndirectedGraph) int {
+	adjacency, _ := AdjacencyCSR(g, UnitWeight)
+	lower := &CSRMatrix{Rows: adjacency.Rows, Cols: adjacency.Cols, RowOffsets: make([]int, adjacency.Rows+1)}
+	for i := 0; i < adjacency.Rows; i++ {
+		for k := adjacency.RowOffsets[i]; k < adjacency.RowOffsets[i+1]; k++ {
+			if adjacency.ColIndices[k] < i {
+				lower.ColIndices = append(lower.ColIndices, adjacency.ColIndices[k])
+				lower.Values = append(lower.Values, 1)
+			}
+		}
+		lower.RowOffsets[i+1] = len(lower.ColIndices)
+	}
+
+	product, _ := lower.MaskedMultiplyMatrix(lower, lower, PlusTimesSemiring)
+	triangles := 0.0
+	for _, value := range product.Values {
+		triangles += value
+	}
+	return int(triangles)
+}
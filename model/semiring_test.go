@@ -0,0 +1,64 @@
+package model
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSemiringProducts(t *testing.T) {
+	// [[1 2] [0 3]]
+	m := &CSRMatrix{Rows: 2, Cols: 2, RowOffsets: []int{0, 2, 3}, ColIndices: []int{0, 1, 1}, Values: []float64{1, 2, 3}}
+
+	y, err := m.MultiplyVector([]float64{1, 1}, PlusTimesSemiring)
+	if err != nil || !reflect.DeepEqual(y, []float64{3, 3}) {
+		t.Errorf("Expected [3 3], but got %v (error %v)", y, err)
+	}
+	y, _ = m.MultiplyVector([]float64{0, 10}, MinPlusSemiring)
+	if !reflect.DeepEqual(y, []float64{1, 13}) {
+		t.Errorf("Expected [1 13], but got %v", y)
+	}
+	if _, err := m.MultiplyVector([]float64{1}, PlusTimesSemiring); err == nil {
+		t.Errorf("Expected a dimension mismatch error, but got nil")
+	}
+
+	// [[1 8] [0 9]]
+	squared, err := m.MultiplyMatrix(m, PlusTimesSemiring)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := &CSRMatrix{Rows: 2, Cols: 2, RowOffsets: []int{0, 2, 3}, ColIndices: []int{0, 1, 1}, Values: []float64{1, 8, 9}}
+	if !reflect.DeepEqual(squared, expected) {
+		t.Errorf("Expected %+v, but got %+v", expected, squared)
+	}
+
+	mask := &CSRMatrix{Rows: 2, Cols: 2, RowOffsets: []int{0, 1, 1}, ColIndices: []int{1}, Values: []float64{1}}
+	masked, _ := m.MaskedMultiplyMatrix(m, mask, PlusTimesSemiring)
+	expected = &CSRMatrix{Rows: 2, Cols: 2, RowOffsets: []int{0, 1, 1}, ColIndices: []int{1}, Values: []float64{8}}
+	if !reflect.DeepEqual(masked, expected) {
+		t.Errorf("Expected %+v, but got %+v", expected, masked)
+	}
+}
+
+func TestAlgebraicKernels(t *testing.T) {
+	g := CycleGraph(5)
+	g.AddNode(9)
+
+	expectedLevels := map[Node]int{0: 0, 1: 1, 4: 1, 2: 2, 3: 2}
+	if levels := AlgebraicBFS(g, 0); !reflect.DeepEqual(levels, expectedLevels) {
+		t.Errorf("Expected %v, but got %v", expectedLevels, levels)
+	}
+
+	weight := weightsFromMap(map[Edge]float64{{Node1: 0, Node2: 1}: 1, {Node1: 1, Node2: 2}: 1, {Node1: 2, Node2: 3}: 1, {Node1: 3, Node2: 4}: 1, {Node1: 0, Node2: 4}: 10})
+	expectedDistances := map[Node]float64{0: 0, 1: 1, 2: 2, 3: 3, 4: 4, 9: math.Inf(1)}
+	if distances := AlgebraicShortestPaths(g, 0, weight); !reflect.DeepEqual(distances, expectedDistances) {
+		t.Errorf("Expected %v, but got %v", expectedDistances, distances)
+	}
+
+	if triangles := AlgebraicTriangleCount(CompleteGraph(5)); triangles != 10 {
+		t.Errorf("Expected 10 triangles in K5, but got %d", triangles)
+	}
+	if triangles := AlgebraicTriangleCount(g); triangles != 0 {
+		t.Errorf("Expected no triangles in a 5-cycle, but got %d", triangles)
+	}
+}
@@ -0,0 +1,180 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNegativeCycle is returned, wrapped, by the Bellman-Ford functions when a negative cycle can be reached from the source.
+var ErrNegativeCycle = errors.New("negative cycle")
+
+// ShortestPaths holds the single-source shortest paths of a graph.
+type ShortestPaths struct {
+	Source Node
+	// Distances holds the length of a shortest path from Source to every reachable node, Source included.
+	Distances map[Node]float64
+	// Parents holds the predecessor of every reachable node other than Source on one of its shortest paths.
+	Parents map[Node]Node
+}
+
+// PathTo returns a shortest path from Source to target, both included, or nil if target cannot be reached.
+func (p *ShortestPaths) PathTo(target Node) []Node {
+	if _, ok := p.Distances[target]; !ok {
+		return nil
+	}
+	path := []Node{target}
+	for node := target; node != p.Source; {
+		node = p.Parents[node]
+		path = append(path, node)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+/*
+BFSShortestPaths finds the paths with the fewest edges from a source to every node of an UndirectedGraph.
+
+Parameters:
+- g: The graph.
+- source: The first node of the paths.
+
+Returns:
+- paths: The hop distances and the parents of the reachable nodes; see ShortestPaths.PathTo.
+- err: An error if source is not in the graph.
+
+Example:
+
+	paths, _ := BFSShortestPaths(CycleGraph(6), 0)
+
+	fmt.Println(paths.Distances[3], paths.PathTo(3)) // Output: 3 [0 1 2 3]
+*/
+func BFSShortestPaths(g *UndirectedGraph, source Node) (*ShortestPaths, error) {
+	if !g.HasNode(source) {
+		return nil, fmt.Errorf("node %d is not in the graph", source)
+	}
+	return bfsShortestPaths(g.Edges, source), nil
+}
+
+/*
+Dijkstra finds the shortest paths from a source to every node of an UndirectedGraph with non-negative edge weights.
+
+Parameters:
+- g: The graph.
+- source: The first node of the paths.
+- weight: The length of every edge, such as the EdgeWeight of a WeightedUndirectedGraph.
+
+Returns:
+- paths: The distances and the parents of the reachable nodes; see ShortestPaths.PathTo.
+- err: An error if source is not in the graph or an edge of negative weight is reached; use BellmanFord then.
+*/
+func Dijkstra(g *UndirectedGraph, source Node, weight WeightFunc) (*ShortestPaths, error) {
+	if !g.HasNode(source) {
+		return nil, fmt.Errorf("node %d is not in the graph", source)
+	}
+	distances, parents, _, err := dijkstra(g.Edges, source, weight)
+	if err != nil {
+		return nil, err
+	}
+	return &ShortestPaths{Source: source, Distances: distances, Parents: parents}, nil
+}
+
+/*
+BellmanFord finds the shortest paths from a source to every node of an UndirectedGraph whose edge weights may be negative.
+
+Parameters:
+- g: The graph.
+- source: The first node of the paths.
+- weight: The length of every edge.
+
+Returns:
+- paths: The distances and the parents of the reachable nodes; see ShortestPaths.PathTo.
+- err: An error if source is not in the graph, or an error wrapping ErrNegativeCycle if a negative cycle can be
+reached from source. Since an undirected edge can be walked back and forth, a reachable edge of negative weight is
+such a cycle.
+
+Description:
+All the edges are relaxed up to n - 1 times, stopping early once nothing changes, in O(nm) time.
+*/
+func BellmanFord(g *UndirectedGraph, source Node, weight WeightFunc) (*ShortestPaths, error) {
+	if !g.HasNode(source) {
+		return nil, fmt.Errorf("node %d is not in the graph", source)
+	}
+	return bellmanFord(g.Nodes, g.Edges, source, weight)
+}
+
+// DirectedBFSShortestPaths finds the paths with the fewest edges from a source to every node of a DirectedGraph,
+// following the edges forward. See BFSShortestPaths.
+func DirectedBFSShortestPaths(g *DirectedGraph, source Node) (*ShortestPaths, error) {
+	if !g.HasNode(source) {
+		return nil, fmt.Errorf("node %d is not in the graph", source)
+	}
+	return bfsShortestPaths(g.Successors, source), nil
+}
+
+// DirectedDijkstra finds the shortest paths from a source to every node of a DirectedGraph with non-negative edge
+// weights, weight(u, v) being the length of the edge u -> v. See Dijkstra.
+func DirectedDijkstra(g *DirectedGraph, source Node, weight WeightFunc) (*ShortestPaths, error) {
+	if !g.HasNode(source) {
+		return nil, fmt.Errorf("node %d is not in the graph", source)
+	}
+	distances, parents, _, err := dijkstra(g.Successors, source, weight)
+	if err != nil {
+		return nil, err
+	}
+	return &ShortestPaths{Source: source, Distances: distances, Parents: parents}, nil
+}
+
+// DirectedBellmanFord finds the shortest paths from a source to every node of a DirectedGraph whose edge weights may
+// be negative, returning an error wrapping ErrNegativeCycle if a negative cycle can be reached from source. See BellmanFord.
+func DirectedBellmanFord(g *DirectedGraph, source Node, weight WeightFunc) (*ShortestPaths, error) {
+	if !g.HasNode(source) {
+		return nil, fmt.Errorf("node %d is not in the graph", source)
+	}
+	return bellmanFord(g.Nodes, g.Successors, source, weight)
+}
+
+func bfsShortestPaths(adjacency map[Node][]Node, source Node) *ShortestPaths {
+	paths := &ShortestPaths{Source: source, Distances: map[Node]float64{source: 0}, Parents: make(map[Node]Node)}
+	queue := []Node{source}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, neighbor := range adjacency[node] {
+			if _, seen := paths.Distances[neighbor]; !seen {
+				paths.Distances[neighbor] = paths.Distances[node] + 1
+				paths.Parents[neighbor] = node
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	return paths
+}
+
+func bellmanFord(nodes map[Node]bool, adjacency map[Node][]Node, source Node, weight WeightFunc) (*ShortestPaths, error) {
+	paths := &ShortestPaths{Source: source, Distances: map[Node]float64{source: 0}, Parents: make(map[Node]Node)}
+	order := sortedNodes(nodes)
+	// Without negative cycles the distances are final after n - 1 rounds, so a change in round n reveals one
+	for round := 0; round < len(order); round++ {
+		changed := false
+		for _, node := range order {
+			distance, reached := paths.Distances[node]
+			if !reached {
+				continue
+			}
+			for _, neighbor := range adjacency[node] {
+				candidate := distance + weight(node, neighbor)
+				if current, ok := paths.Distances[neighbor]; !ok || candidate < current {
+					paths.Distances[neighbor] = candidate
+					paths.Parents[neighbor] = node
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			return paths, nil
+		}
+	}
+	return nil, fmt.Errorf("%w reachable from node %d", ErrNegativeCycle, source)
+}
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestBFSShortestPaths(t *testing.T) {
+	g := CycleGraph(6)
+	g.AddNode(9)
+	paths, err := BFSShortestPaths(g, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if paths.Distances[3] != 3 || paths.Distances[5] != 1 || len(paths.Distances) != 6 {
+		t.Errorf("Expected hop distances, but got %v", paths.Distances)
+	}
+	if path := paths.PathTo(4); !reflect.DeepEqual(path, []Node{0, 5, 4}) {
+		t.Errorf("Expected [0 5 4], but got %v", path)
+	}
+	if path := paths.PathTo(0); !reflect.DeepEqual(path, []Node{0}) {
+		t.Errorf("Expected [0], but got %v", path)
+	}
+	if path := paths.PathTo(9); path != nil {
+		t.Errorf("Expected no path to an isolated node, but got %v", path)
+	}
+	if _, err := BFSShortestPaths(g, 42); err == nil {
+		t.Errorf("Expected an error for a missing source")
+	}
+
+	directed, err := DirectedBFSShortestPaths(DirectedCycleGraph(6), 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if directed.Distances[5] != 5 || !reflect.DeepEqual(directed.PathTo(2), []Node{0, 1, 2}) {
+		t.Errorf("Expected the edges to be followed forward, but got %v", directed.Distances)
+	}
+}
+
+func TestDijkstraAndBellmanFord(t *testing.T) {
+	w := NewWeightedUndirectedGraph()
+	for _, edge := range []struct {
+		u, v   Node
+		weight float64
+	}{{0, 1, 4}, {0, 2, 1}, {2, 1, 2}, {1, 3, 1}, {2, 3, 5}, {3, 4, 3}} {
+		w.AddWeightedEdge(Edge{Node1: edge.u, Node2: edge.v}, edge.weight)
+	}
+	expected := map[Node]float64{0: 0, 1: 3, 2: 1, 3: 4, 4: 7}
+
+	for name, run := range map[string]func() (*ShortestPaths, error){
+		"dijkstra":     func() (*ShortestPaths, error) { return Dijkstra(w.Graph, 0, w.EdgeWeight) },
+		"bellman-ford": func() (*ShortestPaths, error) { return BellmanFord(w.Graph, 0, w.EdgeWeight) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			paths, err := run()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(paths.Distances, expected) {
+				t.Errorf("Expected %v, but got %v", expected, paths.Distances)
+			}
+			if path := paths.PathTo(4); !reflect.DeepEqual(path, []Node{0, 2, 1, 3, 4}) {
+				t.Errorf("Expected [0 2 1 3 4], but got %v", path)
+			}
+		})
+	}
+
+	negative := func(u, v Node) float64 {
+		if orderedEdge(u, v) == (Edge{Node1: 1, Node2: 3}) {
+			return -1
+		}
+		return w.EdgeWeight(u, v)
+	}
+	if _, err := Dijkstra(w.Graph, 0, negative); err == nil {
+		t.Errorf("Expected Dijkstra to reject a negative weight")
+	}
+	// An undirected negative edge is a negative cycle
+	if _, err := BellmanFord(w.Graph, 0, negative); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Expected ErrNegativeCycle, but got %v", err)
+	}
+}
+
+func TestDirectedBellmanFord(t *testing.T) {
+	g := directedFromEdges([][2]int{{0, 1}, {0, 2}, {1, 2}, {2, 3}, {3, 1}})
+	weights := map[Edge]float64{{Node1: 0, Node2: 1}: 4, {Node1: 0, Node2: 2}: 5, {Node1: 1, Node2: 2}: -3, {Node1: 2, Node2: 3}: 2, {Node1: 3, Node2: 1}: 2}
+	weight := func(u, v Node) float64 { return weights[Edge{Node1: u, Node2: v}] }
+
+	paths, err := DirectedBellmanFord(g, 0, weight)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := map[Node]float64{0: 0, 1: 4, 2: 1, 3: 3}; !reflect.DeepEqual(paths.Distances, expected) {
+		t.Errorf("Expected %v, but got %v", expected, paths.Distances)
+	}
+	if path := paths.PathTo(3); !reflect.DeepEqual(path, []Node{0, 1, 2, 3}) {
+		t.Errorf("Expected [0 1 2 3], but got %v", path)
+	}
+	if _, err := DirectedDijkstra(g, 0, weight); err == nil {
+		t.Errorf("Expected DirectedDijkstra to reject a negative weight")
+	}
+
+	// The cycle 1 -> 2 -> 3 -> 1 becomes negative
+	weights[Edge{Node1: 3, Node2: 1}] = 0
+	if _, err := DirectedBellmanFord(g, 0, weight); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Expected ErrNegativeCycle, but got %v", err)
+	}
+	// In the reversed graph the cycle is reached from 1 but not from 0
+	reversed := g.Reverse()
+	reversedWeight := func(u, v Node) float64 { return weight(v, u) }
+	if _, err := DirectedBellmanFord(reversed, 1, reversedWeight); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Expected ErrNegativeCycle in the reversed graph, but got %v", err)
+	}
+	if paths, err := DirectedBellmanFord(reversed, 0, reversedWeight); err != nil || len(paths.Distances) != 1 {
+		t.Errorf("Expected only the source to be reached from 0 in the reversed graph, but got %v", err)
+	}
+}
+
+func TestShortestPathsAgree(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	g := &UndirectedGraph{}
+	for i := 0; i < 60; i++ {
+		g.AddEdge(Edge{Node1: Node(rng.Intn(25)), Node2: Node(rng.Intn(25))})
+	}
+	weights := make(map[Edge]float64)
+	for _, edge := range sortedEdgeList(g) {
+		weights[edge] = 1 + rng.Float64()*9
+	}
+	weight := weightsFromMap(weights)
+	source := sortedNodes(g.Nodes)[0]
+
+	dijkstraPaths, err := Dijkstra(g, source, weight)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	bellmanFordPaths, err := BellmanFord(g, source, weight)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for node, distance := range dijkstraPaths.Distances {
+		if math.Abs(bellmanFordPaths.Distances[node]-distance) > 1e-9 {
+			t.Errorf("Expected %v for %d, but got %v", distance, node, bellmanFordPaths.Distances[node])
+		}
+		length := 0.0
+		path := bellmanFordPaths.PathTo(node)
+		for i := 1; i < len(path); i++ {
+			length += weight(path[i-1], path[i])
+		}
+		if math.Abs(length-distance) > 1e-9 {
+			t.Errorf("Expected a path of length %v to %d, but got %v", distance, node, path)
+		}
+	}
+	if len(bellmanFordPaths.Distances) != len(dijkstraPaths.Distances) {
+		t.Errorf("Expected the same reachable nodes")
+	}
+}
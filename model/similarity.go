@@ -0,0 +1,104 @@
+package model
+
+import "fmt"
+
+// SimilarNode is a node paired with its similarity score to a query node.
+type SimilarNode = ScoredNode
+
+/*
+RandomWalkWithRestart approximates the random walk with restart (personalized PageRank) scores of all nodes with respect to a source node.
+
+Parameters:
+- g: The graph to walk on.
+- source: The node the walker restarts from.
+- restartProbability: The probability, in (0, 1], of jumping back to source at every step. Typical values are 0.1-0.2.
+- tolerance: The residual threshold per unit of degree at which the push stops; smaller values give more accurate scores.
+
+Returns:
+- scores: The approximate stationary probability of every node touched by the push. Nodes far from source are omitted, their score being below the tolerance.
+- err: An error if the parameters are invalid.
+
+Description:
+The scores are computed with the local forward push algorithm, which only touches the neighbourhood of source, so its cost does not depend on the size of the graph. Every score is underestimated by at most tolerance times the degree of the node.
+
+Reference: R. Andersen, F. Chung and K. Lang, "Local graph partitioning using PageRank vectors", FOCS 2006.
+*/
+func RandomWalkWithRestart(g *UndirectedGraph, source Node, restartProbability float64, tolerance float64) (map[Node]float64, error) {
+	if !g.HasNode(source) {
+		return nil, fmt.Errorf("source %d is not part of the graph", source)
+	}
+	if restartProbability <= 0 || restartProbability > 1 {
+		return nil, fmt.Errorf("restart probability must be in (0, 1]")
+	}
+	if tolerance <= 0 {
+		return nil, fmt.Errorf("tolerance must be positive")
+	}
+
+	scores := make(map[Node]float64)
+	residuals := map[Node]float64{source: 1}
+	queue := []Node{source}
+	queued := map[Node]bool{source: true}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		queued[node] = false
+
+		residual := residuals[node]
+		residuals[node] = 0
+		neighbors := g.Edges[node]
+		if len(neighbors) == 0 {
+			// a walker on an isolated node can only restart
+			scores[node] += residual
+			continue
+		}
+
+		scores[node] += restartProbability * residual
+		share := (1 - restartProbability) * residual / float64(len(neighbors))
+		for _, neighbor := range neighbors {
+			residuals[neighbor] += share
+			if !queued[neighbor] && residuals[neighbor] > tolerance*float64(g.NodeDegree(neighbor)) {
+				queued[neighbor] = true
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	return scores, nil
+}
+
+/*
+TopKSimilarNodes returns, for every query node, the k nodes with the highest random walk with restart score.
+
+Parameters:
+- g: The graph to walk on.
+- queries: The query nodes.
+- k: The maximum number of similar nodes returned per query.
+- restartProbability: The restart probability passed to RandomWalkWithRestart.
+- tolerance: The push tolerance passed to RandomWalkWithRestart.
+
+Returns:
+- similar: A map from every query node to at most k other nodes sorted by decreasing score, ties broken by node id.
+- err: An error if a query node is missing or the parameters are invalid.
+*/
+func TopKSimilarNodes(g *UndirectedGraph, queries []Node, k int, restartProbability float64, tolerance float64) (map[Node][]SimilarNode, error) {
+	if k < 0 {
+		return nil, fmt.Errorf("k must be non-negative")
+	}
+
+	similar := make(map[Node][]SimilarNode, len(queries))
+	for _, query := range queries {
+		scores, err := RandomWalkWithRestart(g, query, restartProbability, tolerance)
+		if err != nil {
+			return nil, err
+		}
+
+		h := &scoredNodeHeap{}
+		for node, score := range scores {
+			if node != query && score > 0 {
+				h.offer(ScoredNode{Node: node, Score: score}, k)
+			}
+		}
+		similar[query] = h.ranked()
+	}
+	return similar, nil
+}
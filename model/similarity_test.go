@@ -0,0 +1,56 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRandomWalkWithRestart(t *testing.T) {
+	scores, err := RandomWalkWithRestart(CycleGraph(5), 0, 0.15, 1e-9)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	total := 0.0
+	for _, score := range scores {
+		total += score
+	}
+	if math.Abs(total-1) > 1e-6 {
+		t.Errorf("Expected the scores to sum to 1, but got %v", total)
+	}
+	if math.Abs(scores[1]-scores[4]) > 1e-6 {
+		t.Errorf("Expected symmetric nodes to have equal scores, got %v and %v", scores[1], scores[4])
+	}
+	if scores[0] <= scores[1] || scores[1] <= scores[2] {
+		t.Errorf("Expected scores to decrease with the distance from the source, got %v", scores)
+	}
+
+	if _, err := RandomWalkWithRestart(CycleGraph(5), 7, 0.15, 1e-9); err == nil {
+		t.Errorf("Expected an error for a missing source, but got nil")
+	}
+	if _, err := RandomWalkWithRestart(CycleGraph(5), 0, 0, 1e-9); err == nil {
+		t.Errorf("Expected an error for a zero restart probability, but got nil")
+	}
+}
+
+func TestTopKSimilarNodes(t *testing.T) {
+	// Two triangles joined by the edge 2-3
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {0, 2}, {2, 3}, {3, 4}, {4, 5}, {3, 5}})
+
+	similar, err := TopKSimilarNodes(g, []Node{0, 5}, 2, 0.2, 1e-8)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for query, expected := range map[Node][]Node{0: {2, 1}, 5: {3, 4}} {
+		if len(similar[query]) != 2 {
+			t.Fatalf("Expected 2 similar nodes for %d, but got %v", query, similar[query])
+		}
+		for i, node := range expected {
+			if similar[query][i].Node != node {
+				t.Errorf("Expected %v for query %d, but got %v", expected, query, similar[query])
+			}
+		}
+	}
+}
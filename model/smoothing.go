@@ -0,0 +1,141 @@
+package model
+
+import "slices"
+
+// SmoothedGraph is a graph whose chains of degree-2 nodes were contracted into single edges.
+type SmoothedGraph struct {
+	Graph *UndirectedGraph
+	// Weights holds the weight of every edge, keyed with the smaller node first: the total weight of the chain it replaces.
+	Weights map[Edge]float64
+	// Chains holds, for every edge that replaces a chain, the removed nodes in order from Node1 to Node2.
+	Chains map[Edge][]Node
+}
+
+// EdgeWeight returns the weight of an edge of the smoothed graph. It can be used as a WeightFunc.
+func (s *SmoothedGraph) EdgeWeight(u, v Node) float64 {
+	return s.Weights[orderedEdge(u, v)]
+}
+
+// SmoothDegreeTwoNodes contracts the chains of degree-2 nodes of an unweighted graph, every edge weighing the number
+// of original edges it replaces. See SmoothDegreeTwoNodesWeighted.
+func SmoothDegreeTwoNodes(g *UndirectedGraph) *SmoothedGraph {
+	return SmoothDegreeTwoNodesWeighted(g, UnitWeight)
+}
+
+/*
+SmoothDegreeTwoNodesWeighted contracts every maximal chain of degree-2 nodes into a single weighted edge.
+
+Parameters:
+- g: The graph.
+- weight: The weight of the edges of g.
+
+Returns:
+- smoothed: The graph where every chain u - c1 - ... - ck - w, with c1..ck of degree 2 and u, w not, is replaced by an
+edge u - w weighing the sum of the chain's edges, together with the removed nodes of every chain.
+
+Description:
+To keep the result a simple graph, a chain whose endpoints are already linked keeps its first node, and a chain that
+loops back to its endpoint keeps its first and last nodes. A cycle made only of degree-2 nodes is anchored at its
+smallest node and therefore reduced to a triangle.
+*/
+func SmoothDegreeTwoNodesWeighted(g *UndirectedGraph, weight WeightFunc) *SmoothedGraph {
+	s := &SmoothedGraph{
+		Graph:   &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)},
+		Weights: make(map[Edge]float64),
+		Chains:  make(map[Edge][]Node),
+	}
+	interior := make(map[Node]bool)
+	for node := range g.Nodes {
+		if g.NodeDegree(node) == 2 && !g.HasEdge(node, node) {
+			interior[node] = true
+		}
+	}
+
+	addEdge := func(u, v Node, w float64, chain []Node) {
+		s.Graph.AddEdge(Edge{Node1: u, Node2: v})
+		edge := orderedEdge(u, v)
+		s.Weights[edge] = w
+		if len(chain) > 0 {
+			if edge.Node1 != u {
+				chain = append([]Node{}, chain...)
+				slices.Reverse(chain)
+			}
+			s.Chains[edge] = chain
+		}
+	}
+
+	// Edges between kept nodes are kept as they are
+	for _, node := range sortedNodes(g.Nodes) {
+		if interior[node] {
+			continue
+		}
+		s.Graph.AddNode(node)
+		for _, neighbor := range g.Edges[node] {
+			if !interior[neighbor] && node <= neighbor {
+				addEdge(node, neighbor, weight(node, neighbor), nil)
+			}
+		}
+	}
+
+	visited := make(map[Node]bool)
+	contract := func(start Node) {
+		neighbors := append([]Node{}, g.Edges[start]...)
+		slices.Sort(neighbors)
+		for _, first := range neighbors {
+			if !interior[first] || visited[first] {
+				continue
+			}
+			// Walk the chain, recording the weight of every edge
+			chain := []Node{}
+			weights := []float64{weight(start, first)}
+			previous, current := start, first
+			for interior[current] && !visited[current] {
+				visited[current] = true
+				chain = append(chain, current)
+				next := g.Edges[current][0]
+				if next == previous {
+					next = g.Edges[current][1]
+				}
+				weights = append(weights, weight(current, next))
+				previous, current = current, next
+			}
+			end := current
+
+			total := 0.0
+			for _, w := range weights {
+				total += w
+			}
+			switch {
+			case end == start:
+				// Keep the first and last nodes of a loop
+				last := len(chain) - 1
+				s.Graph.AddNodes([]Node{chain[0], chain[last]})
+				addEdge(start, chain[0], weights[0], nil)
+				addEdge(chain[0], chain[last], total-weights[0]-weights[len(weights)-1], chain[1:last])
+				addEdge(chain[last], end, weights[len(weights)-1], nil)
+			case s.Graph.HasEdge(start, end):
+				// Keep the first node of a chain parallel to an existing edge
+				s.Graph.AddNode(chain[0])
+				addEdge(start, chain[0], weights[0], nil)
+				addEdge(chain[0], end, total-weights[0], chain[1:])
+			default:
+				addEdge(start, end, total, chain)
+			}
+		}
+	}
+
+	for _, node := range sortedNodes(g.Nodes) {
+		if !interior[node] {
+			contract(node)
+		}
+	}
+	// Cycles of degree-2 nodes are anchored at their smallest node
+	for _, node := range sortedNodes(interior) {
+		if !visited[node] {
+			visited[node] = true
+			s.Graph.AddNode(node)
+			contract(node)
+		}
+	}
+	return s
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSmoothDegreeTwoNodes(t *testing.T) {
+	// Test case 1: A path becomes a single edge
+	s := SmoothDegreeTwoNodes(PathGraph(5))
+	expected := &UndirectedGraph{}
+	expected.AddEdge(Edge{Node1: 0, Node2: 4})
+	if !s.Graph.Equals(expected) || s.EdgeWeight(4, 0) != 4 {
+		t.Errorf("Test case 1 failed: Expected %v with weight 4, but got %v with weights %v", expected, s.Graph, s.Weights)
+	}
+	if chain := s.Chains[Edge{Node1: 0, Node2: 4}]; !reflect.DeepEqual(chain, []Node{1, 2, 3}) {
+		t.Errorf("Test case 1 failed: Expected chain [1 2 3], but got %v", chain)
+	}
+
+	// Test case 2: A chain parallel to an existing edge keeps its first node
+	g := CycleGraph(3)
+	g.AddEdgesFromIntTupleList([][2]int{{0, 3}, {1, 4}})
+	s = SmoothDegreeTwoNodes(g)
+	if !s.Graph.Equals(g) {
+		t.Errorf("Test case 2 failed: Expected the graph to be unchanged, but got %v", s.Graph)
+	}
+
+	// Test case 3: A cycle is reduced to a triangle around its smallest node
+	s = SmoothDegreeTwoNodes(CycleGraph(6))
+	expected = CycleGraph(3)
+	expected.RemoveNode(2)
+	expected.AddEdgesFromIntTupleList([][2]int{{1, 5}, {5, 0}})
+	if !s.Graph.Equals(expected) {
+		t.Errorf("Test case 3 failed: Expected %v, but got %v", expected, s.Graph)
+	}
+	if s.EdgeWeight(1, 5) != 4 || !reflect.DeepEqual(s.Chains[Edge{Node1: 1, Node2: 5}], []Node{2, 3, 4}) {
+		t.Errorf("Test case 3 failed: Unexpected weights %v and chains %v", s.Weights, s.Chains)
+	}
+}
+
+func TestSmoothDegreeTwoNodesWeighted(t *testing.T) {
+	// A star whose arms were subdivided
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {0, 3}, {3, 4}, {4, 5}, {0, 6}})
+	weights := map[Edge]float64{{Node1: 0, Node2: 1}: 1, {Node1: 1, Node2: 2}: 2, {Node1: 0, Node2: 3}: 3, {Node1: 3, Node2: 4}: 4, {Node1: 4, Node2: 5}: 5, {Node1: 0, Node2: 6}: 6}
+
+	s := SmoothDegreeTwoNodesWeighted(g, weightsFromMap(weights))
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 2}, {0, 5}, {0, 6}})
+	if !s.Graph.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, s.Graph)
+	}
+	expectedWeights := map[Edge]float64{{Node1: 0, Node2: 2}: 3, {Node1: 0, Node2: 5}: 12, {Node1: 0, Node2: 6}: 6}
+	if !reflect.DeepEqual(s.Weights, expectedWeights) {
+		t.Errorf("Expected weights %v, but got %v", expectedWeights, s.Weights)
+	}
+	if chain := s.Chains[Edge{Node1: 0, Node2: 5}]; !reflect.DeepEqual(chain, []Node{3, 4}) {
+		t.Errorf("Expected chain [3 4], but got %v", chain)
+	}
+}
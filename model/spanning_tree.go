@@ -0,0 +1,118 @@
+package model
+
+import (
+	"container/heap"
+	"sort"
+)
+
+/*
+KruskalMST returns a minimum spanning tree of an UndirectedGraph, or a minimum spanning forest if it is disconnected.
+
+Parameters:
+- g: The graph.
+- weight: The weight of the edges, UnitWeight for an unweighted graph. Weights may be negative.
+
+Returns:
+- tree: A subgraph with all the nodes of g and, for every connected component, a spanning tree of least total weight.
+- total: The total weight of the edges of tree.
+
+Description:
+The edges are scanned by increasing weight, ties broken by increasing edge, and every edge joining two different
+trees of the forest grown so far is kept, the trees being tracked with a union-find structure. It runs in
+O(m log m) time. Self-loops are never kept.
+
+Reference: J. B. Kruskal, "On the shortest spanning subtree of a graph and the traveling salesman problem",
+Proceedings of the American Mathematical Society 7(1), 1956.
+*/
+func KruskalMST(g *UndirectedGraph, weight WeightFunc) (*UndirectedGraph, float64) {
+	edges := sortedEdgeList(g)
+	weights := make(map[Edge]float64, len(edges))
+	for _, edge := range edges {
+		weights[edge] = weight(edge.Node1, edge.Node2)
+	}
+	sort.SliceStable(edges, func(i, j int) bool { return weights[edges[i]] < weights[edges[j]] })
+
+	tree := &UndirectedGraph{Nodes: make(map[Node]bool, len(g.Nodes)), Edges: make(map[Node][]Node)}
+	parent := make(map[Node]Node, len(g.Nodes))
+	for node := range g.Nodes {
+		tree.AddNode(node)
+		parent[node] = node
+	}
+	root := func(node Node) Node {
+		for parent[node] != node {
+			// Path halving
+			parent[node] = parent[parent[node]]
+			node = parent[node]
+		}
+		return node
+	}
+	total := 0.0
+	for _, edge := range edges {
+		first, second := root(edge.Node1), root(edge.Node2)
+		if first == second {
+			continue
+		}
+		parent[first] = second
+		tree.AddEdge(edge)
+		total += weights[edge]
+	}
+	return tree, total
+}
+
+/*
+PrimMST returns a minimum spanning tree of an UndirectedGraph, or a minimum spanning forest if it is disconnected.
+
+Parameters:
+- g: The graph.
+- weight: The weight of the edges, UnitWeight for an unweighted graph. Weights may be negative.
+
+Returns:
+- tree: A subgraph with all the nodes of g and, for every connected component, a spanning tree of least total weight.
+- total: The total weight of the edges of tree.
+
+Description:
+A tree is grown from the smallest node of every component, repeatedly adding the lightest edge leaving it, found
+with a binary heap of the nodes around the tree. It runs in O(m log n) time. When the minimum spanning tree is not
+unique, the tree may differ from the one of KruskalMST, but both have the same total weight.
+
+Reference: R. C. Prim, "Shortest connection networks and some generalizations", Bell System Technical Journal 36(6),
+1957.
+*/
+func PrimMST(g *UndirectedGraph, weight WeightFunc) (*UndirectedGraph, float64) {
+	tree := &UndirectedGraph{Nodes: make(map[Node]bool, len(g.Nodes)), Edges: make(map[Node][]Node)}
+	total := 0.0
+	// cheapest holds the lightest known edge to every node around the tree, from parent
+	cheapest := make(map[Node]float64)
+	parent := make(map[Node]Node)
+	for _, root := range sortedNodes(g.Nodes) {
+		if tree.Nodes[root] {
+			continue
+		}
+		queue := &nodePriorityQueue{{node: root, priority: 0}}
+		parent[root] = root
+		for queue.Len() > 0 {
+			item := heap.Pop(queue).(nodePriorityItem)
+			node := item.node
+			if tree.Nodes[node] || (node != root && item.priority > cheapest[node]) {
+				continue
+			}
+			tree.AddNode(node)
+			if node != root {
+				tree.AddEdge(Edge{Node1: parent[node], Node2: node})
+				total += item.priority
+			}
+			for _, neighbor := range g.Edges[node] {
+				if tree.Nodes[neighbor] {
+					continue
+				}
+				w := weight(node, neighbor)
+				if known, ok := cheapest[neighbor]; !ok || w < known {
+					cheapest[neighbor] = w
+					parent[neighbor] = node
+					heap.Push(queue, nodePriorityItem{node: neighbor, priority: w})
+				}
+			}
+		}
+	}
+	return tree, total
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMinimumSpanningTree(t *testing.T) {
+	// The classic example of Cormen et al., with the nodes a to i numbered 0 to 8
+	w := NewWeightedUndirectedGraph()
+	for _, e := range []struct {
+		u, v   Node
+		weight float64
+	}{
+		{0, 1, 4}, {0, 7, 8}, {1, 2, 8}, {1, 7, 11}, {2, 3, 7}, {2, 8, 2}, {2, 5, 4}, {3, 4, 9}, {3, 5, 14},
+		{4, 5, 10}, {5, 6, 2}, {6, 7, 1}, {6, 8, 6}, {7, 8, 7},
+	} {
+		w.AddWeightedEdge(Edge{Node1: e.u, Node2: e.v}, e.weight)
+	}
+
+	for name, mst := range map[string]func(*UndirectedGraph, WeightFunc) (*UndirectedGraph, float64){
+		"Kruskal": KruskalMST,
+		"Prim":    PrimMST,
+	} {
+		t.Run(name, func(t *testing.T) {
+			tree, total := mst(w.Graph, w.EdgeWeight)
+			if total != 37 {
+				t.Errorf("Expected a total weight of 37, but got %v", total)
+			}
+			if len(tree.Nodes) != 9 || tree.NumberOfEdges() != 8 || !IsConnected(tree) {
+				t.Errorf("Expected a spanning tree, but got %v", tree)
+			}
+			for _, edge := range sortedEdgeList(tree) {
+				if _, ok := w.Weights[edge]; !ok {
+					t.Errorf("Expected the edges of the graph, but got %v", edge)
+				}
+			}
+
+			// A forest on a disconnected graph, with isolated nodes kept
+			g := PathGraph(3)
+			g.AddEdge(Edge{Node1: 5, Node2: 6})
+			g.AddNode(9)
+			g.AddEdge(Edge{Node1: 9, Node2: 9})
+			forest, total := mst(g, UnitWeight)
+			if total != 3 || len(forest.Nodes) != 6 || forest.NumberOfEdges() != 3 {
+				t.Errorf("Expected a forest of 3 edges on 6 nodes, but got %v", forest)
+			}
+
+			empty, total := mst(NullGraph(), UnitWeight)
+			if len(empty.Nodes) != 0 || total != 0 {
+				t.Errorf("Expected an empty tree, but got %v", empty)
+			}
+		})
+	}
+}
+
+func TestMinimumSpanningTreesAgree(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for seed := int64(0); seed < 10; seed++ {
+		g, _ := GnpRandomGraph(30, 0.2, seed)
+		w := WeightGraph(g, func(u, v Node) float64 { return float64(rng.Intn(10) - 3) })
+		_, kruskal := KruskalMST(g, w.EdgeWeight)
+		_, prim := PrimMST(g, w.EdgeWeight)
+		if kruskal != prim {
+			t.Errorf("Expected the same total weight for seed %d, but got %v and %v", seed, kruskal, prim)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package model
+
+import (
+	"math"
+	"sort"
+)
+
+// Point is a position in the plane.
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Distance returns the Euclidean distance between two points.
+func (p Point) Distance(q Point) float64 {
+	return math.Hypot(p.X-q.X, p.Y-q.Y)
+}
+
+// SpatialGraph is an UndirectedGraph whose nodes have a position in the plane.
+type SpatialGraph struct {
+	Graph     *UndirectedGraph
+	Positions map[Node]Point
+	tree      *KDTree
+}
+
+// NewSpatialGraph returns a SpatialGraph with one node per position and no edges.
+func NewSpatialGraph(positions map[Node]Point) *SpatialGraph {
+	s := &SpatialGraph{Graph: &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}, Positions: make(map[Node]Point, len(positions))}
+	for node, position := range positions {
+		s.Graph.AddNode(node)
+		s.Positions[node] = position
+	}
+	return s
+}
+
+// SetPosition adds a node at the given position, or moves it if it already exists.
+func (s *SpatialGraph) SetPosition(node Node, position Point) {
+	s.Graph.AddNode(node)
+	s.Positions[node] = position
+	s.tree = nil
+}
+
+// EuclideanWeight returns the distance between the positions of two nodes. It can be used as a WeightFunc.
+func (s *SpatialGraph) EuclideanWeight(u, v Node) float64 {
+	return s.Positions[u].Distance(s.Positions[v])
+}
+
+// NearestNeighbors returns the k nodes closest to a point, closest first, ties broken by node id.
+func (s *SpatialGraph) NearestNeighbors(p Point, k int) []Node {
+	return s.index().Nearest(p, k)
+}
+
+// WithinRadius returns the nodes at distance at most radius from a point, in ascending order.
+func (s *SpatialGraph) WithinRadius(p Point, radius float64) []Node {
+	return s.index().WithinRadius(p, radius)
+}
+
+// index returns the k-d tree of the positions, building it after the positions changed.
+func (s *SpatialGraph) index() *KDTree {
+	if s.tree == nil {
+		s.tree = NewKDTree(s.Positions)
+	}
+	return s.tree
+}
+
+type kdNode struct {
+	node        Node
+	point       Point
+	axis        int
+	left, right *kdNode
+}
+
+// KDTree is a 2-d tree over the positions of nodes, answering nearest neighbor and radius queries.
+type KDTree struct {
+	root *kdNode
+}
+
+type kdEntry struct {
+	node  Node
+	point Point
+}
+
+// NewKDTree builds a balanced k-d tree over the given positions.
+func NewKDTree(positions map[Node]Point) *KDTree {
+	entries := make([]kdEntry, 0, len(positions))
+	for _, node := range sortedNodes(nodeSet(positions)) {
+		entries = append(entries, kdEntry{node: node, point: positions[node]})
+	}
+	return &KDTree{root: buildKDTree(entries, 0)}
+}
+
+func nodeSet(positions map[Node]Point) map[Node]bool {
+	nodes := make(map[Node]bool, len(positions))
+	for node := range positions {
+		nodes[node] = true
+	}
+	return nodes
+}
+
+func buildKDTree(entries []kdEntry, axis int) *kdNode {
+	if len(entries) == 0 {
+		return nil
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return coordinate(entries[i].point, axis) < coordinate(entries[j].point, axis) })
+	median := len(entries) / 2
+	return &kdNode{
+		node:  entries[median].node,
+		point: entries[median].point,
+		axis:  axis,
+		left:  buildKDTree(entries[:median], 1-axis),
+		right: buildKDTree(entries[median+1:], 1-axis),
+	}
+}
+
+func coordinate(p Point, axis int) float64 {
+	if axis == 0 {
+		return p.X
+	}
+	return p.Y
+}
+
+type kdCandidate struct {
+	node     Node
+	distance float64
+}
+
+// Nearest returns the k nodes closest to p, closest first, ties broken by node id.
+func (t *KDTree) Nearest(p Point, k int) []Node {
+	if k <= 0 {
+		return []Node{}
+	}
+	var best []kdCandidate
+	worse := func(a, b kdCandidate) bool {
+		return a.distance > b.distance || (a.distance == b.distance && a.node > b.node)
+	}
+
+	var search func(n *kdNode)
+	search = func(n *kdNode) {
+		if n == nil {
+			return
+		}
+		candidate := kdCandidate{node: n.node, distance: p.Distance(n.point)}
+		if len(best) < k || worse(best[len(best)-1], candidate) {
+			i := sort.Search(len(best), func(i int) bool { return worse(best[i], candidate) })
+			best = append(best, kdCandidate{})
+			copy(best[i+1:], best[i:])
+			best[i] = candidate
+			if len(best) > k {
+				best = best[:k]
+			}
+		}
+
+		difference := coordinate(p, n.axis) - coordinate(n.point, n.axis)
+		near, far := n.left, n.right
+		if difference > 0 {
+			near, far = n.right, n.left
+		}
+		search(near)
+		if len(best) < k || math.Abs(difference) <= best[len(best)-1].distance {
+			search(far)
+		}
+	}
+	search(t.root)
+
+	nodes := make([]Node, len(best))
+	for i, candidate := range best {
+		nodes[i] = candidate.node
+	}
+	return nodes
+}
+
+// WithinRadius returns the nodes at distance at most radius from p, in ascending order.
+func (t *KDTree) WithinRadius(p Point, radius float64) []Node {
+	nodes := []Node{}
+	var search func(n *kdNode)
+	search = func(n *kdNode) {
+		if n == nil {
+			return
+		}
+		if p.Distance(n.point) <= radius {
+			nodes = append(nodes, n.node)
+		}
+		difference := coordinate(p, n.axis) - coordinate(n.point, n.axis)
+		if difference <= radius {
+			search(n.left)
+		}
+		if difference >= -radius {
+			search(n.right)
+		}
+	}
+	search(t.root)
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return nodes
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func gridPositions(size int) map[Node]Point {
+	positions := make(map[Node]Point, size*size)
+	for row := 0; row < size; row++ {
+		for col := 0; col < size; col++ {
+			positions[Node(row*size+col)] = Point{X: float64(col), Y: float64(row)}
+		}
+	}
+	return positions
+}
+
+func TestKDTree(t *testing.T) {
+	s := NewSpatialGraph(gridPositions(4))
+
+	// Test case 1: Nearest neighbors, ties broken by node id
+	if nearest := s.NearestNeighbors(Point{X: 1.1, Y: 1}, 3); !reflect.DeepEqual(nearest, []Node{5, 6, 1}) {
+		t.Errorf("Test case 1 failed: Expected [5 6 1], but got %v", nearest)
+	}
+
+	// Test case 2: Radius query
+	if within := s.WithinRadius(Point{X: 0, Y: 0}, 1); !reflect.DeepEqual(within, []Node{0, 1, 4}) {
+		t.Errorf("Test case 2 failed: Expected [0 1 4], but got %v", within)
+	}
+
+	// Test case 3: The index follows moved nodes
+	s.SetPosition(15, Point{X: 1, Y: 1.1})
+	if nearest := s.NearestNeighbors(Point{X: 1, Y: 1.2}, 1); !reflect.DeepEqual(nearest, []Node{15}) {
+		t.Errorf("Test case 3 failed: Expected [15], but got %v", nearest)
+	}
+
+	// Test case 4: Asking for more neighbors than nodes
+	if nearest := s.NearestNeighbors(Point{}, 100); len(nearest) != 16 {
+		t.Errorf("Test case 4 failed: Expected 16 nodes, but got %d", len(nearest))
+	}
+
+	if weight := s.EuclideanWeight(0, 15); math.Abs(weight-math.Hypot(1, 1.1)) > 1e-12 {
+		t.Errorf("Expected weight %v, but got %v", math.Hypot(1, 1.1), weight)
+	}
+}
@@ -0,0 +1,132 @@
+package model
+
+import "math"
+
+/*
+Constraint computes Burt's constraint of every node of a weighted UndirectedGraph: how much its ties lead, directly or
+through mutual contacts, to the same people.
+
+Parameters:
+- g: The graph.
+- weight: A WeightFunc giving the (positive) strength of every edge; use UnitWeight for an unweighted graph.
+
+Returns:
+- constraint: For every node u, the sum over its neighbors v of (p_uv + Σ_w p_uw p_wv)^2, w ranging over the common
+neighbors of u and v, with p_uv the share of the strength of u spent on v. Nodes without edges have NaN.
+
+Description:
+A node bridging structural holes between otherwise disconnected contacts has low constraint, from 1/k for k
+unrelated contacts, while a node embedded in a clique approaches 1 or more.
+
+Reference: R. S. Burt, "Structural holes: the social structure of competition", Harvard University Press, 1992.
+*/
+func Constraint(g *UndirectedGraph, weight WeightFunc) map[Node]float64 {
+	shares := proportionalTieStrengths(g, weight)
+	constraint := make(map[Node]float64, len(g.Nodes))
+	for u := range g.Nodes {
+		if len(shares[u]) == 0 {
+			constraint[u] = math.NaN()
+			continue
+		}
+		total := 0.0
+		for v, direct := range shares[u] {
+			indirect := 0.0
+			for w, uw := range shares[u] {
+				indirect += uw * shares[w][v]
+			}
+			total += (direct + indirect) * (direct + indirect)
+		}
+		constraint[u] = total
+	}
+	return constraint
+}
+
+/*
+EffectiveSize computes Burt's effective size of every node of a weighted UndirectedGraph: its number of contacts minus
+their redundancy.
+
+Parameters:
+- g: The graph.
+- weight: A WeightFunc giving the (positive) strength of every edge; use UnitWeight for an unweighted graph.
+
+Returns:
+- sizes: For every node u, the sum over its neighbors v of 1 - Σ_w p_uw m_vw, with p_uw the share of the strength of
+u spent on w and m_vw the strength of the tie between v and w divided by the strongest tie of v. Nodes without edges
+have NaN.
+
+Description:
+In an unweighted graph the effective size of a node of degree k whose neighbors share t edges is k - 2t/k.
+*/
+func EffectiveSize(g *UndirectedGraph, weight WeightFunc) map[Node]float64 {
+	shares := proportionalTieStrengths(g, weight)
+	strongest := make(map[Node]float64, len(g.Nodes))
+	for node, neighbors := range g.Edges {
+		for _, neighbor := range neighbors {
+			if neighbor != node {
+				strongest[node] = math.Max(strongest[node], weight(node, neighbor))
+			}
+		}
+	}
+
+	sizes := make(map[Node]float64, len(g.Nodes))
+	for u := range g.Nodes {
+		if len(shares[u]) == 0 {
+			sizes[u] = math.NaN()
+			continue
+		}
+		size := 0.0
+		for v := range shares[u] {
+			redundancy := 0.0
+			for w, uw := range shares[u] {
+				if w != v && g.HasEdge(v, w) && strongest[v] > 0 {
+					redundancy += uw * weight(v, w) / strongest[v]
+				}
+			}
+			size += 1 - redundancy
+		}
+		sizes[u] = size
+	}
+	return sizes
+}
+
+// Efficiency computes Burt's efficiency of every node of a weighted UndirectedGraph: its effective size divided by its
+// number of contacts, see EffectiveSize. Nodes without edges have NaN.
+func Efficiency(g *UndirectedGraph, weight WeightFunc) map[Node]float64 {
+	efficiency := EffectiveSize(g, weight)
+	for node, size := range efficiency {
+		efficiency[node] = size / float64(len(proportionalTieNeighbors(g, node)))
+	}
+	return efficiency
+}
+
+// proportionalTieStrengths returns, for every node u and neighbor v, the share of the total strength of u carried by
+// the edge u-v. Self-loops are ignored and nodes of zero strength get no shares.
+func proportionalTieStrengths(g *UndirectedGraph, weight WeightFunc) map[Node]map[Node]float64 {
+	shares := make(map[Node]map[Node]float64, len(g.Nodes))
+	for node := range g.Nodes {
+		neighbors := proportionalTieNeighbors(g, node)
+		strength := 0.0
+		for _, neighbor := range neighbors {
+			strength += weight(node, neighbor)
+		}
+		if strength <= 0 {
+			continue
+		}
+		shares[node] = make(map[Node]float64, len(neighbors))
+		for _, neighbor := range neighbors {
+			shares[node][neighbor] = weight(node, neighbor) / strength
+		}
+	}
+	return shares
+}
+
+// proportionalTieNeighbors returns the neighbors of a node other than itself.
+func proportionalTieNeighbors(g *UndirectedGraph, node Node) []Node {
+	neighbors := make([]Node, 0, len(g.Edges[node]))
+	for _, neighbor := range g.Edges[node] {
+		if neighbor != node {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+	return neighbors
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStructuralHoles(t *testing.T) {
+	weighted := CompleteGraph(3)
+	weights := weightsFromMap(map[Edge]float64{{Node1: 0, Node2: 1}: 2, {Node1: 0, Node2: 2}: 1, {Node1: 1, Node2: 2}: 1})
+
+	testCases := []struct {
+		name                              string
+		g                                 *UndirectedGraph
+		weight                            WeightFunc
+		node                              Node
+		constraint, effective, efficiency float64
+	}{
+		// The center of a star bridges every pair of its 4 leaves
+		{"star center", StarGraph(5), UnitWeight, 0, 0.25, 4, 1},
+		{"star leaf", StarGraph(5), UnitWeight, 1, 1, 1, 1},
+		// In a triangle every tie is redundant: k - 2t/k = 2 - 1
+		{"triangle", CompleteGraph(3), UnitWeight, 0, 1.125, 1, 0.5},
+		{"weighted triangle", weighted, weights, 0, 325.0 / 324, 7.0 / 6, 7.0 / 12},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			constraint := Constraint(testCase.g, testCase.weight)[testCase.node]
+			effective := EffectiveSize(testCase.g, testCase.weight)[testCase.node]
+			efficiency := Efficiency(testCase.g, testCase.weight)[testCase.node]
+			if math.Abs(constraint-testCase.constraint) > 1e-9 {
+				t.Errorf("Expected constraint %v, but got %v", testCase.constraint, constraint)
+			}
+			if math.Abs(effective-testCase.effective) > 1e-9 {
+				t.Errorf("Expected effective size %v, but got %v", testCase.effective, effective)
+			}
+			if math.Abs(efficiency-testCase.efficiency) > 1e-9 {
+				t.Errorf("Expected efficiency %v, but got %v", testCase.efficiency, efficiency)
+			}
+		})
+	}
+
+	g := PathGraph(2)
+	g.AddNode(5)
+	if c := Constraint(g, UnitWeight)[5]; !math.IsNaN(c) {
+		t.Errorf("Expected NaN for an isolated node, but got %v", c)
+	}
+}
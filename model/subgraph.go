@@ -0,0 +1,142 @@
+package model
+
+/*
+GraphView is a read-only view of a subgraph of an UndirectedGraph, as returned by SubgraphView and EdgeSubgraphView.
+
+A view stores only the nodes, or the edges, it keeps and filters the adjacency lists of its parent graph when they are
+read, so that taking it costs nothing more than listing them. It follows its parent: nodes and edges removed from the
+parent disappear from the view, and edges added to the parent between nodes of a node-induced view appear in it.
+Copy turns a view into an independent UndirectedGraph, for the algorithms that need one.
+*/
+type GraphView struct {
+	parent *UndirectedGraph
+	nodes  map[Node]bool
+	// edges holds the edges of an edge-induced view, keyed with the smaller node first, and is nil for a node-induced one.
+	edges map[Edge]bool
+}
+
+// Subgraph returns a copy of the subgraph of g induced by nodes: those of them in g and the edges of g between them.
+func Subgraph(g *UndirectedGraph, nodes []Node) *UndirectedGraph {
+	return SubgraphView(g, nodes).Copy()
+}
+
+// EdgeSubgraph returns a copy of the subgraph of g formed by edges, those of them in g in either orientation, and their
+// endpoints.
+func EdgeSubgraph(g *UndirectedGraph, edges []Edge) *UndirectedGraph {
+	return EdgeSubgraphView(g, edges).Copy()
+}
+
+// SubgraphView returns a read-only view of the subgraph of g induced by nodes; nodes not in g are ignored.
+func SubgraphView(g *UndirectedGraph, nodes []Node) *GraphView {
+	view := &GraphView{parent: g, nodes: make(map[Node]bool, len(nodes))}
+	for _, node := range nodes {
+		if g.Nodes[node] {
+			view.nodes[node] = true
+		}
+	}
+	return view
+}
+
+// EdgeSubgraphView returns a read-only view of the subgraph of g formed by edges and their endpoints; edges not in g
+// are ignored.
+func EdgeSubgraphView(g *UndirectedGraph, edges []Edge) *GraphView {
+	view := &GraphView{parent: g, nodes: make(map[Node]bool), edges: make(map[Edge]bool, len(edges))}
+	for _, edge := range edges {
+		if g.HasEdge(edge.Node1, edge.Node2) {
+			view.edges[orderedEdge(edge.Node1, edge.Node2)] = true
+			view.nodes[edge.Node1] = true
+			view.nodes[edge.Node2] = true
+		}
+	}
+	return view
+}
+
+// HasNode reports whether node is in the view.
+func (v *GraphView) HasNode(node Node) bool {
+	return v.nodes[node] && v.parent.Nodes[node]
+}
+
+// HasEdge reports whether the edge between a and b is in the view.
+func (v *GraphView) HasEdge(a, b Node) bool {
+	if !v.HasNode(a) || !v.HasNode(b) || !v.parent.HasEdge(a, b) {
+		return false
+	}
+	return v.edges == nil || v.edges[orderedEdge(a, b)]
+}
+
+// Nodes returns the nodes of the view in ascending order.
+func (v *GraphView) Nodes() []Node {
+	nodes := make([]Node, 0, len(v.nodes))
+	for _, node := range sortedNodes(v.nodes) {
+		if v.parent.Nodes[node] {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// NumberOfNodes returns the number of nodes of the view.
+func (v *GraphView) NumberOfNodes() int {
+	count := 0
+	for node := range v.nodes {
+		if v.parent.Nodes[node] {
+			count++
+		}
+	}
+	return count
+}
+
+// Neighbors returns the neighbors of node in the view, in the order of the adjacency list of the parent graph, a
+// self-loop appearing twice as in an UndirectedGraph.
+func (v *GraphView) Neighbors(node Node) []Node {
+	if !v.HasNode(node) {
+		return nil
+	}
+	var neighbors []Node
+	for _, neighbor := range v.parent.Edges[node] {
+		if v.nodes[neighbor] && (v.edges == nil || v.edges[orderedEdge(node, neighbor)]) {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+	return neighbors
+}
+
+// NodeDegree returns the degree of node in the view.
+func (v *GraphView) NodeDegree(node Node) int {
+	return len(v.Neighbors(node))
+}
+
+// NumberOfEdges returns the number of edges of the view.
+func (v *GraphView) NumberOfEdges() int {
+	total := 0
+	for node := range v.nodes {
+		total += v.NodeDegree(node)
+	}
+	return total / 2
+}
+
+// GetEdgeTuples returns the edges of the view in both orientations, like UndirectedGraph.GetEdgeTuples.
+func (v *GraphView) GetEdgeTuples() []Edge {
+	var edges []Edge
+	for _, node := range v.Nodes() {
+		for _, neighbor := range v.Neighbors(node) {
+			edges = append(edges, Edge{Node1: node, Node2: neighbor})
+		}
+	}
+	return edges
+}
+
+// Copy returns the subgraph seen by the view as an independent UndirectedGraph.
+func (v *GraphView) Copy() *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	for node := range v.nodes {
+		if !v.parent.Nodes[node] {
+			continue
+		}
+		g.Nodes[node] = true
+		if neighbors := v.Neighbors(node); len(neighbors) > 0 {
+			g.Edges[node] = neighbors
+		}
+	}
+	return g
+}
@@ -0,0 +1,172 @@
+package model
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+)
+
+/*
+EnumerateConnectedSubgraphs lists every set of k nodes that induces a connected subgraph.
+
+Parameters:
+- g: The graph.
+- k: The number of nodes of the subgraphs.
+
+Returns:
+- subgraphs: Every connected node set once, its nodes sorted, in the order they are found.
+
+Description:
+The sets are enumerated with the ESU algorithm of Wernicke: every set is grown from its smallest node, only adding
+nodes larger than it that are exclusive neighbors of the last added node, so that no set is produced twice. The
+number of sets grows exponentially with k.
+*/
+func EnumerateConnectedSubgraphs(g *UndirectedGraph, k int) [][]Node {
+	subgraphs := [][]Node{}
+	if k <= 0 {
+		return subgraphs
+	}
+
+	var extend func(subgraph []Node, extension []Node, root Node, neighborhood map[Node]bool)
+	extend = func(subgraph []Node, extension []Node, root Node, neighborhood map[Node]bool) {
+		if len(subgraph) == k {
+			sorted := append([]Node{}, subgraph...)
+			slices.Sort(sorted)
+			subgraphs = append(subgraphs, sorted)
+			return
+		}
+		for len(extension) > 0 {
+			w := extension[len(extension)-1]
+			extension = extension[:len(extension)-1]
+
+			nextExtension := append([]Node{}, extension...)
+			nextNeighborhood := make(map[Node]bool, len(neighborhood)+len(g.Edges[w]))
+			for node := range neighborhood {
+				nextNeighborhood[node] = true
+			}
+			for _, u := range g.Edges[w] {
+				if u > root && !neighborhood[u] {
+					nextExtension = append(nextExtension, u)
+				}
+				nextNeighborhood[u] = true
+			}
+			extend(append(subgraph, w), nextExtension, root, nextNeighborhood)
+		}
+	}
+
+	for _, root := range sortedNodes(g.Nodes) {
+		neighborhood := map[Node]bool{root: true}
+		var extension []Node
+		for _, u := range g.Edges[root] {
+			neighborhood[u] = true
+			if u > root {
+				extension = append(extension, u)
+			}
+		}
+		extend([]Node{root}, extension, root, neighborhood)
+	}
+	return subgraphs
+}
+
+/*
+SampleConnectedSubgraphs samples sets of k nodes inducing connected subgraphs with a Markov chain whose stationary distribution is uniform.
+
+Parameters:
+- g: The graph.
+- k: The number of nodes of the subgraphs.
+- count: The number of samples.
+- steps: The number of steps of the chain before the first sample and between consecutive samples.
+- seed: The seed of the random number generator.
+
+Returns:
+- samples: count node sets, each sorted.
+- err: An error if the parameters are invalid or no connected component has k nodes.
+
+Description:
+The chain starts from a set grown from a random node by repeatedly adding a random node of its boundary. At every step it proposes to
+swap a random node of the set for a random node of its boundary, rejecting proposals that disconnect the set, and
+accepts with the Metropolis-Hastings probability min(1, |boundary before| / |boundary after|), which makes the uniform
+distribution stationary. The chain never leaves the connected component it starts in, so samples are uniform within
+that component; the component is chosen with probability proportional to its number of nodes.
+*/
+func SampleConnectedSubgraphs(g *UndirectedGraph, k, count, steps int, seed int64) ([][]Node, error) {
+	if k <= 0 || count < 0 || steps < 0 {
+		return nil, fmt.Errorf("k must be positive and count and steps not negative")
+	}
+	rng := rand.New(rand.NewSource(seed))
+
+	var candidates []Node
+	components := ConnectedComponents(g)
+	for _, component := range components.ComponentsArray {
+		if len(component.Nodes) >= k {
+			candidates = append(candidates, GetDictKeys(component.Nodes)...)
+		}
+	}
+	slices.Sort(candidates)
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no connected component has %d nodes", k)
+	}
+
+	// Grow the initial set from a random node
+	start := candidates[rng.Intn(len(candidates))]
+	current := map[Node]bool{start: true}
+	for len(current) < k {
+		boundary := subgraphBoundary(g, current)
+		current[boundary[rng.Intn(len(boundary))]] = true
+	}
+
+	samples := make([][]Node, 0, count)
+	for len(samples) < count {
+		for step := 0; step < steps; step++ {
+			boundary := subgraphBoundary(g, current)
+			if len(boundary) == 0 {
+				// The set is a whole component, the only state of the chain
+				break
+			}
+			members := sortedNodes(current)
+			out, in := members[rng.Intn(len(members))], boundary[rng.Intn(len(boundary))]
+
+			delete(current, out)
+			current[in] = true
+			if !inducesConnectedSubgraph(g, current) || rng.Float64()*float64(len(subgraphBoundary(g, current))) > float64(len(boundary)) {
+				delete(current, in)
+				current[out] = true
+			}
+		}
+		samples = append(samples, sortedNodes(current))
+	}
+	return samples, nil
+}
+
+// subgraphBoundary returns the nodes outside the set adjacent to one of its nodes, sorted.
+func subgraphBoundary(g *UndirectedGraph, nodes map[Node]bool) []Node {
+	boundary := make(map[Node]bool)
+	for node := range nodes {
+		for _, neighbor := range g.Edges[node] {
+			if !nodes[neighbor] {
+				boundary[neighbor] = true
+			}
+		}
+	}
+	return sortedNodes(boundary)
+}
+
+// inducesConnectedSubgraph reports whether the nodes of the set are connected through edges between them.
+func inducesConnectedSubgraph(g *UndirectedGraph, nodes map[Node]bool) bool {
+	for start := range nodes {
+		visited := map[Node]bool{start: true}
+		stack := []Node{start}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, neighbor := range g.Edges[node] {
+				if nodes[neighbor] && !visited[neighbor] {
+					visited[neighbor] = true
+					stack = append(stack, neighbor)
+				}
+			}
+		}
+		return len(visited) == len(nodes)
+	}
+	return true
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestEnumerateConnectedSubgraphs(t *testing.T) {
+	// Test case 1: Small graphs with known counts
+	if count := len(EnumerateConnectedSubgraphs(CycleGraph(5), 3)); count != 5 {
+		t.Errorf("Test case 1 failed: Expected 5 connected triples in C5, but got %d", count)
+	}
+	if count := len(EnumerateConnectedSubgraphs(StarGraph(5), 3)); count != 6 {
+		t.Errorf("Test case 1 failed: Expected 6 connected triples in a star, but got %d", count)
+	}
+
+	// Test case 2: Every connected set of a random graph is found exactly once
+	rng := rand.New(rand.NewSource(4))
+	g := &UndirectedGraph{}
+	for u := 0; u < 10; u++ {
+		g.AddNode(Node(u))
+		for v := u + 1; v < 10; v++ {
+			if rng.Float64() < 0.3 {
+				g.AddEdge(Edge{Node1: Node(u), Node2: Node(v)})
+			}
+		}
+	}
+	found := make(map[string]int)
+	for _, subgraph := range EnumerateConnectedSubgraphs(g, 4) {
+		found[fmt.Sprint(subgraph)]++
+	}
+	expected := 0
+	for mask := 0; mask < 1<<10; mask++ {
+		nodes := make(map[Node]bool)
+		for i := 0; i < 10; i++ {
+			if mask&(1<<i) != 0 {
+				nodes[Node(i)] = true
+			}
+		}
+		if len(nodes) != 4 || !inducesConnectedSubgraph(g, nodes) {
+			continue
+		}
+		expected++
+		if key := fmt.Sprint(sortedNodes(nodes)); found[key] != 1 {
+			t.Errorf("Test case 2 failed: Expected %s to be found once, but got %d", key, found[key])
+		}
+	}
+	if len(found) != expected {
+		t.Errorf("Test case 2 failed: Expected %d sets, but got %d", expected, len(found))
+	}
+}
+
+func TestSampleConnectedSubgraphs(t *testing.T) {
+	// A triangle with a pendant path: the connected triples have boundaries of different sizes
+	g := CycleGraph(3)
+	g.AddEdgesFromIntTupleList([][2]int{{2, 3}, {3, 4}})
+	triples := EnumerateConnectedSubgraphs(g, 3)
+
+	samples, err := SampleConnectedSubgraphs(g, 3, 20000, 3, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	counts := make(map[string]int)
+	for _, sample := range samples {
+		if !inducesConnectedSubgraph(g, map[Node]bool{sample[0]: true, sample[1]: true, sample[2]: true}) {
+			t.Fatalf("Expected a connected sample, but got %v", sample)
+		}
+		counts[fmt.Sprint(sample)]++
+	}
+	expected := float64(len(samples)) / float64(len(triples))
+	for _, triple := range triples {
+		if count := float64(counts[fmt.Sprint(triple)]); math.Abs(count-expected) > 0.1*expected {
+			t.Errorf("Expected about %v samples of %v, but got %v", expected, triple, count)
+		}
+	}
+
+	if _, err := SampleConnectedSubgraphs(g, 6, 1, 1, 1); err == nil {
+		t.Errorf("Expected an error when no component is large enough, but got nil")
+	}
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubgraph(t *testing.T) {
+	g := CompleteGraph(5)
+	g.AddEdge(Edge{Node1: 1, Node2: 1})
+
+	sub := Subgraph(g, []Node{0, 1, 2, 9})
+	expected := CompleteGraph(3)
+	expected.AddEdge(Edge{Node1: 1, Node2: 1})
+	if !sub.Equals(expected) {
+		t.Errorf("Expected a triangle with a self-loop, but got %v", sub)
+	}
+
+	edges := EdgeSubgraph(g, []Edge{{Node1: 3, Node2: 0}, {Node1: 0, Node2: 4}, {Node1: 1, Node2: 1}, {Node1: 7, Node2: 8}})
+	expected = &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 3}, {0, 4}, {1, 1}})
+	if !edges.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, edges)
+	}
+
+	// The copies do not share adjacency lists with g
+	sub.AddEdge(Edge{Node1: 0, Node2: 9})
+	if g.HasNode(9) || len(g.Edges[0]) != 4 {
+		t.Errorf("Expected g to be unchanged, but got %v", g)
+	}
+}
+
+func TestGraphView(t *testing.T) {
+	g := CycleGraph(6)
+	view := SubgraphView(g, []Node{0, 1, 2, 3})
+	if view.NumberOfNodes() != 4 || view.NumberOfEdges() != 3 {
+		t.Errorf("Expected 4 nodes and 3 edges, but got %d and %d", view.NumberOfNodes(), view.NumberOfEdges())
+	}
+	if !view.HasEdge(1, 2) || view.HasEdge(3, 4) || view.HasEdge(0, 5) {
+		t.Errorf("Expected only the edges between nodes of the view")
+	}
+	if neighbors := view.Neighbors(0); !reflect.DeepEqual(neighbors, []Node{1}) || view.NodeDegree(0) != 1 {
+		t.Errorf("Expected [1], but got %v", neighbors)
+	}
+	if view.Neighbors(4) != nil {
+		t.Errorf("Expected no neighbors outside the view")
+	}
+
+	// The view follows its parent
+	g.AddEdge(Edge{Node1: 0, Node2: 3})
+	g.RemoveNode(2)
+	if !reflect.DeepEqual(view.Nodes(), []Node{0, 1, 3}) || view.NumberOfEdges() != 2 || !view.HasEdge(0, 3) {
+		t.Errorf("Expected the nodes [0 1 3] and 2 edges, but got %v and %d", view.Nodes(), view.NumberOfEdges())
+	}
+	if len(view.GetEdgeTuples()) != 4 {
+		t.Errorf("Expected 4 edge tuples, but got %v", view.GetEdgeTuples())
+	}
+
+	edgeView := EdgeSubgraphView(g, []Edge{{Node1: 1, Node2: 0}, {Node1: 4, Node2: 5}})
+	if edgeView.NumberOfNodes() != 4 || edgeView.NumberOfEdges() != 2 || edgeView.HasEdge(0, 3) || edgeView.HasEdge(0, 5) {
+		t.Errorf("Expected only the given edges, but got %v", edgeView.GetEdgeTuples())
+	}
+	g.RemoveEdge(Edge{Node1: 4, Node2: 5})
+	if edgeView.NumberOfEdges() != 1 || edgeView.NumberOfNodes() != 4 {
+		t.Errorf("Expected 1 edge on 4 nodes, but got %v", edgeView.GetEdgeTuples())
+	}
+	if copied := edgeView.Copy(); len(copied.Nodes) != 4 || copied.NumberOfEdges() != 1 {
+		t.Errorf("Expected a copy with 1 edge on 4 nodes, but got %v", copied)
+	}
+}
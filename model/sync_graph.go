@@ -0,0 +1,130 @@
+package model
+
+import "sync"
+
+/*
+SyncGraph wraps an UndirectedGraph so that it can be shared between goroutines. It implements the Graph interface.
+
+Every method takes a lock on the graph for its whole duration, a shared one for the methods that only read it, so
+that each call is atomic: concurrent calls behave as if they ran one after the other in some order. Sequences of
+calls are not atomic; Read and Update run a function under a single lock, to query or change the graph in several
+steps, or to run an algorithm on it. The slices returned by the methods are copies that the caller owns.
+
+The wrapped graph must not be used directly while it is shared.
+*/
+type SyncGraph struct {
+	mu    sync.RWMutex
+	graph *UndirectedGraph
+}
+
+// NewSyncGraph wraps g, or an empty graph if g is nil, for concurrent use.
+func NewSyncGraph(g *UndirectedGraph) *SyncGraph {
+	if g == nil {
+		g = &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+	}
+	return &SyncGraph{graph: g}
+}
+
+// Read runs f with the graph under a shared lock; f must not change the graph or keep it after returning.
+func (s *SyncGraph) Read(f func(g *UndirectedGraph)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	f(s.graph)
+}
+
+// Update runs f with the graph under an exclusive lock; f must not keep the graph after returning.
+func (s *SyncGraph) Update(f func(g *UndirectedGraph)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(s.graph)
+}
+
+// Snapshot returns a copy of the graph that the caller owns.
+func (s *SyncGraph) Snapshot() *UndirectedGraph {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return copyGraph(s.graph)
+}
+
+// AddEdge adds an edge and its nodes, see UndirectedGraph.AddEdge.
+func (s *SyncGraph) AddEdge(edge Edge) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.graph.AddEdge(edge)
+}
+
+// AddNode adds a node if it does not already exist.
+func (s *SyncGraph) AddNode(node Node) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.graph.AddNode(node)
+}
+
+// RemoveEdge removes an edge, see UndirectedGraph.RemoveEdge.
+func (s *SyncGraph) RemoveEdge(edge Edge) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.graph.RemoveEdge(edge)
+}
+
+// RemoveNode removes a node and its edges, see UndirectedGraph.RemoveNode.
+func (s *SyncGraph) RemoveNode(node Node) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.graph.RemoveNode(node)
+}
+
+// HasNode reports whether node is in the graph.
+func (s *SyncGraph) HasNode(node Node) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.graph.HasNode(node)
+}
+
+// HasEdge reports whether the edge between u and v is in the graph.
+func (s *SyncGraph) HasEdge(u, v Node) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.graph.HasEdge(u, v)
+}
+
+// Neighbors returns a copy of the adjacency list of node.
+func (s *SyncGraph) Neighbors(node Node) []Node {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]Node(nil), s.graph.Edges[node]...)
+}
+
+// NodeDegree returns the degree of node, 0 if it is not in the graph.
+func (s *SyncGraph) NodeDegree(node Node) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.graph.NodeDegree(node)
+}
+
+// NumberOfNodes returns the number of nodes of the graph.
+func (s *SyncGraph) NumberOfNodes() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.graph.Nodes)
+}
+
+// NumberOfEdges returns the number of edges of the graph.
+func (s *SyncGraph) NumberOfEdges() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.graph.NumberOfEdges()
+}
+
+// GetEdgeTuples returns the edges of the graph in both orientations, see UndirectedGraph.GetEdgeTuples.
+func (s *SyncGraph) GetEdgeTuples() []Edge {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.graph.GetEdgeTuples()
+}
+
+// Sample samples a snapshot of the graph, so that sampling strategies which change the graph they sample leave it
+// intact.
+func (s *SyncGraph) Sample(sampler ISamplingStrategy, ratioNodesToDelete float32) (*UndirectedGraph, error) {
+	return sampler.Sample(s.Snapshot(), ratioNodesToDelete)
+}
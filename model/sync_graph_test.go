@@ -0,0 +1,76 @@
+package model
+
+import (
+	"sync"
+	"testing"
+)
+
+var _ Graph = (*SyncGraph)(nil)
+
+// These tests are meant to be run with the race detector: go test -race ./model
+func TestSyncGraphConcurrentMutation(t *testing.T) {
+	s := NewSyncGraph(nil)
+	const workers, nodesPerWorker = 8, 50
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			// Every worker builds its own path, plus an edge to the path of the next worker
+			for i := 1; i < nodesPerWorker; i++ {
+				s.AddEdge(Edge{Node1: Node(w*nodesPerWorker + i - 1), Node2: Node(w*nodesPerWorker + i)})
+			}
+			s.AddEdge(Edge{Node1: Node(w * nodesPerWorker), Node2: Node(((w + 1) % workers) * nodesPerWorker)})
+		}(w)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < nodesPerWorker; i++ {
+				s.NumberOfEdges()
+				s.NodeDegree(Node(i))
+				s.Neighbors(0)
+				s.HasEdge(0, 1)
+				s.Read(func(g *UndirectedGraph) { IsConnected(g) })
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.NumberOfNodes() != workers*nodesPerWorker || s.NumberOfEdges() != workers*nodesPerWorker {
+		t.Errorf("Expected %d nodes and edges, but got %d and %d", workers*nodesPerWorker, s.NumberOfNodes(), s.NumberOfEdges())
+	}
+	if snapshot := s.Snapshot(); !IsConnected(snapshot) {
+		t.Errorf("Expected a connected graph, but got %v", snapshot)
+	}
+}
+
+func TestSyncGraphUpdate(t *testing.T) {
+	s := NewSyncGraph(CompleteGraph(4))
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Removing the highest node and adding a new one is atomic
+			s.Update(func(g *UndirectedGraph) {
+				nodes := sortedNodes(g.Nodes)
+				g.RemoveNode(nodes[len(nodes)-1])
+				g.AddEdge(Edge{Node1: 0, Node2: nodes[len(nodes)-1] + 10})
+			})
+		}()
+	}
+	wg.Wait()
+	if s.NumberOfNodes() != 4 || !s.HasEdge(0, 43) {
+		t.Errorf("Expected 4 nodes with the edge (0, 43), but got %v", s.Snapshot())
+	}
+
+	snapshot := s.Snapshot()
+	snapshot.AddNode(100)
+	if s.HasNode(100) {
+		t.Errorf("Expected the snapshot to be independent of the graph")
+	}
+	s.RemoveEdge(Edge{Node1: 0, Node2: 43})
+	s.RemoveNode(43)
+	if s.HasNode(43) || len(s.GetEdgeTuples()) != 6 {
+		t.Errorf("Expected a triangle, but got %v", s.GetEdgeTuples())
+	}
+}
@@ -0,0 +1,61 @@
+package model
+
+import "sort"
+
+// Contact is a timestamped interaction between two nodes.
+type Contact struct {
+	Node1 Node
+	Node2 Node
+	Time  float64
+}
+
+// TemporalGraph is a graph whose edges only exist at given points in time.
+// In an undirected temporal graph a contact can be traversed in both
+// directions; in a directed one only from Node1 to Node2.
+type TemporalGraph struct {
+	Nodes    map[Node]bool
+	Contacts []Contact
+	Directed bool
+}
+
+// AddNode adds a node to the TemporalGraph if it does not already exist.
+func (g *TemporalGraph) AddNode(node Node) {
+	// Ensure the existence of the Nodes map
+	if g.Nodes == nil {
+		g.Nodes = make(map[Node]bool)
+	}
+
+	g.Nodes[node] = true
+}
+
+// AddContact records a contact between two nodes, adding them to the graph if needed.
+func (g *TemporalGraph) AddContact(contact Contact) {
+	g.AddNode(contact.Node1)
+	g.AddNode(contact.Node2)
+	g.Contacts = append(g.Contacts, contact)
+}
+
+// HasNode checks if the TemporalGraph contains a specific node.
+func (g *TemporalGraph) HasNode(node Node) bool {
+	return g.Nodes[node]
+}
+
+// SortedContacts returns a copy of the contacts sorted by time, contacts with the same time keeping their insertion order.
+func (g *TemporalGraph) SortedContacts() []Contact {
+	contacts := append([]Contact{}, g.Contacts...)
+	sort.SliceStable(contacts, func(i, j int) bool { return contacts[i].Time < contacts[j].Time })
+	return contacts
+}
+
+// directedContacts returns the contacts in time order, each undirected contact being returned once per direction.
+func (g *TemporalGraph) directedContacts() []Contact {
+	contacts := g.SortedContacts()
+	if g.Directed {
+		return contacts
+	}
+	both := make([]Contact, 0, 2*len(contacts))
+	for _, contact := range contacts {
+		both = append(both, contact, Contact{Node1: contact.Node2, Node2: contact.Node1, Time: contact.Time})
+	}
+	return both
+}
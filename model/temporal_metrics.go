@@ -0,0 +1,152 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+// InterContactStatistics summarizes the times elapsed between consecutive contacts of the same pair of nodes.
+type InterContactStatistics struct {
+	Count  int
+	Mean   float64
+	StdDev float64
+	Min    float64
+	Max    float64
+}
+
+// TemporalDegree returns, for every node, the number of distinct nodes it had a contact with in the time window [start, end).
+func TemporalDegree(g *TemporalGraph, start, end float64) map[Node]int {
+	neighbors := make(map[Node]map[Node]bool, len(g.Nodes))
+	for node := range g.Nodes {
+		neighbors[node] = make(map[Node]bool)
+	}
+	for _, contact := range g.Contacts {
+		if contact.Time < start || contact.Time >= end || contact.Node1 == contact.Node2 {
+			continue
+		}
+		neighbors[contact.Node1][contact.Node2] = true
+		neighbors[contact.Node2][contact.Node1] = true
+	}
+
+	degrees := make(map[Node]int, len(neighbors))
+	for node, set := range neighbors {
+		degrees[node] = len(set)
+	}
+	return degrees
+}
+
+/*
+TemporalBetweenness computes the foremost-path betweenness of every node of a TemporalGraph.
+
+Parameters:
+- g: The temporal graph.
+- startTime: The time from which paths may start.
+
+Returns:
+- betweenness: For every node, the fraction of ordered pairs (s, t), with s and t distinct from the node, such that the node lies on the earliest-arrival path from s to t computed by EarliestArrivalPath. Pairs that are not temporally connected do not contribute.
+
+Description:
+Only one foremost path is considered per pair, the one found first in time order, so the values are an approximation of the betweenness over all foremost paths.
+*/
+func TemporalBetweenness(g *TemporalGraph, startTime float64) map[Node]float64 {
+	betweenness := make(map[Node]float64, len(g.Nodes))
+	for node := range g.Nodes {
+		betweenness[node] = 0
+	}
+	n := len(g.Nodes)
+	if n < 3 {
+		return betweenness
+	}
+
+	for source := range g.Nodes {
+		arrival, parents := earliestArrival(g, source, startTime)
+		for target := range arrival {
+			if target == source {
+				continue
+			}
+			for node := parents[target].Node1; node != source; node = parents[node].Node1 {
+				betweenness[node]++
+			}
+		}
+	}
+
+	for node := range betweenness {
+		betweenness[node] /= float64((n - 1) * (n - 2))
+	}
+	return betweenness
+}
+
+// InterContactTimes returns the times elapsed between consecutive contacts of every pair of nodes, pairs being unordered unless the graph is directed.
+func InterContactTimes(g *TemporalGraph) []float64 {
+	lastContact := make(map[Edge]float64)
+	var times []float64
+	for _, contact := range g.SortedContacts() {
+		pair := Edge{Node1: contact.Node1, Node2: contact.Node2}
+		if !g.Directed {
+			pair = orderedEdge(contact.Node1, contact.Node2)
+		}
+		if last, ok := lastContact[pair]; ok {
+			times = append(times, contact.Time-last)
+		}
+		lastContact[pair] = contact.Time
+	}
+	return times
+}
+
+// InterContactTimeStatistics returns the count, mean, standard deviation and range of the inter-contact times of a TemporalGraph.
+func InterContactTimeStatistics(g *TemporalGraph) InterContactStatistics {
+	times := InterContactTimes(g)
+	stats := InterContactStatistics{Count: len(times)}
+	if len(times) == 0 {
+		return stats
+	}
+
+	stats.Min, stats.Max = math.Inf(1), math.Inf(-1)
+	for _, time := range times {
+		stats.Mean += time
+		stats.Min = math.Min(stats.Min, time)
+		stats.Max = math.Max(stats.Max, time)
+	}
+	stats.Mean /= float64(len(times))
+	for _, time := range times {
+		stats.StdDev += (time - stats.Mean) * (time - stats.Mean)
+	}
+	stats.StdDev = math.Sqrt(stats.StdDev / float64(len(times)))
+	return stats
+}
+
+/*
+Snapshots aggregates a TemporalGraph into a sequence of static graphs.
+
+Parameters:
+- g: The temporal graph.
+- windowSize: The duration covered by every snapshot.
+
+Returns:
+- snapshots: One UndirectedGraph per window [t0 + i*windowSize, t0 + (i+1)*windowSize), t0 being the time of the first contact. Every snapshot contains all the nodes of g and an edge for every pair of nodes in contact during the window; the direction of the contacts is ignored.
+- err: An error if windowSize is not positive.
+*/
+func Snapshots(g *TemporalGraph, windowSize float64) ([]*UndirectedGraph, error) {
+	if windowSize <= 0 {
+		return nil, fmt.Errorf("window size must be positive")
+	}
+
+	contacts := g.SortedContacts()
+	if len(contacts) == 0 {
+		return []*UndirectedGraph{}, nil
+	}
+	start := contacts[0].Time
+	count := int((contacts[len(contacts)-1].Time-start)/windowSize) + 1
+
+	nodes := GetDictKeys(g.Nodes)
+	snapshots := make([]*UndirectedGraph, count)
+	for i := range snapshots {
+		snapshots[i] = &UndirectedGraph{Nodes: make(map[Node]bool, len(nodes)), Edges: make(map[Node][]Node)}
+		snapshots[i].AddNodes(nodes)
+	}
+	for _, contact := range contacts {
+		window := int((contact.Time - start) / windowSize)
+		snapshots[window].AddEdge(Edge{Node1: contact.Node1, Node2: contact.Node2})
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTemporalDegree(t *testing.T) {
+	g := contactChain()
+
+	expected := map[Node]int{1: 1, 2: 2, 3: 2, 4: 1}
+	if degrees := TemporalDegree(g, 0, 10); !reflect.DeepEqual(degrees, expected) {
+		t.Errorf("Expected %v, but got %v", expected, degrees)
+	}
+
+	expected = map[Node]int{1: 0, 2: 0, 3: 1, 4: 1}
+	if degrees := TemporalDegree(g, 0, 1); !reflect.DeepEqual(degrees, expected) {
+		t.Errorf("Expected %v, but got %v", expected, degrees)
+	}
+}
+
+func TestTemporalBetweenness(t *testing.T) {
+	expected := map[Node]float64{1: 0, 2: 2.0 / 6, 3: 3.0 / 6, 4: 0}
+	if betweenness := TemporalBetweenness(contactChain(), 0); !reflect.DeepEqual(betweenness, expected) {
+		t.Errorf("Expected %v, but got %v", expected, betweenness)
+	}
+}
+
+func TestInterContactTimeStatistics(t *testing.T) {
+	g := contactChain()
+	g.AddContact(Contact{Node1: 4, Node2: 3, Time: 4.5})
+
+	expectedTimes := []float64{2.5, 1.5}
+	if times := InterContactTimes(g); !reflect.DeepEqual(times, expectedTimes) {
+		t.Errorf("Expected %v, but got %v", expectedTimes, times)
+	}
+
+	stats := InterContactTimeStatistics(g)
+	expected := InterContactStatistics{Count: 2, Mean: 2, StdDev: 0.5, Min: 1.5, Max: 2.5}
+	if stats != expected {
+		t.Errorf("Expected %+v, but got %+v", expected, stats)
+	}
+
+	if stats := InterContactTimeStatistics(&TemporalGraph{}); stats.Count != 0 {
+		t.Errorf("Expected no inter-contact times, but got %+v", stats)
+	}
+}
+
+func TestSnapshots(t *testing.T) {
+	snapshots, err := Snapshots(contactChain(), 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(snapshots) != 3 {
+		t.Fatalf("Expected 3 snapshots, but got %d", len(snapshots))
+	}
+
+	expectedEdges := []map[Node][]Node{
+		{3: {4}, 4: {3}, 1: {2}, 2: {1}},
+		{2: {3}, 3: {2}},
+		{3: {4}, 4: {3}},
+	}
+	for i, snapshot := range snapshots {
+		if len(snapshot.Nodes) != 4 {
+			t.Errorf("Expected snapshot %d to have 4 nodes, but got %d", i, len(snapshot.Nodes))
+		}
+		if !reflect.DeepEqual(snapshot.Edges, expectedEdges[i]) {
+			t.Errorf("Expected snapshot %d to have edges %v, but got %v", i, expectedEdges[i], snapshot.Edges)
+		}
+	}
+
+	if _, err := Snapshots(contactChain(), 0); err == nil {
+		t.Errorf("Expected an error for an empty window, but got nil")
+	}
+}
@@ -0,0 +1,170 @@
+package model
+
+import "fmt"
+
+/*
+EarliestArrivalTimes computes, for every node, the earliest time it can be reached from source by a time-respecting path.
+
+Parameters:
+- g: The temporal graph.
+- source: The node the paths start from.
+- startTime: The time at which source starts spreading; contacts before it are ignored.
+
+Returns:
+- arrival: A map from every reachable node to its earliest arrival time; source arrives at startTime. Unreachable nodes are omitted.
+
+Description:
+A time-respecting path uses contacts with non-decreasing times, so a node can pass something on during the very contact it received it in a chain of simultaneous contacts. The contacts are scanned once in time order.
+
+Reference: H. Wu et al., "Path problems in temporal graphs", PVLDB 7, 2014.
+*/
+func EarliestArrivalTimes(g *TemporalGraph, source Node, startTime float64) map[Node]float64 {
+	arrival, _ := earliestArrival(g, source, startTime)
+	return arrival
+}
+
+// TemporallyReachable returns the set of nodes, excluding source, reachable from source by a time-respecting path starting at startTime.
+// This answers contact-tracing questions such as "who could source have infected after startTime?".
+func TemporallyReachable(g *TemporalGraph, source Node, startTime float64) map[Node]bool {
+	reached := make(map[Node]bool)
+	for node := range EarliestArrivalTimes(g, source, startTime) {
+		if node != source {
+			reached[node] = true
+		}
+	}
+	return reached
+}
+
+/*
+EarliestArrivalPath returns a time-respecting path from source to target arriving as early as possible.
+
+Returns:
+- path: The contacts of the path in time order, each contact oriented from the node it leaves to the node it reaches. The path is empty when source and target are the same node.
+- err: An error if a node is missing or target cannot be reached after startTime.
+*/
+func EarliestArrivalPath(g *TemporalGraph, source, target Node, startTime float64) ([]Contact, error) {
+	if !g.HasNode(source) || !g.HasNode(target) {
+		return nil, fmt.Errorf("source and target must be part of the graph")
+	}
+
+	arrival, parents := earliestArrival(g, source, startTime)
+	if _, ok := arrival[target]; !ok {
+		return nil, fmt.Errorf("%d cannot be reached from %d after time %v", target, source, startTime)
+	}
+
+	var path []Contact
+	for node := target; node != source; {
+		contact := parents[node]
+		path = append([]Contact{contact}, path...)
+		node = contact.Node1
+	}
+	return path, nil
+}
+
+/*
+LatestDepartureTimes computes, for every node, the latest time it can leave and still reach target by a time-respecting path before deadline.
+
+Returns:
+- departure: A map from every node that can reach target to its latest departure time; target departs at deadline. Nodes that cannot reach target are omitted.
+*/
+func LatestDepartureTimes(g *TemporalGraph, target Node, deadline float64) map[Node]float64 {
+	departure, _ := latestDeparture(g, target, deadline)
+	return departure
+}
+
+/*
+LatestDeparturePath returns a time-respecting path from source to target that leaves as late as possible and arrives no later than deadline.
+
+Returns:
+- path: The contacts of the path in time order, each contact oriented from the node it leaves to the node it reaches. The path is empty when source and target are the same node.
+- err: An error if a node is missing or target cannot be reached before deadline.
+*/
+func LatestDeparturePath(g *TemporalGraph, source, target Node, deadline float64) ([]Contact, error) {
+	if !g.HasNode(source) || !g.HasNode(target) {
+		return nil, fmt.Errorf("source and target must be part of the graph")
+	}
+
+	departure, next := latestDeparture(g, target, deadline)
+	if _, ok := departure[source]; !ok {
+		return nil, fmt.Errorf("%d cannot reach %d before time %v", source, target, deadline)
+	}
+
+	var path []Contact
+	for node := source; node != target; {
+		contact := next[node]
+		path = append(path, contact)
+		node = contact.Node2
+	}
+	return path, nil
+}
+
+// earliestArrival returns the earliest arrival times from source and the contact through which every node was first reached.
+func earliestArrival(g *TemporalGraph, source Node, startTime float64) (map[Node]float64, map[Node]Contact) {
+	arrival := make(map[Node]float64)
+	parents := make(map[Node]Contact)
+	if !g.HasNode(source) {
+		return arrival, parents
+	}
+	arrival[source] = startTime
+
+	contacts := g.directedContacts()
+	for start := 0; start < len(contacts); {
+		end := start
+		for end < len(contacts) && contacts[end].Time == contacts[start].Time {
+			end++
+		}
+
+		// simultaneous contacts are relaxed until no arrival changes, so that chains within the same instant are followed
+		for changed := true; changed; {
+			changed = false
+			for _, contact := range contacts[start:end] {
+				from, ok := arrival[contact.Node1]
+				if !ok || from > contact.Time {
+					continue
+				}
+				if to, ok := arrival[contact.Node2]; !ok || contact.Time < to {
+					arrival[contact.Node2] = contact.Time
+					parents[contact.Node2] = contact
+					changed = true
+				}
+			}
+		}
+		start = end
+	}
+	return arrival, parents
+}
+
+// latestDeparture returns the latest departure times towards target and the contact every node should leave through.
+func latestDeparture(g *TemporalGraph, target Node, deadline float64) (map[Node]float64, map[Node]Contact) {
+	departure := make(map[Node]float64)
+	next := make(map[Node]Contact)
+	if !g.HasNode(target) {
+		return departure, next
+	}
+	departure[target] = deadline
+
+	contacts := g.directedContacts()
+	for end := len(contacts); end > 0; {
+		start := end - 1
+		for start > 0 && contacts[start-1].Time == contacts[end-1].Time {
+			start--
+		}
+
+		for changed := true; changed; {
+			changed = false
+			for _, contact := range contacts[start:end] {
+				to, ok := departure[contact.Node2]
+				if !ok || contact.Time > to {
+					continue
+				}
+				if from, ok := departure[contact.Node1]; !ok || contact.Time > from {
+					departure[contact.Node1] = contact.Time
+					next[contact.Node1] = contact
+					changed = true
+				}
+			}
+		}
+		end = start
+	}
+	return departure, next
+}
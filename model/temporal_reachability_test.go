@@ -0,0 +1,88 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func contactChain() *TemporalGraph {
+	g := &TemporalGraph{}
+	g.AddContact(Contact{Node1: 1, Node2: 2, Time: 1})
+	g.AddContact(Contact{Node1: 2, Node2: 3, Time: 2})
+	g.AddContact(Contact{Node1: 3, Node2: 4, Time: 0.5})
+	g.AddContact(Contact{Node1: 3, Node2: 4, Time: 3})
+	return g
+}
+
+func TestEarliestArrivalTimes(t *testing.T) {
+	g := contactChain()
+
+	// Test case 1: Spreading from the start
+	expected := map[Node]float64{1: 0, 2: 1, 3: 2, 4: 3}
+	if arrival := EarliestArrivalTimes(g, 1, 0); !reflect.DeepEqual(arrival, expected) {
+		t.Errorf("Test case 1 failed: Expected %v, but got %v", expected, arrival)
+	}
+
+	// Test case 2: The only contact of 1 happened before the start time
+	if reached := TemporallyReachable(g, 1, 1.5); len(reached) != 0 {
+		t.Errorf("Test case 2 failed: Expected nothing to be reachable, but got %v", reached)
+	}
+
+	// Test case 3: Contacts are traversed backwards in undirected graphs
+	expectedReached := map[Node]bool{3: true, 2: true}
+	if reached := TemporallyReachable(g, 4, 0); !reflect.DeepEqual(reached, expectedReached) {
+		t.Errorf("Test case 3 failed: Expected %v, but got %v", expectedReached, reached)
+	}
+
+	// Test case 4: Chains of simultaneous directed contacts are followed regardless of their order
+	directed := &TemporalGraph{Directed: true}
+	directed.AddContact(Contact{Node1: 2, Node2: 3, Time: 1})
+	directed.AddContact(Contact{Node1: 1, Node2: 2, Time: 1})
+	expectedReached = map[Node]bool{2: true, 3: true}
+	if reached := TemporallyReachable(directed, 1, 0); !reflect.DeepEqual(reached, expectedReached) {
+		t.Errorf("Test case 4 failed: Expected %v, but got %v", expectedReached, reached)
+	}
+	if reached := TemporallyReachable(directed, 3, 0); len(reached) != 0 {
+		t.Errorf("Test case 4 failed: Expected nothing to be reachable, but got %v", reached)
+	}
+}
+
+func TestEarliestArrivalPath(t *testing.T) {
+	g := contactChain()
+
+	path, err := EarliestArrivalPath(g, 1, 4, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []Contact{{Node1: 1, Node2: 2, Time: 1}, {Node1: 2, Node2: 3, Time: 2}, {Node1: 3, Node2: 4, Time: 3}}
+	if !reflect.DeepEqual(path, expected) {
+		t.Errorf("Expected %v, but got %v", expected, path)
+	}
+
+	if _, err := EarliestArrivalPath(g, 1, 4, 5); err == nil {
+		t.Errorf("Expected an error for an unreachable target, but got nil")
+	}
+}
+
+func TestLatestDeparture(t *testing.T) {
+	g := contactChain()
+
+	expected := map[Node]float64{1: 1, 2: 2, 3: 3, 4: 3}
+	if departure := LatestDepartureTimes(g, 4, 3); !reflect.DeepEqual(departure, expected) {
+		t.Errorf("Expected %v, but got %v", expected, departure)
+	}
+
+	path, err := LatestDeparturePath(g, 2, 4, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expectedPath := []Contact{{Node1: 2, Node2: 3, Time: 2}, {Node1: 3, Node2: 4, Time: 3}}
+	if !reflect.DeepEqual(path, expectedPath) {
+		t.Errorf("Expected %v, but got %v", expectedPath, path)
+	}
+
+	// Only the early contact between 3 and 4 is usable, and 1 cannot catch it
+	if _, err := LatestDeparturePath(g, 1, 4, 2.5); err == nil {
+		t.Errorf("Expected an error for an unreachable target, but got nil")
+	}
+}
@@ -0,0 +1,147 @@
+package model
+
+import "fmt"
+
+/*
+ThresholdGraph builds a threshold graph from its creation sequence.
+
+Parameters:
+- creationSequence: For every node in order, true if it is added as a dominating node, linked to all the nodes
+added before it, false if it is added as an isolated node. The first entry makes no difference.
+
+Returns:
+- g: The threshold graph, node i being the i-th node of the sequence.
+
+Example:
+
+	g := ThresholdGraph([]bool{false, false, true, false, true})
+
+	fmt.Println(g.NumberOfEdges()) // Output: 6
+*/
+func ThresholdGraph(creationSequence []bool) *UndirectedGraph {
+	g := &UndirectedGraph{Nodes: make(map[Node]bool, len(creationSequence)), Edges: make(map[Node][]Node)}
+	for i, dominating := range creationSequence {
+		g.AddNode(Node(i))
+		if dominating {
+			for j := 0; j < i; j++ {
+				g.AddEdge(Edge{Node1: Node(j), Node2: Node(i)})
+			}
+		}
+	}
+	return g
+}
+
+/*
+ThresholdCreationSequence recognizes a threshold graph and returns how to build it.
+
+Parameters:
+- g: The graph.
+
+Returns:
+- nodes: The nodes of g in creation order.
+- creationSequence: The creation sequence, see ThresholdGraph, such that node i of ThresholdGraph(creationSequence) is nodes[i].
+- ok: Whether g is a threshold graph; nodes and creationSequence are nil otherwise.
+
+Description:
+A graph is a threshold graph if and only if it can be emptied by repeatedly removing an isolated or a dominating
+node. Both are recognized from the degrees alone, so the procedure runs in O(n^2) time on the degrees. The first
+node of the sequence is reported as isolated.
+*/
+func ThresholdCreationSequence(g *UndirectedGraph) ([]Node, []bool, bool) {
+	nodes := sortedNodes(g.Nodes)
+	degrees := make([]int, len(nodes))
+	for i, node := range nodes {
+		degrees[i] = g.NodeDegree(node)
+	}
+	order, sequence, ok := thresholdElimination(degrees)
+	if !ok {
+		return nil, nil, false
+	}
+	creationOrder := make([]Node, len(order))
+	for i, index := range order {
+		creationOrder[i] = nodes[index]
+	}
+	return creationOrder, sequence, true
+}
+
+// IsThresholdGraph checks if an UndirectedGraph is a threshold graph, see ThresholdCreationSequence.
+func IsThresholdGraph(g *UndirectedGraph) bool {
+	_, _, ok := ThresholdCreationSequence(g)
+	return ok
+}
+
+// ThresholdDegreeSequence returns the degree of every node of ThresholdGraph(creationSequence).
+func ThresholdDegreeSequence(creationSequence []bool) []int {
+	degrees := make([]int, len(creationSequence))
+	dominatingAfter := 0
+	for i := len(creationSequence) - 1; i >= 0; i-- {
+		degrees[i] = dominatingAfter
+		if creationSequence[i] {
+			degrees[i] += i
+			dominatingAfter++
+		}
+	}
+	return degrees
+}
+
+/*
+ThresholdCreationSequenceFromDegrees returns the creation sequence of the threshold graph with a degree sequence.
+
+Parameters:
+- degrees: The degree sequence, in any order.
+
+Returns:
+- creationSequence: The creation sequence, see ThresholdGraph. A threshold graph is determined by its degrees up to
+isomorphism, so ThresholdGraph(creationSequence) realizes degrees, node i of the graph having degree degrees[order[i]].
+- order: The index in degrees of every node of the sequence.
+- err: An error if no threshold graph has this degree sequence.
+*/
+func ThresholdCreationSequenceFromDegrees(degrees []int) ([]bool, []int, error) {
+	order, sequence, ok := thresholdElimination(degrees)
+	if !ok {
+		return nil, nil, fmt.Errorf("%v is not the degree sequence of a threshold graph", degrees)
+	}
+	return sequence, order, nil
+}
+
+// thresholdElimination repeatedly removes an isolated or a dominating node, given by its index in degrees. It returns
+// the nodes in creation order, which is the reverse of the removal order, and their creation sequence.
+func thresholdElimination(degrees []int) ([]int, []bool, bool) {
+	removed := make([]bool, len(degrees))
+	order := make([]int, len(degrees))
+	sequence := make([]bool, len(degrees))
+	decrement := 0
+	for remaining := len(degrees); remaining > 0; remaining-- {
+		isolated, dominating := -1, -1
+		for i, degree := range degrees {
+			if removed[i] {
+				continue
+			}
+			switch degree - decrement {
+			case 0:
+				isolated = i
+			case remaining - 1:
+				dominating = i
+			}
+			if degree-decrement < 0 || degree-decrement > remaining-1 {
+				return nil, nil, false
+			}
+		}
+
+		position := remaining - 1
+		switch {
+		case isolated >= 0:
+			removed[isolated] = true
+			order[position] = isolated
+		case dominating >= 0:
+			removed[dominating] = true
+			order[position] = dominating
+			sequence[position] = position > 0
+			// Removing a dominating node lowers the degree of every remaining node
+			decrement++
+		default:
+			return nil, nil, false
+		}
+	}
+	return order, sequence, true
+}
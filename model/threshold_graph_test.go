@@ -0,0 +1,81 @@
+package model
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestThresholdGraph(t *testing.T) {
+	g := ThresholdGraph([]bool{false, false, true, false, true})
+	expected := &UndirectedGraph{}
+	expected.AddEdgesFromIntTupleList([][2]int{{0, 2}, {1, 2}, {0, 4}, {1, 4}, {2, 4}, {3, 4}})
+	if !g.Equals(expected) {
+		t.Errorf("Expected %v, but got %v", expected, g)
+	}
+	if degrees := ThresholdDegreeSequence([]bool{false, false, true, false, true}); !reflect.DeepEqual(degrees, []int{2, 2, 3, 1, 4}) {
+		t.Errorf("Expected [2 2 3 1 4], but got %v", degrees)
+	}
+}
+
+func TestThresholdCreationSequence(t *testing.T) {
+	// Test case 1: Relabeled threshold graphs are recognized and rebuilt
+	for _, sequence := range [][]bool{{false, true, false, false, true, true, false}, {false, false, false}, {true, true, true, true}} {
+		built := ThresholdGraph(sequence)
+		g := &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)}
+		for node := range built.Nodes {
+			g.AddNode(10 * node)
+		}
+		for _, edge := range built.GetEdgeTuples() {
+			g.AddEdge(Edge{Node1: 10 * edge.Node1, Node2: 10 * edge.Node2})
+		}
+
+		nodes, creationSequence, ok := ThresholdCreationSequence(g)
+		if !ok {
+			t.Fatalf("Test case 1 failed: Expected %v to be a threshold graph", g)
+		}
+		rebuilt := ThresholdGraph(creationSequence)
+		for _, edge := range rebuilt.GetEdgeTuples() {
+			if !g.HasEdge(nodes[edge.Node1], nodes[edge.Node2]) {
+				t.Errorf("Test case 1 failed: Expected nodes %v to map %v onto %v", nodes, rebuilt, g)
+			}
+		}
+		if rebuilt.NumberOfEdges() != g.NumberOfEdges() {
+			t.Errorf("Test case 1 failed: Expected %d edges, but got %d", g.NumberOfEdges(), rebuilt.NumberOfEdges())
+		}
+	}
+
+	// Test case 2: Graphs with an induced path on four nodes or a square are not threshold graphs
+	for _, g := range []*UndirectedGraph{PathGraph(4), CycleGraph(4), CycleGraph(5)} {
+		if IsThresholdGraph(g) {
+			t.Errorf("Test case 2 failed: Expected %v not to be a threshold graph", g)
+		}
+	}
+	if !IsThresholdGraph(StarGraph(5)) || !IsThresholdGraph(CompleteGraph(4)) {
+		t.Errorf("Test case 2 failed: Expected stars and complete graphs to be threshold graphs")
+	}
+}
+
+func TestThresholdCreationSequenceFromDegrees(t *testing.T) {
+	degrees := []int{1, 4, 2, 3, 2}
+	sequence, order, err := ThresholdCreationSequenceFromDegrees(degrees)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	realized := ThresholdDegreeSequence(sequence)
+	for i, index := range order {
+		if realized[i] != degrees[index] {
+			t.Errorf("Expected node %d to have degree %d, but got %d", i, degrees[index], realized[i])
+		}
+	}
+	sorted := append([]int{}, realized...)
+	sort.Ints(sorted)
+	if !reflect.DeepEqual(sorted, []int{1, 2, 2, 3, 4}) {
+		t.Errorf("Expected the degrees [1 2 2 3 4], but got %v", sorted)
+	}
+
+	// A path on four nodes has degrees 1, 2, 2, 1
+	if _, _, err := ThresholdCreationSequenceFromDegrees([]int{1, 2, 2, 1}); err == nil {
+		t.Errorf("Expected an error for the degrees of a path")
+	}
+}
@@ -0,0 +1,157 @@
+package model
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+)
+
+// ScoredNode is a node paired with a score, such as its centrality, its similarity to a query node or its distance
+// from a source.
+type ScoredNode struct {
+	Node  Node
+	Score float64
+}
+
+// scoredNodeHeap is a heap of ScoredNodes whose root is the worst one: the lowest score, ties broken by the largest
+// node, or the reverse if ascending. Keeping the k best nodes seen in it selects them in O(n log k) time.
+type scoredNodeHeap struct {
+	nodes     []ScoredNode
+	ascending bool
+}
+
+func (h *scoredNodeHeap) Len() int { return len(h.nodes) }
+
+func (h *scoredNodeHeap) Less(i, j int) bool { return ranksBefore(h.nodes[j], h.nodes[i], h.ascending) }
+
+func (h *scoredNodeHeap) Swap(i, j int) { h.nodes[i], h.nodes[j] = h.nodes[j], h.nodes[i] }
+
+func (h *scoredNodeHeap) Push(x any) { h.nodes = append(h.nodes, x.(ScoredNode)) }
+
+func (h *scoredNodeHeap) Pop() any {
+	item := h.nodes[len(h.nodes)-1]
+	h.nodes = h.nodes[:len(h.nodes)-1]
+	return item
+}
+
+// ranksBefore reports whether a comes before b in a ranking by decreasing score, or increasing if ascending, ties
+// broken by ascending node.
+func ranksBefore(a, b ScoredNode, ascending bool) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score != ascending
+	}
+	return a.Node < b.Node
+}
+
+// offer adds a node to the heap if it is among the k best seen so far.
+func (h *scoredNodeHeap) offer(node ScoredNode, k int) {
+	if len(h.nodes) < k {
+		heap.Push(h, node)
+	} else if k > 0 && ranksBefore(node, h.nodes[0], h.ascending) {
+		h.nodes[0] = node
+		heap.Fix(h, 0)
+	}
+}
+
+// ranked returns the nodes of the heap from the best to the worst.
+func (h *scoredNodeHeap) ranked() []ScoredNode {
+	sort.Slice(h.nodes, func(i, j int) bool { return ranksBefore(h.nodes[i], h.nodes[j], h.ascending) })
+	return h.nodes
+}
+
+/*
+TopKNodes selects the k nodes with the highest scores, such as the most central nodes of a graph, without sorting all
+the scores.
+
+Parameters:
+- scores: The score of every node, such as the map returned by a centrality measure.
+- k: The number of nodes to return; all of them if there are fewer.
+
+Returns:
+- top: The nodes with their scores, by decreasing score, ties broken by ascending node.
+
+Description:
+A min-heap of the k best nodes seen so far is kept, in O(n log k) time and O(k) memory. Use BottomKNodes for the
+lowest scores.
+*/
+func TopKNodes(scores map[Node]float64, k int) []ScoredNode {
+	return selectKNodes(scores, k, false)
+}
+
+// BottomKNodes selects the k nodes with the lowest scores, by increasing score, ties broken by ascending node. See
+// TopKNodes.
+func BottomKNodes(scores map[Node]float64, k int) []ScoredNode {
+	return selectKNodes(scores, k, true)
+}
+
+func selectKNodes(scores map[Node]float64, k int, ascending bool) []ScoredNode {
+	h := &scoredNodeHeap{nodes: make([]ScoredNode, 0, max(0, min(k, len(scores)))), ascending: ascending}
+	for node, score := range scores {
+		h.offer(ScoredNode{Node: node, Score: score}, k)
+	}
+	return h.ranked()
+}
+
+/*
+KNearestNodes finds the k nodes closest to a source, stopping the search as soon as they are known instead of computing
+the distances to the whole graph.
+
+Parameters:
+- g: The graph.
+- source: The node the distances are measured from, which is not returned.
+- k: The number of nodes to return; all the reachable nodes if there are fewer.
+- weight: The length of every edge, non-negative; UnitWeight counts the hops.
+
+Returns:
+- nearest: The nodes with their distances from source, by increasing distance, ties broken by ascending node.
+- err: An error if source is not in the graph, k is negative, or an edge of negative weight is reached.
+
+Description:
+Dijkstra's algorithm settles the nodes by increasing distance, so it stops once k nodes other than source are settled,
+after exploring only the neighborhood of source. Every node at the same distance as the k-th is settled before it is
+returned, so that the ties are broken by node and not by the order of the search.
+*/
+func KNearestNodes(g *UndirectedGraph, source Node, k int, weight WeightFunc) ([]ScoredNode, error) {
+	if !g.HasNode(source) {
+		return nil, fmt.Errorf("node %d is not in the graph", source)
+	}
+	if k < 0 {
+		return nil, fmt.Errorf("k must be non-negative, got %d", k)
+	}
+	distances := map[Node]float64{source: 0}
+	settled := make(map[Node]bool)
+	pq := &nodePriorityQueue{{node: source, priority: 0}}
+	var nearest []ScoredNode
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(nodePriorityItem)
+		if settled[item.node] {
+			continue
+		}
+		if len(nearest) >= k && (k == 0 || item.priority > nearest[len(nearest)-1].Score) {
+			break
+		}
+		settled[item.node] = true
+		if item.node != source {
+			nearest = append(nearest, ScoredNode{Node: item.node, Score: item.priority})
+		}
+		for _, neighbor := range g.Edges[item.node] {
+			w := weight(item.node, neighbor)
+			if w < 0 {
+				return nil, fmt.Errorf("negative weight %v on edge (%d, %d)", w, item.node, neighbor)
+			}
+			if settled[neighbor] {
+				continue
+			}
+			if current, ok := distances[neighbor]; !ok || item.priority+w < current {
+				distances[neighbor] = item.priority + w
+				heap.Push(pq, nodePriorityItem{node: neighbor, priority: item.priority + w})
+			}
+		}
+	}
+	// Nodes reached through edges of weight 0 can be settled after larger nodes at the same distance
+	sort.Slice(nearest, func(i, j int) bool { return ranksBefore(nearest[i], nearest[j], true) })
+	if len(nearest) > k {
+		nearest = nearest[:k]
+	}
+	return nearest, nil
+}
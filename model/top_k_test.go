@@ -0,0 +1,89 @@
+package model
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestTopKNodes(t *testing.T) {
+	scores := map[Node]float64{0: 0.5, 1: 2, 2: 0.5, 3: -1, 4: 2, 5: 1}
+	expected := []ScoredNode{{Node: 1, Score: 2}, {Node: 4, Score: 2}, {Node: 5, Score: 1}, {Node: 0, Score: 0.5}}
+	if top := TopKNodes(scores, 4); !reflect.DeepEqual(top, expected) {
+		t.Errorf("Expected %v, but got %v", expected, top)
+	}
+	expected = []ScoredNode{{Node: 3, Score: -1}, {Node: 0, Score: 0.5}, {Node: 2, Score: 0.5}}
+	if bottom := BottomKNodes(scores, 3); !reflect.DeepEqual(bottom, expected) {
+		t.Errorf("Expected %v, but got %v", expected, bottom)
+	}
+	if top := TopKNodes(scores, 10); len(top) != 6 {
+		t.Errorf("Expected all 6 nodes, but got %v", top)
+	}
+	if top := TopKNodes(scores, 0); len(top) != 0 {
+		t.Errorf("Expected no nodes, but got %v", top)
+	}
+
+	// The selection matches a full sort
+	rng := rand.New(rand.NewSource(1))
+	scores = make(map[Node]float64)
+	for node := Node(0); node < 1000; node++ {
+		scores[node] = float64(rng.Intn(100))
+	}
+	sorted := make([]ScoredNode, 0, len(scores))
+	for node, score := range scores {
+		sorted = append(sorted, ScoredNode{Node: node, Score: score})
+	}
+	sort.Slice(sorted, func(i, j int) bool { return ranksBefore(sorted[i], sorted[j], false) })
+	if top := TopKNodes(scores, 50); !reflect.DeepEqual(top, sorted[:50]) {
+		t.Errorf("Expected %v, but got %v", sorted[:50], top)
+	}
+}
+
+func TestKNearestNodes(t *testing.T) {
+	g := GridGraph(5, 5)
+	nearest, err := KNearestNodes(g, 12, 6, UnitWeight)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The 4 neighbors of the center of the grid, then the 2 smallest of the 8 nodes at distance 2
+	paths, _ := BFSShortestPaths(g, 12)
+	var expected []ScoredNode
+	for _, node := range sortedNodes(g.Nodes) {
+		if node != 12 {
+			expected = append(expected, ScoredNode{Node: node, Score: paths.Distances[node]})
+		}
+	}
+	sort.SliceStable(expected, func(i, j int) bool { return expected[i].Score < expected[j].Score })
+	if !reflect.DeepEqual(nearest, expected[:6]) {
+		t.Errorf("Expected %v, but got %v", expected[:6], nearest)
+	}
+
+	// Ties reached through an edge of weight 0 are still broken by node
+	h := &UndirectedGraph{}
+	h.AddEdgesFromIntTupleList([][2]int{{0, 5}, {0, 3}, {5, 1}})
+	weight := func(u, v Node) float64 {
+		if u+v == 6 {
+			return 0
+		}
+		return 1
+	}
+	nearest, err = KNearestNodes(h, 0, 2, weight)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected = []ScoredNode{{Node: 1, Score: 1}, {Node: 3, Score: 1}}
+	if !reflect.DeepEqual(nearest, expected) {
+		t.Errorf("Expected %v, but got %v", expected, nearest)
+	}
+	if nearest, _ := KNearestNodes(PathGraph(3), 0, 10, UnitWeight); len(nearest) != 2 {
+		t.Errorf("Expected the 2 reachable nodes, but got %v", nearest)
+	}
+
+	if _, err := KNearestNodes(g, 99, 1, UnitWeight); err == nil {
+		t.Errorf("Expected an error for a missing source")
+	}
+	if _, err := KNearestNodes(g, 0, -1, UnitWeight); err == nil {
+		t.Errorf("Expected an error for a negative k")
+	}
+}
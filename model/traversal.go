@@ -0,0 +1,292 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+TraversalVisitor holds the callbacks a traversal calls as it explores a graph, so that custom algorithms can be built on
+BFS, DFS and DFSForest. Every callback is optional.
+
+In an undirected graph every edge is reported once, either as a tree edge or as a non-tree edge, from the first of
+its nodes that explores it. In a directed graph every edge is reported once, from its source.
+*/
+type TraversalVisitor struct {
+	// OnDiscover is called when a node is reached for the first time.
+	OnDiscover func(node Node)
+	// OnFinish is called once all the edges of a node have been explored.
+	OnFinish func(node Node)
+	// OnTreeEdge is called for the edge through which a node is discovered, from the node it is discovered from.
+	OnTreeEdge func(parent, child Node)
+	// OnNonTreeEdge is called for every other edge, from the node exploring it to an already discovered node.
+	OnNonTreeEdge func(u, v Node)
+}
+
+// EdgeClass is the kind of an edge of a directed graph with respect to a depth-first forest, see ClassifyEdges.
+type EdgeClass int
+
+const (
+	// TreeEdge is an edge of the forest, through which a node is discovered.
+	TreeEdge EdgeClass = iota
+	// BackEdge leads to an ancestor of its source in the forest, or is a self-loop. A directed graph is acyclic if and
+	// only if it has none.
+	BackEdge
+	// ForwardEdge leads to a descendant of its source that is not its child in the forest.
+	ForwardEdge
+	// CrossEdge leads to a node that is neither an ancestor nor a descendant of its source, discovered earlier.
+	CrossEdge
+)
+
+// String returns the name of the edge class.
+func (c EdgeClass) String() string {
+	switch c {
+	case TreeEdge:
+		return "tree"
+	case BackEdge:
+		return "back"
+	case ForwardEdge:
+		return "forward"
+	case CrossEdge:
+		return "cross"
+	}
+	return fmt.Sprintf("EdgeClass(%d)", int(c))
+}
+
+/*
+DepthFirstForest records a depth-first traversal of a whole graph, as returned by DFSForest and DirectedDFSForest.
+
+The traversal keeps a clock, ticking at every discovery and every finish, so that the descendants of a node are
+exactly the nodes discovered between its discovery and its finish.
+*/
+type DepthFirstForest struct {
+	// Roots lists the nodes the trees are grown from, in ascending order.
+	Roots []Node
+	// Preorder lists the nodes in the order they are discovered, Postorder in the order they are finished.
+	Preorder, Postorder []Node
+	// Parent gives the parent of every node that is not a root.
+	Parent map[Node]Node
+	// Discovered and Finished give the clock time at which every node is discovered and finished.
+	Discovered, Finished map[Node]int
+}
+
+// IsAncestor reports whether u is an ancestor of v in the forest, or v itself.
+func (f *DepthFirstForest) IsAncestor(u, v Node) bool {
+	return f.Discovered[u] <= f.Discovered[v] && f.Finished[v] <= f.Finished[u]
+}
+
+// BFS returns the nodes reachable from start in breadth-first order, neighbors being visited in ascending order, and
+// calls the callbacks of visitor, which may be nil. It returns nil if start is not in g.
+func BFS(g *UndirectedGraph, start Node, visitor *TraversalVisitor) []Node {
+	if !g.Nodes[start] {
+		return nil
+	}
+	return breadthFirst(newTraversal(g.Edges, visitor, false), start)
+}
+
+// DirectedBFS returns the nodes reachable from start in a DirectedGraph in breadth-first order, following the edges
+// forward, like BFS.
+func DirectedBFS(g *DirectedGraph, start Node, visitor *TraversalVisitor) []Node {
+	if !g.Nodes[start] {
+		return nil
+	}
+	return breadthFirst(newTraversal(g.Successors, visitor, true), start)
+}
+
+// DFS returns the nodes reachable from start in depth-first preorder, neighbors being visited in ascending order, and
+// calls the callbacks of visitor, which may be nil. It returns nil if start is not in g.
+func DFS(g *UndirectedGraph, start Node, visitor *TraversalVisitor) []Node {
+	if !g.Nodes[start] {
+		return nil
+	}
+	t := newTraversal(g.Edges, visitor, false)
+	t.depthFirst(start)
+	return t.forest.Preorder
+}
+
+// DirectedDFS returns the nodes reachable from start in a DirectedGraph in depth-first preorder, following the edges
+// forward, like DFS.
+func DirectedDFS(g *DirectedGraph, start Node, visitor *TraversalVisitor) []Node {
+	if !g.Nodes[start] {
+		return nil
+	}
+	t := newTraversal(g.Successors, visitor, true)
+	t.depthFirst(start)
+	return t.forest.Preorder
+}
+
+// DFSForest runs a depth-first search from every node not yet discovered, in ascending order, so that every node of g
+// is visited, and calls the callbacks of visitor, which may be nil. There is one tree per connected component.
+func DFSForest(g *UndirectedGraph, visitor *TraversalVisitor) *DepthFirstForest {
+	return newTraversal(g.Edges, visitor, false).depthFirstForest(sortedNodes(g.Nodes))
+}
+
+// DirectedDFSForest runs a depth-first search of a DirectedGraph from every node not yet discovered, in ascending
+// order, following the edges forward, like DFSForest.
+func DirectedDFSForest(g *DirectedGraph, visitor *TraversalVisitor) *DepthFirstForest {
+	return newTraversal(g.Successors, visitor, true).depthFirstForest(sortedNodes(g.Nodes))
+}
+
+// ClassifyEdges returns the class of every edge of a DirectedGraph with respect to its DirectedDFSForest.
+func ClassifyEdges(g *DirectedGraph) map[Edge]EdgeClass {
+	forest := DirectedDFSForest(g, nil)
+	classes := make(map[Edge]EdgeClass, len(g.Successors))
+	for _, edge := range g.GetEdgeTuples() {
+		u, v := edge.Node1, edge.Node2
+		switch parent, ok := forest.Parent[v]; {
+		case ok && parent == u:
+			classes[edge] = TreeEdge
+		case forest.IsAncestor(v, u):
+			classes[edge] = BackEdge
+		case forest.IsAncestor(u, v):
+			classes[edge] = ForwardEdge
+		default:
+			classes[edge] = CrossEdge
+		}
+	}
+	return classes
+}
+
+// traversal holds the state shared by the breadth-first and depth-first searches.
+type traversal struct {
+	adjacency map[Node][]Node
+	sorted    map[Node][]Node
+	visitor   TraversalVisitor
+	directed  bool
+	forest    *DepthFirstForest
+	clock     int
+}
+
+func newTraversal(adjacency map[Node][]Node, visitor *TraversalVisitor, directed bool) *traversal {
+	t := &traversal{
+		adjacency: adjacency,
+		sorted:    make(map[Node][]Node),
+		directed:  directed,
+		forest: &DepthFirstForest{
+			Parent:     make(map[Node]Node),
+			Discovered: make(map[Node]int),
+			Finished:   make(map[Node]int),
+		},
+	}
+	if visitor != nil {
+		t.visitor = *visitor
+	}
+	return t
+}
+
+// neighbors returns the neighbors of node in ascending order.
+func (t *traversal) neighbors(node Node) []Node {
+	if neighbors, ok := t.sorted[node]; ok {
+		return neighbors
+	}
+	neighbors := append([]Node(nil), t.adjacency[node]...)
+	sort.Slice(neighbors, func(i, j int) bool { return neighbors[i] < neighbors[j] })
+	t.sorted[node] = neighbors
+	return neighbors
+}
+
+func (t *traversal) discover(node Node) {
+	t.forest.Discovered[node] = t.clock
+	t.clock++
+	t.forest.Preorder = append(t.forest.Preorder, node)
+	if t.visitor.OnDiscover != nil {
+		t.visitor.OnDiscover(node)
+	}
+}
+
+func (t *traversal) finish(node Node) {
+	t.forest.Finished[node] = t.clock
+	t.clock++
+	t.forest.Postorder = append(t.forest.Postorder, node)
+	if t.visitor.OnFinish != nil {
+		t.visitor.OnFinish(node)
+	}
+}
+
+// explore handles the edge from u to an already discovered node v. An undirected edge is reported from the node that
+// explores it first, that is unless v is finished; the tree edge to the parent of u and the second entry of a
+// self-loop were reported already.
+func (t *traversal) explore(u, v Node, skipParent *bool, skipLoop *bool) {
+	if !t.directed {
+		if _, finished := t.forest.Finished[v]; finished {
+			return
+		}
+		if parent, ok := t.forest.Parent[u]; ok && parent == v && *skipParent {
+			*skipParent = false
+			return
+		}
+		if u == v {
+			if *skipLoop {
+				return
+			}
+			*skipLoop = true
+		}
+	}
+	if t.visitor.OnNonTreeEdge != nil {
+		t.visitor.OnNonTreeEdge(u, v)
+	}
+}
+
+func breadthFirst(t *traversal, start Node) []Node {
+	t.discover(start)
+	for i := 0; i < len(t.forest.Preorder); i++ {
+		node := t.forest.Preorder[i]
+		skipParent, skipLoop := true, false
+		for _, neighbor := range t.neighbors(node) {
+			if _, discovered := t.forest.Discovered[neighbor]; discovered {
+				t.explore(node, neighbor, &skipParent, &skipLoop)
+				continue
+			}
+			t.forest.Parent[neighbor] = node
+			if t.visitor.OnTreeEdge != nil {
+				t.visitor.OnTreeEdge(node, neighbor)
+			}
+			t.discover(neighbor)
+		}
+		t.finish(node)
+	}
+	return t.forest.Preorder
+}
+
+// depthFirst grows a depth-first tree from root with an explicit stack, so that deep graphs cannot overflow the call
+// stack.
+func (t *traversal) depthFirst(root Node) {
+	type frame struct {
+		node                 Node
+		next                 int
+		skipParent, skipLoop bool
+	}
+	t.discover(root)
+	stack := []frame{{node: root, skipParent: true}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		neighbors := t.neighbors(top.node)
+		if top.next == len(neighbors) {
+			t.finish(top.node)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		neighbor := neighbors[top.next]
+		top.next++
+		if _, discovered := t.forest.Discovered[neighbor]; discovered {
+			t.explore(top.node, neighbor, &top.skipParent, &top.skipLoop)
+			continue
+		}
+		t.forest.Parent[neighbor] = top.node
+		if t.visitor.OnTreeEdge != nil {
+			t.visitor.OnTreeEdge(top.node, neighbor)
+		}
+		t.discover(neighbor)
+		stack = append(stack, frame{node: neighbor, skipParent: true})
+	}
+}
+
+func (t *traversal) depthFirstForest(nodes []Node) *DepthFirstForest {
+	for _, node := range nodes {
+		if _, discovered := t.forest.Discovered[node]; !discovered {
+			t.forest.Roots = append(t.forest.Roots, node)
+			t.depthFirst(node)
+		}
+	}
+	return t.forest
+}
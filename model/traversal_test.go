@@ -0,0 +1,132 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBFSAndDFS(t *testing.T) {
+	// 0 - 1 - 3, 0 - 2 - 3, 3 - 4, and an isolated node 5
+	g := &UndirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {3, 4}})
+	g.AddNode(5)
+
+	var discovered, finished []Node
+	var tree, nonTree []Edge
+	visitor := &TraversalVisitor{
+		OnDiscover:    func(node Node) { discovered = append(discovered, node) },
+		OnFinish:      func(node Node) { finished = append(finished, node) },
+		OnTreeEdge:    func(u, v Node) { tree = append(tree, Edge{Node1: u, Node2: v}) },
+		OnNonTreeEdge: func(u, v Node) { nonTree = append(nonTree, Edge{Node1: u, Node2: v}) },
+	}
+
+	order := BFS(g, 0, visitor)
+	if expected := []Node{0, 1, 2, 3, 4}; !reflect.DeepEqual(order, expected) || !reflect.DeepEqual(discovered, expected) {
+		t.Errorf("Expected the breadth-first order %v, but got %v and %v", expected, order, discovered)
+	}
+	if expected := []Edge{{0, 1}, {0, 2}, {1, 3}, {3, 4}}; !reflect.DeepEqual(tree, expected) {
+		t.Errorf("Expected the tree edges %v, but got %v", expected, tree)
+	}
+	if expected := []Edge{{2, 3}}; !reflect.DeepEqual(nonTree, expected) {
+		t.Errorf("Expected the non-tree edges %v, but got %v", expected, nonTree)
+	}
+
+	discovered, finished, tree, nonTree = nil, nil, nil, nil
+	order = DFS(g, 0, visitor)
+	if expected := []Node{0, 1, 3, 2, 4}; !reflect.DeepEqual(order, expected) {
+		t.Errorf("Expected the depth-first order %v, but got %v", expected, order)
+	}
+	if expected := []Node{2, 4, 3, 1, 0}; !reflect.DeepEqual(finished, expected) {
+		t.Errorf("Expected the finish order %v, but got %v", expected, finished)
+	}
+	if expected := []Edge{{0, 1}, {1, 3}, {3, 2}, {3, 4}}; !reflect.DeepEqual(tree, expected) {
+		t.Errorf("Expected the tree edges %v, but got %v", expected, tree)
+	}
+	if expected := []Edge{{2, 0}}; !reflect.DeepEqual(nonTree, expected) {
+		t.Errorf("Expected the non-tree edges %v, but got %v", expected, nonTree)
+	}
+
+	if BFS(g, 7, nil) != nil || DFS(g, 7, nil) != nil {
+		t.Errorf("Expected no order from a missing node")
+	}
+	if order := DFS(g, 5, nil); !reflect.DeepEqual(order, []Node{5}) {
+		t.Errorf("Expected only the isolated node, but got %v", order)
+	}
+}
+
+func TestTraversalReportsEveryEdgeOnce(t *testing.T) {
+	g := CompleteGraph(5)
+	g.AddEdge(Edge{Node1: 2, Node2: 2})
+	for name, traverse := range map[string]func(*UndirectedGraph, Node, *TraversalVisitor) []Node{"BFS": BFS, "DFS": DFS} {
+		edges := 0
+		traverse(g, 0, &TraversalVisitor{
+			OnTreeEdge:    func(u, v Node) { edges++ },
+			OnNonTreeEdge: func(u, v Node) { edges++ },
+		})
+		if edges != g.NumberOfEdges() {
+			t.Errorf("Expected %s to report %d edges, but got %d", name, g.NumberOfEdges(), edges)
+		}
+	}
+}
+
+func TestDFSForest(t *testing.T) {
+	g := PathGraph(3)
+	g.AddEdge(Edge{Node1: 5, Node2: 4})
+	g.AddNode(7)
+	forest := DFSForest(g, nil)
+	if expected := []Node{0, 4, 7}; !reflect.DeepEqual(forest.Roots, expected) {
+		t.Errorf("Expected the roots %v, but got %v", expected, forest.Roots)
+	}
+	if expected := []Node{0, 1, 2, 4, 5, 7}; !reflect.DeepEqual(forest.Preorder, expected) {
+		t.Errorf("Expected the preorder %v, but got %v", expected, forest.Preorder)
+	}
+	if expected := []Node{2, 1, 0, 5, 4, 7}; !reflect.DeepEqual(forest.Postorder, expected) {
+		t.Errorf("Expected the postorder %v, but got %v", expected, forest.Postorder)
+	}
+	if expected := map[Node]Node{1: 0, 2: 1, 5: 4}; !reflect.DeepEqual(forest.Parent, expected) {
+		t.Errorf("Expected the parents %v, but got %v", expected, forest.Parent)
+	}
+	if !forest.IsAncestor(0, 2) || forest.IsAncestor(2, 0) || forest.IsAncestor(0, 5) {
+		t.Errorf("Expected 0 to be an ancestor of 2 only")
+	}
+
+	// A long path does not overflow the stack
+	if order := DFS(PathGraph(100000), 0, nil); len(order) != 100000 {
+		t.Errorf("Expected 100000 nodes, but got %d", len(order))
+	}
+}
+
+func TestDirectedTraversal(t *testing.T) {
+	g := &DirectedGraph{}
+	g.AddEdgesFromIntTupleList([][2]int{{0, 1}, {1, 2}, {0, 2}, {2, 0}, {3, 1}, {3, 3}})
+
+	if order := DirectedBFS(g, 0, nil); !reflect.DeepEqual(order, []Node{0, 1, 2}) {
+		t.Errorf("Expected [0 1 2], but got %v", order)
+	}
+	if order := DirectedDFS(g, 3, nil); !reflect.DeepEqual(order, []Node{3, 1, 2, 0}) {
+		t.Errorf("Expected [3 1 2 0], but got %v", order)
+	}
+	edges := 0
+	forest := DirectedDFSForest(g, &TraversalVisitor{
+		OnTreeEdge:    func(u, v Node) { edges++ },
+		OnNonTreeEdge: func(u, v Node) { edges++ },
+	})
+	if edges != g.NumberOfEdges() || !reflect.DeepEqual(forest.Roots, []Node{0, 3}) {
+		t.Errorf("Expected %d edges from the roots [0 3], but got %d from %v", g.NumberOfEdges(), edges, forest.Roots)
+	}
+
+	expected := map[Edge]EdgeClass{
+		{0, 1}: TreeEdge,
+		{1, 2}: TreeEdge,
+		{0, 2}: ForwardEdge,
+		{2, 0}: BackEdge,
+		{3, 1}: CrossEdge,
+		{3, 3}: BackEdge,
+	}
+	if classes := ClassifyEdges(g); !reflect.DeepEqual(classes, expected) {
+		t.Errorf("Expected %v, but got %v", expected, classes)
+	}
+	if BackEdge.String() != "back" {
+		t.Errorf("Expected back, but got %v", BackEdge)
+	}
+}
@@ -0,0 +1,149 @@
+package model
+
+import "math"
+
+// TriadNames lists the 16 isomorphism classes of directed triads in the M-A-N notation of Holland and Leinhardt: the
+// numbers of mutual, asymmetric and null dyads, followed by a letter telling apart the classes with the same numbers
+// (D for down, U for up, C for cyclic, T for transitive).
+var TriadNames = [16]string{
+	"003", "012", "102", "021D", "021U", "021C", "111D", "111U",
+	"030T", "030C", "201", "120D", "120U", "120C", "210", "300",
+}
+
+// triadCodes maps the six possible arcs of a triad (v, u, w), as bits v->u, u->v, v->w, w->v, u->w, w->u, to the
+// index of its class in TriadNames.
+var triadCodes = [64]int{
+	0, 1, 1, 2, 1, 3, 5, 7, 1, 5, 4, 6, 2, 7, 6, 10,
+	1, 5, 3, 7, 4, 8, 8, 12, 5, 9, 8, 13, 6, 13, 11, 14,
+	1, 4, 5, 6, 5, 8, 9, 13, 3, 8, 8, 11, 7, 12, 13, 14,
+	2, 6, 7, 10, 6, 11, 13, 14, 7, 13, 12, 14, 10, 14, 14, 15,
+}
+
+// Reciprocity returns the fraction of the edges u -> v of a directed graph whose reverse edge v -> u also exists,
+// self-loops excluded, or NaN if the graph has no such edges.
+func Reciprocity(g *DirectedGraph) float64 {
+	edges, reciprocated := 0, 0
+	for node, successors := range g.Successors {
+		for _, successor := range successors {
+			if successor == node {
+				continue
+			}
+			edges++
+			if g.HasEdge(successor, node) {
+				reciprocated++
+			}
+		}
+	}
+	if edges == 0 {
+		return math.NaN()
+	}
+	return float64(reciprocated) / float64(edges)
+}
+
+// DyadCensus counts the pairs of distinct nodes of a directed graph joined in both directions (mutual), in one
+// direction (asymmetric) or not at all (null). Self-loops are ignored.
+func DyadCensus(g *DirectedGraph) (mutual, asymmetric, null int) {
+	for node, successors := range g.Successors {
+		for _, successor := range successors {
+			if successor == node {
+				continue
+			}
+			if !g.HasEdge(successor, node) {
+				asymmetric++
+			} else if node < successor {
+				mutual++
+			}
+		}
+	}
+	n := len(g.Nodes)
+	null = n*(n-1)/2 - mutual - asymmetric
+	return mutual, asymmetric, null
+}
+
+/*
+TriadCensus counts the triads of a directed graph, the subgraphs induced by three nodes, of every isomorphism class.
+
+Parameters:
+- g: The directed graph. Self-loops are ignored.
+
+Returns:
+- census: The number of triads of every class, indexed like TriadNames. The counts add up to n(n-1)(n-2)/6.
+
+Description:
+The algorithm of Batagelj and Mrvar only visits the triads with at least one edge, in O(m Δ) time with Δ the largest
+degree, each triad being counted once from its connected pair of smallest nodes. The empty triads are found by
+difference.
+
+Example:
+
+	g := &DirectedGraph{}
+	g.AddEdge(Edge{Node1: 0, Node2: 1})
+	g.AddEdge(Edge{Node1: 1, Node2: 2})
+	g.AddEdge(Edge{Node1: 2, Node2: 0})
+	census := TriadCensus(g)
+
+	fmt.Println(census[9]) // Output: 1, the cycle 030C
+*/
+func TriadCensus(g *DirectedGraph) [16]int {
+	// neighbors holds the nodes linked to every node in either direction
+	neighbors := make(map[Node]map[Node]bool, len(g.Nodes))
+	for node := range g.Nodes {
+		neighbors[node] = make(map[Node]bool)
+	}
+	for node, successors := range g.Successors {
+		for _, successor := range successors {
+			if successor != node {
+				neighbors[node][successor] = true
+				neighbors[successor][node] = true
+			}
+		}
+	}
+	code := func(v, u, w Node) int {
+		arcs := [6][2]Node{{v, u}, {u, v}, {v, w}, {w, v}, {u, w}, {w, u}}
+		c := 0
+		for bit, arc := range arcs {
+			if g.HasEdge(arc[0], arc[1]) {
+				c |= 1 << bit
+			}
+		}
+		return triadCodes[c]
+	}
+
+	var census [16]int
+	n := len(g.Nodes)
+	for v, vNeighbors := range neighbors {
+		for u := range vNeighbors {
+			if u <= v {
+				continue
+			}
+			union := make(map[Node]bool, len(vNeighbors)+len(neighbors[u]))
+			for w := range vNeighbors {
+				union[w] = true
+			}
+			for w := range neighbors[u] {
+				union[w] = true
+			}
+			delete(union, u)
+			delete(union, v)
+
+			// Triads of v and u with a node linked to neither
+			if g.HasEdge(v, u) && g.HasEdge(u, v) {
+				census[2] += n - len(union) - 2
+			} else {
+				census[1] += n - len(union) - 2
+			}
+			for w := range union {
+				if u < w || (v < w && w < u && !vNeighbors[w]) {
+					census[code(v, u, w)]++
+				}
+			}
+		}
+	}
+
+	total := n * (n - 1) * (n - 2) / 6
+	census[0] = total
+	for _, count := range census[1:] {
+		census[0] -= count
+	}
+	return census
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// triadRepresentatives gives the arcs of one triad of every class, on nodes 0, 1 and 2 standing for A, B and C.
+var triadRepresentatives = map[string][][2]int{
+	"003":  {},
+	"012":  {{0, 1}},
+	"102":  {{0, 1}, {1, 0}},
+	"021D": {{1, 0}, {1, 2}},
+	"021U": {{0, 1}, {2, 1}},
+	"021C": {{0, 1}, {1, 2}},
+	"111D": {{0, 1}, {1, 0}, {2, 1}},
+	"111U": {{0, 1}, {1, 0}, {1, 2}},
+	"030T": {{0, 1}, {2, 1}, {0, 2}},
+	"030C": {{0, 1}, {1, 2}, {2, 0}},
+	"201":  {{0, 1}, {1, 0}, {1, 2}, {2, 1}},
+	"120D": {{1, 0}, {1, 2}, {0, 2}, {2, 0}},
+	"120U": {{0, 1}, {2, 1}, {0, 2}, {2, 0}},
+	"120C": {{0, 1}, {1, 2}, {0, 2}, {2, 0}},
+	"210":  {{0, 1}, {1, 2}, {2, 1}, {0, 2}, {2, 0}},
+	"300":  {{0, 1}, {1, 0}, {1, 2}, {2, 1}, {0, 2}, {2, 0}},
+}
+
+// bruteForceTriadClass returns the name of the class of the triad induced by a, b and c, comparing it with the
+// representatives under every relabeling.
+func bruteForceTriadClass(g *DirectedGraph, a, b, c Node) string {
+	nodes := [3]Node{a, b, c}
+	permutations := [][3]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+	for name, arcs := range triadRepresentatives {
+		representative := directedFromEdges(arcs)
+		for _, p := range permutations {
+			matches := true
+			for i := 0; i < 3 && matches; i++ {
+				for j := 0; j < 3; j++ {
+					if i != j && g.HasEdge(nodes[p[i]], nodes[p[j]]) != representative.HasEdge(Node(i), Node(j)) {
+						matches = false
+						break
+					}
+				}
+			}
+			if matches {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+func TestTriadCensus(t *testing.T) {
+	index := make(map[string]int, len(TriadNames))
+	for i, name := range TriadNames {
+		index[name] = i
+	}
+
+	// Every representative on its own
+	for name, arcs := range triadRepresentatives {
+		g := directedFromEdges(arcs)
+		g.AddNode(0)
+		g.AddNode(1)
+		g.AddNode(2)
+		census := TriadCensus(g)
+		if census[index[name]] != 1 {
+			t.Errorf("Expected one %s triad, but got %v", name, census)
+		}
+	}
+
+	// A random graph against brute-force enumeration
+	rng := rand.New(rand.NewSource(3))
+	g := &DirectedGraph{}
+	for i := 0; i < 14; i++ {
+		g.AddNode(Node(i))
+	}
+	for i := 0; i < 40; i++ {
+		g.AddEdge(Edge{Node1: Node(rng.Intn(14)), Node2: Node(rng.Intn(14))})
+	}
+	var expected [16]int
+	for a := 0; a < 14; a++ {
+		for b := a + 1; b < 14; b++ {
+			for c := b + 1; c < 14; c++ {
+				expected[index[bruteForceTriadClass(g, Node(a), Node(b), Node(c))]]++
+			}
+		}
+	}
+	if census := TriadCensus(g); census != expected {
+		t.Errorf("Expected %v, but got %v", expected, census)
+	}
+}
+
+func TestReciprocityAndDyadCensus(t *testing.T) {
+	g := directedFromEdges([][2]int{{0, 1}, {1, 0}, {1, 2}, {2, 3}, {3, 3}})
+	if r := Reciprocity(g); math.Abs(r-0.5) > 1e-9 {
+		t.Errorf("Expected 0.5, but got %v", r)
+	}
+	if mutual, asymmetric, null := DyadCensus(g); mutual != 1 || asymmetric != 2 || null != 3 {
+		t.Errorf("Expected 1, 2 and 3 dyads, but got %d, %d and %d", mutual, asymmetric, null)
+	}
+	if r := Reciprocity(&DirectedGraph{}); !math.IsNaN(r) {
+		t.Errorf("Expected NaN, but got %v", r)
+	}
+}
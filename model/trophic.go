@@ -0,0 +1,165 @@
+package model
+
+import (
+	"fmt"
+	"math"
+)
+
+/*
+TrophicLevels computes the trophic level of every node of a directed graph, edges pointing from prey to predator.
+
+Parameters:
+- g: The directed graph.
+
+Returns:
+- levels: The trophic level of every node. Basal nodes, without predecessors, have level 1 and every other node has 1
+plus the mean level of its predecessors.
+- err: An error if some node cannot be reached from a basal node, in which case the levels are not defined.
+
+Description:
+The levels solve the linear system s_i = 1 + (1/k_i) Σ_{j -> i} s_j, with k_i the in-degree of node i, by Gaussian
+elimination in O(n^3) time.
+
+Example:
+
+	g := &DirectedGraph{}
+	g.AddEdge(Edge{Node1: 0, Node2: 1})
+	g.AddEdge(Edge{Node1: 0, Node2: 2})
+	g.AddEdge(Edge{Node1: 1, Node2: 2})
+	levels, _ := TrophicLevels(g)
+
+	fmt.Println(levels[2]) // Output: 2.5
+*/
+func TrophicLevels(g *DirectedGraph) (map[Node]float64, error) {
+	nodes := sortedNodes(g.Nodes)
+	index := make(map[Node]int, len(nodes))
+	for i, node := range nodes {
+		index[node] = i
+	}
+
+	reached := make(map[Node]bool, len(nodes))
+	for _, node := range nodes {
+		if len(g.Predecessors[node]) == 0 {
+			reached[node] = true
+			for descendant := range Descendants(g, node) {
+				reached[descendant] = true
+			}
+		}
+	}
+	if len(reached) < len(nodes) {
+		var unreached []Node
+		for _, node := range nodes {
+			if !reached[node] {
+				unreached = append(unreached, node)
+			}
+		}
+		return nil, fmt.Errorf("the nodes %v cannot be reached from a basal node", unreached)
+	}
+
+	matrix := make([][]float64, len(nodes))
+	ones := make([]float64, len(nodes))
+	for i, node := range nodes {
+		matrix[i] = make([]float64, len(nodes))
+		matrix[i][i] = 1
+		ones[i] = 1
+		for _, predecessor := range g.Predecessors[node] {
+			matrix[i][index[predecessor]] -= 1 / float64(len(g.Predecessors[node]))
+		}
+	}
+	solution, ok := solveLinearSystem(matrix, ones)
+	if !ok {
+		return nil, fmt.Errorf("the trophic levels are not defined")
+	}
+	levels := make(map[Node]float64, len(nodes))
+	for i, node := range nodes {
+		levels[node] = solution[i]
+	}
+	return levels, nil
+}
+
+/*
+TrophicIncoherence returns the trophic incoherence parameter q of a directed graph: the standard deviation of the
+trophic differences s_j - s_i over the edges i -> j, see TrophicLevels.
+
+Returns:
+- q: 0 for a perfectly coherent graph, where every edge climbs exactly one level, and larger values as the edges mix
+levels, or NaN if the graph has no edges.
+- err: An error if the trophic levels are not defined.
+*/
+func TrophicIncoherence(g *DirectedGraph) (float64, error) {
+	levels, err := TrophicLevels(g)
+	if err != nil {
+		return 0, err
+	}
+	count, sum, squares := 0.0, 0.0, 0.0
+	for node, successors := range g.Successors {
+		for _, successor := range successors {
+			difference := levels[successor] - levels[node]
+			count++
+			sum += difference
+			squares += difference * difference
+		}
+	}
+	if count == 0 {
+		return math.NaN(), nil
+	}
+	mean := sum / count
+	return math.Sqrt(math.Max(squares/count-mean*mean, 0)), nil
+}
+
+// FlowHierarchy returns the fraction of the edges of a directed graph that belong to no cycle, i.e. that join two
+// different strongly connected components, or NaN if the graph has no edges. A DAG has flow hierarchy 1.
+func FlowHierarchy(g *DirectedGraph) float64 {
+	components := stronglyConnectedComponents(g)
+	edges, acyclic := 0, 0
+	for node, successors := range g.Successors {
+		for _, successor := range successors {
+			edges++
+			if components[node] != components[successor] {
+				acyclic++
+			}
+		}
+	}
+	if edges == 0 {
+		return math.NaN()
+	}
+	return float64(acyclic) / float64(edges)
+}
+
+// solveLinearSystem solves a x = b by Gaussian elimination with partial pivoting, overwriting a and b. It reports
+// false if the matrix is singular.
+func solveLinearSystem(a [][]float64, b []float64) ([]float64, bool) {
+	n := len(b)
+	for column := 0; column < n; column++ {
+		pivot := column
+		for row := column + 1; row < n; row++ {
+			if math.Abs(a[row][column]) > math.Abs(a[pivot][column]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][column]) < 1e-12 {
+			return nil, false
+		}
+		a[column], a[pivot] = a[pivot], a[column]
+		b[column], b[pivot] = b[pivot], b[column]
+		for row := column + 1; row < n; row++ {
+			factor := a[row][column] / a[column][column]
+			if factor == 0 {
+				continue
+			}
+			for k := column; k < n; k++ {
+				a[row][k] -= factor * a[column][k]
+			}
+			b[row] -= factor * b[column]
+		}
+	}
+	x := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := b[row]
+		for k := row + 1; k < n; k++ {
+			sum -= a[row][k] * x[k]
+		}
+		x[row] = sum / a[row][row]
+	}
+	return x, true
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTrophicLevels(t *testing.T) {
+	testCases := []struct {
+		name     string
+		g        *DirectedGraph
+		expected map[Node]float64
+		q        float64
+	}{
+		{"chain", directedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 3}}), map[Node]float64{0: 1, 1: 2, 2: 3, 3: 4}, 0},
+		{"omnivore", directedFromEdges([][2]int{{0, 1}, {0, 2}, {1, 2}}), map[Node]float64{0: 1, 1: 2, 2: 2.5}, math.Sqrt(1.0 / 6)},
+		// The cycle between 1 and 2 is fed by 0: s1 = 1 + (1 + s2)/2 and s2 = 1 + s1
+		{"fed cycle", directedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 1}}), map[Node]float64{0: 1, 1: 4, 2: 5}, math.Sqrt(8.0 / 3)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			levels, err := TrophicLevels(testCase.g)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for node, expected := range testCase.expected {
+				if math.Abs(levels[node]-expected) > 1e-9 {
+					t.Errorf("Expected level %v for %d, but got %v", expected, node, levels[node])
+				}
+			}
+			q, err := TrophicIncoherence(testCase.g)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if math.Abs(q-testCase.q) > 1e-9 {
+				t.Errorf("Expected incoherence %v, but got %v", testCase.q, q)
+			}
+		})
+	}
+
+	if _, err := TrophicLevels(directedFromEdges([][2]int{{0, 1}, {2, 3}, {3, 2}})); err == nil {
+		t.Errorf("Expected an error for a cycle without basal node")
+	}
+}
+
+func TestFlowHierarchy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		g        *DirectedGraph
+		expected float64
+	}{
+		{"dag", directedFromEdges([][2]int{{0, 1}, {0, 2}, {1, 2}}), 1},
+		{"cycle with tail", directedFromEdges([][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}}), 0.25},
+		{"two cycles", directedFromEdges([][2]int{{0, 1}, {1, 0}, {1, 2}, {2, 3}, {3, 2}, {4, 4}}), 1.0 / 6},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if h := FlowHierarchy(testCase.g); math.Abs(h-testCase.expected) > 1e-9 {
+				t.Errorf("Expected %v, but got %v", testCase.expected, h)
+			}
+		})
+	}
+	if h := FlowHierarchy(&DirectedGraph{}); !math.IsNaN(h) {
+		t.Errorf("Expected NaN, but got %v", h)
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "sort"
+
 type WeightedElement struct {
 	Payload any
 	Weight  float32
@@ -49,3 +51,50 @@ func GetDictKeys(dict map[Node]bool) []Node {
 	}
 	return keys
 }
+
+// orderedEdge returns the edge between u and v with the smaller node as Node1,
+// the canonical key for an undirected edge in edge-indexed maps.
+func orderedEdge(u, v Node) Edge {
+	if u > v {
+		u, v = v, u
+	}
+	return Edge{Node1: u, Node2: v}
+}
+
+// sortedNodes returns the keys of a node set in ascending order.
+func sortedNodes(nodes map[Node]bool) []Node {
+	keys := GetDictKeys(nodes)
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// nodePriorityItem is an entry of a nodePriorityQueue.
+type nodePriorityItem struct {
+	node     Node
+	priority float64
+}
+
+// nodePriorityQueue is a min-heap of nodes ordered by priority, meant to be
+// used through container/heap. Ties are broken by node id so that the pop
+// order is deterministic.
+type nodePriorityQueue []nodePriorityItem
+
+func (pq nodePriorityQueue) Len() int { return len(pq) }
+
+func (pq nodePriorityQueue) Less(i, j int) bool {
+	if pq[i].priority == pq[j].priority {
+		return pq[i].node < pq[j].node
+	}
+	return pq[i].priority < pq[j].priority
+}
+
+func (pq nodePriorityQueue) Swap(i, j int) { pq[i], pq[j] = pq[j], pq[i] }
+
+func (pq *nodePriorityQueue) Push(x any) { *pq = append(*pq, x.(nodePriorityItem)) }
+
+func (pq *nodePriorityQueue) Pop() any {
+	old := *pq
+	item := old[len(old)-1]
+	*pq = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,72 @@
+package model
+
+// WeightedUndirectedGraph is an UndirectedGraph with a weight on every edge.
+type WeightedUndirectedGraph struct {
+	Graph *UndirectedGraph
+	// Weights holds the weight of every edge, keyed with Node1 <= Node2.
+	Weights map[Edge]float64
+}
+
+// NewWeightedUndirectedGraph returns an empty WeightedUndirectedGraph.
+func NewWeightedUndirectedGraph() *WeightedUndirectedGraph {
+	return &WeightedUndirectedGraph{
+		Graph:   &UndirectedGraph{Nodes: make(map[Node]bool), Edges: make(map[Node][]Node)},
+		Weights: make(map[Edge]float64),
+	}
+}
+
+/*
+WeightGraph attaches weights to the edges of an UndirectedGraph, for instance one returned by a generator.
+
+Parameters:
+- g: The graph; it is shared, not copied.
+- weight: The weight of every edge, called once per edge with the smaller node first, in increasing order of the edges.
+
+Returns:
+- w: The weighted graph.
+
+Example:
+
+	rng := rand.New(rand.NewSource(1))
+	w := WeightGraph(CycleGraph(5), func(u, v Node) float64 { return 1 + rng.Float64() })
+
+	fmt.Println(w.TotalWeight() > 5) // Output: true
+*/
+func WeightGraph(g *UndirectedGraph, weight WeightFunc) *WeightedUndirectedGraph {
+	w := &WeightedUndirectedGraph{Graph: g, Weights: make(map[Edge]float64)}
+	for _, edge := range sortedEdgeList(g) {
+		w.Weights[edge] = weight(edge.Node1, edge.Node2)
+	}
+	return w
+}
+
+// AddNode adds a node to the graph if it does not already exist.
+func (w *WeightedUndirectedGraph) AddNode(node Node) {
+	w.Graph.AddNode(node)
+}
+
+// AddWeightedEdge adds an edge with a weight, or sets the weight of the edge if it already exists.
+func (w *WeightedUndirectedGraph) AddWeightedEdge(edge Edge, weight float64) {
+	w.Graph.AddEdge(edge)
+	w.Weights[orderedEdge(edge.Node1, edge.Node2)] = weight
+}
+
+// RemoveEdge removes an edge and its weight.
+func (w *WeightedUndirectedGraph) RemoveEdge(edge Edge) {
+	w.Graph.RemoveEdge(edge)
+	delete(w.Weights, orderedEdge(edge.Node1, edge.Node2))
+}
+
+// EdgeWeight returns the weight of the edge between u and v, or 0 if there is none. It can be used as a WeightFunc.
+func (w *WeightedUndirectedGraph) EdgeWeight(u, v Node) float64 {
+	return w.Weights[orderedEdge(u, v)]
+}
+
+// TotalWeight returns the sum of the weights of all the edges.
+func (w *WeightedUndirectedGraph) TotalWeight() float64 {
+	total := 0.0
+	for _, edge := range sortedEdgeList(w.Graph) {
+		total += w.Weights[edge]
+	}
+	return total
+}
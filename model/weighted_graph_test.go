@@ -0,0 +1,54 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWeightedUndirectedGraph(t *testing.T) {
+	w := NewWeightedUndirectedGraph()
+	w.AddWeightedEdge(Edge{Node1: 2, Node2: 1}, 1.5)
+	w.AddWeightedEdge(Edge{Node1: 2, Node2: 3}, 2)
+	w.AddNode(7)
+
+	if w.EdgeWeight(1, 2) != 1.5 || w.EdgeWeight(2, 1) != 1.5 || w.EdgeWeight(1, 3) != 0 {
+		t.Errorf("Expected 1.5 in both directions and 0 for a missing edge, but got %v", w.Weights)
+	}
+	if !w.Graph.HasEdge(1, 2) || !w.Graph.HasNode(7) || w.Graph.NumberOfEdges() != 2 {
+		t.Errorf("Expected the edges and nodes in the graph, but got %v", w.Graph)
+	}
+	if total := w.TotalWeight(); total != 3.5 {
+		t.Errorf("Expected 3.5, but got %v", total)
+	}
+
+	// Adding an existing edge updates its weight
+	w.AddWeightedEdge(Edge{Node1: 3, Node2: 2}, 4)
+	if w.Graph.NumberOfEdges() != 2 || w.EdgeWeight(2, 3) != 4 {
+		t.Errorf("Expected the weight to be updated, but got %v", w.Weights)
+	}
+
+	w.RemoveEdge(Edge{Node1: 1, Node2: 2})
+	if w.Graph.HasEdge(1, 2) || len(w.Weights) != 1 || w.TotalWeight() != 4 {
+		t.Errorf("Expected the edge and its weight to be removed, but got %v", w.Weights)
+	}
+}
+
+func TestWeightGraph(t *testing.T) {
+	calls := 0
+	w := WeightGraph(PathGraph(4), func(u, v Node) float64 {
+		calls++
+		return float64(u + v)
+	})
+	if calls != 3 || w.EdgeWeight(2, 1) != 3 || w.EdgeWeight(2, 3) != 5 {
+		t.Errorf("Expected one call per edge with the smaller node first, but got %d calls and %v", calls, w.Weights)
+	}
+	if total := w.TotalWeight(); math.Abs(total-9) > 1e-9 {
+		t.Errorf("Expected 9, but got %v", total)
+	}
+
+	// The weights can be used wherever a WeightFunc is expected
+	var weight WeightFunc = w.EdgeWeight
+	if weight(0, 1) != 1 {
+		t.Errorf("Expected 1, but got %v", weight(0, 1))
+	}
+}
@@ -0,0 +1,186 @@
+// Package resilience simulates the failure of the nodes and edges of a network, at random or by targeted attacks, and
+// measures how its connectivity degrades.
+package resilience
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/jmCodeCraft/go-network/centrality"
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+// Strategy chooses the next node to remove from the current graph, which has at least one node, in an
+// AttackSimulation.
+type Strategy func(g *model.UndirectedGraph) model.Node
+
+// RandomFailure returns a Strategy removing the nodes in a random order, drawn once from the nodes of the first graph
+// it is given, as for failures striking regardless of the role of the nodes. The same seed gives the same order.
+func RandomFailure(seed int64) Strategy {
+	var order []model.Node
+	rng := rand.New(rand.NewSource(seed))
+	return func(g *model.UndirectedGraph) model.Node {
+		if order == nil {
+			order = sortedNodes(g.Nodes)
+			rng.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+		}
+		for len(order) > 0 && !g.Nodes[order[0]] {
+			order = order[1:]
+		}
+		if len(order) == 0 {
+			// Nodes added since the first call are removed in ascending order
+			return sortedNodes(g.Nodes)[0]
+		}
+		return order[0]
+	}
+}
+
+// DegreeAttack is a Strategy removing the node of highest degree in the current graph, ties broken by the smallest
+// node, so that the degrees are recomputed after every removal.
+func DegreeAttack(g *model.UndirectedGraph) model.Node {
+	return model.TopKNodes(centrality.Degree(g, false), 1)[0].Node
+}
+
+// BetweennessAttack is a Strategy removing the node of highest betweenness in the current graph, ties broken by the
+// smallest node. It damages networks faster than DegreeAttack, but takes O(nm) time per removal.
+func BetweennessAttack(g *model.UndirectedGraph) model.Node {
+	return model.TopKNodes(centrality.Betweenness(g, false), 1)[0].Node
+}
+
+// AttackStep is the state of the graph after a removal of an AttackSimulation.
+type AttackStep struct {
+	// Removed is the node removed at this step.
+	Removed model.Node
+	// Nodes is the number of nodes left.
+	Nodes int
+	// LargestComponent is the number of nodes of the largest connected component left, 0 once no node is left.
+	LargestComponent int
+	// Components is the number of connected components left.
+	Components int
+	// Connected reports whether the nodes left form a single connected component.
+	Connected bool
+}
+
+/*
+AttackSimulation removes the nodes of a graph one at a time and measures its connectivity after every removal, to
+compare the robustness of networks, or of a network to different attacks.
+
+Parameters:
+- g: The graph, left unchanged.
+- strategy: Chooses the node to remove at every step, such as RandomFailure(seed), DegreeAttack or BetweennessAttack.
+- steps: The number of nodes to remove, at most the number of nodes of g.
+
+Returns:
+- steps: The state of the graph after every removal, in order.
+- err: An error if steps is negative or larger than the number of nodes, or the strategy chooses a node not in the
+graph.
+
+Description:
+The removals are applied to a copy of g, and the connected components are found after every one in O(n + m) time.
+The fraction of the nodes in the largest component, against the fraction removed, is the usual robustness curve; see
+RobustnessIndex for its area.
+
+Reference: R. Albert, H. Jeong and A.-L. Barabási, "Error and attack tolerance of complex networks", Nature 406, 2000.
+*/
+func AttackSimulation(g *model.UndirectedGraph, strategy Strategy, steps int) ([]AttackStep, error) {
+	if steps < 0 || steps > len(g.Nodes) {
+		return nil, fmt.Errorf("the number of steps must be between 0 and %d, got %d", len(g.Nodes), steps)
+	}
+	current := copyGraph(g)
+	result := make([]AttackStep, 0, steps)
+	for step := 0; step < steps; step++ {
+		node := strategy(current)
+		if !current.Nodes[node] {
+			return nil, fmt.Errorf("step %d: the strategy chose node %d, which is not in the graph", step+1, node)
+		}
+		current.RemoveNode(node)
+		components := model.ConnectedComponentSets(current)
+		largest := 0
+		for _, component := range components {
+			largest = max(largest, len(component))
+		}
+		result = append(result, AttackStep{
+			Removed:          node,
+			Nodes:            len(current.Nodes),
+			LargestComponent: largest,
+			Components:       len(components),
+			Connected:        len(components) == 1,
+		})
+	}
+	return result, nil
+}
+
+// RobustnessIndex returns the robustness R of Schneider et al. for the steps of an AttackSimulation of a graph of
+// numberOfNodes nodes: the sum of the fractions of the nodes in the largest component after every removal, divided by
+// numberOfNodes. It is the area under the robustness curve, at most 1/2, and lower for more fragile networks.
+func RobustnessIndex(steps []AttackStep, numberOfNodes int) float64 {
+	if numberOfNodes == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, step := range steps {
+		total += float64(step.LargestComponent)
+	}
+	return total / float64(numberOfNodes) / float64(numberOfNodes)
+}
+
+// RemoveRandomEdges returns a copy of g without a fraction of its edges, rounded to the nearest integer, drawn
+// uniformly at random, as for failing links; its nodes are all kept. The same seed gives the same graph. It returns an
+// error if fraction is not in [0, 1].
+func RemoveRandomEdges(g *model.UndirectedGraph, fraction float64, seed int64) (*model.UndirectedGraph, error) {
+	if !(fraction >= 0 && fraction <= 1) {
+		return nil, fmt.Errorf("the fraction of edges to remove must be in [0, 1], got %v", fraction)
+	}
+	edges := edgeList(g)
+	rng := rand.New(rand.NewSource(seed))
+	rng.Shuffle(len(edges), func(i, j int) { edges[i], edges[j] = edges[j], edges[i] })
+	result := copyGraph(g)
+	for _, edge := range edges[:int(math.Round(fraction*float64(len(edges))))] {
+		result.RemoveEdge(edge)
+	}
+	return result, nil
+}
+
+// RemoveHighestDegreeNodes returns a copy of g without the k nodes of highest degree in g, ties broken by the
+// smallest node, or without any node if k is larger than the number of nodes. Unlike DegreeAttack, the degrees are
+// not recomputed between removals. It returns an error if k is negative.
+func RemoveHighestDegreeNodes(g *model.UndirectedGraph, k int) (*model.UndirectedGraph, error) {
+	if k < 0 {
+		return nil, fmt.Errorf("the number of nodes to remove must be non-negative, got %d", k)
+	}
+	result := copyGraph(g)
+	for _, node := range model.TopKNodes(centrality.Degree(g, false), k) {
+		result.RemoveNode(node.Node)
+	}
+	return result, nil
+}
+
+func copyGraph(g *model.UndirectedGraph) *model.UndirectedGraph {
+	return model.Subgraph(g, sortedNodes(g.Nodes))
+}
+
+// edgeList returns every edge of g once, with its smaller node first, in ascending order.
+func edgeList(g *model.UndirectedGraph) []model.Edge {
+	var edges []model.Edge
+	for _, node := range sortedNodes(g.Nodes) {
+		neighbors := append([]model.Node(nil), g.Edges[node]...)
+		sort.Slice(neighbors, func(i, j int) bool { return neighbors[i] < neighbors[j] })
+		for i, neighbor := range neighbors {
+			if neighbor >= node && (i == 0 || neighbors[i-1] != neighbor) {
+				edges = append(edges, model.Edge{Node1: node, Node2: neighbor})
+			}
+		}
+	}
+	return edges
+}
+
+func sortedNodes(set map[model.Node]bool) []model.Node {
+	nodes := make([]model.Node, 0, len(set))
+	for node := range set {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return nodes
+}
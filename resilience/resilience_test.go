@@ -0,0 +1,114 @@
+package resilience
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func TestAttackSimulation(t *testing.T) {
+	star := model.StarGraph(6)
+	steps, err := AttackSimulation(star, DegreeAttack, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Removing the center leaves 5 isolated nodes
+	expected := []AttackStep{
+		{Removed: 0, Nodes: 5, LargestComponent: 1, Components: 5},
+		{Removed: 1, Nodes: 4, LargestComponent: 1, Components: 4},
+	}
+	if !reflect.DeepEqual(steps, expected) {
+		t.Errorf("Expected %v, but got %v", expected, steps)
+	}
+	if len(star.Nodes) != 6 || star.NumberOfEdges() != 5 {
+		t.Errorf("Expected the graph to be unchanged, but got %v", star)
+	}
+
+	path := model.PathGraph(5)
+	steps, err = AttackSimulation(path, BetweennessAttack, 5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if steps[0].Removed != 2 || steps[0].LargestComponent != 2 || steps[0].Connected {
+		t.Errorf("Expected the middle of the path to be removed first, but got %v", steps[0])
+	}
+	if last := steps[4]; last.Nodes != 0 || last.LargestComponent != 0 || last.Components != 0 {
+		t.Errorf("Expected no node left, but got %v", last)
+	}
+
+	if _, err := AttackSimulation(path, DegreeAttack, 6); err == nil {
+		t.Errorf("Expected an error for more steps than nodes")
+	}
+	if _, err := AttackSimulation(path, func(*model.UndirectedGraph) model.Node { return 9 }, 1); err == nil {
+		t.Errorf("Expected an error for a strategy choosing a missing node")
+	}
+}
+
+func TestRandomFailureAndRobustness(t *testing.T) {
+	g, err := model.BarabasiAlbertGraph(300, 2, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	failures, err := AttackSimulation(g, RandomFailure(2), 300)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	removed := make(map[model.Node]bool)
+	for _, step := range failures {
+		removed[step.Removed] = true
+	}
+	if len(removed) != 300 {
+		t.Errorf("Expected every node to be removed once, but got %d", len(removed))
+	}
+	again, _ := AttackSimulation(g, RandomFailure(2), 300)
+	if !reflect.DeepEqual(failures, again) {
+		t.Errorf("Expected the same failures with the same seed")
+	}
+
+	// Scale-free networks are robust to failures but fragile to attacks
+	attacks, err := AttackSimulation(g, DegreeAttack, 300)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	random, targeted := RobustnessIndex(failures, 300), RobustnessIndex(attacks, 300)
+	if !(targeted < random && random <= 0.5) {
+		t.Errorf("Expected a lower robustness to attacks, but got %v against %v for failures", targeted, random)
+	}
+}
+
+func TestRemoveRandomEdges(t *testing.T) {
+	g := model.CompleteGraph(10)
+	h, err := RemoveRandomEdges(g, 0.2, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if h.NumberOfEdges() != 36 || len(h.Nodes) != 10 || g.NumberOfEdges() != 45 {
+		t.Errorf("Expected 9 of the 45 edges to be removed from a copy, but got %d edges", h.NumberOfEdges())
+	}
+	if again, _ := RemoveRandomEdges(g, 0.2, 1); !again.Equals(h) {
+		t.Errorf("Expected the same graph with the same seed")
+	}
+	if _, err := RemoveRandomEdges(g, 1.5, 1); err == nil {
+		t.Errorf("Expected an error for a fraction above 1")
+	}
+}
+
+func TestRemoveHighestDegreeNodes(t *testing.T) {
+	// A star whose leaf 1 also links 2 and 3
+	g := model.StarGraph(6)
+	g.AddEdgesFromIntTupleList([][2]int{{1, 2}, {1, 3}})
+	h, err := RemoveHighestDegreeNodes(g, 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if h.HasNode(0) || h.HasNode(1) || len(h.Nodes) != 4 || h.NumberOfEdges() != 0 {
+		t.Errorf("Expected the nodes 0 and 1 to be removed, but got %v", h)
+	}
+	if h, _ := RemoveHighestDegreeNodes(g, 10); len(h.Nodes) != 0 {
+		t.Errorf("Expected every node to be removed, but got %v", h)
+	}
+	if _, err := RemoveHighestDegreeNodes(g, -1); err == nil {
+		t.Errorf("Expected an error for a negative k")
+	}
+}
@@ -0,0 +1,466 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmCodeCraft/go-network/model"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Every stored graph is a top level bucket holding the following sub-buckets.
+var (
+	nodesBucket          = []byte("nodes")
+	adjacencyBucket      = []byte("adjacency")
+	nodeAttributesBucket = []byte("node_attributes")
+	edgeAttributesBucket = []byte("edge_attributes")
+)
+
+// ErrGraphNotFound is returned when a graph name is not present in the store.
+var ErrGraphNotFound = errors.New("graph not found")
+
+// GraphStore persists named undirected graphs, with string attributes on nodes and edges, in an embedded BoltDB database.
+// A GraphStore is safe for concurrent use by multiple goroutines.
+type GraphStore struct {
+	db *bolt.DB
+}
+
+// StoredGraph is a handle on a graph kept in a GraphStore. Nodes, adjacency lists and attributes are read from
+// disk only when they are requested, so the graph does not need to fit in memory. Every mutation is committed
+// in its own transaction and survives a restart.
+type StoredGraph struct {
+	store *GraphStore
+	name  []byte
+}
+
+/*
+OpenGraphStore opens the store persisted in the given file, creating it if it does not exist.
+
+Description:
+The file is locked while the store is open; opening it a second time waits up to one second before failing. Close must be called to release it.
+*/
+func OpenGraphStore(path string) (*GraphStore, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("error opening graph store: %w", err)
+	}
+	return &GraphStore{db: db}, nil
+}
+
+// Close releases the database file.
+func (s *GraphStore) Close() error {
+	return s.db.Close()
+}
+
+// Graphs returns the names of the stored graphs in lexicographic order.
+func (s *GraphStore) Graphs() ([]string, error) {
+	var names []string
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			names = append(names, string(name))
+			return nil
+		})
+	})
+	return names, err
+}
+
+// CreateGraph creates an empty graph, or opens the existing graph with the same name.
+func (s *GraphStore) CreateGraph(name string) (*StoredGraph, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		_, err := createGraphBuckets(tx, []byte(name))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating graph %q: %w", name, err)
+	}
+	return &StoredGraph{store: s, name: []byte(name)}, nil
+}
+
+// Graph returns a handle on an existing graph, or ErrGraphNotFound.
+func (s *GraphStore) Graph(name string) (*StoredGraph, error) {
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket([]byte(name)) == nil {
+			return ErrGraphNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error opening graph %q: %w", name, err)
+	}
+	return &StoredGraph{store: s, name: []byte(name)}, nil
+}
+
+// DeleteGraph removes a graph and all its attributes from the store.
+func (s *GraphStore) DeleteGraph(name string) error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		err := tx.DeleteBucket([]byte(name))
+		if errors.Is(err, bolt.ErrBucketNotFound) {
+			return ErrGraphNotFound
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting graph %q: %w", name, err)
+	}
+	return nil
+}
+
+// SaveGraph stores a whole UndirectedGraph under the given name, replacing any graph with the same name. The old graph
+// is deleted and the new one written in a single transaction, so a failure leaves the old graph untouched.
+func (s *GraphStore) SaveGraph(name string, g *model.UndirectedGraph) (*StoredGraph, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket([]byte(name)); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+			return err
+		}
+		root, err := createGraphBuckets(tx, []byte(name))
+		if err != nil {
+			return err
+		}
+		for node := range g.Nodes {
+			if err := root.Bucket(nodesBucket).Put(encodeNode(node), nil); err != nil {
+				return err
+			}
+			if err := root.Bucket(adjacencyBucket).Put(encodeNode(node), encodeNodes(g.Edges[node])); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error saving graph %q: %w", name, err)
+	}
+	return &StoredGraph{store: s, name: []byte(name)}, nil
+}
+
+// LoadGraph reads a whole stored graph into memory.
+func (s *GraphStore) LoadGraph(name string) (*model.UndirectedGraph, error) {
+	stored, err := s.Graph(name)
+	if err != nil {
+		return nil, err
+	}
+	return stored.Load()
+}
+
+// Load reads the whole graph into memory.
+func (g *StoredGraph) Load() (*model.UndirectedGraph, error) {
+	loaded := &model.UndirectedGraph{
+		Nodes: make(map[model.Node]bool),
+		Edges: make(map[model.Node][]model.Node),
+	}
+	err := g.view(func(root *bolt.Bucket) error {
+		return root.Bucket(adjacencyBucket).ForEach(func(key, value []byte) error {
+			node := decodeNode(key)
+			loaded.AddNode(node)
+			if neighbors := decodeNodes(value); len(neighbors) > 0 {
+				loaded.Edges[node] = neighbors
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error loading graph %q: %w", g.name, err)
+	}
+	return loaded, nil
+}
+
+// AddNode adds a node to the stored graph if it does not already exist.
+func (g *StoredGraph) AddNode(node model.Node) error {
+	return g.update(func(root *bolt.Bucket) error {
+		return addStoredNode(root, node)
+	})
+}
+
+// AddEdge adds an undirected edge, and its endpoints, to the stored graph if it does not already exist.
+func (g *StoredGraph) AddEdge(edge model.Edge) error {
+	return g.update(func(root *bolt.Bucket) error {
+		if err := addStoredNode(root, edge.Node1); err != nil {
+			return err
+		}
+		if err := addStoredNode(root, edge.Node2); err != nil {
+			return err
+		}
+		if containsNode(storedNeighbors(root, edge.Node1), edge.Node2) {
+			return nil
+		}
+		if err := appendStoredNeighbor(root, edge.Node1, edge.Node2); err != nil {
+			return err
+		}
+		if edge.Node1 == edge.Node2 {
+			return nil
+		}
+		return appendStoredNeighbor(root, edge.Node2, edge.Node1)
+	})
+}
+
+// RemoveEdge removes an undirected edge and its attributes from the stored graph.
+func (g *StoredGraph) RemoveEdge(edge model.Edge) error {
+	return g.update(func(root *bolt.Bucket) error {
+		if err := removeStoredNeighbor(root, edge.Node1, edge.Node2); err != nil {
+			return err
+		}
+		if err := removeStoredNeighbor(root, edge.Node2, edge.Node1); err != nil {
+			return err
+		}
+		return deleteWithPrefix(root.Bucket(edgeAttributesBucket), encodeEdge(edge))
+	})
+}
+
+// RemoveNode removes a node, its edges and all their attributes from the stored graph.
+func (g *StoredGraph) RemoveNode(node model.Node) error {
+	return g.update(func(root *bolt.Bucket) error {
+		for _, neighbor := range storedNeighbors(root, node) {
+			if err := removeStoredNeighbor(root, neighbor, node); err != nil {
+				return err
+			}
+			if err := deleteWithPrefix(root.Bucket(edgeAttributesBucket), encodeEdge(model.Edge{Node1: node, Node2: neighbor})); err != nil {
+				return err
+			}
+		}
+		if err := root.Bucket(nodesBucket).Delete(encodeNode(node)); err != nil {
+			return err
+		}
+		if err := root.Bucket(adjacencyBucket).Delete(encodeNode(node)); err != nil {
+			return err
+		}
+		return deleteWithPrefix(root.Bucket(nodeAttributesBucket), encodeNode(node))
+	})
+}
+
+// HasNode checks if the stored graph contains a node.
+func (g *StoredGraph) HasNode(node model.Node) (bool, error) {
+	found := false
+	err := g.view(func(root *bolt.Bucket) error {
+		found = root.Bucket(nodesBucket).Get(encodeNode(node)) != nil
+		return nil
+	})
+	return found, err
+}
+
+// HasEdge checks if the stored graph contains the edge between u and v.
+func (g *StoredGraph) HasEdge(u, v model.Node) (bool, error) {
+	neighbors, err := g.Neighbors(u)
+	return containsNode(neighbors, v), err
+}
+
+// Neighbors loads the adjacency list of a single node.
+func (g *StoredGraph) Neighbors(node model.Node) ([]model.Node, error) {
+	var neighbors []model.Node
+	err := g.view(func(root *bolt.Bucket) error {
+		neighbors = storedNeighbors(root, node)
+		return nil
+	})
+	return neighbors, err
+}
+
+// NodeDegree returns the number of neighbors of a node.
+func (g *StoredGraph) NodeDegree(node model.Node) (int, error) {
+	neighbors, err := g.Neighbors(node)
+	return len(neighbors), err
+}
+
+// NumberOfNodes returns the number of nodes of the stored graph.
+func (g *StoredGraph) NumberOfNodes() (int, error) {
+	count := 0
+	err := g.view(func(root *bolt.Bucket) error {
+		count = root.Bucket(nodesBucket).Stats().KeyN
+		return nil
+	})
+	return count, err
+}
+
+// ForEachNode calls fn for every node of the stored graph in increasing id order, stopping at the first error.
+// The graph must not be modified from within fn.
+func (g *StoredGraph) ForEachNode(fn func(node model.Node) error) error {
+	return g.view(func(root *bolt.Bucket) error {
+		return root.Bucket(nodesBucket).ForEach(func(key, _ []byte) error {
+			return fn(decodeNode(key))
+		})
+	})
+}
+
+// SetNodeAttribute stores a named attribute on an existing node.
+func (g *StoredGraph) SetNodeAttribute(node model.Node, key, value string) error {
+	return g.update(func(root *bolt.Bucket) error {
+		if root.Bucket(nodesBucket).Get(encodeNode(node)) == nil {
+			return fmt.Errorf("node %d not found", node)
+		}
+		return root.Bucket(nodeAttributesBucket).Put(attributeKey(encodeNode(node), key), []byte(value))
+	})
+}
+
+// NodeAttribute reads a named attribute of a node; the boolean reports whether it is set.
+func (g *StoredGraph) NodeAttribute(node model.Node, key string) (string, bool, error) {
+	return g.attribute(nodeAttributesBucket, attributeKey(encodeNode(node), key))
+}
+
+// NodeAttributes reads all the attributes of a node.
+func (g *StoredGraph) NodeAttributes(node model.Node) (map[string]string, error) {
+	return g.attributes(nodeAttributesBucket, encodeNode(node))
+}
+
+// SetEdgeAttribute stores a named attribute on an existing edge.
+func (g *StoredGraph) SetEdgeAttribute(edge model.Edge, key, value string) error {
+	return g.update(func(root *bolt.Bucket) error {
+		if !containsNode(storedNeighbors(root, edge.Node1), edge.Node2) {
+			return fmt.Errorf("edge (%d, %d) not found", edge.Node1, edge.Node2)
+		}
+		return root.Bucket(edgeAttributesBucket).Put(attributeKey(encodeEdge(edge), key), []byte(value))
+	})
+}
+
+// EdgeAttribute reads a named attribute of an edge; the boolean reports whether it is set.
+func (g *StoredGraph) EdgeAttribute(edge model.Edge, key string) (string, bool, error) {
+	return g.attribute(edgeAttributesBucket, attributeKey(encodeEdge(edge), key))
+}
+
+// EdgeAttributes reads all the attributes of an edge.
+func (g *StoredGraph) EdgeAttributes(edge model.Edge) (map[string]string, error) {
+	return g.attributes(edgeAttributesBucket, encodeEdge(edge))
+}
+
+func (g *StoredGraph) attribute(bucket []byte, key []byte) (string, bool, error) {
+	var value []byte
+	err := g.view(func(root *bolt.Bucket) error {
+		value = root.Bucket(bucket).Get(key)
+		return nil
+	})
+	return string(value), value != nil, err
+}
+
+func (g *StoredGraph) attributes(bucket []byte, prefix []byte) (map[string]string, error) {
+	attributes := make(map[string]string)
+	err := g.view(func(root *bolt.Bucket) error {
+		cursor := root.Bucket(bucket).Cursor()
+		for key, value := cursor.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, value = cursor.Next() {
+			attributes[string(key[len(prefix):])] = string(value)
+		}
+		return nil
+	})
+	return attributes, err
+}
+
+func (g *StoredGraph) view(fn func(root *bolt.Bucket) error) error {
+	return g.store.db.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket(g.name)
+		if root == nil {
+			return ErrGraphNotFound
+		}
+		return fn(root)
+	})
+}
+
+func (g *StoredGraph) update(fn func(root *bolt.Bucket) error) error {
+	return g.store.db.Update(func(tx *bolt.Tx) error {
+		root := tx.Bucket(g.name)
+		if root == nil {
+			return ErrGraphNotFound
+		}
+		return fn(root)
+	})
+}
+
+// createGraphBuckets creates the top level bucket of a graph and its sub-buckets, or returns the existing ones.
+func createGraphBuckets(tx *bolt.Tx, name []byte) (*bolt.Bucket, error) {
+	root, err := tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	for _, bucket := range [][]byte{nodesBucket, adjacencyBucket, nodeAttributesBucket, edgeAttributesBucket} {
+		if _, err := root.CreateBucketIfNotExists(bucket); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+func addStoredNode(root *bolt.Bucket, node model.Node) error {
+	key := encodeNode(node)
+	if root.Bucket(nodesBucket).Get(key) != nil {
+		return nil
+	}
+	if err := root.Bucket(nodesBucket).Put(key, nil); err != nil {
+		return err
+	}
+	return root.Bucket(adjacencyBucket).Put(key, nil)
+}
+
+func storedNeighbors(root *bolt.Bucket, node model.Node) []model.Node {
+	return decodeNodes(root.Bucket(adjacencyBucket).Get(encodeNode(node)))
+}
+
+func appendStoredNeighbor(root *bolt.Bucket, node, neighbor model.Node) error {
+	return root.Bucket(adjacencyBucket).Put(encodeNode(node), encodeNodes(append(storedNeighbors(root, node), neighbor)))
+}
+
+func removeStoredNeighbor(root *bolt.Bucket, node, neighbor model.Node) error {
+	neighbors := storedNeighbors(root, node)
+	if !containsNode(neighbors, neighbor) {
+		return nil
+	}
+	return root.Bucket(adjacencyBucket).Put(encodeNode(node), encodeNodes(model.DeleteFromSlice(neighbors, neighbor)))
+}
+
+func deleteWithPrefix(bucket *bolt.Bucket, prefix []byte) error {
+	var keys [][]byte
+	cursor := bucket.Cursor()
+	for key, _ := cursor.Seek(prefix); key != nil && bytes.HasPrefix(key, prefix); key, _ = cursor.Next() {
+		keys = append(keys, append([]byte{}, key...))
+	}
+	for _, key := range keys {
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func containsNode(nodes []model.Node, node model.Node) bool {
+	for _, n := range nodes {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
+
+// Nodes are encoded as 8 byte big-endian integers with the sign bit flipped, so that the byte order of the keys matches the numeric order.
+func encodeNode(node model.Node) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(node)^(1<<63))
+	return key
+}
+
+func decodeNode(key []byte) model.Node {
+	return model.Node(int64(binary.BigEndian.Uint64(key) ^ (1 << 63)))
+}
+
+func encodeNodes(nodes []model.Node) []byte {
+	value := make([]byte, 0, 8*len(nodes))
+	for _, node := range nodes {
+		value = append(value, encodeNode(node)...)
+	}
+	return value
+}
+
+func decodeNodes(value []byte) []model.Node {
+	nodes := make([]model.Node, 0, len(value)/8)
+	for i := 0; i+8 <= len(value); i += 8 {
+		nodes = append(nodes, decodeNode(value[i:i+8]))
+	}
+	return nodes
+}
+
+// Edges are encoded with their smaller endpoint first so that both orientations share their attributes.
+func encodeEdge(edge model.Edge) []byte {
+	if edge.Node1 > edge.Node2 {
+		edge.Node1, edge.Node2 = edge.Node2, edge.Node1
+	}
+	return append(encodeNode(edge.Node1), encodeNode(edge.Node2)...)
+}
+
+func attributeKey(prefix []byte, key string) []byte {
+	return append(append([]byte{}, prefix...), key...)
+}
@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jmCodeCraft/go-network/model"
+)
+
+func openTestStore(t *testing.T, path string) *GraphStore {
+	store, err := OpenGraphStore(path)
+	if err != nil {
+		t.Fatalf("Unexpected error opening the store: %v", err)
+	}
+	return store
+}
+
+func TestSaveAndLoadGraph(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graphs.db")
+	store := openTestStore(t, path)
+
+	g := model.CycleGraph(5)
+	g.AddNode(-3)
+	if _, err := store.SaveGraph("cycle", g); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The graph survives reopening the store
+	store = openTestStore(t, path)
+	defer store.Close()
+
+	loaded, err := store.LoadGraph("cycle")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !loaded.Equals(g) {
+		t.Errorf("Expected %v, but got %v", g, loaded)
+	}
+
+	names, err := store.Graphs()
+	if err != nil || !reflect.DeepEqual(names, []string{"cycle"}) {
+		t.Errorf("Expected [cycle], but got %v (error %v)", names, err)
+	}
+
+	if _, err := store.LoadGraph("missing"); !errors.Is(err, ErrGraphNotFound) {
+		t.Errorf("Expected ErrGraphNotFound, but got %v", err)
+	}
+
+	// Saving again replaces the graph along with its attributes
+	stored, _ := store.Graph("cycle")
+	if err := stored.SetNodeAttribute(0, "label", "old"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	path3 := model.PathGraph(3)
+	if _, err := store.SaveGraph("cycle", path3); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if loaded, _ := store.LoadGraph("cycle"); !loaded.Equals(path3) {
+		t.Errorf("Expected %v, but got %v", path3, loaded)
+	}
+	if _, ok, _ := stored.NodeAttribute(0, "label"); ok {
+		t.Errorf("Expected the attributes of the old graph to be deleted")
+	}
+}
+
+func TestStoredGraphMutations(t *testing.T) {
+	store := openTestStore(t, filepath.Join(t.TempDir(), "graphs.db"))
+	defer store.Close()
+
+	stored, err := store.CreateGraph("g")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, edge := range []model.Edge{{Node1: 1, Node2: 2}, {Node1: 2, Node2: 3}, {Node1: 1, Node2: 2}} {
+		if err := stored.AddEdge(edge); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	neighbors, err := stored.Neighbors(2)
+	if err != nil || !reflect.DeepEqual(neighbors, []model.Node{1, 3}) {
+		t.Errorf("Expected neighbors [1 3], but got %v (error %v)", neighbors, err)
+	}
+	if count, _ := stored.NumberOfNodes(); count != 3 {
+		t.Errorf("Expected 3 nodes, but got %d", count)
+	}
+
+	if err := stored.SetNodeAttribute(1, "name", "router"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := stored.SetEdgeAttribute(model.Edge{Node1: 3, Node2: 2}, "capacity", "10"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if value, ok, _ := stored.EdgeAttribute(model.Edge{Node1: 2, Node2: 3}, "capacity"); !ok || value != "10" {
+		t.Errorf("Expected capacity 10, but got %q (set: %v)", value, ok)
+	}
+	if err := stored.SetNodeAttribute(9, "name", "missing"); err == nil {
+		t.Errorf("Expected an error for a missing node, but got nil")
+	}
+
+	if err := stored.RemoveNode(2); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if hasEdge, _ := stored.HasEdge(1, 2); hasEdge {
+		t.Errorf("Expected the edge (1, 2) to be removed")
+	}
+	if attributes, _ := stored.EdgeAttributes(model.Edge{Node1: 2, Node2: 3}); len(attributes) != 0 {
+		t.Errorf("Expected the edge attributes to be removed, but got %v", attributes)
+	}
+	if attributes, _ := stored.NodeAttributes(1); !reflect.DeepEqual(attributes, map[string]string{"name": "router"}) {
+		t.Errorf("Expected the attributes of node 1 to be kept, but got %v", attributes)
+	}
+
+	var nodes []model.Node
+	_ = stored.ForEachNode(func(node model.Node) error {
+		nodes = append(nodes, node)
+		return nil
+	})
+	if !reflect.DeepEqual(nodes, []model.Node{1, 3}) {
+		t.Errorf("Expected nodes [1 3], but got %v", nodes)
+	}
+}